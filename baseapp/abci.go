@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"syscall"
 
@@ -37,6 +38,13 @@ func (app *BaseApp) InitChain(req abci.RequestInitChain) (res abci.ResponseInitC
 
 	res = app.initChainer(app.deliverState.ctx, req)
 
+	// catch inconsistent genesis states before block 1 is produced
+	if app.initChainInvariantsChecker != nil {
+		if err := app.initChainInvariantsChecker.CheckInvariants(app.deliverState.ctx); err != nil {
+			panic(fmt.Errorf("genesis state breaks invariants: %v", err))
+		}
+	}
+
 	// sanity check
 	if len(req.Validators) > 0 {
 		if len(req.Validators) != len(res.Validators) {
@@ -211,7 +219,8 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 // latest header and reset the deliver state. Also, if a non-zero halt height is
 // defined in config, Commit will execute a deferred function call to check
 // against that height and gracefully halt if it matches the latest committed
-// height.
+// height. If HaltAfterCommit was called while executing the block, Commit
+// gracefully halts once the block is committed.
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	header := app.deliverState.ctx.BlockHeader()
 
@@ -226,6 +235,7 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	}
 
 	if halt {
+		app.logger.Info("halting node per configuration", "height", app.haltHeight, "time", app.haltTime)
 		app.halt()
 
 		// Note: State is not actually committed when halted. Logs from Tendermint
@@ -249,6 +259,12 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// empty/reset the deliver state
 	app.deliverState = nil
 
+	if app.haltAfterCommit {
+		app.logger.Info("halting node after commit", "height", header.Height)
+		app.haltAfterCommit = false
+		app.halt()
+	}
+
 	return abci.ResponseCommit{
 		Data: commitID.Hash,
 	}
@@ -257,8 +273,6 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
 // back on os.Exit if both fail.
 func (app *BaseApp) halt() {
-	app.logger.Info("halting node per configuration", "height", app.haltHeight, "time", app.haltTime)
-
 	p, err := os.FindProcess(os.Getpid())
 	if err == nil {
 		// attempt cascading signals in case SIGINT fails (os dependent)
@@ -326,6 +340,12 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 				Value:     []byte(app.appVersion),
 			}
 
+		case "store_stats":
+			return handleQueryStoreStats(app, req)
+
+		case "block_timings":
+			return handleQueryBlockTimings(app)
+
 		default:
 			result = sdk.ErrUnknownRequest(fmt.Sprintf("unknown query: %s", path)).Result()
 		}
@@ -339,10 +359,61 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 		}
 	}
 
-	msg := "expected second parameter to be either 'simulate' or 'version', neither was present"
+	msg := "expected second parameter to be either 'simulate', 'version', 'store_stats' or 'block_timings', none was present"
 	return sdk.ErrUnknownRequest(msg).QueryResult()
 }
 
+// handleQueryStoreStats returns the JSON encoded size statistics of every
+// mounted store. The request data may optionally contain the maximum number of
+// keys to iterate per store as a decimal string, capped to MaxStoreStatsMaxKeys.
+// It defaults to DefaultStoreStatsMaxKeys when missing or not positive.
+func handleQueryStoreStats(app *BaseApp, req abci.RequestQuery) abci.ResponseQuery {
+	reporter, ok := app.cms.(sdk.MultiStoreStatsReporter)
+	if !ok {
+		msg := "multistore doesn't support store statistics"
+		return sdk.ErrUnknownRequest(msg).QueryResult()
+	}
+
+	maxKeys := int64(DefaultStoreStatsMaxKeys)
+	if len(req.Data) > 0 {
+		var err error
+		maxKeys, err = strconv.ParseInt(string(req.Data), 10, 64)
+		if err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("invalid max keys: %s", err)).QueryResult()
+		}
+	}
+
+	switch {
+	case maxKeys <= 0:
+		maxKeys = DefaultStoreStatsMaxKeys
+	case maxKeys > MaxStoreStatsMaxKeys:
+		maxKeys = MaxStoreStatsMaxKeys
+	}
+
+	return abci.ResponseQuery{
+		Code:      uint32(sdk.CodeOK),
+		Codespace: string(sdk.CodespaceRoot),
+		Height:    app.LastBlockHeight(),
+		Value:     codec.Cdc.MustMarshalJSON(reporter.StoreStats(maxKeys)),
+	}
+}
+
+// handleQueryBlockTimings returns the JSON encoded time spent by each module in
+// BeginBlock and EndBlock during the last block.
+func handleQueryBlockTimings(app *BaseApp) abci.ResponseQuery {
+	if app.blockTimingsReporter == nil {
+		msg := "application doesn't report block timings"
+		return sdk.ErrUnknownRequest(msg).QueryResult()
+	}
+
+	return abci.ResponseQuery{
+		Code:      uint32(sdk.CodeOK),
+		Codespace: string(sdk.CodespaceRoot),
+		Height:    app.LastBlockHeight(),
+		Value:     codec.Cdc.MustMarshalJSON(app.blockTimingsReporter.BlockTimings()),
+	}
+}
+
 func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) abci.ResponseQuery {
 	// "/store" prefix for store queries
 	queryable, ok := app.cms.(sdk.Queryable)
@@ -427,9 +498,13 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res
 	}
 
 	// cache wrap the commit-multistore for safety
+	//
+	// NOTE: the block height of the context is the queried height so that
+	// queriers relying on it are consistent with the versioned state, while the
+	// rest of the header (e.g. block time) is the one of the latest block.
 	ctx := sdk.NewContext(
 		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
+	).WithMinGasPrices(app.minGasPrices).WithBlockHeight(req.Height)
 
 	// Passes the rest of the path as an argument to the querier.
 	//
@@ -17,6 +17,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -29,6 +30,14 @@ const (
 
 	// MainStoreKey is the string representation of the main store
 	MainStoreKey = "main"
+
+	// DefaultStoreStatsMaxKeys is the default maximum number of keys iterated
+	// per store when computing store statistics.
+	DefaultStoreStatsMaxKeys = 100000
+
+	// MaxStoreStatsMaxKeys caps the maximum number of keys iterated per store
+	// requested by a store statistics query.
+	MaxStoreStatsMaxKeys = 1000000
 )
 
 var (
@@ -66,6 +75,7 @@ type BaseApp struct { // nolint: maligned
 	baseKey *sdk.KVStoreKey // Main KVStore in cms
 
 	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
+	checkTxAnte    sdk.AnteHandler  // node-local ante handler run only in CheckTx and ReCheckTx
 	initChainer    sdk.InitChainer  // initialize state with validators and state blob
 	beginBlocker   sdk.BeginBlocker // logic to run before any txs
 	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
@@ -73,6 +83,12 @@ type BaseApp struct { // nolint: maligned
 	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
 	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
+	// reports the per-module durations of the last block, if any
+	blockTimingsReporter sdk.BlockTimingsReporter
+
+	// checks the invariants of the state resulting from InitChain, if any
+	initChainInvariantsChecker sdk.InvariantsChecker
+
 	// volatile states:
 	//
 	// checkState is set on InitChain and reset on Commit
@@ -103,6 +119,9 @@ type BaseApp struct { // nolint: maligned
 	// minimum block time (in Unix seconds) at which to halt the chain and gracefully shutdown
 	haltTime uint64
 
+	// whether to gracefully shutdown once the current block is committed
+	haltAfterCommit bool
+
 	// application's version string
 	appVersion string
 }
@@ -349,10 +368,32 @@ func (app *BaseApp) setHaltTime(haltTime uint64) {
 	app.haltTime = haltTime
 }
 
+// HaltAfterCommit makes the node gracefully shutdown once the block being
+// executed is committed, in the same way as the halt-height config. Unlike the
+// latter, the block is committed, so that the node resumes from the next one
+// when restarted.
+func (app *BaseApp) HaltAfterCommit() {
+	app.haltAfterCommit = true
+}
+
 func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+// iavlCacheConfigurer is implemented by multistores which are able to size the
+// node caches of their IAVL stores.
+type iavlCacheConfigurer interface {
+	SetIAVLCacheConfig(config iavl.CacheConfig)
+}
+
+func (app *BaseApp) setIAVLCacheConfig(config iavl.CacheConfig) {
+	cms, ok := app.cms.(iavlCacheConfigurer)
+	if !ok {
+		panic(fmt.Sprintf("multistore %T doesn't support configuring IAVL node caches", app.cms))
+	}
+	cms.SetIAVLCacheConfig(config)
+}
+
 // Router returns the router of the BaseApp.
 func (app *BaseApp) Router() sdk.Router {
 	if app.sealed {
@@ -573,7 +614,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		return err.Result()
 	}
 
-	if app.anteHandler != nil {
+	if anteHandler := app.anteHandlerForMode(mode); anteHandler != nil {
 		var anteCtx sdk.Context
 		var msCache sdk.CacheMultiStore
 
@@ -586,7 +627,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		// performance benefits, but it'll be more difficult to get right.
 		anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
 
-		newCtx, err := app.anteHandler(anteCtx, tx, mode == runTxModeSimulate)
+		newCtx, err := anteHandler(anteCtx, tx, mode == runTxModeSimulate)
 		if !newCtx.IsZero() {
 			// At this point, newCtx.MultiStore() is cache-wrapped, or something else
 			// replaced by the ante handler. We want the original multistore, not one
@@ -631,6 +672,38 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	return result
 }
 
+// anteHandlerForMode returns the ante handler to run a transaction with in the
+// given mode. In CheckTx and ReCheckTx, the CheckTx-only ante decorators run
+// after the ante handler, on the context it returns. They never run in
+// DeliverTx or when simulating, so they can't affect consensus.
+func (app *BaseApp) anteHandlerForMode(mode runTxMode) sdk.AnteHandler {
+	if app.checkTxAnte == nil || (mode != runTxModeCheck && mode != runTxModeReCheck) {
+		return app.anteHandler
+	}
+
+	if app.anteHandler == nil {
+		return app.checkTxAnte
+	}
+
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		newCtx, err := app.anteHandler(ctx, tx, simulate)
+		if err != nil {
+			return newCtx, err
+		}
+
+		if newCtx.IsZero() {
+			newCtx = ctx
+		}
+
+		checkCtx, err := app.checkTxAnte(newCtx, tx, simulate)
+		if checkCtx.IsZero() {
+			checkCtx = newCtx
+		}
+
+		return checkCtx, err
+	}
+}
+
 // runMsgs iterates through all the messages and executes them.
 func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (result sdk.Result) {
 	msgLogs := make(sdk.ABCIMessageLogs, 0, len(msgs))
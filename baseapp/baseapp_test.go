@@ -3,10 +3,14 @@ package baseapp
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/signal"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -132,6 +136,36 @@ func TestLoadVersion(t *testing.T) {
 	testLoadVersionHelper(t, app, int64(2), commitID2)
 }
 
+func TestHaltAfterCommit(t *testing.T) {
+	app := setupBaseApp(t)
+	app.InitChain(abci.RequestInitChain{})
+
+	// catch the signals sent by the graceful halt instead of stopping the test
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.Commit()
+	require.Empty(t, signals)
+
+	header = abci.Header{Height: 2}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.HaltAfterCommit()
+	res := app.Commit()
+
+	// the block is committed before halting
+	require.NotEmpty(t, res.Data)
+	require.Equal(t, int64(2), app.LastBlockHeight())
+
+	select {
+	case <-signals:
+	case <-time.After(time.Second):
+		t.Fatal("node did not halt after commit")
+	}
+}
+
 func useDefaultLoader(app *BaseApp) {
 	app.SetStoreLoader(DefaultStoreLoader)
 }
@@ -290,6 +324,59 @@ func TestAppVersionSetterGetter(t *testing.T) {
 	require.Equal(t, versionString, string(res.Value))
 }
 
+func TestQueryStoreStats(t *testing.T) {
+	logger := defaultLogger()
+	db := dbm.NewMemDB()
+	app := NewBaseApp(t.Name(), logger, db, nil)
+
+	capKey := sdk.NewKVStoreKey(MainStoreKey)
+	app.MountStores(capKey)
+	require.NoError(t, app.LoadLatestVersion(capKey))
+
+	res := app.Query(abci.RequestQuery{Path: "app/store_stats"})
+	require.True(t, res.IsOK(), res.Log)
+
+	var stats []sdk.StoreStats
+	require.NoError(t, codec.Cdc.UnmarshalJSON(res.Value, &stats))
+	require.Len(t, stats, 1)
+	require.Equal(t, MainStoreKey, stats[0].Name)
+
+	// a cap which is not positive or above the maximum is bounded server-side
+	for _, data := range []string{"0", "-1", "100000000000"} {
+		res = app.Query(abci.RequestQuery{Path: "app/store_stats", Data: []byte(data)})
+		require.True(t, res.IsOK(), res.Log)
+	}
+
+	res = app.Query(abci.RequestQuery{Path: "app/store_stats", Data: []byte("invalid")})
+	require.False(t, res.IsOK())
+}
+
+type blockTimingsReporter sdk.BlockTimings
+
+func (r blockTimingsReporter) BlockTimings() sdk.BlockTimings { return sdk.BlockTimings(r) }
+
+func TestQueryBlockTimings(t *testing.T) {
+	logger := defaultLogger()
+	db := dbm.NewMemDB()
+	app := NewBaseApp(t.Name(), logger, db, nil)
+
+	res := app.Query(abci.RequestQuery{Path: "app/block_timings"})
+	require.False(t, res.IsOK())
+
+	expected := sdk.BlockTimings{
+		Height:  3,
+		Modules: []sdk.ModuleBlockTiming{{Module: "mint", BeginBlock: time.Millisecond, EndBlock: 0}},
+	}
+	app.SetBlockTimingsReporter(blockTimingsReporter(expected))
+
+	res = app.Query(abci.RequestQuery{Path: "app/block_timings"})
+	require.True(t, res.IsOK(), res.Log)
+
+	var timings sdk.BlockTimings
+	require.NoError(t, codec.Cdc.UnmarshalJSON(res.Value, &timings))
+	require.Equal(t, expected, timings)
+}
+
 func TestLoadVersionInvalid(t *testing.T) {
 	logger := log.NewNopLogger()
 	pruningOpt := SetPruning(store.PruneSyncable)
@@ -496,6 +583,34 @@ func TestInitChainer(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+type invariantsChecker struct{ err error }
+
+func (ic invariantsChecker) CheckInvariants(sdk.Context) error { return ic.err }
+
+func TestInitChainInvariantsChecker(t *testing.T) {
+	initChainer := func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		return abci.ResponseInitChain{}
+	}
+
+	app := setupBaseApp(t, func(bapp *BaseApp) {
+		bapp.SetInitChainer(initChainer)
+		bapp.SetInitChainInvariantsChecker(invariantsChecker{})
+	})
+	require.NotPanics(t, func() { app.InitChain(abci.RequestInitChain{ChainId: "test-chain-id"}) })
+
+	app = setupBaseApp(t, func(bapp *BaseApp) {
+		bapp.SetInitChainer(initChainer)
+		bapp.SetInitChainInvariantsChecker(invariantsChecker{errors.New("bank/total-supply: broken")})
+	})
+	require.PanicsWithValue(t,
+		"genesis state breaks invariants: bank/total-supply: broken",
+		func() {
+			defer func() { panic(recover().(error).Error()) }()
+			app.InitChain(abci.RequestInitChain{ChainId: "test-chain-id"})
+		},
+	)
+}
+
 // Simple tx with a list of Msgs.
 type txTest struct {
 	Msgs       []sdk.Msg
@@ -712,6 +827,80 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+// denyCounterDecorator rejects the txs with a denied counter and records the
+// modes it ran in.
+type denyCounterDecorator struct {
+	denied int64
+	modes  *[]string
+}
+
+func (d denyCounterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	mode := "check"
+	switch {
+	case ctx.IsReCheckTx():
+		mode = "recheck"
+	case !ctx.IsCheckTx():
+		mode = "deliver"
+	}
+	*d.modes = append(*d.modes, mode)
+
+	if tx.(txTest).Counter == d.denied {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "denied by node policy")
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// Test that the CheckTx ante decorators run in CheckTx and ReCheckTx only.
+func TestCheckTxAnteDecorators(t *testing.T) {
+	var modes []string
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(sdk.NewGasMeter(100)), nil
+		})
+		bapp.SetCheckTxAnteDecorators(denyCounterDecorator{denied: 1, modes: &modes})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	allowed, denied := newTxCounter(0, 0), newTxCounter(1, 0)
+	allowedBytes, err := codec.MarshalBinaryLengthPrefixed(allowed)
+	require.NoError(t, err)
+	deniedBytes, err := codec.MarshalBinaryLengthPrefixed(denied)
+	require.NoError(t, err)
+
+	res := app.CheckTx(abci.RequestCheckTx{Tx: allowedBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, int64(100), res.GasWanted)
+
+	res = app.CheckTx(abci.RequestCheckTx{Tx: deniedBytes})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Contains(t, res.Log, "denied by node policy")
+
+	res = app.CheckTx(abci.RequestCheckTx{Tx: deniedBytes, Type: abci.CheckTxType_Recheck})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, []string{"check", "check", "recheck"}, modes)
+
+	// the decorators are skipped when simulating and in DeliverTx
+	simRes := app.Simulate(deniedBytes, denied)
+	require.True(t, simRes.IsOK(), fmt.Sprintf("%v", simRes))
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	deliverRes := app.DeliverTx(abci.RequestDeliverTx{Tx: deniedBytes})
+	require.True(t, deliverRes.IsOK(), fmt.Sprintf("%v", deliverRes))
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	require.Equal(t, []string{"check", "check", "recheck"}, modes)
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -1358,6 +1547,55 @@ func TestQuery(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+func TestCustomQueryHistoricalHeight(t *testing.T) {
+	key := []byte("height")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			store := ctx.KVStore(capKey1)
+			store.Set(key, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+			return sdk.Result{}
+		})
+	}
+
+	// the querier returns the value set on the queried height and the block
+	// height of its context
+	querierOpt := func(bapp *BaseApp) {
+		bapp.QueryRouter().AddRoute("test", func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+			bz := ctx.KVStore(capKey1).Get(key)
+			return append(bz, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))...), nil
+		})
+	}
+
+	app := setupBaseApp(t, SetPruning(store.PruneNothing), routerOpt, querierOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		res := app.Deliver(newTxCounter(height, 0))
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	for _, height := range []int64{0, 1, 2, 3} {
+		res := app.Query(abci.RequestQuery{Path: "/custom/test", Height: height})
+		require.True(t, res.IsOK(), res.Log)
+
+		expected := height
+		if height == 0 {
+			expected = app.LastBlockHeight()
+		}
+
+		require.Equal(t, expected, res.Height)
+		require.Equal(t, uint64(expected), binary.BigEndian.Uint64(res.Value[:8]), "store value at height %d", height)
+		require.Equal(t, uint64(expected), binary.BigEndian.Uint64(res.Value[8:]), "context height at height %d", height)
+	}
+
+	// state of a future height can't be loaded
+	res := app.Query(abci.RequestQuery{Path: "/custom/test", Height: 4})
+	require.False(t, res.IsOK())
+}
+
 // Test p2p filter queries
 func TestP2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *BaseApp) {
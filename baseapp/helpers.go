@@ -31,3 +31,23 @@ func (app *BaseApp) NewContext(isCheckTx bool, header abci.Header) sdk.Context {
 
 	return sdk.NewContext(app.deliverState.ms, header, false, app.logger)
 }
+
+// CurrentBlockHeight returns the height of the block being delivered, or the
+// last committed height outside of a block. Used by the simulation.
+func (app *BaseApp) CurrentBlockHeight() int64 {
+	if app.deliverState == nil {
+		return app.LastBlockHeight()
+	}
+
+	return app.deliverState.ctx.BlockHeight()
+}
+
+// BlockGasConsumed returns the gas consumed so far by the transactions delivered
+// in the current block, or 0 outside of a block. Used by the simulation.
+func (app *BaseApp) BlockGasConsumed() uint64 {
+	if app.deliverState == nil || app.deliverState.ctx.BlockGasMeter() == nil {
+		return 0
+	}
+
+	return app.deliverState.ctx.BlockGasMeter().GasConsumed()
+}
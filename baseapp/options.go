@@ -7,6 +7,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -15,6 +16,10 @@ import (
 
 // SetPruning sets a pruning option on the multistore associated with the app
 func SetPruning(opts sdk.PruningOptions) func(*BaseApp) {
+	if err := opts.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid pruning options: %v", err))
+	}
+
 	return func(bap *BaseApp) { bap.cms.SetPruning(opts) }
 }
 
@@ -44,6 +49,12 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetIAVLCacheConfig provides a BaseApp option function that sets the node
+// cache sizes of the IAVL stores and the metrics they report their usage to.
+func SetIAVLCacheConfig(config iavl.CacheConfig) func(*BaseApp) {
+	return func(app *BaseApp) { app.setIAVLCacheConfig(config) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -101,6 +112,24 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+// SetCheckTxAnteDecorators sets the ante decorators that run only in CheckTx
+// and ReCheckTx, after the ante handler, to enforce node-local policies such as
+// spam heuristics or local allowlists. They are never run in DeliverTx, so
+// they can't affect consensus and may depend on the local node configuration.
+// They are not run either when simulating a transaction.
+func (app *BaseApp) SetCheckTxAnteDecorators(decorators ...sdk.AnteDecorator) {
+	if app.sealed {
+		panic("SetCheckTxAnteDecorators() on sealed BaseApp")
+	}
+
+	if len(decorators) == 0 {
+		app.checkTxAnte = nil
+		return
+	}
+
+	app.checkTxAnte = sdk.ChainAnteDecorators(decorators...)
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -122,6 +151,26 @@ func (app *BaseApp) SetFauxMerkleMode() {
 	app.fauxMerkleMode = true
 }
 
+// SetBlockTimingsReporter sets the reporter queried for the per-module
+// BeginBlock and EndBlock durations of the last block, typically the module
+// manager.
+func (app *BaseApp) SetBlockTimingsReporter(reporter sdk.BlockTimingsReporter) {
+	if app.sealed {
+		panic("SetBlockTimingsReporter() on sealed BaseApp")
+	}
+	app.blockTimingsReporter = reporter
+}
+
+// SetInitChainInvariantsChecker sets the checker running all the registered
+// invariants once the genesis state has been initialized, aborting InitChain
+// if any of them is broken.
+func (app *BaseApp) SetInitChainInvariantsChecker(checker sdk.InvariantsChecker) {
+	if app.sealed {
+		panic("SetInitChainInvariantsChecker() on sealed BaseApp")
+	}
+	app.initChainInvariantsChecker = checker
+}
+
 // SetCommitMultiStoreTracer sets the store tracer on the BaseApp's underlying
 // CommitMultiStore.
 func (app *BaseApp) SetCommitMultiStoreTracer(w io.Writer) {
@@ -7,7 +7,19 @@ import (
 )
 
 type Router struct {
-	routes map[string]sdk.Handler
+	routes      map[string]sdk.Handler
+	middlewares []routeMiddleware
+}
+
+// routeMiddleware is a middleware registered on the router along with the
+// routes it applies to. It applies to every route if routes is nil.
+type routeMiddleware struct {
+	middleware sdk.HandlerMiddleware
+	routes     map[string]bool
+}
+
+func (rm routeMiddleware) appliesTo(path string) bool {
+	return rm.routes == nil || rm.routes[path]
 }
 
 var _ sdk.Router = NewRouter()
@@ -33,9 +45,46 @@ func (rtr *Router) AddRoute(path string, h sdk.Handler) sdk.Router {
 	return rtr
 }
 
-// Route returns a handler for a given route path.
+// AddMiddleware adds a middleware wrapping the handlers of the given routes, or
+// of every route if none is given, including the routes added afterwards.
+// Middlewares are applied in the order they are added, the first one being the
+// outermost.
+func (rtr *Router) AddMiddleware(m sdk.HandlerMiddleware, routes ...string) sdk.Router {
+	if m == nil {
+		panic("middleware cannot be nil")
+	}
+
+	rm := routeMiddleware{middleware: m}
+	if len(routes) > 0 {
+		rm.routes = make(map[string]bool, len(routes))
+		for _, path := range routes {
+			if !isAlphaNumeric(path) {
+				panic("route expressions can only contain alphanumeric characters")
+			}
+			rm.routes[path] = true
+		}
+	}
+
+	rtr.middlewares = append(rtr.middlewares, rm)
+	return rtr
+}
+
+// Route returns a handler for a given route path, wrapped by the middlewares
+// applying to it.
 //
 // TODO: Handle expressive matches.
 func (rtr *Router) Route(path string) sdk.Handler {
-	return rtr.routes[path]
+	h := rtr.routes[path]
+	if h == nil {
+		return nil
+	}
+
+	chain := make([]sdk.HandlerMiddleware, 0, len(rtr.middlewares))
+	for _, rm := range rtr.middlewares {
+		if rm.appliesTo(path) {
+			chain = append(chain, rm.middleware)
+		}
+	}
+
+	return sdk.ChainHandlerMiddlewares(path, h, chain...)
 }
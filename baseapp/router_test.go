@@ -29,3 +29,43 @@ func TestRouter(t *testing.T) {
 		rtr.AddRoute("testRoute", testHandler)
 	})
 }
+
+func TestRouterMiddleware(t *testing.T) {
+	rtr := NewRouter()
+
+	var calls []string
+	middleware := func(name string) sdk.HandlerMiddleware {
+		return func(route string, next sdk.Handler) sdk.Handler {
+			return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+				calls = append(calls, name+" pre "+route)
+				res := next(ctx, msg)
+				calls = append(calls, name+" post "+route)
+				return res
+			}
+		}
+	}
+
+	rtr.AddRoute("bank", testHandler)
+	rtr.AddMiddleware(middleware("all"))
+	rtr.AddMiddleware(middleware("staking"), "staking")
+	rtr.AddRoute("staking", testHandler)
+
+	// require panic on a nil middleware or an invalid route
+	require.Panics(t, func() {
+		rtr.AddMiddleware(nil)
+	})
+	require.Panics(t, func() {
+		rtr.AddMiddleware(middleware("invalid"), "*")
+	})
+
+	rtr.Route("bank")(sdk.Context{}, nil)
+	require.Equal(t, []string{"all pre bank", "all post bank"}, calls)
+
+	// the first middleware is the outermost, and middlewares apply to the
+	// routes added after them
+	calls = nil
+	rtr.Route("staking")(sdk.Context{}, nil)
+	require.Equal(t, []string{"all pre staking", "staking pre staking", "staking post staking", "all post staking"}, calls)
+
+	require.Nil(t, rtr.Route("unknown"))
+}
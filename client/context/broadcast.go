@@ -33,6 +33,28 @@ func (ctx CLIContext) BroadcastTx(txBytes []byte) (res sdk.TxResponse, err error
 	return res, err
 }
 
+// BroadcastTxs broadcasts the given transactions one after the other, in
+// order, based on the context parameters. Broadcasting stops at the first
+// transaction returning a non-zero code, as the following ones usually depend
+// on its sequence, and the responses of the transactions broadcasted so far
+// are returned.
+func (ctx CLIContext) BroadcastTxs(txsBytes [][]byte) ([]sdk.TxResponse, error) {
+	res := make([]sdk.TxResponse, 0, len(txsBytes))
+	for i, txBytes := range txsBytes {
+		txRes, err := ctx.BroadcastTx(txBytes)
+		if err != nil {
+			return res, fmt.Errorf("failed to broadcast tx %d: %v", i, err)
+		}
+
+		res = append(res, txRes)
+		if txRes.Code != 0 {
+			break
+		}
+	}
+
+	return res, nil
+}
+
 // CheckTendermintError checks if the error returned from BroadcastTx is a
 // Tendermint error that is returned before the tx is submitted due to
 // precondition checks that failed. If an Tendermint error is detected, this
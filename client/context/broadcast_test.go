@@ -1,6 +1,7 @@
 package context
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -30,7 +31,11 @@ func (c MockClient) BroadcastTxAsync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx,
 }
 
 func (c MockClient) BroadcastTxSync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
-	return nil, c.err
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
 }
 
 func CreateContextWithErrorAndMode(err error, mode string) CLIContext {
@@ -68,3 +73,28 @@ func TestBroadcastError(t *testing.T) {
 	}
 
 }
+
+func TestBroadcastTxs(t *testing.T) {
+	txsBytes := [][]byte{{0xA, 0xB}, {0xC, 0xD}, {0xE, 0xF}}
+
+	// all the txs are broadcasted in order
+	ctx := CreateContextWithErrorAndMode(nil, flags.BroadcastSync)
+	res, err := ctx.BroadcastTxs(txsBytes)
+	require.NoError(t, err)
+	require.Len(t, res, len(txsBytes))
+	for i, txBytes := range txsBytes {
+		require.Equal(t, fmt.Sprintf("%X", tmhash.Sum(txBytes)), res[i].TxHash)
+	}
+
+	// broadcasting stops at the first failed tx
+	ctx = CreateContextWithErrorAndMode(mempool.ErrMempoolIsFull{}, flags.BroadcastSync)
+	res, err = ctx.BroadcastTxs(txsBytes)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, uint32(types.CodeMempoolIsFull), res[0].Code)
+
+	ctx = CreateContextWithErrorAndMode(errors.New("connection refused"), flags.BroadcastSync)
+	res, err = ctx.BroadcastTxs(txsBytes)
+	require.Error(t, err)
+	require.Empty(t, res)
+}
@@ -1,9 +1,14 @@
 package context
 
 import (
+	"bytes"
+	gocontext "context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
@@ -43,6 +48,16 @@ type CLIContext struct {
 	GenerateOnly  bool
 	Indent        bool
 	SkipConfirm   bool
+	DisplayUnits  bool
+
+	// Ctx is the context the calls of the RPC client created from NodeURI are
+	// bound to, each call being cancelled once it is done or Timeout elapses.
+	Ctx     gocontext.Context
+	Timeout time.Duration
+
+	// transport is the HTTP transport shared by the RPC clients created from
+	// NodeURI
+	transport http.RoundTripper
 }
 
 // NewCLIContextWithFrom returns a new initialized CLIContext with parameters from the
@@ -54,6 +69,7 @@ type CLIContext struct {
 func NewCLIContextWithFrom(from string) CLIContext {
 	var nodeURI string
 	var rpc rpcclient.Client
+	var transport http.RoundTripper
 
 	genOnly := viper.GetBool(flags.FlagGenerateOnly)
 	fromAddress, fromName, err := GetFromFields(from, genOnly)
@@ -65,7 +81,8 @@ func NewCLIContextWithFrom(from string) CLIContext {
 	if !genOnly {
 		nodeURI = viper.GetString(flags.FlagNode)
 		if nodeURI != "" {
-			rpc = rpcclient.NewHTTP(nodeURI, "/websocket")
+			transport = newRPCTransport(nodeURI)
+			rpc = newRPCClient(nodeURI, transport, nil, viper.GetDuration(flags.FlagRPCTimeout))
 		}
 	}
 
@@ -87,6 +104,9 @@ func NewCLIContextWithFrom(from string) CLIContext {
 		FromName:      fromName,
 		Indent:        viper.GetBool(flags.FlagIndentResponse),
 		SkipConfirm:   viper.GetBool(flags.FlagSkipConfirmation),
+		DisplayUnits:  viper.GetBool(flags.FlagDisplayUnits),
+		Timeout:       viper.GetDuration(flags.FlagRPCTimeout),
+		transport:     transport,
 	}
 
 	// create a verifier for the specific chain ID and RPC client
@@ -130,7 +150,31 @@ func (ctx CLIContext) WithTrustNode(trustNode bool) CLIContext {
 // WithNodeURI returns a copy of the context with an updated node URI.
 func (ctx CLIContext) WithNodeURI(nodeURI string) CLIContext {
 	ctx.NodeURI = nodeURI
-	ctx.Client = rpcclient.NewHTTP(nodeURI, "/websocket")
+	ctx.transport = newRPCTransport(nodeURI)
+	ctx.Client = newRPCClient(nodeURI, ctx.transport, ctx.Ctx, ctx.Timeout)
+	return ctx
+}
+
+// WithContext returns a copy of the context whose node queries and broadcasts
+// are cancelled once the given context is done. It only applies to the RPC
+// client created from the node URI, not to one set through WithClient.
+func (ctx CLIContext) WithContext(goCtx gocontext.Context) CLIContext {
+	ctx.Ctx = goCtx
+	if ctx.transport != nil {
+		ctx.Client = newRPCClient(ctx.NodeURI, ctx.transport, ctx.Ctx, ctx.Timeout)
+	}
+	return ctx
+}
+
+// WithTimeout returns a copy of the context whose node queries and broadcasts
+// are each cancelled once the given timeout elapses; zero disables it. It only
+// applies to the RPC client created from the node URI, not to one set through
+// WithClient.
+func (ctx CLIContext) WithTimeout(timeout time.Duration) CLIContext {
+	ctx.Timeout = timeout
+	if ctx.transport != nil {
+		ctx.Client = newRPCClient(ctx.NodeURI, ctx.transport, ctx.Ctx, ctx.Timeout)
+	}
 	return ctx
 }
 
@@ -144,6 +188,7 @@ func (ctx CLIContext) WithHeight(height int64) CLIContext {
 // instance.
 func (ctx CLIContext) WithClient(client rpcclient.Client) CLIContext {
 	ctx.Client = client
+	ctx.transport = nil
 	return ctx
 }
 
@@ -197,6 +242,13 @@ func (ctx CLIContext) WithBroadcastMode(mode string) CLIContext {
 	return ctx
 }
 
+// WithDisplayUnits returns a copy of the context with updated DisplayUnits
+// value.
+func (ctx CLIContext) WithDisplayUnits(displayUnits bool) CLIContext {
+	ctx.DisplayUnits = displayUnits
+	return ctx
+}
+
 // PrintOutput prints output while respecting output and indent flags
 // NOTE: pass in marshalled structs that have been unmarshaled
 // because this function will panic on marshaling errors
@@ -206,11 +258,14 @@ func (ctx CLIContext) PrintOutput(toPrint interface{}) error {
 		err error
 	)
 
-	switch ctx.OutputFormat {
-	case "text":
+	switch {
+	case ctx.DisplayUnits:
+		out, err = ctx.displayOutput(toPrint)
+
+	case ctx.OutputFormat == "text":
 		out, err = yaml.Marshal(&toPrint)
 
-	case "json":
+	case ctx.OutputFormat == "json":
 		if ctx.Indent {
 			out, err = ctx.Codec.MarshalJSONIndent(toPrint, "", "  ")
 		} else {
@@ -226,6 +281,49 @@ func (ctx CLIContext) PrintOutput(toPrint interface{}) error {
 	return nil
 }
 
+// displayOutput formats toPrint in the output format of the context, with
+// its coin amounts rendered in their display denominations.
+func (ctx CLIContext) displayOutput(toPrint interface{}) ([]byte, error) {
+	bz, err := ctx.Codec.MarshalJSON(toPrint)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err = ctx.FormatDisplayUnits(bz)
+	if err != nil || ctx.OutputFormat != "text" {
+		return bz, err
+	}
+
+	var obj interface{}
+	if err := json.Unmarshal(bz, &obj); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(obj)
+}
+
+// FormatDisplayUnits renders the coin amounts of a JSON response in their
+// display denominations if the context has DisplayUnits set, indenting the
+// result if the context has Indent set. Otherwise it returns the response
+// untouched.
+func (ctx CLIContext) FormatDisplayUnits(bz []byte) ([]byte, error) {
+	if !ctx.DisplayUnits {
+		return bz, nil
+	}
+
+	bz, err := sdk.DisplayCoinsJSON(bz)
+	if err != nil || !ctx.Indent {
+		return bz, err
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, bz, "", "  "); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
 // GetFromFields returns a from account address and Keybase name given either
 // an address or key name. If genOnly is true, only a valid Bech32 cosmos
 // address is returned.
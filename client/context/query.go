@@ -136,27 +136,35 @@ func (ctx CLIContext) verifyProof(queryPath string, resp abci.ResponseQuery) err
 		return err
 	}
 
-	// TODO: Instead of reconstructing, stash on CLIContext field?
-	prt := rootmulti.DefaultProofRuntime()
-
 	// TODO: Better convention for path?
 	storeName, err := parseQueryStorePath(queryPath)
 	if err != nil {
 		return err
 	}
 
+	return VerifyStoreProof(storeName, resp, commit.Header.AppHash)
+}
+
+// VerifyStoreProof verifies the multistore Merkle proof of a key query made on
+// the given store against an application hash, i.e. the one found in the header
+// following the height of the response. An empty response value is verified as
+// an absence proof.
+func VerifyStoreProof(storeName string, resp abci.ResponseQuery, appHash []byte) error {
+	// TODO: Instead of reconstructing, stash on CLIContext field?
+	prt := rootmulti.DefaultProofRuntime()
+
 	kp := merkle.KeyPath{}
 	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
 	kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
 
 	if resp.Value == nil {
-		err = prt.VerifyAbsence(resp.Proof, commit.Header.AppHash, kp.String())
+		err := prt.VerifyAbsence(resp.Proof, appHash, kp.String())
 		if err != nil {
 			return errors.Wrap(err, "failed to prove merkle proof")
 		}
 		return nil
 	}
-	err = prt.VerifyValue(resp.Proof, commit.Header.AppHash, kp.String(), resp.Value)
+	err := prt.VerifyValue(resp.Proof, appHash, kp.String(), resp.Value)
 	if err != nil {
 		return errors.Wrap(err, "failed to prove merkle proof")
 	}
@@ -164,6 +172,41 @@ func (ctx CLIContext) verifyProof(queryPath string, resp abci.ResponseQuery) err
 	return nil
 }
 
+// QueryStoreWithProof performs a key query on the given store, always asking
+// the node for a multistore Merkle proof, and returns the raw response holding
+// the value, the proof and the height. The proof is verified unless the node
+// is trusted.
+func (ctx CLIContext) QueryStoreWithProof(key cmn.HexBytes, storeName string) (abci.ResponseQuery, error) {
+	node, err := ctx.GetNode()
+	if err != nil {
+		return abci.ResponseQuery{}, err
+	}
+
+	opts := rpcclient.ABCIQueryOptions{
+		Height: ctx.Height,
+		Prove:  true,
+	}
+
+	path := fmt.Sprintf("/store/%s/key", storeName)
+	result, err := node.ABCIQueryWithOptions(path, key, opts)
+	if err != nil {
+		return abci.ResponseQuery{}, err
+	}
+
+	resp := result.Response
+	if !resp.IsOK() {
+		return abci.ResponseQuery{}, errors.New(resp.Log)
+	}
+
+	if !ctx.TrustNode {
+		if err := ctx.verifyProof(path, resp); err != nil {
+			return abci.ResponseQuery{}, err
+		}
+	}
+
+	return resp, nil
+}
+
 // queryStore performs a query to a Tendermint node with the provided a store
 // name and path. It returns the result and height of the query upon success
 // or an error if the query fails.
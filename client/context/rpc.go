@@ -0,0 +1,83 @@
+package context
+
+import (
+	gocontext "context"
+	"io"
+	"net/http"
+	"time"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	jsonrpcclient "github.com/tendermint/tendermint/rpc/lib/client"
+)
+
+// newRPCClient returns an RPC client to the node at the given URI whose calls
+// use the given HTTP transport and are bound to the given context, each of them
+// being cancelled once the context is done or the timeout, if positive, elapses.
+func newRPCClient(
+	nodeURI string, transport http.RoundTripper, ctx gocontext.Context, timeout time.Duration,
+) rpcclient.Client {
+
+	if ctx == nil {
+		ctx = gocontext.Background()
+	}
+
+	client := &http.Client{
+		Transport: contextTransport{ctx: ctx, timeout: timeout, base: transport},
+	}
+
+	return rpcclient.NewHTTPWithClient(nodeURI, "/websocket", client)
+}
+
+// newRPCTransport returns the HTTP transport of the RPC clients to the node at
+// the given URI. It is shared by the clients bound to different contexts so
+// that they reuse the same connections.
+func newRPCTransport(nodeURI string) http.RoundTripper {
+	return jsonrpcclient.DefaultHTTPClient(nodeURI).Transport
+}
+
+// contextTransport binds the HTTP requests sent through the base transport to
+// a context, each of them being cancelled once the context is done or the
+// timeout, if positive, elapses.
+type contextTransport struct {
+	ctx     gocontext.Context
+	timeout time.Duration
+	base    http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var (
+		ctx    gocontext.Context
+		cancel gocontext.CancelFunc
+	)
+
+	if t.timeout > 0 {
+		ctx, cancel = gocontext.WithTimeout(t.ctx, t.timeout)
+	} else {
+		ctx, cancel = gocontext.WithCancel(t.ctx)
+	}
+
+	res, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// the response body is read after the request returns, so the timeout
+	// covers it until it is closed
+	res.Body = cancelReadCloser{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// cancelReadCloser cancels the context of a request once its response body is
+// closed.
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel gocontext.CancelFunc
+}
+
+// Close implements io.Closer.
+func (rc cancelReadCloser) Close() error {
+	defer rc.cancel()
+	return rc.ReadCloser.Close()
+}
@@ -0,0 +1,70 @@
+package context
+
+import (
+	gocontext "context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newHungNode returns a node whose RPC calls never return, until the client
+// gives up on them. The node signals the calls it receives and those given up.
+func newHungNode() (nodeURI string, received, cancelled <-chan struct{}, closeNode func()) {
+	receivedCh, cancelledCh := make(chan struct{}, 10), make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the server notices the client going away once the request is read
+		_, _ = io.Copy(ioutil.Discard, r.Body)
+		receivedCh <- struct{}{}
+		<-r.Context().Done()
+		cancelledCh <- struct{}{}
+	}))
+
+	return "tcp://" + strings.TrimPrefix(server.URL, "http://"), receivedCh, cancelledCh, server.Close
+}
+
+func TestRPCTimeout(t *testing.T) {
+	nodeURI, _, cancelled, closeNode := newHungNode()
+	defer closeNode()
+
+	ctx := CLIContext{TrustNode: true}.WithNodeURI(nodeURI).WithTimeout(50 * time.Millisecond)
+
+	// every call gets its own timeout
+	for i := 0; i < 2; i++ {
+		_, _, err := ctx.Query("custom/foo/bar")
+		require.Error(t, err)
+		<-cancelled
+	}
+
+	_, err := ctx.BroadcastTxSync([]byte("tx"))
+	require.Error(t, err)
+	<-cancelled
+}
+
+func TestRPCContextCancellation(t *testing.T) {
+	nodeURI, received, cancelled, closeNode := newHungNode()
+	defer closeNode()
+
+	goCtx, cancel := gocontext.WithCancel(gocontext.Background())
+	ctx := CLIContext{TrustNode: true}.WithNodeURI(nodeURI).WithContext(goCtx)
+
+	errCh := make(chan error)
+	go func() {
+		_, _, err := ctx.Query("custom/foo/bar")
+		errCh <- err
+	}()
+
+	<-received
+	cancel()
+	require.Error(t, <-errCh)
+	<-cancelled
+
+	// the calls made once the context is done fail right away
+	_, _, err := ctx.Query("custom/foo/bar")
+	require.Error(t, err)
+}
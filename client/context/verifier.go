@@ -7,7 +7,6 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmlite "github.com/tendermint/tendermint/lite"
 	tmliteproxy "github.com/tendermint/tendermint/lite/proxy"
-	rpcclient "github.com/tendermint/tendermint/rpc/client"
 )
 
 const (
@@ -41,7 +40,7 @@ func CreateVerifier(ctx CLIContext, cacheSize int) (tmlite.Verifier, error) {
 	// create an RPC client based off of the RPC URI if no RPC client exists
 	client := ctx.Client
 	if client == nil {
-		client = rpcclient.NewHTTP(ctx.NodeURI, "/websocket")
+		client = newRPCClient(ctx.NodeURI, newRPCTransport(ctx.NodeURI), ctx.Ctx, ctx.Timeout)
 	}
 
 	return tmliteproxy.NewVerifier(
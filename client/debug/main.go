@@ -4,8 +4,10 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
@@ -28,6 +30,7 @@ func Cmd(cdc *codec.Codec) *cobra.Command {
 	cmd.AddCommand(PubkeyCmd(cdc))
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
+	cmd.AddCommand(CodecTypesCmd(cdc))
 
 	return cmd
 }
@@ -183,3 +186,32 @@ $ %s debug raw-bytes [72 101 108 108 111 44 32 112 108 97 121 103 114 111 117 11
 		},
 	}
 }
+
+func CodecTypesCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "codec-types",
+		Short: "List the concrete types registered with the application codec",
+		Long: fmt.Sprintf(`List the concrete types registered with the application codec
+along with their amino route names and prefix bytes, sorted by route name.
+
+Example:
+$ %s debug codec-types
+			`, version.ClientName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			types, err := codec.RegisteredTypes(cdc)
+			if err != nil {
+				return err
+			}
+
+			sort.SliceStable(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tTYPE\tPREFIX")
+			for _, t := range types {
+				fmt.Fprintln(w, t.String())
+			}
+			return w.Flush()
+		},
+	}
+}
@@ -31,6 +31,7 @@ const (
 	flagIndex       = "index"
 	flagMultisig    = "multisig"
 	flagNoSort      = "nosort"
+	flagWatchOnly   = "watch-only"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -50,7 +51,9 @@ The flag --recover allows one to recover a key from a seed passphrase.
 If run with --dry-run, a key would be generated (or recovered) but not stored to the
 local keystore.
 Use the --pubkey flag to add arbitrary public keys to the keystore for constructing
-multisig transactions.
+multisig transactions. Pass --watch-only along with --pubkey to store the public key
+as a watch-only key, which can be used to display addresses, build unsigned
+transactions and compose multisig keys but can never sign.
 
 You can add a multisig key by passing the list of key names you want the public
 key to be composed of to the --multisig flag and the minimum number of signatures
@@ -64,6 +67,7 @@ the flag --nosort is set.
 	cmd.Flags().Uint(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
 	cmd.Flags().Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	cmd.Flags().String(FlagPublicKey, "", "Parse a public key in bech32 format and save it to disk")
+	cmd.Flags().Bool(flagWatchOnly, false, "Store the key passed to --pubkey as a watch-only key that can never sign")
 	cmd.Flags().BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	cmd.Flags().Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
 	cmd.Flags().Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
@@ -95,6 +99,10 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	interactive := viper.GetBool(flagInteractive)
 	showMnemonic := !viper.GetBool(flagNoBackup)
 
+	if viper.GetBool(flagWatchOnly) && viper.GetString(FlagPublicKey) == "" {
+		return errors.New("--watch-only requires a public key to be passed with --pubkey")
+	}
+
 	if viper.GetBool(flagDryRun) {
 		// we throw this away, so don't enforce args,
 		// we want to get a new random seed phrase quickly
@@ -167,7 +175,11 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
-		_, err = kb.CreateOffline(name, pk)
+		if viper.GetBool(flagWatchOnly) {
+			_, err = kb.CreateWatchOnly(name, pk)
+		} else {
+			_, err = kb.CreateOffline(name, pk)
+		}
 		if err != nil {
 			return err
 		}
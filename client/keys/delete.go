@@ -52,7 +52,8 @@ func runDeleteCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	buf := bufio.NewReader(cmd.InOrStdin())
-	if info.GetType() == keys.TypeLedger || info.GetType() == keys.TypeOffline {
+	if info.GetType() == keys.TypeLedger || info.GetType() == keys.TypeOffline ||
+		info.GetType() == keys.TypeWatch {
 		if !viper.GetBool(flagYes) {
 			if err := confirmDeletion(buf); err != nil {
 				return err
@@ -25,6 +25,8 @@ func Commands() *cobra.Command {
 		importKeyCommand(),
 		listKeysCmd(),
 		showKeysCmd(),
+		signTextCommand(),
+		verifyTextCommand(),
 		flags.LineBreak,
 		deleteKeyCommand(),
 		updateKeyCommand(),
@@ -0,0 +1,120 @@
+package keys
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TextSignatureOutput is the output of the sign-text command.
+type TextSignatureOutput struct {
+	Signer    string `json:"signer" yaml:"signer"`
+	PubKey    string `json:"pub_key" yaml:"pub_key"`
+	Signature string `json:"signature" yaml:"signature"`
+}
+
+func signTextCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-text [name] [text]",
+		Short: "Sign an arbitrary text with a local key",
+		Long: `Sign an arbitrary off-chain text with the given key. The text is wrapped
+in a payload that can never be a valid transaction on any chain, so the
+signature can only be used to prove ownership of the account, e.g. by
+passing it to verify-text.`,
+		Args: cobra.ExactArgs(2),
+		RunE: runSignTextCmd,
+	}
+	cmd.Flags().Bool(flags.FlagIndentResponse, false, "Add indent to JSON response")
+	return cmd
+}
+
+func runSignTextCmd(cmd *cobra.Command, args []string) error {
+	kb, err := NewKeyBaseFromHomeFlag()
+	if err != nil {
+		return err
+	}
+
+	info, err := kb.Get(args[0])
+	if err != nil {
+		return err
+	}
+
+	var passphrase string
+	if info.GetType() == keys.TypeLocal {
+		buf := bufio.NewReader(cmd.InOrStdin())
+		passphrase, err = input.GetPassword(fmt.Sprintf("Password to sign with '%s':", args[0]), buf)
+		if err != nil {
+			return err
+		}
+	}
+
+	signer := info.GetAddress()
+	sig, pubKey, err := kb.Sign(args[0], passphrase, crypto.OffChainSignBytes(signer, []byte(args[1])))
+	if err != nil {
+		return err
+	}
+
+	bechPubKey, err := sdk.Bech32ifyAccPub(pubKey)
+	if err != nil {
+		return err
+	}
+
+	out := TextSignatureOutput{
+		Signer:    signer.String(),
+		PubKey:    bechPubKey,
+		Signature: base64.StdEncoding.EncodeToString(sig),
+	}
+
+	var bz []byte
+	if viper.GetBool(flags.FlagIndentResponse) {
+		bz, err = cdc.MarshalJSONIndent(out, "", "  ")
+	} else {
+		bz, err = cdc.MarshalJSON(out)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(string(bz))
+	return nil
+}
+
+func verifyTextCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify-text [pubkey] [signature] [text]",
+		Short: "Verify a text signature produced by sign-text",
+		Long: `Verify that the base64 encoded signature of the given text was produced
+by the account owning the Bech32 encoded public key.`,
+		Args: cobra.ExactArgs(3),
+		RunE: runVerifyTextCmd,
+	}
+}
+
+func runVerifyTextCmd(cmd *cobra.Command, args []string) error {
+	pubKey, err := sdk.GetAccPubKeyBech32(args[0])
+	if err != nil {
+		return err
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid signature encoding: %v", err)
+	}
+
+	signer := sdk.AccAddress(pubKey.Address())
+	if err := crypto.VerifyOffChainSignature(pubKey, signer, []byte(args[2]), sig); err != nil {
+		return err
+	}
+
+	cmd.Printf("signature of %s is valid\n", signer)
+	return nil
+}
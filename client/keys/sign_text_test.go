@@ -0,0 +1,54 @@
+package keys
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/tests"
+)
+
+func Test_runSignTextCmd(t *testing.T) {
+	signCmd := signTextCommand()
+
+	// Now add a temporary keybase
+	kbHome, cleanUp := tests.NewTestCaseDir(t)
+	defer cleanUp()
+	viper.Set(flags.FlagHome, kbHome)
+
+	kb, err := NewKeyBaseFromHomeFlag()
+	require.NoError(t, err)
+	_, err = kb.CreateAccount("keyname1", tests.TestMnemonic, "", "123456789", 0, 0)
+	require.NoError(t, err)
+
+	// wrong password
+	mockIn, _, _ := tests.ApplyMockIO(signCmd)
+	mockIn.Reset("987654321\n")
+	require.Error(t, runSignTextCmd(signCmd, []string{"keyname1", "hello"}))
+
+	// unknown key
+	mockIn.Reset("123456789\n")
+	require.Error(t, runSignTextCmd(signCmd, []string{"keyname2", "hello"}))
+
+	mockIn, mockOut, _ := tests.ApplyMockIO(signCmd)
+	mockIn.Reset("123456789\n")
+	require.NoError(t, runSignTextCmd(signCmd, []string{"keyname1", "hello"}))
+
+	var out TextSignatureOutput
+	require.NoError(t, cdc.UnmarshalJSON(mockOut.Bytes(), &out))
+
+	info, err := kb.Get("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, info.GetAddress().String(), out.Signer)
+
+	verifyCmd := verifyTextCommand()
+	_, mockOut, _ = tests.ApplyMockIO(verifyCmd)
+	require.NoError(t, runVerifyTextCmd(verifyCmd, []string{out.PubKey, out.Signature, "hello"}))
+	require.Contains(t, mockOut.String(), out.Signer)
+
+	require.Error(t, runVerifyTextCmd(verifyCmd, []string{out.PubKey, out.Signature, "bye"}))
+	require.Error(t, runVerifyTextCmd(verifyCmd, []string{out.PubKey, "not base64!", "hello"}))
+	require.Error(t, runVerifyTextCmd(verifyCmd, []string{out.Signer, out.Signature, "hello"}))
+}
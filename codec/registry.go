@@ -0,0 +1,56 @@
+package codec
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// RegisteredType describes a concrete type registered with an amino codec.
+type RegisteredType struct {
+	Type   string `json:"type"`   // Go type name
+	Name   string `json:"name"`   // amino route name
+	Prefix string `json:"prefix"` // amino prefix bytes in hex
+}
+
+func (rt RegisteredType) String() string {
+	return fmt.Sprintf("%s\t%s\t%s", rt.Name, rt.Type, rt.Prefix)
+}
+
+// RegisteredTypes returns all concrete types registered with the codec in
+// registration order. Amino does not expose its type registry directly, so the
+// table produced by PrintTypes is parsed instead.
+func RegisteredTypes(cdc *Codec) ([]RegisteredType, error) {
+	var buf bytes.Buffer
+	if err := cdc.PrintTypes(&buf); err != nil {
+		return nil, err
+	}
+
+	var types []RegisteredType
+	scanner := bufio.NewScanner(&buf)
+	for i := 0; scanner.Scan(); i++ {
+		// skip the table header and separator rows
+		if i < 2 {
+			continue
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		cols := strings.Split(strings.Trim(line, "|"), "|")
+		if len(cols) < 3 {
+			return nil, fmt.Errorf("unexpected codec type table row: %s", line)
+		}
+
+		types = append(types, RegisteredType{
+			Type:   strings.TrimSpace(cols[0]),
+			Name:   strings.TrimSpace(cols[1]),
+			Prefix: strings.TrimSpace(cols[2]),
+		})
+	}
+
+	return types, scanner.Err()
+}
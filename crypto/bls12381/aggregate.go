@@ -0,0 +1,99 @@
+package bls12381
+
+import (
+	bls "github.com/kilic/bls12-381"
+	"github.com/pkg/errors"
+)
+
+// AggregateSignatures combines the given signatures into a single signature by
+// adding their G2 points. The result can be checked with VerifyAggregate or,
+// when all signers signed the same message, with FastAggregateVerify.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+
+	g2 := bls.NewG2()
+	agg := g2.Zero()
+	for i, sig := range sigs {
+		if len(sig) != SignatureSize {
+			return nil, errors.Errorf("invalid signature length at index %d: got %d, expected %d", i, len(sig), SignatureSize)
+		}
+
+		point, err := g2.FromCompressed(sig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid signature at index %d", i)
+		}
+
+		g2.Add(agg, agg, point)
+	}
+
+	return g2.ToCompressed(agg), nil
+}
+
+// AggregatePubKeys combines the given public keys into a single public key by
+// adding their G1 points.
+//
+// CONTRACT: the aggregated key is only meaningful if each of the public keys
+// has proven possession of its private key (e.g. by signing its own public
+// key), otherwise the result is vulnerable to rogue key attacks.
+func AggregatePubKeys(pubKeys []PubKeyBls12381) (PubKeyBls12381, error) {
+	if len(pubKeys) == 0 {
+		return PubKeyBls12381{}, errors.New("no public keys to aggregate")
+	}
+
+	g1 := bls.NewG1()
+	agg := g1.Zero()
+	for i, pubKey := range pubKeys {
+		point, err := pubKey.point()
+		if err != nil {
+			return PubKeyBls12381{}, errors.Wrapf(err, "invalid public key at index %d", i)
+		}
+
+		g1.Add(agg, agg, point)
+	}
+
+	var aggPubKey PubKeyBls12381
+	copy(aggPubKey[:], g1.ToCompressed(agg))
+	return aggPubKey, nil
+}
+
+// VerifyAggregate verifies an aggregate signature where the i-th public key
+// signed the i-th message. All messages must be distinct.
+func VerifyAggregate(pubKeys []PubKeyBls12381, msgs [][]byte, sig []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) {
+		return false
+	}
+
+	seen := make(map[string]struct{}, len(msgs))
+	for _, msg := range msgs {
+		if _, ok := seen[string(msg)]; ok {
+			return false
+		}
+		seen[string(msg)] = struct{}{}
+	}
+
+	points := make([]*bls.PointG1, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		point, err := pubKey.point()
+		if err != nil {
+			return false
+		}
+		points[i] = point
+	}
+
+	return verify(points, msgs, sig)
+}
+
+// FastAggregateVerify verifies an aggregate signature where every public key
+// signed the same message.
+//
+// CONTRACT: see AggregatePubKeys regarding proofs of possession.
+func FastAggregateVerify(pubKeys []PubKeyBls12381, msg []byte, sig []byte) bool {
+	aggPubKey, err := AggregatePubKeys(pubKeys)
+	if err != nil {
+		return false
+	}
+
+	return aggPubKey.VerifyBytes(msg, sig)
+}
@@ -0,0 +1,233 @@
+// Package bls12381 implements BLS signatures over the BLS12-381 curve.
+//
+// Public keys live in G1 (48 bytes compressed) and signatures in G2 (96 bytes
+// compressed), following the "minimal-pubkey-size" variant of the IETF BLS
+// signature draft. Messages are hashed to G2 using the BLS_SIG_..._NUL_
+// ciphersuite.
+//
+// The key type is not registered with the SDK codec nor supported by the
+// default AnteHandler. It is provided so that downstream chains can experiment
+// with aggregated multi-party signing.
+package bls12381
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	bls "github.com/kilic/bls12-381"
+	"github.com/pkg/errors"
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	PrivKeyAminoName = "cosmos-sdk/PrivKeyBls12381"
+	PubKeyAminoName  = "cosmos-sdk/PubKeyBls12381"
+
+	// PrivKeySize is the size, in bytes, of a private key scalar.
+	PrivKeySize = 32
+	// PubKeySize is the size, in bytes, of a compressed G1 public key.
+	PubKeySize = 48
+	// SignatureSize is the size, in bytes, of a compressed G2 signature.
+	SignatureSize = 96
+)
+
+// DST is the domain separation tag used when hashing messages to G2.
+var DST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	RegisterAmino(cdc)
+}
+
+// RegisterAmino registers the BLS12-381 key types in the given (amino) codec.
+// The codec must already have the crypto.PubKey and crypto.PrivKey interfaces
+// registered.
+func RegisterAmino(cdc *amino.Codec) {
+	cdc.RegisterConcrete(PubKeyBls12381{}, PubKeyAminoName, nil)
+	cdc.RegisterConcrete(PrivKeyBls12381{}, PrivKeyAminoName, nil)
+}
+
+//-------------------------------------
+
+var _ crypto.PrivKey = PrivKeyBls12381{}
+
+// PrivKeyBls12381 implements crypto.PrivKey. It holds the big-endian encoding
+// of a non-zero scalar modulo the order of the BLS12-381 groups.
+type PrivKeyBls12381 [PrivKeySize]byte
+
+// GenPrivKey generates a new BLS12-381 private key using OS randomness.
+func GenPrivKey() PrivKeyBls12381 {
+	return genPrivKey(crypto.CReader())
+}
+
+func genPrivKey(rand io.Reader) PrivKeyBls12381 {
+	order := bls.NewG1().Q()
+	for {
+		var bz [PrivKeySize]byte
+		if _, err := io.ReadFull(rand, bz[:]); err != nil {
+			panic(err)
+		}
+
+		d := new(big.Int).SetBytes(bz[:])
+		if d.Sign() != 0 && d.Cmp(order) < 0 {
+			return PrivKeyBls12381(bz)
+		}
+	}
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA256 and reduces the result
+// modulo the group order to obtain a private key.
+//
+// NOTE: secret should be the output of a KDF like bcrypt if it is derived from
+// user input.
+func GenPrivKeyFromSecret(secret []byte) PrivKeyBls12381 {
+	order := bls.NewG1().Q()
+	seed := sha256.Sum256(secret)
+
+	d := new(big.Int).SetBytes(seed[:])
+	d.Mod(d, order)
+	for d.Sign() == 0 {
+		// vanishingly unlikely, but a zero scalar is not a valid key
+		seed = sha256.Sum256(seed[:])
+		d.SetBytes(seed[:])
+		d.Mod(d, order)
+	}
+
+	var privKey PrivKeyBls12381
+	dBytes := d.Bytes()
+	copy(privKey[PrivKeySize-len(dBytes):], dBytes)
+	return privKey
+}
+
+// Bytes marshals the private key using amino encoding.
+func (privKey PrivKeyBls12381) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign produces a signature on the provided message by multiplying the
+// message's hash-to-curve point in G2 with the private key scalar.
+func (privKey PrivKeyBls12381) Sign(msg []byte) ([]byte, error) {
+	g2 := bls.NewG2()
+	point, err := g2.HashToCurve(msg, DST)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := g2.MulScalarBig(g2.New(), point, privKey.scalar())
+	return g2.ToCompressed(sig), nil
+}
+
+// PubKey returns the public key corresponding to the private key, i.e. the
+// G1 generator multiplied by the private key scalar.
+func (privKey PrivKeyBls12381) PubKey() crypto.PubKey {
+	g1 := bls.NewG1()
+	point := g1.MulScalarBig(g1.New(), g1.One(), privKey.scalar())
+
+	var pubKey PubKeyBls12381
+	copy(pubKey[:], g1.ToCompressed(point))
+	return pubKey
+}
+
+// Equals returns true if the other private key is the same BLS12-381 key. It
+// runs in constant time.
+func (privKey PrivKeyBls12381) Equals(other crypto.PrivKey) bool {
+	if otherBls, ok := other.(PrivKeyBls12381); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherBls[:]) == 1
+	}
+	return false
+}
+
+func (privKey PrivKeyBls12381) scalar() *big.Int {
+	return new(big.Int).SetBytes(privKey[:])
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKeyBls12381{}
+
+// PubKeyBls12381 implements crypto.PubKey. It holds a compressed G1 point.
+type PubKeyBls12381 [PubKeySize]byte
+
+// Address returns the truncated SHA256 hash of the compressed public key.
+func (pubKey PubKeyBls12381) Address() crypto.Address {
+	return crypto.AddressHash(pubKey[:])
+}
+
+// Bytes marshals the public key using amino encoding.
+func (pubKey PubKeyBls12381) Bytes() []byte {
+	bz, err := cdc.MarshalBinaryBare(pubKey)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// VerifyBytes verifies a signature on the given message, i.e. it checks that
+// e(pubKey, H(msg)) == e(G1, sig).
+func (pubKey PubKeyBls12381) VerifyBytes(msg []byte, sig []byte) bool {
+	pk, err := pubKey.point()
+	if err != nil {
+		return false
+	}
+
+	return verify([]*bls.PointG1{pk}, [][]byte{msg}, sig)
+}
+
+// Equals returns true if the other public key is the same BLS12-381 key.
+func (pubKey PubKeyBls12381) Equals(other crypto.PubKey) bool {
+	if otherBls, ok := other.(PubKeyBls12381); ok {
+		return bytes.Equal(pubKey[:], otherBls[:])
+	}
+	return false
+}
+
+func (pubKey PubKeyBls12381) String() string {
+	return fmt.Sprintf("PubKeyBls12381{%X}", pubKey[:])
+}
+
+// point decodes the public key into a G1 point, rejecting the identity.
+func (pubKey PubKeyBls12381) point() (*bls.PointG1, error) {
+	g1 := bls.NewG1()
+	p, err := g1.FromCompressed(pubKey[:])
+	if err != nil {
+		return nil, err
+	}
+	if g1.IsZero(p) {
+		return nil, errors.New("public key is the point at infinity")
+	}
+	return p, nil
+}
+
+// verify checks an (aggregate) signature over the given public key and message
+// pairs.
+func verify(pubKeys []*bls.PointG1, msgs [][]byte, sig []byte) bool {
+	if len(sig) != SignatureSize || len(pubKeys) != len(msgs) {
+		return false
+	}
+
+	g2 := bls.NewG2()
+	sigPoint, err := g2.FromCompressed(sig)
+	if err != nil {
+		return false
+	}
+
+	engine := bls.NewEngine()
+	for i, pk := range pubKeys {
+		point, err := g2.HashToCurve(msgs[i], DST)
+		if err != nil {
+			return false
+		}
+		engine.AddPair(pk, point)
+	}
+	engine.AddPairInv(engine.G1.One(), sigPoint)
+
+	return engine.Check()
+}
@@ -0,0 +1,133 @@
+package bls12381
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, SignatureSize)
+
+	require.True(t, pubKey.VerifyBytes(msg, sig))
+
+	// mutate the message
+	msg[7] ^= byte(0x01)
+	require.False(t, pubKey.VerifyBytes(msg, sig))
+
+	// a signature from another key must not verify
+	otherSig, err := GenPrivKey().Sign(msg)
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifyBytes(msg, otherSig))
+
+	// malformed signatures are rejected
+	require.False(t, pubKey.VerifyBytes(msg, sig[:SignatureSize-1]))
+	require.False(t, pubKey.VerifyBytes(msg, make([]byte, SignatureSize)))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	secret := []byte("some secret")
+	require.Equal(t, GenPrivKeyFromSecret(secret), GenPrivKeyFromSecret(secret))
+	require.NotEqual(t, GenPrivKeyFromSecret(secret), GenPrivKeyFromSecret([]byte("other secret")))
+}
+
+func TestAddressAndEquals(t *testing.T) {
+	privKey := GenPrivKeyFromSecret([]byte("secret"))
+	pubKey := privKey.PubKey()
+
+	require.Len(t, pubKey.Address(), crypto.AddressSize)
+	require.True(t, pubKey.Equals(privKey.PubKey()))
+	require.True(t, privKey.Equals(GenPrivKeyFromSecret([]byte("secret"))))
+	require.False(t, pubKey.Equals(GenPrivKey().PubKey()))
+}
+
+func TestAminoRoundTrip(t *testing.T) {
+	privKey := GenPrivKey()
+
+	var decodedPriv crypto.PrivKey
+	require.NoError(t, cdc.UnmarshalBinaryBare(privKey.Bytes(), &decodedPriv))
+	require.True(t, privKey.Equals(decodedPriv))
+
+	var decodedPub crypto.PubKey
+	require.NoError(t, cdc.UnmarshalBinaryBare(privKey.PubKey().Bytes(), &decodedPub))
+	require.True(t, privKey.PubKey().Equals(decodedPub))
+}
+
+func TestFastAggregateVerify(t *testing.T) {
+	msg := []byte("the same message")
+
+	var (
+		pubKeys []PubKeyBls12381
+		sigs    [][]byte
+	)
+	for i := 0; i < 4; i++ {
+		privKey := GenPrivKey()
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+
+		pubKeys = append(pubKeys, privKey.PubKey().(PubKeyBls12381))
+		sigs = append(sigs, sig)
+	}
+
+	aggSig, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.True(t, FastAggregateVerify(pubKeys, msg, aggSig))
+
+	aggPubKey, err := AggregatePubKeys(pubKeys)
+	require.NoError(t, err)
+	require.True(t, aggPubKey.VerifyBytes(msg, aggSig))
+
+	// missing a signer
+	require.False(t, FastAggregateVerify(pubKeys[1:], msg, aggSig))
+	require.False(t, FastAggregateVerify(pubKeys, []byte("another message"), aggSig))
+}
+
+func TestVerifyAggregate(t *testing.T) {
+	var (
+		pubKeys []PubKeyBls12381
+		msgs    [][]byte
+		sigs    [][]byte
+	)
+	for i := 0; i < 3; i++ {
+		privKey := GenPrivKey()
+		msg := []byte{byte(i), 'm', 's', 'g'}
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+
+		pubKeys = append(pubKeys, privKey.PubKey().(PubKeyBls12381))
+		msgs = append(msgs, msg)
+		sigs = append(sigs, sig)
+	}
+
+	aggSig, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.True(t, VerifyAggregate(pubKeys, msgs, aggSig))
+
+	// swapped messages
+	require.False(t, VerifyAggregate(pubKeys, [][]byte{msgs[1], msgs[0], msgs[2]}, aggSig))
+	// mismatched lengths
+	require.False(t, VerifyAggregate(pubKeys, msgs[:2], aggSig))
+	// duplicate messages are rejected
+	require.False(t, VerifyAggregate(pubKeys[:2], [][]byte{msgs[0], msgs[0]}, aggSig))
+}
+
+func TestAggregateErrors(t *testing.T) {
+	_, err := AggregateSignatures(nil)
+	require.Error(t, err)
+
+	_, err = AggregateSignatures([][]byte{{0x01}})
+	require.Error(t, err)
+
+	_, err = AggregatePubKeys(nil)
+	require.Error(t, err)
+
+	_, err = AggregatePubKeys([]PubKeyBls12381{{}})
+	require.Error(t, err)
+}
@@ -135,6 +135,12 @@ func (kb dbKeybase) CreateMulti(name string, pub tmcrypto.PubKey) (Info, error)
 	return kb.base.writeMultisigKey(kb, name, pub), nil
 }
 
+// CreateWatchOnly creates a new reference to a watch-only public key. It
+// returns the created key info. Watch-only keys cannot sign.
+func (kb dbKeybase) CreateWatchOnly(name string, pub tmcrypto.PubKey) (Info, error) {
+	return kb.base.writeWatchOnlyKey(kb, name, pub), nil
+}
+
 // List returns the keys from storage in alphabetical order.
 func (kb dbKeybase) List() ([]Info, error) {
 	var res []Info
@@ -208,6 +214,9 @@ func (kb dbKeybase) Sign(name, passphrase string, msg []byte) (sig []byte, pub t
 
 	case offlineInfo, multiInfo:
 		return kb.base.DecodeSignature(info, msg)
+
+	case watchInfo:
+		return nil, nil, keyerror.NewErrWatchOnlyKey(name)
 	}
 
 	sig, err = priv.Sign(msg)
@@ -242,7 +251,7 @@ func (kb dbKeybase) ExportPrivateKeyObject(name string, passphrase string) (tmcr
 			return nil, err
 		}
 
-	case ledgerInfo, offlineInfo, multiInfo:
+	case ledgerInfo, offlineInfo, multiInfo, watchInfo:
 		return nil, errors.New("only works on local private keys")
 	}
 
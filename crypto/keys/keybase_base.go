@@ -190,6 +190,12 @@ func (kb baseKeybase) writeOfflineKey(w infoWriter, name string, pub tmcrypto.Pu
 	return info
 }
 
+func (kb baseKeybase) writeWatchOnlyKey(w infoWriter, name string, pub tmcrypto.PubKey) Info {
+	info := newWatchInfo(name, pub)
+	w.writeInfo(name, info)
+	return info
+}
+
 func (kb baseKeybase) writeMultisigKey(w infoWriter, name string, pub tmcrypto.PubKey) Info {
 	info := NewMultiInfo(name, pub)
 	w.writeInfo(name, info)
@@ -10,8 +10,10 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/keyerror"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -321,6 +323,46 @@ func TestExportImportPubKey(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+// TestWatchOnlyKey verifies watch-only keys expose their public information
+// and can be composed into multisig keys, but can never sign.
+func TestWatchOnlyKey(t *testing.T) {
+	cstore := NewInMemory()
+
+	pub1 := ed25519.GenPrivKey().PubKey()
+	info, err := cstore.CreateWatchOnly("cosigner", pub1)
+	require.NoError(t, err)
+	require.Equal(t, TypeWatch, info.GetType())
+	require.Equal(t, "watch", info.GetType().String())
+	require.Equal(t, pub1, info.GetPubKey())
+	require.Equal(t, sdk.AccAddress(pub1.Address()), info.GetAddress())
+
+	// the key can be looked up by name and address
+	info, err = cstore.Get("cosigner")
+	require.NoError(t, err)
+	require.Equal(t, TypeWatch, info.GetType())
+	info, err = cstore.GetByAddress(sdk.AccAddress(pub1.Address()))
+	require.NoError(t, err)
+	require.Equal(t, "cosigner", info.GetName())
+
+	// watch-only keys can never sign
+	_, _, err = cstore.Sign("cosigner", "", []byte("msg"))
+	require.True(t, keyerror.IsErrWatchOnlyKey(err))
+	_, err = cstore.ExportPrivateKeyObject("cosigner", "")
+	require.Error(t, err)
+
+	// but can be composed into a multisig key
+	local, _, err := cstore.CreateMnemonic("local", English, nums, Secp256k1)
+	require.NoError(t, err)
+	pk := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{pub1, local.GetPubKey()})
+	multi, err := cstore.CreateMulti("multi", pk)
+	require.NoError(t, err)
+	require.Equal(t, pk, multi.GetPubKey())
+
+	require.NoError(t, cstore.Delete("cosigner", "", false))
+	_, err = cstore.Get("cosigner")
+	require.True(t, keyerror.IsErrKeyNotFound(err))
+}
+
 // TestAdvancedKeyManagement verifies update, import, export functionality
 func TestAdvancedKeyManagement(t *testing.T) {
 	// make the storage with reasonable defaults
@@ -7,6 +7,7 @@ import (
 const (
 	codeKeyNotFound   = 1
 	codeWrongPassword = 2
+	codeWatchOnlyKey  = 3
 )
 
 type keybaseError interface {
@@ -79,3 +80,37 @@ func IsErrWrongPassword(err error) bool {
 	}
 	return false
 }
+
+type errWatchOnlyKey struct {
+	code int
+	name string
+}
+
+func (e errWatchOnlyKey) Code() int {
+	return e.code
+}
+
+func (e errWatchOnlyKey) Error() string {
+	return fmt.Sprintf("Key %s is watch-only and cannot sign", e.name)
+}
+
+// NewErrWatchOnlyKey returns a standardized error reflecting that the specified key is watch-only
+func NewErrWatchOnlyKey(name string) error {
+	return errWatchOnlyKey{
+		code: codeWatchOnlyKey,
+		name: name,
+	}
+}
+
+// IsErrWatchOnlyKey returns true if the given error is errWatchOnlyKey
+func IsErrWatchOnlyKey(err error) bool {
+	if err == nil {
+		return false
+	}
+	if keyErr, ok := err.(keybaseError); ok {
+		if keyErr.Code() == codeWatchOnlyKey {
+			return true
+		}
+	}
+	return false
+}
@@ -108,6 +108,12 @@ func (kb keyringKeybase) CreateMulti(name string, pub tmcrypto.PubKey) (Info, er
 	return kb.base.writeMultisigKey(kb, name, pub), nil
 }
 
+// CreateWatchOnly creates a new reference to a watch-only public key. It
+// returns the created key Info object. Watch-only keys cannot sign.
+func (kb keyringKeybase) CreateWatchOnly(name string, pub tmcrypto.PubKey) (Info, error) {
+	return kb.base.writeWatchOnlyKey(kb, name, pub), nil
+}
+
 // List returns the keys from storage in alphabetical order.
 func (kb keyringKeybase) List() ([]Info, error) {
 	var res []Info
@@ -202,6 +208,9 @@ func (kb keyringKeybase) Sign(name, passphrase string, msg []byte) (sig []byte,
 
 	case offlineInfo, multiInfo:
 		return kb.base.DecodeSignature(info, msg)
+
+	case watchInfo:
+		return nil, nil, keyerror.NewErrWatchOnlyKey(name)
 	}
 
 	sig, err = priv.Sign(msg)
@@ -233,7 +242,7 @@ func (kb keyringKeybase) ExportPrivateKeyObject(name string, passphrase string)
 			return nil, err
 		}
 
-	case ledgerInfo, offlineInfo, multiInfo:
+	case ledgerInfo, offlineInfo, multiInfo, watchInfo:
 		return nil, errors.New("only works on local private keys")
 	}
 
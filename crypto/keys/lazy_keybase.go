@@ -137,6 +137,16 @@ func (lkb lazyKeybase) CreateMulti(name string, pubkey crypto.PubKey) (info Info
 	return newDBKeybase(db).CreateMulti(name, pubkey)
 }
 
+func (lkb lazyKeybase) CreateWatchOnly(name string, pubkey crypto.PubKey) (info Info, err error) {
+	db, err := sdk.NewLevelDB(lkb.name, lkb.dir)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	return newDBKeybase(db).CreateWatchOnly(name, pubkey)
+}
+
 func (lkb lazyKeybase) Update(name, oldpass string, getNewpass func() (string, error)) error {
 	db, err := sdk.NewLevelDB(lkb.name, lkb.dir)
 	if err != nil {
@@ -50,6 +50,10 @@ type Keybase interface {
 	// CreateMulti creates, stores, and returns a new multsig (offline) key reference
 	CreateMulti(name string, pubkey crypto.PubKey) (info Info, err error)
 
+	// CreateWatchOnly creates, stores, and returns a new watch-only key reference.
+	// Watch-only keys hold a public key only and can never be used for signing.
+	CreateWatchOnly(name string, pubkey crypto.PubKey) (info Info, err error)
+
 	// The following operations will *only* work on locally-stored keys
 	Update(name, oldpass string, getNewpass func() (string, error)) error
 
@@ -94,6 +98,7 @@ const (
 	TypeLedger  KeyType = 1
 	TypeOffline KeyType = 2
 	TypeMulti   KeyType = 3
+	TypeWatch   KeyType = 4
 )
 
 var keyTypes = map[KeyType]string{
@@ -101,6 +106,7 @@ var keyTypes = map[KeyType]string{
 	TypeLedger:  "ledger",
 	TypeOffline: "offline",
 	TypeMulti:   "multi",
+	TypeWatch:   "watch",
 }
 
 // String implements the stringer interface for KeyType.
@@ -127,6 +133,7 @@ var (
 	_ Info = &ledgerInfo{}
 	_ Info = &offlineInfo{}
 	_ Info = &multiInfo{}
+	_ Info = &watchInfo{}
 )
 
 // localInfo is the public information about a locally stored key
@@ -248,6 +255,45 @@ func (i offlineInfo) GetPath() (*hd.BIP44Params, error) {
 	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
 }
 
+// watchInfo is the public information about a watch-only key. Unlike offline
+// keys, watch-only keys never produce signatures.
+type watchInfo struct {
+	Name   string        `json:"name"`
+	PubKey crypto.PubKey `json:"pubkey"`
+}
+
+func newWatchInfo(name string, pub crypto.PubKey) Info {
+	return &watchInfo{
+		Name:   name,
+		PubKey: pub,
+	}
+}
+
+// GetType implements Info interface
+func (i watchInfo) GetType() KeyType {
+	return TypeWatch
+}
+
+// GetName implements Info interface
+func (i watchInfo) GetName() string {
+	return i.Name
+}
+
+// GetPubKey implements Info interface
+func (i watchInfo) GetPubKey() crypto.PubKey {
+	return i.PubKey
+}
+
+// GetAddress implements Info interface
+func (i watchInfo) GetAddress() types.AccAddress {
+	return i.PubKey.Address().Bytes()
+}
+
+// GetPath implements Info interface
+func (i watchInfo) GetPath() (*hd.BIP44Params, error) {
+	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
+}
+
 type multisigPubKeyInfo struct {
 	PubKey crypto.PubKey `json:"pubkey"`
 	Weight uint          `json:"weight"`
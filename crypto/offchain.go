@@ -0,0 +1,107 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/json"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgSignDataRoute is the route of MsgSignData. No module handles it, so a
+// MsgSignData can never be executed on chain.
+const MsgSignDataRoute = "sign"
+
+var offChainCdc = codec.New()
+
+func init() {
+	offChainCdc.RegisterConcrete(MsgSignData{}, "cosmos-sdk/MsgSignData", nil)
+}
+
+// MsgSignData wraps an arbitrary off-chain payload signed by an account.
+type MsgSignData struct {
+	Signer sdk.AccAddress `json:"signer" yaml:"signer"`
+	Data   []byte         `json:"data" yaml:"data"`
+}
+
+var _ sdk.Msg = MsgSignData{}
+
+// NewMsgSignData creates a new MsgSignData instance.
+func NewMsgSignData(signer sdk.AccAddress, data []byte) MsgSignData {
+	return MsgSignData{Signer: signer, Data: data}
+}
+
+// Route implements sdk.Msg.
+func (msg MsgSignData) Route() string { return MsgSignDataRoute }
+
+// Type implements sdk.Msg.
+func (msg MsgSignData) Type() string { return "signData" }
+
+// ValidateBasic implements sdk.Msg.
+func (msg MsgSignData) ValidateBasic() sdk.Error {
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	if len(msg.Data) == 0 {
+		return sdk.ErrUnknownRequest("data cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgSignData) GetSignBytes() []byte {
+	return sdk.MustSortJSON(offChainCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg.
+func (msg MsgSignData) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// offChainFee mirrors an empty auth StdFee.
+type offChainFee struct {
+	Amount sdk.Coins `json:"amount"`
+	Gas    uint64    `json:"gas"`
+}
+
+// offChainSignDoc mirrors the auth StdSignDoc.
+type offChainSignDoc struct {
+	AccountNumber uint64            `json:"account_number"`
+	ChainID       string            `json:"chain_id"`
+	Fee           json.RawMessage   `json:"fee"`
+	Memo          string            `json:"memo"`
+	Msgs          []json.RawMessage `json:"msgs"`
+	Sequence      uint64            `json:"sequence"`
+}
+
+// OffChainSignBytes returns the bytes an account signs to authenticate an
+// arbitrary off-chain message, following ADR-036. Much like an EIP-191
+// personal message, the payload is domain separated from transactions: it is
+// a StdSignDoc with an empty chain ID, zero account number and sequence, an
+// empty fee and a single MsgSignData, so it cannot be replayed on any chain.
+func OffChainSignBytes(signer sdk.AccAddress, data []byte) []byte {
+	fee := offChainCdc.MustMarshalJSON(offChainFee{Amount: sdk.NewCoins()})
+	bz := offChainCdc.MustMarshalJSON(offChainSignDoc{
+		Fee:  json.RawMessage(fee),
+		Msgs: []json.RawMessage{json.RawMessage(NewMsgSignData(signer, data).GetSignBytes())},
+	})
+	return sdk.MustSortJSON(bz)
+}
+
+// VerifyOffChainSignature verifies that sig is a signature of data produced by
+// the account signer owning pubKey.
+func VerifyOffChainSignature(pubKey tmcrypto.PubKey, signer sdk.AccAddress, data, sig []byte) error {
+	if pubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "missing public key")
+	}
+	if !bytes.Equal(pubKey.Address(), signer) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "public key does not match signer %s", signer)
+	}
+	if !pubKey.VerifyBytes(OffChainSignBytes(signer, data), sig) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed")
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package crypto
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestOffChainSignBytes(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	got := string(OffChainSignBytes(addr, []byte("hello")))
+	want := fmt.Sprintf(`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"cosmos-sdk/MsgSignData","value":{"data":"aGVsbG8=","signer":"%s"}}],"sequence":"0"}`, addr)
+	require.Equal(t, want, got)
+}
+
+func TestVerifyOffChainSignature(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	data := []byte("hello")
+
+	sig, err := priv.Sign(OffChainSignBytes(addr, data))
+	require.NoError(t, err)
+
+	other := ed25519.GenPrivKey()
+	otherAddr := sdk.AccAddress(other.PubKey().Address())
+	otherSig, err := other.Sign(OffChainSignBytes(otherAddr, data))
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		signer sdk.AccAddress
+		data   []byte
+		sig    []byte
+		valid  bool
+	}{
+		"valid":         {addr, data, sig, true},
+		"wrong data":    {addr, []byte("bye"), sig, false},
+		"wrong signer":  {otherAddr, data, sig, false},
+		"wrong sig":     {addr, data, otherSig, false},
+		"tx sign bytes": {addr, data, mustSign(t, priv, []byte("hello")), false},
+		"empty sig":     {addr, data, nil, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := VerifyOffChainSignature(priv.PubKey(), tc.signer, tc.data, tc.sig)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.Error(t, VerifyOffChainSignature(nil, addr, data, sig))
+}
+
+func TestMsgSignDataValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	require.Nil(t, NewMsgSignData(addr, []byte("hello")).ValidateBasic())
+	require.NotNil(t, NewMsgSignData(nil, []byte("hello")).ValidateBasic())
+	require.NotNil(t, NewMsgSignData(addr, nil).ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr}, NewMsgSignData(addr, nil).GetSigners())
+}
+
+func mustSign(t *testing.T, priv secp256k1.PrivKeySecp256k1, msg []byte) []byte {
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+	return sig
+}
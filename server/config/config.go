@@ -2,9 +2,11 @@ package config
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/cache"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -37,7 +39,32 @@ type BaseConfig struct {
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
+	// InterBlockCacheSize is the number of entries the inter-block cache keeps
+	// per store. Zero uses the default size.
+	InterBlockCacheSize uint `mapstructure:"inter-block-cache-size"`
+
+	// IAVLCacheSizes sets the size of the node cache of IAVL stores, in nodes,
+	// by store name (e.g. acc=50000,staking=20000).
+	IAVLCacheSizes string `mapstructure:"iavl-cache-sizes"`
+
+	// IAVLCacheBudget is the number of nodes shared by the node caches of the
+	// IAVL stores without a size in IAVLCacheSizes, in proportion to their number
+	// of keys. Zero gives each of them the default cache size instead.
+	IAVLCacheBudget int `mapstructure:"iavl-cache-budget"`
+
+	// Pruning sets the pruning strategy: syncable, nothing, everything or custom.
 	Pruning string `mapstructure:"pruning"`
+
+	// PruningKeepRecent, PruningKeepEvery and PruningInterval set the number of
+	// recent states kept, the distance between the older states kept and the
+	// number of blocks between two deletions of states for the custom strategy.
+	PruningKeepRecent int64 `mapstructure:"pruning-keep-recent"`
+	PruningKeepEvery  int64 `mapstructure:"pruning-keep-every"`
+	PruningInterval   int64 `mapstructure:"pruning-interval"`
+
+	// InvariantDumpDir is the directory the dumps of the broken invariants are
+	// written to before the chain halts. Dumps are disabled when it is empty.
+	InvariantDumpDir string `mapstructure:"invariant-dump-dir"`
 }
 
 // Config defines the server's top level configuration
@@ -72,13 +99,51 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 	return gasPrices
 }
 
+// GetIAVLCacheSizes returns the sizes of the node caches of the IAVL stores
+// by store name, based on the set configuration.
+func (c *Config) GetIAVLCacheSizes() map[string]int {
+	sizes := make(map[string]int)
+	if c.IAVLCacheSizes == "" {
+		return sizes
+	}
+
+	for _, s := range strings.Split(c.IAVLCacheSizes, ",") {
+		kv := strings.Split(strings.TrimSpace(s), "=")
+		if len(kv) != 2 || kv[0] == "" {
+			panic(fmt.Errorf("failed to parse IAVL cache size (%s): expected store=size", s))
+		}
+
+		size, err := strconv.Atoi(kv[1])
+		if err != nil || size < 0 {
+			panic(fmt.Errorf("failed to parse IAVL cache size (%s): invalid size %s", s, kv[1]))
+		}
+
+		sizes[kv[0]] = size
+	}
+
+	return sizes
+}
+
+// GetPruningOptions returns the pruning options based on the set
+// configuration. An error is returned for an unknown pruning strategy or
+// invalid custom pruning values.
+func (c *Config) GetPruningOptions() (store.PruningOptions, error) {
+	return store.NewPruningOptionsFromConfig(
+		c.Pruning, c.PruningKeepRecent, c.PruningKeepEvery, c.PruningInterval,
+	)
+}
+
 // DefaultConfig returns server's default configuration.
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig{
-			MinGasPrices:    defaultMinGasPrices,
-			InterBlockCache: true,
-			Pruning:         store.PruningStrategySyncable,
+			MinGasPrices:        defaultMinGasPrices,
+			InterBlockCache:     true,
+			InterBlockCacheSize: cache.DefaultCommitKVStoreCacheSize,
+			Pruning:             store.PruningStrategySyncable,
+			PruningKeepRecent:   store.PruneSyncable.KeepRecent,
+			PruningKeepEvery:    store.PruneSyncable.KeepEvery,
+			PruningInterval:     store.PruneSyncable.Interval,
 		},
 	}
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,3 +19,41 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestGetIAVLCacheSizes(t *testing.T) {
+	cfg := DefaultConfig()
+	require.Empty(t, cfg.GetIAVLCacheSizes())
+
+	cfg.IAVLCacheSizes = "acc=50000, staking=20000"
+	require.Equal(t, map[string]int{"acc": 50000, "staking": 20000}, cfg.GetIAVLCacheSizes())
+
+	for _, sizes := range []string{"acc", "acc=", "=100", "acc=-1", "acc=1=2"} {
+		cfg.IAVLCacheSizes = sizes
+		require.Panics(t, func() { cfg.GetIAVLCacheSizes() }, sizes)
+	}
+}
+
+func TestGetPruningOptions(t *testing.T) {
+	cfg := DefaultConfig()
+	opts, err := cfg.GetPruningOptions()
+	require.NoError(t, err)
+	require.Equal(t, store.PruneSyncable, opts)
+
+	cfg.Pruning = store.PruningStrategyCustom
+	cfg.PruningKeepRecent, cfg.PruningKeepEvery, cfg.PruningInterval = 10, 0, 5
+	opts, err = cfg.GetPruningOptions()
+	require.NoError(t, err)
+	require.Equal(t, store.PruningOptions{KeepRecent: 10, KeepEvery: 0, Interval: 5}, opts)
+
+	cfg.PruningInterval = 0
+	_, err = cfg.GetPruningOptions()
+	require.Error(t, err)
+
+	cfg.PruningKeepRecent, cfg.PruningInterval = -1, 5
+	_, err = cfg.GetPruningOptions()
+	require.Error(t, err)
+
+	cfg.Pruning = "unknown"
+	_, err = cfg.GetPruningOptions()
+	require.Error(t, err)
+}
@@ -36,11 +36,41 @@ halt-time = {{ .BaseConfig.HaltTime }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
-# Pruning sets the pruning strategy: syncable, nothing, everything
+# InterBlockCacheSize is the number of entries the inter-block cache keeps per
+# store, e.g. for frequently read keys such as parameters. Zero uses the default
+# size (1000 entries).
+inter-block-cache-size = {{ .BaseConfig.InterBlockCacheSize }}
+
+# IAVLCacheSizes sets the size of the node cache of IAVL stores, in nodes,
+# by store name (e.g. acc=50000,staking=20000).
+iavl-cache-sizes = "{{ .BaseConfig.IAVLCacheSizes }}"
+
+# IAVLCacheBudget is the number of nodes shared by the node caches of the IAVL
+# stores without a size in iavl-cache-sizes, in proportion to their number of
+# keys. Zero gives each of them the default cache size (10000 nodes) instead.
+iavl-cache-budget = {{ .BaseConfig.IAVLCacheBudget }}
+
+# Pruning sets the pruning strategy: syncable, nothing, everything, custom
 # syncable: only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: all saved states will be deleted, storing only the current state
+# custom: the pruning-keep-recent, pruning-keep-every and pruning-interval values below are used
 pruning = "{{ .BaseConfig.Pruning }}"
+
+# PruningKeepRecent is the number of recent states kept by the custom strategy.
+pruning-keep-recent = {{ .BaseConfig.PruningKeepRecent }}
+
+# PruningKeepEvery keeps every Nth older state with the custom strategy,
+# 1 keeping all of them and 0 none of them.
+pruning-keep-every = {{ .BaseConfig.PruningKeepEvery }}
+
+# PruningInterval is the number of blocks between two deletions of the states
+# due for deletion with the custom strategy.
+pruning-interval = {{ .BaseConfig.PruningInterval }}
+
+# InvariantDumpDir is the directory the dumps of the broken invariants are
+# written to before the chain halts. Dumps are disabled when it is empty.
+invariant-dump-dir = "{{ .BaseConfig.InvariantDumpDir }}"
 `
 
 var configTemplate *template.Template
@@ -16,19 +16,28 @@ import (
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
+
+	"github.com/cosmos/cosmos-sdk/store"
 )
 
 // Tendermint full-node start flags
 const (
-	flagWithTendermint  = "with-tendermint"
-	flagAddress         = "address"
-	flagTraceStore      = "trace-store"
-	flagPruning         = "pruning"
-	flagCPUProfile      = "cpu-profile"
-	FlagMinGasPrices    = "minimum-gas-prices"
-	FlagHaltHeight      = "halt-height"
-	FlagHaltTime        = "halt-time"
-	FlagInterBlockCache = "inter-block-cache"
+	flagWithTendermint      = "with-tendermint"
+	flagAddress             = "address"
+	flagTraceStore          = "trace-store"
+	flagPruning             = "pruning"
+	flagCPUProfile          = "cpu-profile"
+	FlagMinGasPrices        = "minimum-gas-prices"
+	FlagHaltHeight          = "halt-height"
+	FlagHaltTime            = "halt-time"
+	FlagInterBlockCache     = "inter-block-cache"
+	FlagInterBlockCacheSize = "inter-block-cache-size"
+	FlagIAVLCacheSizes      = "iavl-cache-sizes"
+	FlagIAVLCacheBudget     = "iavl-cache-budget"
+	FlagPruningKeepRecent   = "pruning-keep-recent"
+	FlagPruningKeepEvery    = "pruning-keep-every"
+	FlagPruningInterval     = "pruning-interval"
+	FlagInvariantDumpDir    = "invariant-dump-dir"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -45,6 +54,14 @@ Pruning options can be provided via the '--pruning' flag. The options are as fol
 syncable: only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
 nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 everything: all saved states will be deleted, storing only the current state
+custom: the states are kept according to the '--pruning-keep-recent', '--pruning-keep-every' and
+'--pruning-interval' flags
+
+With the custom strategy, the last 'pruning-keep-recent' states and every 'pruning-keep-every'-th
+older one are kept, and the other states are deleted in a batch every 'pruning-interval' blocks.
+For instance, a validator may use '--pruning=custom --pruning-keep-recent=10 --pruning-keep-every=0
+--pruning-interval=10' and a query node '--pruning=custom --pruning-keep-recent=100000
+--pruning-keep-every=0 --pruning-interval=100'.
 
 Node halting configurations exist in the form of two flags: '--halt-height' and '--halt-time'. During
 the ABCI Commit phase, the node will check if the current block height is greater than or equal to
@@ -72,7 +89,10 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(flagWithTendermint, true, "Run abci app embedded in-process with tendermint")
 	cmd.Flags().String(flagAddress, "tcp://0.0.0.0:26658", "Listen address")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
-	cmd.Flags().String(flagPruning, "syncable", "Pruning strategy: syncable, nothing, everything")
+	cmd.Flags().String(flagPruning, "syncable", "Pruning strategy: syncable, nothing, everything, custom")
+	cmd.Flags().Int64(FlagPruningKeepRecent, 100, "Number of recent states kept by the custom pruning strategy")
+	cmd.Flags().Int64(FlagPruningKeepEvery, 10000, "Keep every Nth older state with the custom pruning strategy (1 keeps all, 0 none)")
+	cmd.Flags().Int64(FlagPruningInterval, 1, "Number of blocks between two deletions of states with the custom pruning strategy")
 	cmd.Flags().String(
 		FlagMinGasPrices, "",
 		"Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)",
@@ -80,6 +100,10 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Uint(FlagInterBlockCacheSize, 0, "Number of entries the inter-block cache keeps per store, the default size if zero")
+	cmd.Flags().String(FlagIAVLCacheSizes, "", "Node cache sizes of the IAVL stores by store name (e.g. acc=50000,staking=20000)")
+	cmd.Flags().Int(FlagIAVLCacheBudget, 0, "Number of nodes shared by the node caches of the IAVL stores without a configured size")
+	cmd.Flags().String(FlagInvariantDumpDir, "", "Directory the dumps of the broken invariants are written to before the chain halts, disabled if empty")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 
 	// add support for all Tendermint-specific command line options
@@ -87,6 +111,18 @@ which accepts a path for the resulting pprof file.
 	return cmd
 }
 
+// GetPruningOptionsFromFlags returns the pruning options set by the '--pruning'
+// flag or config, along with the custom values for the custom strategy. App
+// creators are expected to pass them to baseapp.SetPruning.
+func GetPruningOptionsFromFlags() (store.PruningOptions, error) {
+	return store.NewPruningOptionsFromConfig(
+		viper.GetString(flagPruning),
+		viper.GetInt64(FlagPruningKeepRecent),
+		viper.GetInt64(FlagPruningKeepEvery),
+		viper.GetInt64(FlagPruningInterval),
+	)
+}
+
 func startStandAlone(ctx *Context, appCreator AppCreator) error {
 	addr := viper.GetString(flagAddress)
 	home := viper.GetString("home")
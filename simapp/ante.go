@@ -0,0 +1,37 @@
+package simapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantante "github.com/cosmos/cosmos-sdk/x/feegrant/ante"
+	"github.com/cosmos/cosmos-sdk/x/permissions"
+	permissionsante "github.com/cosmos/cosmos-sdk/x/permissions/ante"
+)
+
+// NewAnteHandler returns the AnteHandler of the feegrant module, which deducts
+// the fees from the fee granter if the tx names one, with the msgs of the txs
+// additionally checked against the allowlists and the denylist of the
+// permissions module.
+func NewAnteHandler(
+	ak auth.AccountKeeper, supplyKeeper authtypes.SupplyKeeper, feeGrantKeeper feegrant.Keeper,
+	permsKeeper permissions.Keeper, sigGasConsumer authante.SignatureVerificationGasConsumer,
+) sdk.AnteHandler {
+
+	return sdk.ChainAnteDecorators(
+		authante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		authante.NewMempoolFeeDecorator(),
+		authante.NewValidateBasicDecorator(),
+		permissionsante.NewMsgPermissionDecorator(permsKeeper),
+		authante.NewValidateMemoDecorator(ak),
+		authante.NewConsumeGasForTxSizeDecorator(ak),
+		authante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		authante.NewValidateSigCountDecorator(ak),
+		feegrantante.NewDeductGrantedFeeDecorator(ak, supplyKeeper, feeGrantKeeper),
+		authante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		authante.NewSigVerificationDecorator(ak),
+		authante.NewIncrementSequenceDecorator(ak), // innermost AnteDecorator
+	)
+}
@@ -1,9 +1,11 @@
 package simapp
 
 import (
+	"fmt"
 	"io"
 	"os"
 
+	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/log"
@@ -11,24 +13,29 @@ import (
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
-	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
+	"github.com/cosmos/cosmos-sdk/x/permissions"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 )
 
 const appName = "SimApp"
@@ -51,18 +58,25 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler),
+		gov.NewAppModuleBasic(
+			paramsclient.ProposalHandler, distr.ProposalHandler,
+			upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		evidence.AppModuleBasic{},
+		upgrade.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		authz.AppModuleBasic{},
+		permissions.AppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
 		auth.FeeCollectorName:     nil,
 		distr.ModuleName:          nil,
-		mint.ModuleName:           {supply.Minter},
+		mint.ModuleName:           {supply.Minter, supply.Burner},
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
@@ -71,6 +85,10 @@ var (
 
 // custom tx codec
 func MakeCodec() *codec.Codec {
+	if err := ModuleBasics.ValidateCodec(); err != nil {
+		panic(err)
+	}
+
 	var cdc = codec.New()
 	ModuleBasics.RegisterCodec(cdc)
 	vesting.RegisterCodec(cdc)
@@ -107,6 +125,10 @@ type SimApp struct {
 	CrisisKeeper   crisis.Keeper
 	ParamsKeeper   params.Keeper
 	EvidenceKeeper evidence.Keeper
+	UpgradeKeeper  upgrade.Keeper
+	FeeGrantKeeper feegrant.Keeper
+	AuthzKeeper    authz.Keeper
+	PermsKeeper    permissions.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -121,18 +143,32 @@ func NewSimApp(
 	invCheckPeriod uint, baseAppOptions ...func(*bam.BaseApp),
 ) *SimApp {
 
+	keys := sdk.NewKVStoreKeys(
+		bam.MainStoreKey, auth.StoreKey, bank.StoreKey, staking.StoreKey, supply.StoreKey,
+		mint.StoreKey, distr.StoreKey, slashing.StoreKey, gov.StoreKey, params.StoreKey,
+		evidence.StoreKey, upgrade.StoreKey, feegrant.StoreKey, authz.StoreKey,
+	)
+	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
+
+	return newSimApp(logger, db, traceStore, loadLatest, invCheckPeriod, keys, tkeys, baseAppOptions...)
+}
+
+// newSimApp returns a reference to an initialized SimApp mounting the given
+// store keys. The keepers of apps sharing their store keys can access the
+// stores of one another, which lets the simulation restart an app without
+// discarding the operations bound to the keepers of the previous one.
+func newSimApp(
+	logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, invCheckPeriod uint,
+	keys map[string]*sdk.KVStoreKey, tkeys map[string]*sdk.TransientStoreKey,
+	baseAppOptions ...func(*bam.BaseApp),
+) *SimApp {
+
 	cdc := MakeCodec()
 
 	bApp := bam.NewBaseApp(appName, logger, db, auth.DefaultTxDecoder(cdc), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
 
-	keys := sdk.NewKVStoreKeys(
-		bam.MainStoreKey, auth.StoreKey, staking.StoreKey, supply.StoreKey, mint.StoreKey,
-		distr.StoreKey, slashing.StoreKey, gov.StoreKey, params.StoreKey, evidence.StoreKey,
-	)
-	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
-
 	app := &SimApp{
 		BaseApp:        bApp,
 		cdc:            cdc,
@@ -153,15 +189,18 @@ func NewSimApp(
 	app.subspaces[gov.ModuleName] = app.ParamsKeeper.Subspace(gov.DefaultParamspace).WithKeyTable(gov.ParamKeyTable())
 	app.subspaces[crisis.ModuleName] = app.ParamsKeeper.Subspace(crisis.DefaultParamspace)
 	app.subspaces[evidence.ModuleName] = app.ParamsKeeper.Subspace(evidence.DefaultParamspace)
+	app.subspaces[permissions.ModuleName] = app.ParamsKeeper.Subspace(permissions.DefaultParamspace)
 
 	// add keepers
 	app.AccountKeeper = auth.NewAccountKeeper(
 		app.cdc, keys[auth.StoreKey], app.subspaces[auth.ModuleName], auth.ProtoBaseAccount,
 	)
-	app.BankKeeper = bank.NewBaseKeeper(
-		app.AccountKeeper, app.subspaces[bank.ModuleName], bank.DefaultCodespace,
-		app.ModuleAccountAddrs(),
+	bankKeeper := bank.NewBaseKeeper(
+		app.cdc, keys[bank.StoreKey], app.AccountKeeper, app.subspaces[bank.ModuleName],
+		bank.DefaultCodespace, app.ModuleAccountAddrs(),
 	)
+	// the sends from and to the module accounts aren't rate limited
+	app.BankKeeper = bankKeeper.SetHooks(bank.NewSendRateLimitHooks(bankKeeper, app.ModuleAccountAddrs()))
 	app.SupplyKeeper = supply.NewKeeper(
 		app.cdc, keys[supply.StoreKey], app.AccountKeeper, app.BankKeeper, maccPerms,
 	)
@@ -179,28 +218,47 @@ func NewSimApp(
 	app.SlashingKeeper = slashing.NewKeeper(
 		app.cdc, keys[slashing.StoreKey], &stakingKeeper, app.subspaces[slashing.ModuleName], slashing.DefaultCodespace,
 	)
+	app.SlashingKeeper.SetMetrics(getAppMetrics().slashing)
 	app.CrisisKeeper = crisis.NewKeeper(
 		app.subspaces[crisis.ModuleName], invCheckPeriod, app.SupplyKeeper, auth.FeeCollectorName,
 	)
+	app.CrisisKeeper.SetInvariantDumpDir(viper.GetString(server.FlagInvariantDumpDir))
+	app.UpgradeKeeper = upgrade.NewKeeper(keys[upgrade.StoreKey], app.cdc)
+	app.FeeGrantKeeper = feegrant.NewKeeper(
+		app.cdc, keys[feegrant.StoreKey], app.AccountKeeper, feegrant.DefaultCodespace,
+	)
+	app.AuthzKeeper = authz.NewKeeper(
+		app.cdc, keys[authz.StoreKey], app.Router(), authz.DefaultCodespace,
+	)
+	app.PermsKeeper = permissions.NewKeeper(
+		app.subspaces[permissions.ModuleName], permissions.DefaultCodespace,
+	)
 
 	// create evidence keeper with router
 	evidenceKeeper := evidence.NewKeeper(
 		app.cdc, keys[evidence.StoreKey], app.subspaces[evidence.ModuleName], evidence.DefaultCodespace,
+		&stakingKeeper, app.SlashingKeeper,
 	)
+	// NOTE: equivocation evidence from Tendermint is handled in BeginBlock and
+	// is not routed, as it cannot be verified when submitted by users
 	evidenceRouter := evidence.NewRouter()
-	// TODO: Register evidence routes.
 	evidenceKeeper.SetRouter(evidenceRouter)
 	app.EvidenceKeeper = *evidenceKeeper
 
 	// register the proposal types
 	govRouter := gov.NewRouter()
-	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
+	// NOTE: the gov keeper is passed by reference, so that the handler uses it
+	// once it is created below
+	govRouter.AddRoute(gov.RouterKey, gov.NewProposalHandler(&app.GovKeeper)).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper))
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc, keys[gov.StoreKey], app.subspaces[gov.ModuleName], app.SupplyKeeper,
 		&stakingKeeper, gov.DefaultCodespace, govRouter,
 	)
+	// stop the node once the block of a chain halt passed by governance is committed
+	app.GovKeeper.SetHaltHandler(app.BaseApp.HaltAfterCommit)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -216,31 +274,54 @@ func NewSimApp(
 		bank.NewAppModule(app.BankKeeper, app.AccountKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper),
 		supply.NewAppModule(app.SupplyKeeper, app.AccountKeeper),
-		gov.NewAppModule(app.GovKeeper, app.SupplyKeeper),
-		mint.NewAppModule(app.MintKeeper),
-		distr.NewAppModule(app.DistrKeeper, app.SupplyKeeper),
-		slashing.NewAppModule(app.SlashingKeeper, app.StakingKeeper),
+		gov.NewAppModule(app.GovKeeper, app.AccountKeeper, app.SupplyKeeper),
+		mint.NewAppModule(app.MintKeeper, nil),
+		distr.NewAppModule(app.DistrKeeper, app.AccountKeeper, app.SupplyKeeper, app.StakingKeeper),
+		slashing.NewAppModule(app.SlashingKeeper, app.AccountKeeper, app.StakingKeeper),
 		staking.NewAppModule(app.StakingKeeper, app.AccountKeeper, app.SupplyKeeper),
-		evidence.NewAppModule(app.EvidenceKeeper),
+		evidence.NewAppModule(app.EvidenceKeeper, app.StakingKeeper),
+		upgrade.NewAppModule(app.UpgradeKeeper),
+		feegrant.NewAppModule(app.FeeGrantKeeper, app.AccountKeeper),
+		authz.NewAppModule(app.AuthzKeeper, app.AccountKeeper, app.BankKeeper),
+		permissions.NewAppModule(app.PermsKeeper),
 	)
+	app.mm.SetMetrics(getAppMetrics().module)
 
+	// NOTE: The upgrade module must occur first in begin block, so that the
+	// chain halts or the upgrade is applied before any other state transition.
+	//
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName, evidence.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, distr.ModuleName)
 
 	// NOTE: The genutils moodule must occur after staking so that pools are
-	// properly initialized with tokens from genesis accounts.
+	// properly initialized with tokens from genesis accounts, and after
+	// permissions so that the genesis txs are subject to its allowlists.
 	app.mm.SetOrderInitGenesis(
 		auth.ModuleName, distr.ModuleName, staking.ModuleName, bank.ModuleName,
 		slashing.ModuleName, gov.ModuleName, mint.ModuleName, supply.ModuleName,
-		crisis.ModuleName, genutil.ModuleName, evidence.ModuleName,
+		crisis.ModuleName, permissions.ModuleName, genutil.ModuleName, evidence.ModuleName, feegrant.ModuleName,
+		authz.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
+
+	// register the stores included in the dumps of the broken invariants of
+	// each module
+	app.CrisisKeeper.RegisterDumpStores(bank.ModuleName, keys[auth.StoreKey])
+	app.CrisisKeeper.RegisterDumpStores(staking.ModuleName, keys[staking.StoreKey])
+	app.CrisisKeeper.RegisterDumpStores(distr.ModuleName, keys[distr.StoreKey])
+	app.CrisisKeeper.RegisterDumpStores(gov.ModuleName, keys[gov.StoreKey])
+	app.CrisisKeeper.RegisterDumpStores(supply.ModuleName, keys[supply.StoreKey], keys[auth.StoreKey])
+
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 
+	// check the permissions of every routed msg, including the msgs executed
+	// through the authz module, and not only of the msgs of the txs
+	app.Router().AddMiddleware(permissions.NewHandlerMiddleware(app.PermsKeeper))
+
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
 	// NOTE: this is not required apps that don't use the simulator for fuzz testing
@@ -249,11 +330,15 @@ func NewSimApp(
 		auth.NewAppModule(app.AccountKeeper),
 		bank.NewAppModule(app.BankKeeper, app.AccountKeeper),
 		supply.NewAppModule(app.SupplyKeeper, app.AccountKeeper),
-		gov.NewAppModule(app.GovKeeper, app.SupplyKeeper),
-		mint.NewAppModule(app.MintKeeper),
-		distr.NewAppModule(app.DistrKeeper, app.SupplyKeeper),
+		gov.NewAppModule(app.GovKeeper, app.AccountKeeper, app.SupplyKeeper),
+		mint.NewAppModule(app.MintKeeper, nil),
+		distr.NewAppModule(app.DistrKeeper, app.AccountKeeper, app.SupplyKeeper, app.StakingKeeper),
 		staking.NewAppModule(app.StakingKeeper, app.AccountKeeper, app.SupplyKeeper),
-		slashing.NewAppModule(app.SlashingKeeper, app.StakingKeeper),
+		slashing.NewAppModule(app.SlashingKeeper, app.AccountKeeper, app.StakingKeeper),
+		evidence.NewAppModule(app.EvidenceKeeper, app.StakingKeeper),
+		feegrant.NewAppModule(app.FeeGrantKeeper, app.AccountKeeper),
+		authz.NewAppModule(app.AuthzKeeper, app.AccountKeeper, app.BankKeeper),
+		params.NewAppModule(app.ParamsKeeper), // NOTE: only used for simulation to generate randomized param changes
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -264,9 +349,20 @@ func NewSimApp(
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
+	app.SetInitChainInvariantsChecker(&app.CrisisKeeper)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(
+		NewAnteHandler(
+			app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, app.PermsKeeper,
+			auth.DefaultSigVerificationGasConsumer,
+		),
+	)
 	app.SetEndBlocker(app.EndBlocker)
+	app.SetBlockTimingsReporter(app.mm)
+
+	// load the stores applying the store migrations set for a pending upgrade,
+	// if any
+	app.SetStoreLoader(app.UpgradeKeeper.StoreLoader())
 
 	if loadLatest {
 		err := app.LoadLatestVersion(app.keys[bam.MainStoreKey])
@@ -339,6 +435,76 @@ func (app *SimApp) GetSubspace(moduleName string) params.Subspace {
 	return app.subspaces[moduleName]
 }
 
+// SimulationManager implements the SimulationApp interface
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *SimApp) SimulationManager() *module.SimulationManager {
+	return app.sm
+}
+
+// invariantStores maps the modules registering invariants to the stores read by
+// their invariants, which are dumped when one of them is found broken.
+var invariantStores = map[string][]string{
+	bank.ModuleName:    {auth.StoreKey},
+	supply.ModuleName:  {supply.StoreKey, auth.StoreKey},
+	gov.ModuleName:     {gov.StoreKey, supply.StoreKey, auth.StoreKey},
+	distr.ModuleName:   {distr.StoreKey, staking.StoreKey},
+	staking.ModuleName: {staking.StoreKey, supply.StoreKey, auth.StoreKey},
+}
+
+// CheckInvariants runs all the invariants registered in the crisis keeper,
+// implementing the simulation InvariantsChecker interface.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *SimApp) CheckInvariants(ctx sdk.Context) error {
+	return app.CrisisKeeper.CheckInvariants(ctx)
+}
+
+// DumpInvariantStores returns the key-value pairs of each of the stores read by
+// the invariants of a module, implementing the simulation InvariantsChecker
+// interface.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *SimApp) DumpInvariantStores(ctx sdk.Context, moduleName string) map[string][]string {
+	dump := make(map[string][]string)
+	for _, storeName := range invariantStores[moduleName] {
+		var kvs []string
+
+		iter := ctx.KVStore(app.keys[storeName]).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			kvs = append(kvs, fmt.Sprintf("%X => %X", iter.Key(), iter.Value()))
+		}
+		iter.Close()
+
+		dump[storeName] = kvs
+	}
+
+	return dump
+}
+
+// DiffInvariantStores returns the decoded key-value pairs that differ between
+// the committed and the current state of each of the stores read by the
+// invariants of a module, implementing the simulation InvariantsChecker
+// interface.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *SimApp) DiffInvariantStores(committedCtx, ctx sdk.Context, moduleName string) map[string][]string {
+	diffs := make(map[string][]string)
+	for _, storeName := range invariantStores[moduleName] {
+		var logs []string
+
+		key := app.keys[storeName]
+		kvAs, kvBs := DiffKVStoresByKey(committedCtx.KVStore(key), ctx.KVStore(key))
+		for i := range kvAs {
+			logs = append(logs, DecodeKVPair(storeName, app.sm.StoreDecoders, app.cdc, kvAs[i], kvBs[i]))
+		}
+
+		diffs[storeName] = logs
+	}
+
+	return diffs
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 func GetMaccPerms() map[string][]string {
 	dupMaccPerms := make(map[string][]string)
@@ -1,6 +1,7 @@
 package simapp
 
 import (
+	"math/rand"
 	"os"
 	"testing"
 
@@ -46,6 +47,35 @@ func TestBlackListedAddrs(t *testing.T) {
 	}
 }
 
+// ensure that every randomized param change of the simulation can be applied
+// to its subspace and leaves the app state consistent
+func TestSimulationParamChanges(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	paramChanges := app.SimulationManager().GenerateParamChanges(1)
+	require.NotEmpty(t, paramChanges)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		for _, pc := range paramChanges {
+			ss := app.GetSubspace(pc.Subspace)
+			require.Equal(t, pc.Subspace, ss.Name())
+
+			var err error
+			value := pc.SimValue(r)
+			if len(pc.Subkey) == 0 {
+				err = ss.Update(ctx, []byte(pc.Key), []byte(value))
+			} else {
+				err = ss.UpdateWithSubkey(ctx, []byte(pc.Key), []byte(pc.Subkey), []byte(value))
+			}
+			require.NoError(t, err, "%s: %s", pc.ComposedKey(), value)
+		}
+
+		require.NoError(t, app.CheckInvariants(ctx))
+	}
+}
+
 func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
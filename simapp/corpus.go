@@ -0,0 +1,74 @@
+package simapp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// ReplaySimulation re-executes a simulation replay file in a new app, as a
+// subtest of t with the given name, checking every block of the replay. It
+// returns true if the replay passed. Panics are recovered and fail the subtest
+// only.
+//
+// NOTE: This is solely to be used for testing purposes.
+func ReplaySimulation(t *testing.T, config simulation.Config, name, replayPath string) bool {
+	config.ChainID = helpers.SimAppChainID
+	config.ReplayFile = replayPath
+	config.ReplayFromHeight = 0
+	config.ExportReplayPath = ""
+	config.CorpusDir = ""
+
+	return t.Run(name, func(t *testing.T) {
+		app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, FlagPeriodValue, func(bapp *bam.BaseApp) {
+			bapp.SetFauxMerkleMode()
+		})
+		config.InvariantsChecker = app
+		config.ImportExportChecker = app
+		config.FeeAccountingChecker = app
+
+		// the simulation re-raises panics once its logs are printed
+		defer func() {
+			if r := recover(); r != nil {
+				t.Errorf("replay of %s panicked: %v", replayPath, r)
+			}
+		}()
+
+		_, _, err := simulation.SimulateFromSeed(
+			t, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.sm),
+			SimulationOperations(app, app.Codec(), config), app.ModuleAccountAddrs(), config,
+		)
+		if err != nil {
+			t.Errorf("replay of %s failed: %v", replayPath, err)
+		}
+	})
+}
+
+// MinimizeSimulationReplay bisects a failing simulation replay to its shortest
+// failing prefix. Every candidate prefix is written to dir and replayed as a
+// subtest of t, so the failing candidates fail t as well. Failures which are
+// only detected periodically, such as broken invariants, should be checked on
+// every block so that each candidate detects them.
+//
+// NOTE: This is solely to be used for testing purposes.
+func MinimizeSimulationReplay(t *testing.T, config simulation.Config, replay *simulation.Replay, dir string) *simulation.Replay {
+	var candidates int
+	return simulation.MinimizeReplay(replay, func(candidate *simulation.Replay) bool {
+		candidates++
+		path := filepath.Join(dir, fmt.Sprintf("candidate-%d.json", candidates))
+		if err := candidate.ExportJSON(path); err != nil {
+			t.Fatal(err)
+		}
+
+		name := fmt.Sprintf("candidate-%d-%d-blocks", candidates, len(candidate.Checkpoints))
+		return !ReplaySimulation(t, config, name, path)
+	})
+}
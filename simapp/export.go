@@ -164,4 +164,11 @@ func (app *SimApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []str
 			return false
 		},
 	)
+
+	/* Handle fee grant state. */
+
+	// make height based expirations relative to the export height
+	for _, grant := range app.FeeGrantKeeper.GetAllFeeAllowances(ctx) {
+		app.FeeGrantKeeper.GrantFeeAllowance(ctx, grant.PrepareForExport(ctx.BlockTime(), ctx.BlockHeight()))
+	}
 }
@@ -0,0 +1,80 @@
+package simapp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// CheckFeeAccounting checks that the fees collected on the previous block are
+// accounted for by BeginBlock, implementing the simulation FeeAccountingChecker
+// interface:
+//   - the fee collector is drained, its fees and the minted provisions being
+//     handed over to the distribution module
+//   - the mint module account doesn't keep any of the fees it burns or the
+//     provisions it mints
+//   - the total supply only changes by the minted provisions, the burned fees
+//     and the tokens burned by slashing from the staking pools
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *SimApp) CheckFeeAccounting(committedCtx, ctx sdk.Context) error {
+	var failures []string
+
+	feeCollectorAddr := app.SupplyKeeper.GetModuleAddress(auth.FeeCollectorName)
+	collected := app.BankKeeper.GetCoins(committedCtx, feeCollectorAddr)
+	if left := app.BankKeeper.GetCoins(ctx, feeCollectorAddr); !left.IsZero() {
+		failures = append(failures, fmt.Sprintf("fee collector holds %s after BeginBlock; %s were collected on the previous block", left, collected))
+	}
+
+	mintAddr := app.SupplyKeeper.GetModuleAddress(mint.ModuleName)
+	if left := app.BankKeeper.GetCoins(ctx, mintAddr); !left.IsZero() {
+		failures = append(failures, fmt.Sprintf("mint module account holds %s after BeginBlock", left))
+	}
+
+	// the burn rate in effect is the committed one, as parameter changes are
+	// only applied on EndBlock
+	burned := sdk.NewCoins()
+	if rate := app.MintKeeper.GetParams(committedCtx).FeeBurnRate; rate.IsPositive() {
+		for _, fee := range collected {
+			amt := fee.Amount.ToDec().Mul(rate).TruncateInt()
+			if amt.IsPositive() {
+				burned = burned.Add(sdk.NewCoins(sdk.NewCoin(fee.Denom, amt)))
+			}
+		}
+	}
+
+	// the minter stored by BeginBlock holds the provisions of the block
+	minted := sdk.NewCoins(app.MintKeeper.GetMinter(ctx).BlockProvision(app.MintKeeper.GetParams(ctx)))
+
+	// slashing burns the tokens of the staking pools; jailing only moves them
+	// from one pool to the other
+	slashed, _ := app.stakingPools(committedCtx).SafeSub(app.stakingPools(ctx))
+
+	supplyBefore := app.SupplyKeeper.GetSupply(committedCtx).GetTotal()
+	supplyAfter := app.SupplyKeeper.GetSupply(ctx).GetTotal()
+	expected := supplyBefore.Add(minted).Sub(burned)
+	if diff, _ := supplyAfter.Add(slashed).SafeSub(expected); !diff.IsZero() {
+		failures = append(failures, fmt.Sprintf(
+			"total supply went from %s to %s; expected %s minted, %s of the %s collected fees burned and %s slashed",
+			supplyBefore, supplyAfter, minted, burned, collected, slashed,
+		))
+	}
+
+	if len(failures) != 0 {
+		return errors.New(strings.Join(failures, "\n"))
+	}
+
+	return nil
+}
+
+// stakingPools returns the coins held by the bonded and not bonded pools
+func (app *SimApp) stakingPools(ctx sdk.Context) sdk.Coins {
+	bonded := app.BankKeeper.GetCoins(ctx, app.SupplyKeeper.GetModuleAddress(staking.BondedPoolName))
+	notBonded := app.BankKeeper.GetCoins(ctx, app.SupplyKeeper.GetModuleAddress(staking.NotBondedPoolName))
+	return bonded.Add(notBonded)
+}
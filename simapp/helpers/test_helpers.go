@@ -16,9 +16,20 @@ const SimAppChainID = "simulation-app"
 
 // GenTx generates a signed mock transaction.
 func GenTx(msgs []sdk.Msg, feeAmt sdk.Coins, chainID string, accnums []uint64, seq []uint64, priv ...crypto.PrivKey) auth.StdTx {
+	return GenTxWithFeeAccount(msgs, feeAmt, nil, chainID, accnums, seq, priv...)
+}
+
+// GenTxWithFeeAccount generates a signed mock transaction whose fees are paid
+// by the given fee account through a fee allowance.
+func GenTxWithFeeAccount(
+	msgs []sdk.Msg, feeAmt sdk.Coins, feeAccount sdk.AccAddress, chainID string,
+	accnums []uint64, seq []uint64, priv ...crypto.PrivKey,
+) auth.StdTx {
+
 	fee := auth.StdFee{
-		Amount: feeAmt,
-		Gas:    1000000, // TODO: this should be a param
+		Amount:     feeAmt,
+		Gas:        simulation.DefaultGenTxGas,
+		FeeAccount: feeAccount,
 	}
 
 	sigs := make([]auth.StdSignature, len(priv))
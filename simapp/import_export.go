@@ -0,0 +1,156 @@
+package simapp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// importExportStore is a store compared between an app and the app imported
+// from its exported state, along with the prefixes of the keys whose values
+// aren't compared.
+type importExportStore struct {
+	Name     string
+	Prefixes [][]byte
+}
+
+// importExportStores are the stores compared by the import/export checks
+var importExportStores = []importExportStore{
+	{bam.MainStoreKey, [][]byte{}},
+	{auth.StoreKey, [][]byte{}},
+	{bank.StoreKey, [][]byte{}},
+	{staking.StoreKey, [][]byte{
+		staking.UnbondingQueueKey, staking.RedelegationQueueKey, staking.ValidatorQueueKey,
+	}}, // ordering may change but it doesn't matter
+	{slashing.StoreKey, [][]byte{}},
+	{mint.StoreKey, [][]byte{}},
+	{distr.StoreKey, [][]byte{}},
+	{supply.StoreKey, [][]byte{}},
+	{params.StoreKey, [][]byte{}},
+	{gov.StoreKey, [][]byte{}},
+	{evidence.StoreKey, [][]byte{}},
+	{feegrant.StoreKey, [][]byte{}},
+	{authz.StoreKey, [][]byte{}},
+}
+
+// importExportExcluded are the prefixes of the keys of a store which aren't
+// exported, and are left out of the import/export checks
+var importExportExcluded = map[string][][]byte{
+	staking.StoreKey: {staking.HistoricalInfoKey},
+	distr.StoreKey:   {distr.DustPayoutPendingKey},
+}
+
+// CheckImportExport exports the last committed state of the app, imports it in
+// a fresh app and compares the stores of both apps, implementing the
+// simulation ImportExportChecker interface.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *SimApp) CheckImportExport() error {
+	appState, _, err := app.ExportAppStateAndValidators(false, nil)
+	if err != nil {
+		return fmt.Errorf("failed to export the app state: %v", err)
+	}
+
+	newApp := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0)
+
+	var genesisState GenesisState
+	if err := app.cdc.UnmarshalJSON(appState, &genesisState); err != nil {
+		return fmt.Errorf("failed to unmarshal the exported app state: %v", err)
+	}
+
+	header := abci.Header{Height: app.LastBlockHeight()}
+	ctxA := app.NewContext(true, header)
+	ctxB := newApp.NewContext(true, header)
+	if err := initGenesisSafe(newApp, ctxB, genesisState); err != nil {
+		return err
+	}
+
+	return DiffImportedStores(app, newApp, ctxA, ctxB)
+}
+
+// initGenesisSafe initializes the modules of an app from a genesis state,
+// returning an error instead of panicking if the state can't be imported
+func initGenesisSafe(app *SimApp, ctx sdk.Context, genesisState GenesisState) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to import the exported app state: %v", r)
+		}
+	}()
+
+	app.mm.InitGenesis(ctx, genesisState)
+	return nil
+}
+
+// DiffImportedStores compares the stores of an app with the ones of the app
+// imported from its exported state. It returns an error listing the decoded
+// key-value pairs that differ between both apps, if any.
+func DiffImportedStores(app, newApp *SimApp, ctxA, ctxB sdk.Context) error {
+	var diffs []string
+	for _, store := range importExportStores {
+		storeA := withoutPrefixes(ctxA.KVStore(app.keys[store.Name]), importExportExcluded[store.Name])
+		storeB := withoutPrefixes(ctxB.KVStore(newApp.keys[store.Name]), importExportExcluded[store.Name])
+
+		failedKVAs, failedKVBs := sdk.DiffKVStores(storeA, storeB, store.Prefixes)
+		if len(failedKVAs) != len(failedKVBs) {
+			diffs = append(diffs, fmt.Sprintf("unequal sets of key-values to compare in the %s store", store.Name))
+			continue
+		}
+
+		if len(failedKVAs) != 0 {
+			diffs = append(diffs, fmt.Sprintf("%d key/value pairs differ in the %s store:\n%s",
+				len(failedKVAs), store.Name,
+				GetSimulationLog(store.Name, app.sm.StoreDecoders, app.cdc, failedKVAs, failedKVBs)))
+		}
+	}
+
+	if len(diffs) != 0 {
+		return errors.New(strings.Join(diffs, "\n"))
+	}
+
+	return nil
+}
+
+// withoutPrefixes returns a cache branch of a store from which the keys
+// starting with any of the given prefixes are deleted. The store itself is
+// left untouched.
+func withoutPrefixes(store sdk.KVStore, prefixes [][]byte) sdk.KVStore {
+	if len(prefixes) == 0 {
+		return store
+	}
+
+	cache := cachekv.NewStore(store)
+	for _, prefix := range prefixes {
+		var keys [][]byte
+		iter := sdk.KVStorePrefixIterator(cache, prefix)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			cache.Delete(key)
+		}
+	}
+
+	return cache
+}
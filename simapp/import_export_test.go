@@ -0,0 +1,35 @@
+package simapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+func TestCheckImportExport(t *testing.T) {
+	app := Setup(false)
+	app.Commit()
+
+	require.NoError(t, app.CheckImportExport())
+}
+
+func TestDiffImportedStores(t *testing.T) {
+	app := Setup(false)
+	newApp := Setup(false)
+
+	ctxA := app.NewContext(true, abci.Header{})
+	ctxB := newApp.NewContext(true, abci.Header{})
+	require.NoError(t, DiffImportedStores(app, newApp, ctxA, ctxB))
+
+	// an account missing from the imported app is reported
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	app.AccountKeeper.SetAccount(ctxA, app.AccountKeeper.NewAccountWithAddress(ctxA, addr))
+
+	err := DiffImportedStores(app, newApp, ctxA, ctxB)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "in the "+auth.StoreKey+" store")
+}
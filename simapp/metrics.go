@@ -0,0 +1,53 @@
+package simapp
+
+import (
+	"sync"
+
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+)
+
+// Tendermint config keys of the Prometheus instrumentation, shared by the
+// metrics of the node and of the app.
+const (
+	flagPrometheus          = "instrumentation.prometheus"
+	flagPrometheusNamespace = "instrumentation.namespace"
+)
+
+// appMetrics holds the metrics the module manager and the keepers of SimApp
+// report to.
+type appMetrics struct {
+	module   *module.Metrics
+	slashing *slashing.Metrics
+}
+
+var (
+	metrics     *appMetrics
+	metricsOnce sync.Once
+)
+
+// getAppMetrics returns Prometheus metrics if the node exports them, as set
+// in the instrumentation section of the Tendermint config, and no-op metrics
+// otherwise. Prometheus metrics can only be registered once, so they are
+// shared by all the apps built by the process.
+func getAppMetrics() *appMetrics {
+	metricsOnce.Do(func() {
+		if !viper.GetBool(flagPrometheus) {
+			metrics = &appMetrics{
+				module:   module.NopMetrics(),
+				slashing: slashing.NopMetrics(),
+			}
+			return
+		}
+
+		namespace := viper.GetString(flagPrometheusNamespace)
+		metrics = &appMetrics{
+			module:   module.PrometheusMetrics(namespace),
+			slashing: slashing.PrometheusMetrics(namespace),
+		}
+	})
+
+	return metrics
+}
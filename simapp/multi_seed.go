@@ -0,0 +1,199 @@
+package simapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// SeedResult is the outcome of the full app simulation of a single seed
+type SeedResult struct {
+	Seed        int64   `json:"seed"`
+	Passed      bool    `json:"passed"`
+	FailedBlock int64   `json:"failed_block,omitempty"` // height of the block on which the simulation failed
+	Error       string  `json:"error,omitempty"`
+	LogFile     string  `json:"log_file"` // output of the simulation
+	Duration    float64 `json:"duration_seconds"`
+}
+
+// MultiSeedReport aggregates the results of the full app simulations of
+// multiple seeds.
+type MultiSeedReport struct {
+	Passed      int          `json:"passed"`
+	Failed      int          `json:"failed"`
+	FailedSeeds []int64      `json:"failed_seeds,omitempty"`
+	Results     []SeedResult `json:"results"`
+}
+
+// NewMultiSeedReport aggregates the results of the simulations of multiple
+// seeds, sorted by seed.
+func NewMultiSeedReport(results []SeedResult) MultiSeedReport {
+	sort.Slice(results, func(i, j int) bool { return results[i].Seed < results[j].Seed })
+
+	report := MultiSeedReport{Results: results}
+	for _, res := range results {
+		if res.Passed {
+			report.Passed++
+			continue
+		}
+
+		report.Failed++
+		report.FailedSeeds = append(report.FailedSeeds, res.Seed)
+	}
+
+	return report
+}
+
+// String implements the Stringer interface
+func (r MultiSeedReport) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d seed(s) simulated: %d passed, %d failed\n", len(r.Results), r.Passed, r.Failed)
+	for _, res := range r.Results {
+		if res.Passed {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "seed %d failed on block %d: %s\n\tlog: %s\n", res.Seed, res.FailedBlock, res.Error, res.LogFile)
+	}
+
+	return sb.String()
+}
+
+// ExportJSON saves the report as a JSON file on a given path
+func (r MultiSeedReport) ExportJSON(path string) error {
+	bz, err := json.MarshalIndent(r, "", " ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, bz, 0600)
+}
+
+// SimulateSeeds runs the full app simulation of each seed concurrently, as
+// parallel subtests of t, and aggregates their results. The number of
+// simulations running at once is bounded by the -test.parallel flag.
+//
+// The output of each simulation is written to seed-<seed>.log in outputDir,
+// along with its operation statistics and broken invariants report, so that
+// the failure context of every seed is kept. The app state, params and replay
+// exports of the config are disabled as they would be overwritten by every
+// seed.
+//
+// NOTE: This is solely to be used for testing purposes.
+func SimulateSeeds(t *testing.T, config simulation.Config, seeds []int64, outputDir string) MultiSeedReport {
+	var (
+		mtx     sync.Mutex
+		results []SeedResult
+	)
+
+	// parallel subtests only complete once their parent returns
+	t.Run("seeds", func(t *testing.T) {
+		for _, seed := range seeds {
+			seed := seed
+			t.Run(fmt.Sprintf("seed-%d", seed), func(t *testing.T) {
+				t.Parallel()
+
+				simulateSeed(t, config, seed, outputDir, func(res SeedResult) {
+					mtx.Lock()
+					results = append(results, res)
+					mtx.Unlock()
+				})
+			})
+		}
+	})
+
+	return NewMultiSeedReport(results)
+}
+
+// simulateSeed runs the full app simulation of a single seed, recording its
+// outcome even if the simulation fails the test or panics.
+func simulateSeed(t *testing.T, config simulation.Config, seed int64, outputDir string, record func(SeedResult)) {
+	config.Seed = seed
+	config.ChainID = helpers.SimAppChainID
+	config.ExportStatePath = ""
+	config.ExportParamsPath = ""
+	config.ExportReplayPath = ""
+	config.ExportStatsPath = filepath.Join(outputDir, fmt.Sprintf("seed-%d-stats.json", seed))
+	config.ExportInvariantsReportPath = filepath.Join(outputDir, fmt.Sprintf("seed-%d-invariants.json", seed))
+
+	res := SeedResult{
+		Seed:    seed,
+		LogFile: filepath.Join(outputDir, fmt.Sprintf("seed-%d.log", seed)),
+	}
+
+	logFile, err := os.Create(res.LogFile)
+	if err != nil {
+		res.Error = err.Error()
+		record(res)
+		t.Fatal(err)
+	}
+	defer logFile.Close()
+
+	dir, err := ioutil.TempDir("", fmt.Sprintf("goleveldb-app-sim-%d", seed))
+	if err != nil {
+		res.Error = err.Error()
+		record(res)
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := sdk.NewLevelDB("Simulation", dir)
+	if err != nil {
+		res.Error = err.Error()
+		record(res)
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	app := NewSimApp(log.NewNopLogger(), db, nil, true, FlagPeriodValue, func(bapp *bam.BaseApp) {
+		bapp.SetFauxMerkleMode()
+	})
+	config.InvariantsChecker = app
+	config.ImportExportChecker = app
+	config.FeeAccountingChecker = app
+
+	start := time.Now()
+
+	// failed operations end the subtest through t.Fatalf and panics are
+	// re-raised by the simulation once its logs are printed
+	defer func() {
+		res.Duration = time.Since(start).Seconds()
+
+		if r := recover(); r != nil {
+			res.Error = fmt.Sprintf("panic: %v", r)
+			t.Errorf("simulation of seed %d panicked: %v", seed, r)
+		} else if t.Failed() && res.Error == "" {
+			res.Error = fmt.Sprintf("simulation failed; see the output of %s", t.Name())
+		}
+
+		res.Passed = res.Error == ""
+		if !res.Passed {
+			res.FailedBlock = app.CurrentBlockHeight()
+		}
+
+		record(res)
+	}()
+
+	_, _, simErr := simulation.SimulateFromSeed(
+		t, logFile, app.BaseApp, AppStateFn(app.Codec(), app.sm),
+		SimulationOperations(app, app.Codec(), config), app.ModuleAccountAddrs(), config,
+	)
+	if simErr != nil {
+		res.Error = simErr.Error()
+		t.Errorf("simulation of seed %d failed: %v", seed, simErr)
+	}
+}
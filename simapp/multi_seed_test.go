@@ -0,0 +1,25 @@
+package simapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMultiSeedReport(t *testing.T) {
+	report := NewMultiSeedReport([]SeedResult{
+		{Seed: 3, Passed: true, LogFile: "seed-3.log"},
+		{Seed: 1, FailedBlock: 12, Error: "2 invariant(s) broken on block 12", LogFile: "seed-1.log"},
+		{Seed: 2, Passed: true, LogFile: "seed-2.log"},
+	})
+
+	require.Equal(t, 2, report.Passed)
+	require.Equal(t, 1, report.Failed)
+	require.Equal(t, []int64{1}, report.FailedSeeds)
+	require.Equal(t, int64(1), report.Results[0].Seed)
+	require.Equal(t, int64(3), report.Results[2].Seed)
+	require.Equal(t, `3 seed(s) simulated: 2 passed, 1 failed
+seed 1 failed on block 12: 2 invariant(s) broken on block 12
+	log: seed-1.log
+`, report.String())
+}
@@ -2,23 +2,6 @@ package simapp
 
 // Simulation parameter constants
 const (
-	StakePerAccount                        = "stake_per_account"
-	InitiallyBondedValidators              = "initially_bonded_validators"
-	OpWeightDeductFee                      = "op_weight_deduct_fee"
-	OpWeightMsgSend                        = "op_weight_msg_send"
-	OpWeightMsgMultiSend                   = "op_weight_msg_multisend"
-	OpWeightMsgSetWithdrawAddress          = "op_weight_msg_set_withdraw_address"
-	OpWeightMsgWithdrawDelegationReward    = "op_weight_msg_withdraw_delegation_reward"
-	OpWeightMsgWithdrawValidatorCommission = "op_weight_msg_withdraw_validator_commission"
-	OpWeightSubmitTextProposal             = "op_weight_submit_text_proposal"
-	OpWeightSubmitCommunitySpendProposal   = "op_weight_submit_community_spend_proposal"
-	OpWeightSubmitParamChangeProposal      = "op_weight_submit_param_change_proposal"
-	OpWeightMsgDeposit                     = "op_weight_msg_deposit"
-	OpWeightMsgVote                        = "op_weight_msg_vote"
-	OpWeightMsgCreateValidator             = "op_weight_msg_create_validator"
-	OpWeightMsgEditValidator               = "op_weight_msg_edit_validator"
-	OpWeightMsgDelegate                    = "op_weight_msg_delegate"
-	OpWeightMsgUndelegate                  = "op_weight_msg_undelegate"
-	OpWeightMsgBeginRedelegate             = "op_weight_msg_begin_redelegate"
-	OpWeightMsgUnjail                      = "op_weight_msg_unjail"
+	StakePerAccount           = "stake_per_account"
+	InitiallyBondedValidators = "initially_bonded_validators"
 )
@@ -31,22 +31,18 @@ func BenchmarkFullAppSimulation(b *testing.B) {
 	}()
 
 	app := NewSimApp(logger, db, nil, true, FlagPeriodValue, interBlockCacheOpt())
+	defer exportSimulationState(b, app, config)
+	config.InvariantsChecker = app
+	config.ImportExportChecker = app
+	config.FeeAccountingChecker = app
 
 	// Run randomized simulation
 	// TODO: parameterize numbers, save for a later PR
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		b, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.sm),
-		testAndRunTxs(app, config), app.ModuleAccountAddrs(), config,
+		SimulationOperations(app, app.Codec(), config), app.ModuleAccountAddrs(), config,
 	)
 
-	// export state and params before the simulation error is checked
-	if config.ExportStatePath != "" {
-		if err := ExportStateToJSON(app, config.ExportStatePath); err != nil {
-			fmt.Println(err)
-			b.Fail()
-		}
-	}
-
 	if config.ExportParamsPath != "" {
 		if err := ExportParamsToJSON(simParams, config.ExportParamsPath); err != nil {
 			fmt.Println(err)
@@ -82,21 +78,14 @@ func BenchmarkInvariants(b *testing.B) {
 	}()
 
 	app := NewSimApp(logger, db, nil, true, FlagPeriodValue, interBlockCacheOpt())
+	defer exportSimulationState(b, app, config)
 
 	// 2. Run parameterized simulation (w/o invariants)
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		b, ioutil.Discard, app.BaseApp, AppStateFn(app.Codec(), app.sm),
-		testAndRunTxs(app, config), app.ModuleAccountAddrs(), config,
+		SimulationOperations(app, app.Codec(), config), app.ModuleAccountAddrs(), config,
 	)
 
-	// export state and params before the simulation error is checked
-	if config.ExportStatePath != "" {
-		if err := ExportStateToJSON(app, config.ExportStatePath); err != nil {
-			fmt.Println(err)
-			b.Fail()
-		}
-	}
-
 	if config.ExportParamsPath != "" {
 		if err := ExportParamsToJSON(simParams, config.ExportParamsPath); err != nil {
 			fmt.Println(err)
@@ -6,6 +6,8 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -17,21 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/auth"
-	banksim "github.com/cosmos/cosmos-sdk/x/bank/simulation"
-	distr "github.com/cosmos/cosmos-sdk/x/distribution"
-	distrsim "github.com/cosmos/cosmos-sdk/x/distribution/simulation"
-	"github.com/cosmos/cosmos-sdk/x/gov"
-	govsim "github.com/cosmos/cosmos-sdk/x/gov/simulation"
-	"github.com/cosmos/cosmos-sdk/x/mint"
-	"github.com/cosmos/cosmos-sdk/x/params"
-	paramsim "github.com/cosmos/cosmos-sdk/x/params/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
-	"github.com/cosmos/cosmos-sdk/x/slashing"
-	slashingsim "github.com/cosmos/cosmos-sdk/x/slashing/simulation"
-	"github.com/cosmos/cosmos-sdk/x/staking"
-	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
-	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
 // Get flags every time the simulator is run
@@ -39,201 +27,6 @@ func init() {
 	GetSimulatorFlags()
 }
 
-func testAndRunTxs(app *SimApp, config simulation.Config) []simulation.WeightedOperation {
-	ap := make(simulation.AppParams)
-
-	paramChanges := app.sm.GenerateParamChanges(config.Seed)
-
-	if config.ParamsFile != "" {
-		bz, err := ioutil.ReadFile(config.ParamsFile)
-		if err != nil {
-			panic(err)
-		}
-
-		app.cdc.MustUnmarshalJSON(bz, &ap)
-	}
-
-	// nolint: govet
-	return []simulation.WeightedOperation{
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightMsgSend, &v, nil,
-					func(_ *rand.Rand) {
-						v = 100
-					})
-				return v
-			}(nil),
-			banksim.SimulateMsgSend(app.AccountKeeper, app.BankKeeper),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightMsgMultiSend, &v, nil,
-					func(_ *rand.Rand) {
-						v = 40
-					})
-				return v
-			}(nil),
-			banksim.SimulateMsgMultiSend(app.AccountKeeper, app.BankKeeper),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightMsgSetWithdrawAddress, &v, nil,
-					func(_ *rand.Rand) {
-						v = 50
-					})
-				return v
-			}(nil),
-			distrsim.SimulateMsgSetWithdrawAddress(app.AccountKeeper, app.DistrKeeper),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightMsgWithdrawDelegationReward, &v, nil,
-					func(_ *rand.Rand) {
-						v = 50
-					})
-				return v
-			}(nil),
-			distrsim.SimulateMsgWithdrawDelegatorReward(app.AccountKeeper, app.DistrKeeper, app.StakingKeeper),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightMsgWithdrawValidatorCommission, &v, nil,
-					func(_ *rand.Rand) {
-						v = 50
-					})
-				return v
-			}(nil),
-			distrsim.SimulateMsgWithdrawValidatorCommission(app.AccountKeeper, app.DistrKeeper, app.StakingKeeper),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightSubmitTextProposal, &v, nil,
-					func(_ *rand.Rand) {
-						v = 20
-					})
-				return v
-			}(nil),
-			govsim.SimulateSubmitProposal(app.AccountKeeper, app.GovKeeper, govsim.SimulateTextProposalContent),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightSubmitCommunitySpendProposal, &v, nil,
-					func(_ *rand.Rand) {
-						v = 20
-					})
-				return v
-			}(nil),
-			govsim.SimulateSubmitProposal(app.AccountKeeper, app.GovKeeper, distrsim.SimulateCommunityPoolSpendProposalContent(app.DistrKeeper)),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightSubmitParamChangeProposal, &v, nil,
-					func(_ *rand.Rand) {
-						v = 20
-					})
-				return v
-			}(nil),
-			govsim.SimulateSubmitProposal(app.AccountKeeper, app.GovKeeper, paramsim.SimulateParamChangeProposalContent(paramChanges)),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightMsgDeposit, &v, nil,
-					func(_ *rand.Rand) {
-						v = 100
-					})
-				return v
-			}(nil),
-			govsim.SimulateMsgDeposit(app.AccountKeeper, app.GovKeeper),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightMsgVote, &v, nil,
-					func(_ *rand.Rand) {
-						v = 100
-					})
-				return v
-			}(nil),
-			govsim.SimulateMsgVote(app.AccountKeeper, app.GovKeeper),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightMsgCreateValidator, &v, nil,
-					func(_ *rand.Rand) {
-						v = 100
-					})
-				return v
-			}(nil),
-			stakingsim.SimulateMsgCreateValidator(app.AccountKeeper, app.StakingKeeper),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightMsgEditValidator, &v, nil,
-					func(_ *rand.Rand) {
-						v = 20
-					})
-				return v
-			}(nil),
-			stakingsim.SimulateMsgEditValidator(app.AccountKeeper, app.StakingKeeper),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightMsgDelegate, &v, nil,
-					func(_ *rand.Rand) {
-						v = 100
-					})
-				return v
-			}(nil),
-			stakingsim.SimulateMsgDelegate(app.AccountKeeper, app.StakingKeeper),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightMsgUndelegate, &v, nil,
-					func(_ *rand.Rand) {
-						v = 100
-					})
-				return v
-			}(nil),
-			stakingsim.SimulateMsgUndelegate(app.AccountKeeper, app.StakingKeeper),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightMsgBeginRedelegate, &v, nil,
-					func(_ *rand.Rand) {
-						v = 100
-					})
-				return v
-			}(nil),
-			stakingsim.SimulateMsgBeginRedelegate(app.AccountKeeper, app.StakingKeeper),
-		},
-		{
-			func(_ *rand.Rand) int {
-				var v int
-				ap.GetOrGenerate(app.cdc, OpWeightMsgUnjail, &v, nil,
-					func(_ *rand.Rand) {
-						v = 100
-					})
-				return v
-			}(nil),
-			slashingsim.SimulateMsgUnjail(app.AccountKeeper, app.SlashingKeeper, app.StakingKeeper),
-		},
-	}
-}
-
 // fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of
 // an IAVLStore for faster simulation speed.
 func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
@@ -246,6 +39,25 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
 }
 
+// exportSimulationState exports the app state to the state path of the config,
+// if any. With ExportStateOnFailure, the state is only exported if the test
+// failed. It is meant to be deferred, so that failures ending the test through
+// FailNow, such as the broken invariants of the simulation, are caught too.
+func exportSimulationState(tb testing.TB, app *SimApp, config simulation.Config) {
+	if config.ExportStatePath == "" || (config.ExportStateOnFailure && !tb.Failed()) {
+		return
+	}
+
+	if app.LastBlockHeight() == 0 {
+		tb.Log("no committed app state to export")
+		return
+	}
+
+	if err := ExportStateToJSON(app, config.ExportStatePath); err != nil {
+		tb.Error(err)
+	}
+}
+
 func TestFullAppSimulation(t *testing.T) {
 	if !FlagEnabledValue {
 		t.Skip("skipping application simulation")
@@ -271,20 +83,18 @@ func TestFullAppSimulation(t *testing.T) {
 	}()
 
 	app := NewSimApp(logger, db, nil, true, FlagPeriodValue, fauxMerkleModeOpt)
+	defer exportSimulationState(t, app, config)
 	require.Equal(t, "SimApp", app.Name())
+	config.InvariantsChecker = app
+	config.ImportExportChecker = app
+	config.FeeAccountingChecker = app
 
 	// Run randomized simulation
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		t, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.sm),
-		testAndRunTxs(app, config), app.ModuleAccountAddrs(), config,
+		SimulationOperations(app, app.Codec(), config), app.ModuleAccountAddrs(), config,
 	)
 
-	// export state and params before the simulation error is checked
-	if config.ExportStatePath != "" {
-		err := ExportStateToJSON(app, config.ExportStatePath)
-		require.NoError(t, err)
-	}
-
 	if config.ExportParamsPath != "" {
 		err := ExportParamsToJSON(simParams, config.ExportParamsPath)
 		require.NoError(t, err)
@@ -301,6 +111,33 @@ func TestFullAppSimulation(t *testing.T) {
 	}
 }
 
+func TestMultiSeedSimulation(t *testing.T) {
+	if !FlagEnabledValue {
+		t.Skip("skipping multi-seed application simulation")
+	}
+
+	outputDir := FlagMultiSeedOutputDirValue
+	if outputDir == "" {
+		var err error
+		outputDir, err = ioutil.TempDir("", "multi-seed-sim")
+		require.NoError(t, err)
+	} else {
+		require.NoError(t, os.MkdirAll(outputDir, 0755))
+	}
+
+	config := NewConfigFromFlags()
+	seeds := make([]int64, FlagNumSeedsValue)
+	for i := range seeds {
+		seeds[i] = config.Seed + int64(i)
+	}
+
+	report := SimulateSeeds(t, config, seeds, outputDir)
+
+	reportPath := filepath.Join(outputDir, "report.json")
+	require.NoError(t, report.ExportJSON(reportPath))
+	fmt.Printf("\n%s\nMulti-seed simulation report saved to %s\n", report, reportPath)
+}
+
 func TestAppImportExport(t *testing.T) {
 	if !FlagEnabledValue {
 		t.Skip("skipping application import/export simulation")
@@ -326,20 +163,18 @@ func TestAppImportExport(t *testing.T) {
 	}()
 
 	app := NewSimApp(logger, db, nil, true, FlagPeriodValue, fauxMerkleModeOpt)
+	defer exportSimulationState(t, app, config)
 	require.Equal(t, "SimApp", app.Name())
+	config.InvariantsChecker = app
+	config.ImportExportChecker = app
+	config.FeeAccountingChecker = app
 
 	// Run randomized simulation
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		t, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.sm),
-		testAndRunTxs(app, config), app.ModuleAccountAddrs(), config,
+		SimulationOperations(app, app.Codec(), config), app.ModuleAccountAddrs(), config,
 	)
 
-	// export state and simParams before the simulation error is checked
-	if config.ExportStatePath != "" {
-		err := ExportStateToJSON(app, config.ExportStatePath)
-		require.NoError(t, err)
-	}
-
 	if config.ExportParamsPath != "" {
 		err := ExportParamsToJSON(simParams, config.ExportParamsPath)
 		require.NoError(t, err)
@@ -381,43 +216,7 @@ func TestAppImportExport(t *testing.T) {
 
 	fmt.Printf("comparing stores...\n")
 	ctxA := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
-
-	type StoreKeysPrefixes struct {
-		A        sdk.StoreKey
-		B        sdk.StoreKey
-		Prefixes [][]byte
-	}
-
-	storeKeysPrefixes := []StoreKeysPrefixes{
-		{app.keys[baseapp.MainStoreKey], newApp.keys[baseapp.MainStoreKey], [][]byte{}},
-		{app.keys[auth.StoreKey], newApp.keys[auth.StoreKey], [][]byte{}},
-		{app.keys[staking.StoreKey], newApp.keys[staking.StoreKey],
-			[][]byte{
-				staking.UnbondingQueueKey, staking.RedelegationQueueKey, staking.ValidatorQueueKey,
-			}}, // ordering may change but it doesn't matter
-		{app.keys[slashing.StoreKey], newApp.keys[slashing.StoreKey], [][]byte{}},
-		{app.keys[mint.StoreKey], newApp.keys[mint.StoreKey], [][]byte{}},
-		{app.keys[distr.StoreKey], newApp.keys[distr.StoreKey], [][]byte{}},
-		{app.keys[supply.StoreKey], newApp.keys[supply.StoreKey], [][]byte{}},
-		{app.keys[params.StoreKey], newApp.keys[params.StoreKey], [][]byte{}},
-		{app.keys[gov.StoreKey], newApp.keys[gov.StoreKey], [][]byte{}},
-	}
-
-	for _, storeKeysPrefix := range storeKeysPrefixes {
-		storeKeyA := storeKeysPrefix.A
-		storeKeyB := storeKeysPrefix.B
-		prefixes := storeKeysPrefix.Prefixes
-
-		storeA := ctxA.KVStore(storeKeyA)
-		storeB := ctxB.KVStore(storeKeyB)
-
-		failedKVAs, failedKVBs := sdk.DiffKVStores(storeA, storeB, prefixes)
-		require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare")
-
-		fmt.Printf("compared %d key/value pairs between %s and %s\n", len(failedKVAs), storeKeyA, storeKeyB)
-		require.Equal(t, len(failedKVAs), 0, GetSimulationLog(storeKeyA.Name(), app.sm.StoreDecoders, app.cdc, failedKVAs, failedKVBs))
-	}
-
+	require.NoError(t, DiffImportedStores(app, newApp, ctxA, ctxB))
 }
 
 func TestAppSimulationAfterImport(t *testing.T) {
@@ -444,20 +243,18 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	}()
 
 	app := NewSimApp(logger, db, nil, true, FlagPeriodValue, fauxMerkleModeOpt)
+	defer exportSimulationState(t, app, config)
 	require.Equal(t, "SimApp", app.Name())
+	config.InvariantsChecker = app
+	config.ImportExportChecker = app
+	config.FeeAccountingChecker = app
 
 	// Run randomized simulation
 	stopEarly, simParams, simErr := simulation.SimulateFromSeed(
 		t, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.sm),
-		testAndRunTxs(app, config), app.ModuleAccountAddrs(), config,
+		SimulationOperations(app, app.Codec(), config), app.ModuleAccountAddrs(), config,
 	)
 
-	// export state and params before the simulation error is checked
-	if config.ExportStatePath != "" {
-		err := ExportStateToJSON(app, config.ExportStatePath)
-		require.NoError(t, err)
-	}
-
 	if config.ExportParamsPath != "" {
 		err := ExportParamsToJSON(simParams, config.ExportParamsPath)
 		require.NoError(t, err)
@@ -504,12 +301,150 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	// Run randomized simulation on imported app
 	_, _, err = simulation.SimulateFromSeed(
 		t, os.Stdout, newApp.BaseApp, AppStateFn(app.Codec(), app.sm),
-		testAndRunTxs(newApp, config), newApp.ModuleAccountAddrs(), config,
+		SimulationOperations(newApp, newApp.Codec(), config), newApp.ModuleAccountAddrs(), config,
 	)
 
 	require.NoError(t, err)
 }
 
+func TestAppSimulationWithRestarts(t *testing.T) {
+	if !FlagEnabledValue || FlagRestartPeriodValue == 0 {
+		t.Skip("skipping application simulation with restarts")
+	}
+
+	var logger log.Logger
+	config := NewConfigFromFlags()
+	config.ChainID = helpers.SimAppChainID
+	config.Commit = true
+
+	if FlagVerboseValue {
+		logger = log.TestingLogger()
+	} else {
+		logger = log.NewNopLogger()
+	}
+
+	db := dbm.NewMemDB()
+	app := NewSimApp(logger, db, nil, true, FlagPeriodValue, fauxMerkleModeOpt)
+
+	// export the state of the last restarted app
+	defer func() { exportSimulationState(t, app, config) }()
+
+	require.Equal(t, "SimApp", app.Name())
+
+	// every restart loads a new app from the committed state, the same way a
+	// node restarts from its database, and checks that it holds the same
+	// stores as the previous one
+	restarts := 0
+	restartFn := func() (*baseapp.BaseApp, simulation.WeightedOperations, error) {
+		newApp := newSimApp(logger, db, nil, true, FlagPeriodValue, app.keys, app.tkeys, fauxMerkleModeOpt)
+
+		ctxA := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+		ctxB := newApp.NewContext(true, abci.Header{Height: newApp.LastBlockHeight()})
+		for name, key := range app.keys {
+			failedKVAs, failedKVBs := sdk.DiffKVStores(ctxA.KVStore(key), ctxB.KVStore(key), nil)
+			require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare")
+			require.Len(t, failedKVAs, 0, GetSimulationLog(name, app.sm.StoreDecoders, app.cdc, failedKVAs, failedKVBs))
+		}
+
+		app = newApp
+		restarts++
+
+		return app.BaseApp, SimulationOperations(app, app.Codec(), config), nil
+	}
+
+	stopEarly, _, simErr := simulation.SimulateFromSeedWithRestarts(
+		t, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.sm), restartFn,
+		SimulationOperations(app, app.Codec(), config), app.ModuleAccountAddrs(), config,
+	)
+
+	require.NoError(t, simErr)
+
+	if !stopEarly {
+		require.Equal(t, (config.NumBlocks-1)/config.RestartPeriod, restarts)
+	}
+}
+
+func TestAppSimulationReplay(t *testing.T) {
+	if !FlagEnabledValue {
+		t.Skip("skipping application simulation replay")
+	}
+
+	var logger log.Logger
+	config := NewConfigFromFlags()
+	config.ChainID = helpers.SimAppChainID
+	config.Commit = true
+	config.ReplayFile = ""
+
+	if FlagVerboseValue {
+		logger = log.TestingLogger()
+	} else {
+		logger = log.NewNopLogger()
+	}
+
+	dir, _ := ioutil.TempDir("", "app-sim-replay")
+	defer os.RemoveAll(dir)
+	config.ExportReplayPath = filepath.Join(dir, "replay.json")
+
+	app := NewSimApp(logger, dbm.NewMemDB(), nil, true, FlagPeriodValue, fauxMerkleModeOpt)
+	require.Equal(t, "SimApp", app.Name())
+
+	_, _, err := simulation.SimulateFromSeed(
+		t, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.sm),
+		SimulationOperations(app, app.Codec(), config), app.ModuleAccountAddrs(), config,
+	)
+	require.NoError(t, err)
+
+	fmt.Printf("replaying simulation from %s\n", config.ExportReplayPath)
+
+	// the replay checks the app hash of every block against the recorded one
+	replayConfig := NewConfigFromFlags()
+	replayConfig.ReplayFile = config.ExportReplayPath
+	replayConfig.ExportReplayPath = ""
+
+	newApp := NewSimApp(logger, dbm.NewMemDB(), nil, true, FlagPeriodValue, fauxMerkleModeOpt)
+	_, _, err = simulation.SimulateFromSeed(
+		t, os.Stdout, newApp.BaseApp, AppStateFn(newApp.Codec(), newApp.sm),
+		SimulationOperations(newApp, newApp.Codec(), replayConfig), newApp.ModuleAccountAddrs(), replayConfig,
+	)
+	require.NoError(t, err)
+	require.Equal(t, app.LastCommitID(), newApp.LastCommitID())
+}
+
+func TestSimulationCorpus(t *testing.T) {
+	if !FlagEnabledValue || FlagCorpusDirValue == "" {
+		t.Skip("skipping simulation corpus replays")
+	}
+
+	paths, err := simulation.ReadCorpus(FlagCorpusDirValue)
+	require.NoError(t, err)
+
+	// every replay saved in the corpus is expected to pass once its failure
+	// is fixed
+	config := NewConfigFromFlags()
+	for _, path := range paths {
+		ReplaySimulation(t, config, filepath.Base(path), path)
+	}
+}
+
+func TestMinimizeSimulationReplay(t *testing.T) {
+	if !FlagEnabledValue || FlagSimulationReplayValue == "" {
+		t.Skip("skipping simulation replay minimization")
+	}
+
+	replay, err := simulation.ReadReplay(FlagSimulationReplayValue)
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "app-sim-minimize")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	minimized := MinimizeSimulationReplay(t, NewConfigFromFlags(), replay, dir)
+
+	path := strings.TrimSuffix(FlagSimulationReplayValue, ".json") + ".min.json"
+	require.NoError(t, minimized.ExportJSON(path))
+	fmt.Printf("\nMinimized the replay from %d to %d blocks; saved to %s\n", len(replay.Checkpoints), len(minimized.Checkpoints), path)
+}
+
 // TODO: Make another test for the fuzzer itself, which just has noOp txs
 // and doesn't depend on the application.
 func TestAppStateDeterminism(t *testing.T) {
@@ -550,7 +485,7 @@ func TestAppStateDeterminism(t *testing.T) {
 
 			_, _, err := simulation.SimulateFromSeed(
 				t, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.sm),
-				testAndRunTxs(app, config), app.ModuleAccountAddrs(), config,
+				SimulationOperations(app, app.Codec(), config), app.ModuleAccountAddrs(), config,
 			)
 			require.NoError(t, err)
 
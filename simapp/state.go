@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
+	"math/big"
 	"math/rand"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -55,11 +58,11 @@ func AppStateFn(cdc *codec.Codec, simManager *module.SimulationManager) simulati
 			}
 
 			cdc.MustUnmarshalJSON(bz, &appParams)
-			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams)
+			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams, config)
 
 		default:
 			appParams := make(simulation.AppParams)
-			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams)
+			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams, config)
 		}
 
 		return appState, simAccs, chainID, genesisTimestamp
@@ -67,20 +70,27 @@ func AppStateFn(cdc *codec.Codec, simManager *module.SimulationManager) simulati
 }
 
 // AppStateRandomizedFn creates calls each module's GenesisState generator function
-// and creates the simulation params
+// and creates the simulation params. The genesis is denominated in the bond
+// denomination of the given config.
 func AppStateRandomizedFn(
 	simManager *module.SimulationManager, r *rand.Rand, cdc *codec.Codec,
 	accs []simulation.Account, genesisTimestamp time.Time, appParams simulation.AppParams,
+	config simulation.Config,
 ) (json.RawMessage, []simulation.Account) {
 	numAccs := int64(len(accs))
 	genesisState := NewDefaultGenesisState()
 
+	bondDenom, bondExponent := config.BondDenom, config.BondExponent
+	if bondDenom == "" {
+		bondDenom, bondExponent = sdk.DefaultBondDenom, DefaultBondExponent
+	}
+
 	// generate a random amount of initial stake coins and a random initial
 	// number of bonded accounts
 	var initialStake, numInitiallyBonded int64
 	appParams.GetOrGenerate(
 		cdc, StakePerAccount, &initialStake, r,
-		func(r *rand.Rand) { initialStake = int64(r.Intn(1e12)) },
+		func(r *rand.Rand) { initialStake = r.Int63n(maxInitialStake(bondExponent)) },
 	)
 	appParams.GetOrGenerate(
 		cdc, InitiallyBondedValidators, &numInitiallyBonded, r,
@@ -106,6 +116,8 @@ func AppStateRandomizedFn(
 		Rand:         r,
 		GenState:     genesisState,
 		Accounts:     accs,
+		BondDenom:    bondDenom,
+		BondExponent: bondExponent,
 		InitialStake: initialStake,
 		NumBonded:    numInitiallyBonded,
 		GenTimestamp: genesisTimestamp,
@@ -121,6 +133,20 @@ func AppStateRandomizedFn(
 	return appState, accs
 }
 
+// maxInitialStake returns the upper bound of the randomly generated stake per
+// account, i.e. one million bond tokens expressed in base units, capped to fit
+// in an int64.
+func maxInitialStake(bondExponent uint) int64 {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(bondExponent)), nil)
+	max.Mul(max, big.NewInt(1e6))
+
+	if !max.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return max.Int64()
+}
+
 // AppStateFromGenesisFileFn util function to generate the genesis AppState
 // from a genesis.json file.
 func AppStateFromGenesisFileFn(r io.Reader, cdc *codec.Codec, genesisFile string) (tmtypes.GenesisDoc, []simulation.Account) {
@@ -0,0 +1,43 @@
+package simapp
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+func TestAppStateRandomizedFnBondDenom(t *testing.T) {
+	app := Setup(false)
+	r := rand.New(rand.NewSource(1))
+	config := simulation.Config{BondDenom: "uatom", BondExponent: 6}
+
+	appState, _ := AppStateRandomizedFn(
+		app.sm, r, app.Codec(), simulation.RandomAccounts(r, 3), time.Now(), make(simulation.AppParams), config,
+	)
+
+	var genesisState map[string]json.RawMessage
+	app.Codec().MustUnmarshalJSON(appState, &genesisState)
+
+	var stakingGenesis staking.GenesisState
+	app.Codec().MustUnmarshalJSON(genesisState[staking.ModuleName], &stakingGenesis)
+	require.Equal(t, "uatom", stakingGenesis.Params.BondDenom)
+
+	var supplyGenesis supply.GenesisState
+	app.Codec().MustUnmarshalJSON(genesisState[supply.ModuleName], &supplyGenesis)
+	require.Len(t, supplyGenesis.Supply, 1)
+	require.Equal(t, "uatom", supplyGenesis.Supply[0].Denom)
+}
+
+func TestMaxInitialStake(t *testing.T) {
+	require.Equal(t, int64(1e6), maxInitialStake(0))
+	require.Equal(t, int64(1e12), maxInitialStake(DefaultBondExponent))
+	require.Equal(t, int64(math.MaxInt64), maxInitialStake(18))
+}
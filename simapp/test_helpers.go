@@ -9,6 +9,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
@@ -17,6 +18,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
@@ -76,6 +78,59 @@ func SetupWithGenesisAccounts(genAccs []authexported.GenesisAccount) *SimApp {
 	return app
 }
 
+// SetupWithGenesisValSet initializes a new SimApp with the passed in genesis
+// accounts and the validators of valSet bonded at genesis. Each validator is
+// bonded with the tokens of its voting power, delegated by the first genesis
+// account, and its operator address is its consensus address.
+func SetupWithGenesisValSet(valSet *tmtypes.ValidatorSet, genAccs []authexported.GenesisAccount) *SimApp {
+	if len(genAccs) == 0 {
+		panic("at least one genesis account is needed to delegate to the validators")
+	}
+
+	db := dbm.NewMemDB()
+	app := NewSimApp(log.NewNopLogger(), db, nil, true, 0)
+
+	genesisState := NewDefaultGenesisState()
+
+	authGenesis := auth.NewGenesisState(auth.DefaultParams(), genAccs)
+	genesisState[auth.ModuleName] = app.cdc.MustMarshalJSON(authGenesis)
+
+	validators := make([]staking.Validator, len(valSet.Validators))
+	delegations := make([]staking.Delegation, len(valSet.Validators))
+	for i, val := range valSet.Validators {
+		tokens := sdk.TokensFromConsensusPower(val.VotingPower)
+
+		validator := staking.NewValidator(sdk.ValAddress(val.Address), val.PubKey, staking.Description{})
+		validator, _ = validator.AddTokensFromDel(tokens)
+
+		validators[i] = validator
+		delegations[i] = staking.NewDelegation(genAccs[0].GetAddress(), validator.OperatorAddress, validator.DelegatorShares)
+	}
+
+	// the validators are bonded by InitChain, their tokens being moved from the
+	// not bonded pool to the bonded one
+	stakingGenesis := staking.NewGenesisState(staking.DefaultParams(), validators, delegations)
+	genesisState[staking.ModuleName] = app.cdc.MustMarshalJSON(stakingGenesis)
+
+	stateBytes, err := codec.MarshalJSONIndent(app.cdc, genesisState)
+	if err != nil {
+		panic(err)
+	}
+
+	// Initialize the chain
+	app.InitChain(
+		abci.RequestInitChain{
+			Validators:    []abci.ValidatorUpdate{},
+			AppStateBytes: stateBytes,
+		},
+	)
+
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: app.LastBlockHeight() + 1}})
+
+	return app
+}
+
 // AddTestAddrs constructs and returns accNum amount of accounts with an
 // initial balance of accAmt
 func AddTestAddrs(app *SimApp, ctx sdk.Context, accNum int, accAmt sdk.Int) []sdk.AccAddress {
@@ -0,0 +1,177 @@
+/*
+Package testutil provides deterministic test accounts and helpers to fund them
+on a SimApp, so that integration tests share the same well-known fixtures
+instead of generating their own keys.
+
+All the accounts are derived from the fixed Mnemonic following the BIP 44 path
+of the Cosmos coin type. The named accounts (alice, bob, ...) use account 0 and
+the validators use account 1 of that path, so their keys and addresses are the
+same on every run:
+
+	alice := testutil.Named("alice")
+	vals := testutil.Validators(4)
+
+	app := simapp.SetupWithGenesisAccounts(
+		testutil.GenesisAccounts(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), alice),
+	)
+
+The validators can be bonded at genesis, or created once the app is set up:
+
+	app := simapp.SetupWithGenesisValSet(testutil.ValidatorSet(10, vals...), genAccs)
+	validators := testutil.CreateValidators(app, ctx, sdk.TokensFromConsensusPower(10), vals...)
+*/
+package testutil
+
+import (
+	"fmt"
+
+	"github.com/cosmos/go-bip39"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// Mnemonic is the fixed mnemonic every test account is derived from. It is the
+// same mnemonic the ledger tests expect the device to be initialized to.
+const Mnemonic = "equip will roof matter pink blind book anxiety banner elbow sun young"
+
+const (
+	namedAccount     uint32 = 0
+	validatorAccount uint32 = 1
+)
+
+// Names are the names of the well-known test accounts in derivation order.
+var Names = []string{"alice", "bob", "charlie", "dave", "eve", "frank", "grace", "heidi"}
+
+// seed is the BIP 39 seed of the Mnemonic, computed once.
+var seed = bip39.NewSeed(Mnemonic, keys.DefaultBIP39Passphrase)
+
+// TestAccount is a test account derived from the Mnemonic.
+type TestAccount struct {
+	Name    string
+	PrivKey crypto.PrivKey
+	PubKey  crypto.PubKey
+	Address sdk.AccAddress
+}
+
+// Derive returns the test account derived from the Mnemonic at the given BIP
+// 44 account and address index of the Cosmos coin type.
+func Derive(name string, account, index uint32) TestAccount {
+	hdPath := hd.NewFundraiserParams(account, sdk.CoinType, index)
+
+	derivedPriv, err := keys.ComputeDerivedKey(seed, hdPath.String())
+	if err != nil {
+		panic(err)
+	}
+
+	privKey := secp256k1.PrivKeySecp256k1(derivedPriv)
+	pubKey := privKey.PubKey()
+
+	return TestAccount{
+		Name:    name,
+		PrivKey: privKey,
+		PubKey:  pubKey,
+		Address: sdk.AccAddress(pubKey.Address()),
+	}
+}
+
+// Named returns the well-known test account with the given name. It panics if
+// the name is not one of Names.
+func Named(name string) TestAccount {
+	for i, n := range Names {
+		if n == name {
+			return Derive(name, namedAccount, uint32(i))
+		}
+	}
+
+	panic(fmt.Sprintf("unknown test account %q", name))
+}
+
+// NamedAccounts returns the first n well-known test accounts. It panics if n
+// is greater than the number of Names.
+func NamedAccounts(n int) []TestAccount {
+	if n > len(Names) {
+		panic(fmt.Sprintf("only %d named test accounts are available, got %d", len(Names), n))
+	}
+
+	accs := make([]TestAccount, n)
+	for i := 0; i < n; i++ {
+		accs[i] = Derive(Names[i], namedAccount, uint32(i))
+	}
+
+	return accs
+}
+
+// Validators returns n test accounts to be used as validator operators, named
+// validator0 to validator<n-1>.
+func Validators(n int) []TestAccount {
+	accs := make([]TestAccount, n)
+	for i := 0; i < n; i++ {
+		accs[i] = Derive(fmt.Sprintf("validator%d", i), validatorAccount, uint32(i))
+	}
+
+	return accs
+}
+
+// ValAddress returns the validator operator address of the test account.
+func (acc TestAccount) ValAddress() sdk.ValAddress {
+	return sdk.ValAddress(acc.Address)
+}
+
+// Addresses returns the addresses of the given test accounts.
+func Addresses(accs ...TestAccount) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(accs))
+	for i, acc := range accs {
+		addrs[i] = acc.Address
+	}
+
+	return addrs
+}
+
+// PrivKeys returns the private keys of the given test accounts.
+func PrivKeys(accs ...TestAccount) []crypto.PrivKey {
+	privKeys := make([]crypto.PrivKey, len(accs))
+	for i, acc := range accs {
+		privKeys[i] = acc.PrivKey
+	}
+
+	return privKeys
+}
+
+// GenesisAccounts returns a genesis account holding the given coins for each
+// of the test accounts, to be passed to simapp.SetupWithGenesisAccounts.
+func GenesisAccounts(coins sdk.Coins, accs ...TestAccount) []authexported.GenesisAccount {
+	genAccs := make([]authexported.GenesisAccount, len(accs))
+	for i, acc := range accs {
+		genAccs[i] = auth.NewBaseAccount(acc.Address, coins, acc.PubKey, 0, 0)
+	}
+
+	return genAccs
+}
+
+// FundAccount adds the given coins to the account of addr, creating it if
+// needed, and increases the total supply accordingly.
+func FundAccount(app *simapp.SimApp, ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error {
+	prevSupply := app.SupplyKeeper.GetSupply(ctx)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(prevSupply.GetTotal().Add(coins)))
+
+	_, err := app.BankKeeper.AddCoins(ctx, addr, coins)
+	return err
+}
+
+// FundAccounts funds each of the test accounts with the given coins. It panics
+// on failure.
+func FundAccounts(app *simapp.SimApp, ctx sdk.Context, coins sdk.Coins, accs ...TestAccount) {
+	for _, acc := range accs {
+		if err := FundAccount(app, ctx, acc.Address, coins); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package testutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func TestDeterministicAccounts(t *testing.T) {
+	alice := testutil.Named("alice")
+	require.Equal(t, "alice", alice.Name)
+	require.Equal(t, "cosmos1w34k53py5v5xyluazqpq65agyajavep2rflq6h", alice.Address.String())
+	require.Equal(t, alice, testutil.Named("alice"))
+	require.Equal(t, alice.Address, sdk.AccAddress(alice.PrivKey.PubKey().Address()))
+
+	bob := testutil.Named("bob")
+	require.Equal(t, "cosmos19ewxwemt6uahejvwf44u7dh6tq859tkyvarh2q", bob.Address.String())
+	require.Equal(t, []testutil.TestAccount{alice, bob}, testutil.NamedAccounts(2))
+
+	vals := testutil.Validators(3)
+	require.Len(t, vals, 3)
+	require.Equal(t, "validator0", vals[0].Name)
+	require.Equal(t, "cosmosvaloper10sdrdtthkp9g8szfsdwwt2jznhgutrr2kw6av6", vals[0].ValAddress().String())
+	require.Equal(t, vals, testutil.Validators(3))
+
+	seen := make(map[string]bool)
+	for _, acc := range append(testutil.NamedAccounts(len(testutil.Names)), vals...) {
+		require.False(t, seen[acc.Address.String()], "duplicate address for %s", acc.Name)
+		seen[acc.Address.String()] = true
+	}
+
+	require.Panics(t, func() { testutil.Named("mallory") })
+	require.Panics(t, func() { testutil.NamedAccounts(len(testutil.Names) + 1) })
+}
+
+func TestFundAccounts(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	accs := testutil.NamedAccounts(3)
+	testutil.FundAccounts(app, ctx, coins, accs...)
+
+	for _, acc := range accs {
+		require.Equal(t, coins, app.BankKeeper.GetCoins(ctx, acc.Address))
+	}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)), app.SupplyKeeper.GetSupply(ctx).GetTotal())
+}
+
+func TestGenesisAccounts(t *testing.T) {
+	alice, bob := testutil.Named("alice"), testutil.Named("bob")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	app := simapp.SetupWithGenesisAccounts(testutil.GenesisAccounts(coins, alice, bob))
+	ctx := app.BaseApp.NewContext(true, abci.Header{})
+	require.Equal(t, coins, app.AccountKeeper.GetAccount(ctx, alice.Address).GetCoins())
+
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	msg := bank.NewMsgSend(alice.Address, bob.Address, sendCoins)
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	accNum := app.AccountKeeper.GetAccount(ctx, alice.Address).GetAccountNumber()
+
+	simapp.SignCheckDeliver(t, app.Codec(), app.BaseApp, header, []sdk.Msg{msg}, []uint64{accNum}, []uint64{0}, true, true, alice.PrivKey)
+
+	simapp.CheckBalance(t, app, alice.Address, coins.Sub(sendCoins))
+	simapp.CheckBalance(t, app, bob.Address, coins.Add(sendCoins))
+}
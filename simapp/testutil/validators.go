@@ -0,0 +1,69 @@
+package testutil
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// ConsPrivKey returns the consensus private key of the test account, derived
+// from its private key so that it is the same on every run.
+func (acc TestAccount) ConsPrivKey() crypto.PrivKey {
+	return ed25519.GenPrivKeyFromSecret(acc.PrivKey.Bytes())
+}
+
+// ConsPubKey returns the consensus public key of the test account.
+func (acc TestAccount) ConsPubKey() crypto.PubKey {
+	return acc.ConsPrivKey().PubKey()
+}
+
+// ValidatorSet returns a Tendermint validator set made of the consensus keys
+// of the test accounts, each with the given voting power, to be passed to
+// simapp.SetupWithGenesisValSet.
+func ValidatorSet(power int64, accs ...TestAccount) *tmtypes.ValidatorSet {
+	vals := make([]*tmtypes.Validator, len(accs))
+	for i, acc := range accs {
+		vals[i] = tmtypes.NewValidator(acc.ConsPubKey(), power)
+	}
+
+	return tmtypes.NewValidatorSet(vals)
+}
+
+// CreateValidators funds each of the test accounts with the given amount of
+// bond tokens and creates a validator operated by it, self-delegating these
+// tokens. The validator set updates are applied, so that the validators are
+// bonded if their power allows it. It panics on failure.
+func CreateValidators(app *simapp.SimApp, ctx sdk.Context, tokens sdk.Int, accs ...TestAccount) []staking.Validator {
+	handler := staking.NewHandler(app.StakingKeeper)
+	selfDelegation := sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), tokens)
+
+	for _, acc := range accs {
+		FundAccounts(app, ctx, sdk.NewCoins(selfDelegation), acc)
+
+		msg := staking.NewMsgCreateValidator(
+			acc.ValAddress(), acc.ConsPubKey(), selfDelegation,
+			staking.NewDescription(acc.Name, "", "", "", ""),
+			staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+			sdk.OneInt(),
+		)
+
+		if res := handler(ctx, msg); !res.IsOK() {
+			panic(fmt.Sprintf("failed to create validator %s: %s", acc.Name, res.Log))
+		}
+	}
+
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	validators := make([]staking.Validator, len(accs))
+	for i, acc := range accs {
+		validators[i], _ = app.StakingKeeper.GetValidator(ctx, acc.ValAddress())
+	}
+
+	return validators
+}
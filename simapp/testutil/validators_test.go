@@ -0,0 +1,56 @@
+package testutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCreateValidators(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	tokens := sdk.TokensFromConsensusPower(10)
+	vals := testutil.Validators(2)
+	validators := testutil.CreateValidators(app, ctx, tokens, vals...)
+
+	require.Len(t, validators, 2)
+	for i, validator := range validators {
+		require.Equal(t, vals[i].ValAddress(), validator.OperatorAddress)
+		require.Equal(t, vals[i].ConsPubKey(), validator.ConsPubKey)
+		require.Equal(t, sdk.Bonded, validator.Status)
+		require.Equal(t, tokens, validator.Tokens)
+
+		_, found := app.StakingKeeper.GetDelegation(ctx, vals[i].Address, vals[i].ValAddress())
+		require.True(t, found)
+		require.True(t, app.BankKeeper.GetCoins(ctx, vals[i].Address).IsZero())
+	}
+	require.Equal(t, tokens.MulRaw(2), app.StakingKeeper.TotalBondedTokens(ctx))
+}
+
+func TestSetupWithGenesisValSet(t *testing.T) {
+	alice := testutil.Named("alice")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	valSet := testutil.ValidatorSet(1, testutil.Validators(3)...)
+
+	app := simapp.SetupWithGenesisValSet(valSet, testutil.GenesisAccounts(coins, alice))
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	require.Equal(t, coins, app.BankKeeper.GetCoins(ctx, alice.Address))
+
+	validators := app.StakingKeeper.GetLastValidators(ctx)
+	require.Len(t, validators, 3)
+	for _, validator := range validators {
+		require.Equal(t, sdk.Bonded, validator.Status)
+		require.Equal(t, sdk.TokensFromConsensusPower(1), validator.Tokens)
+
+		_, found := app.StakingKeeper.GetDelegation(ctx, alice.Address, validator.OperatorAddress)
+		require.True(t, found)
+	}
+	require.Equal(t, sdk.TokensFromConsensusPower(3), app.StakingKeeper.TotalBondedTokens(ctx))
+}
@@ -1,21 +1,28 @@
 package simapp
 
 import (
+	"bytes"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"time"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
 //---------------------------------------------------------------------
 // Flags
 
+// DefaultBondExponent defines the default number of decimal places of the bond
+// denomination of simulations, matching sdk.PowerReduction.
+const DefaultBondExponent = 6
+
 // List of available flags for the simulator
 var (
 	FlagGenesisFileValue        string
@@ -32,11 +39,36 @@ var (
 	FlagCommitValue             bool
 	FlagOnOperationValue        bool // TODO: Remove in favor of binary search for invariant violation
 	FlagAllInvariantsValue      bool
+	FlagExtremeValueRateValue   float64
+	FlagFeePressureValue        bool
+	FlagMinGasPricesValue       bool
+	FlagBondDenomValue          string
+	FlagBondExponentValue       uint
+	FlagRestartPeriodValue      int
+	FlagExportReplayPathValue   string
+	FlagSimulationReplayValue   string
+	FlagReplayFromHeightValue   int
+	FlagCorpusDirValue          string
+
+	FlagExportStateOnFailureValue       bool
+	FlagInvariantCheckPeriodValue       int
+	FlagExportInvariantsReportPathValue string
+	FlagImportExportCheckPeriodValue    int
+	FlagMaxFailureRateValue             float64
+	FlagMaxNoOpRateValue                float64
+	FlagBlockSizeDistributionValue      string
+	FlagEmptyBlockRateValue             float64
+	FlagEmptyBlockStretchValue          int
+	FlagMinBlockTimeValue               time.Duration
+	FlagMaxBlockTimeValue               time.Duration
 
 	FlagEnabledValue     bool
 	FlagVerboseValue     bool
 	FlagPeriodValue      uint
 	FlagGenesisTimeValue int64
+
+	FlagNumSeedsValue           int
+	FlagMultiSeedOutputDirValue string
 )
 
 // GetSimulatorFlags gets the values of all the available simulation flags
@@ -47,7 +79,10 @@ func GetSimulatorFlags() {
 	flag.StringVar(&FlagExportParamsPathValue, "ExportParamsPath", "", "custom file path to save the exported params JSON")
 	flag.IntVar(&FlagExportParamsHeightValue, "ExportParamsHeight", 0, "height to which export the randomly generated params")
 	flag.StringVar(&FlagExportStatePathValue, "ExportStatePath", "", "custom file path to save the exported app state JSON")
+	flag.BoolVar(&FlagExportStateOnFailureValue, "ExportStateOnFailure", false, "only export the app state to the state path if the simulation fails")
 	flag.StringVar(&FlagExportStatsPathValue, "ExportStatsPath", "", "custom file path to save the exported simulation statistics JSON")
+	flag.Float64Var(&FlagMaxFailureRateValue, "MaxFailureRate", 0, "fail the simulation if the operations of a msg type fail more often than this rate; zero disables the check")
+	flag.Float64Var(&FlagMaxNoOpRateValue, "MaxNoOpRate", 0, "fail the simulation if the operations of a msg type end up as no-ops more often than this rate; zero disables the check")
 	flag.Int64Var(&FlagSeedValue, "Seed", 42, "simulation random seed")
 	flag.IntVar(&FlagInitialBlockHeightValue, "InitialBlockHeight", 1, "initial block to start the simulation")
 	flag.IntVar(&FlagNumBlocksValue, "NumBlocks", 500, "number of new blocks to simulate from the initial block height")
@@ -56,12 +91,32 @@ func GetSimulatorFlags() {
 	flag.BoolVar(&FlagCommitValue, "Commit", false, "have the simulation commit")
 	flag.BoolVar(&FlagOnOperationValue, "SimulateEveryOperation", false, "run slow invariants every operation")
 	flag.BoolVar(&FlagAllInvariantsValue, "PrintAllInvariants", false, "print all invariants if a broken invariant is found")
+	flag.StringVar(&FlagBondDenomValue, "BondDenom", sdk.DefaultBondDenom, "bond denomination of the randomized genesis")
+	flag.UintVar(&FlagBondExponentValue, "BondExponent", DefaultBondExponent, "number of decimal places of the bond denomination")
+	flag.IntVar(&FlagRestartPeriodValue, "RestartPeriod", 0, "restart the app from its committed state every period blocks; requires commit")
+	flag.StringVar(&FlagExportReplayPathValue, "ExportReplayPath", "", "custom file path to record the simulation replay JSON")
+	flag.StringVar(&FlagSimulationReplayValue, "SimulationReplay", "", "simulation replay file to re-execute; overrides the seed and the block parameters")
+	flag.IntVar(&FlagReplayFromHeightValue, "ReplayFromHeight", 0, "height of a block of the simulation replay from which its blocks are checked; the previous ones are re-executed without checks")
+	flag.StringVar(&FlagCorpusDirValue, "CorpusDir", "", "corpus directory to save the replays of failing simulations to, and to replay with TestSimulationCorpus")
+	flag.IntVar(&FlagInvariantCheckPeriodValue, "InvariantCheckPeriod", 0, "check the invariants every period blocks and report the broken ones with the stores they read")
+	flag.StringVar(&FlagExportInvariantsReportPathValue, "ExportInvariantsReportPath", "", "custom file path to save the broken invariants report JSON")
+	flag.IntVar(&FlagImportExportCheckPeriodValue, "ImportExportCheckPeriod", 0, "export the app state every period blocks, import it in a fresh app and compare the stores of both apps; requires commit")
+	flag.Float64Var(&FlagExtremeValueRateValue, "ExtremeValueRate", 0, "probability of generating boundary values (1, max, max-1, truncating amounts) for random amounts")
+	flag.BoolVar(&FlagFeePressureValue, "FeePressure", false, "vary the fees paid by the simulated txs across low, normal and high fee pressure regimes and check their accounting; requires commit")
+	flag.BoolVar(&FlagMinGasPricesValue, "MinGasPrices", false, "set a random minimum gas price on the app, generate fees below and above it and check CheckTx rejects the underpriced txs")
+	flag.StringVar(&FlagBlockSizeDistributionValue, "BlockSizeDistribution", simulation.BlockSizeMarkov, "distribution of the operations per block around the block size: markov, fixed or uniform")
+	flag.Float64Var(&FlagEmptyBlockRateValue, "EmptyBlockRate", 0, "probability of starting a stretch of empty blocks on every block")
+	flag.IntVar(&FlagEmptyBlockStretchValue, "EmptyBlockStretch", 1, "maximum number of consecutive empty blocks of a stretch started by the empty block rate")
+	flag.DurationVar(&FlagMinBlockTimeValue, "MinBlockTime", 5000*time.Second, "minimum time between two blocks")
+	flag.DurationVar(&FlagMaxBlockTimeValue, "MaxBlockTime", 10000*time.Second, "maximum time between two blocks")
 
 	// simulation flags
 	flag.BoolVar(&FlagEnabledValue, "Enabled", false, "enable the simulation")
 	flag.BoolVar(&FlagVerboseValue, "Verbose", false, "verbose log output")
 	flag.UintVar(&FlagPeriodValue, "Period", 0, "run slow invariants only once every period assertions")
 	flag.Int64Var(&FlagGenesisTimeValue, "GenesisTime", 0, "override genesis UNIX time instead of using a random UNIX time")
+	flag.IntVar(&FlagNumSeedsValue, "NumSeeds", 10, "number of seeds simulated concurrently by the multi-seed simulation, starting from the given seed")
+	flag.StringVar(&FlagMultiSeedOutputDirValue, "MultiSeedOutputDir", "", "directory to save the logs of every seed and the report of the multi-seed simulation; defaults to a temporary directory")
 }
 
 // NewConfigFromFlags creates a simulation from the retrieved values of the flags.
@@ -81,12 +136,62 @@ func NewConfigFromFlags() simulation.Config {
 		Commit:             FlagCommitValue,
 		OnOperation:        FlagOnOperationValue,
 		AllInvariants:      FlagAllInvariantsValue,
+		ExtremeValueRate:   FlagExtremeValueRateValue,
+		FeePressure:        FlagFeePressureValue,
+		MinGasPrices:       FlagMinGasPricesValue,
+		BondDenom:          FlagBondDenomValue,
+		BondExponent:       FlagBondExponentValue,
+		RestartPeriod:      FlagRestartPeriodValue,
+		ExportReplayPath:   FlagExportReplayPathValue,
+		ReplayFile:         FlagSimulationReplayValue,
+		ReplayFromHeight:   FlagReplayFromHeightValue,
+		CorpusDir:          FlagCorpusDirValue,
+
+		ExportStateOnFailure:       FlagExportStateOnFailureValue,
+		InvariantCheckPeriod:       FlagInvariantCheckPeriodValue,
+		ExportInvariantsReportPath: FlagExportInvariantsReportPathValue,
+		ImportExportCheckPeriod:    FlagImportExportCheckPeriodValue,
+		MaxFailureRate:             FlagMaxFailureRateValue,
+		MaxNoOpRate:                FlagMaxNoOpRateValue,
+		BlockSizeDistribution:      FlagBlockSizeDistributionValue,
+		EmptyBlockRate:             FlagEmptyBlockRateValue,
+		EmptyBlockStretch:          FlagEmptyBlockStretchValue,
+		MinBlockTime:               FlagMinBlockTimeValue,
+		MaxBlockTime:               FlagMaxBlockTimeValue,
 	}
 }
 
 //---------------------------------------------------------------------
 // Simulation Utils
 
+// SimulationOperations retrieves the simulation params from the provided file path
+// and returns all the modules weighted operations
+func SimulationOperations(app *SimApp, cdc *codec.Codec, config simulation.Config) []simulation.WeightedOperation {
+	bondDenom := config.BondDenom
+	if bondDenom == "" {
+		bondDenom = sdk.DefaultBondDenom
+	}
+
+	simState := module.SimulationState{
+		AppParams: make(simulation.AppParams),
+		Cdc:       cdc,
+		BondDenom: bondDenom,
+	}
+
+	if config.ParamsFile != "" {
+		bz, err := ioutil.ReadFile(config.ParamsFile)
+		if err != nil {
+			panic(err)
+		}
+
+		app.cdc.MustUnmarshalJSON(bz, &simState.AppParams)
+	}
+
+	simState.ParamChanges = app.SimulationManager().GenerateParamChanges(config.Seed)
+	simState.Contents = app.SimulationManager().GetProposalContents(simState)
+	return app.SimulationManager().WeightedOperations(simState)
+}
+
 // ExportStateToJSON util function to export the app state to JSON
 func ExportStateToJSON(app *SimApp, path string) error {
 	fmt.Println("exporting app state...")
@@ -119,13 +224,85 @@ func GetSimulationLog(storeName string, sdr sdk.StoreDecoderRegistry, cdc *codec
 			continue
 		}
 
-		decoder, ok := sdr[storeName]
-		if ok {
-			log += decoder(cdc, kvAs[i], kvBs[i])
-		} else {
-			log += fmt.Sprintf("store A %X => %X\nstore B %X => %X\n", kvAs[i].Key, kvAs[i].Value, kvBs[i].Key, kvBs[i].Value)
-		}
+		log += DecodeKVPair(storeName, sdr, cdc, kvAs[i], kvBs[i])
 	}
 
 	return
 }
+
+// DecodeKVPair decodes a pair of differing key-value pairs of a store with the
+// decoder registered for it. If the key is missing from one of the stores,
+// the pair of the other store is decoded against itself. The pairs are printed
+// as raw bytes if the store doesn't have any decoder or if the decoder fails to
+// decode them.
+func DecodeKVPair(storeName string, sdr sdk.StoreDecoderRegistry, cdc *codec.Codec, kvA, kvB cmn.KVPair) (log string) {
+	decoder, ok := sdr[storeName]
+	if !ok {
+		return rawKVPairLog(kvA, kvB)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			log = rawKVPairLog(kvA, kvB)
+		}
+	}()
+
+	switch {
+	case len(kvA.Value) == 0 && len(kvB.Value) != 0:
+		return fmt.Sprintf("%X missing from store A\n%s", kvB.Key, decoder(cdc, kvB, kvB))
+	case len(kvB.Value) == 0 && len(kvA.Value) != 0:
+		return fmt.Sprintf("%X missing from store B\n%s", kvA.Key, decoder(cdc, kvA, kvA))
+	default:
+		return decoder(cdc, kvA, kvB)
+	}
+}
+
+func rawKVPairLog(kvA, kvB cmn.KVPair) string {
+	return fmt.Sprintf("store A %X => %X\nstore B %X => %X\n", kvA.Key, kvA.Value, kvB.Key, kvB.Value)
+}
+
+// DiffKVStoresByKey returns the key-value pairs that differ between two stores.
+// Unlike sdk.DiffKVStores, the pairs are matched by key, so that a key added to
+// or removed from one of the stores doesn't shift the remaining pairs. Such a
+// key is returned on both sides, with an empty value on the store missing it.
+func DiffKVStoresByKey(a, b sdk.KVStore) (kvAs, kvBs []cmn.KVPair) {
+	iterA := a.Iterator(nil, nil)
+	defer iterA.Close()
+
+	iterB := b.Iterator(nil, nil)
+	defer iterB.Close()
+
+	for iterA.Valid() || iterB.Valid() {
+		var cmp int
+		switch {
+		case !iterA.Valid():
+			cmp = 1
+		case !iterB.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(iterA.Key(), iterB.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			kvAs = append(kvAs, cmn.KVPair{Key: iterA.Key(), Value: iterA.Value()})
+			kvBs = append(kvBs, cmn.KVPair{Key: iterA.Key()})
+			iterA.Next()
+
+		case cmp > 0:
+			kvAs = append(kvAs, cmn.KVPair{Key: iterB.Key()})
+			kvBs = append(kvBs, cmn.KVPair{Key: iterB.Key(), Value: iterB.Value()})
+			iterB.Next()
+
+		default:
+			if !bytes.Equal(iterA.Value(), iterB.Value()) {
+				kvAs = append(kvAs, cmn.KVPair{Key: iterA.Key(), Value: iterA.Value()})
+				kvBs = append(kvBs, cmn.KVPair{Key: iterB.Key(), Value: iterB.Value()})
+			}
+			iterA.Next()
+			iterB.Next()
+		}
+	}
+
+	return kvAs, kvBs
+}
@@ -7,8 +7,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 
 	"github.com/cosmos/cosmos-sdk/x/auth"
 
@@ -50,3 +52,62 @@ func TestGetSimulationLog(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeKVPair(t *testing.T) {
+	cdc := MakeCodec()
+
+	decoders := make(sdk.StoreDecoderRegistry)
+	decoders[auth.StoreKey] = func(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+		var numberA, numberB uint64
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &numberA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &numberB)
+		return fmt.Sprintf("%d\n%d", numberA, numberB)
+	}
+
+	kvA := cmn.KVPair{Key: auth.GlobalAccountNumberKey, Value: cdc.MustMarshalBinaryLengthPrefixed(uint64(10))}
+	kvB := cmn.KVPair{Key: auth.GlobalAccountNumberKey, Value: cdc.MustMarshalBinaryLengthPrefixed(uint64(11))}
+	require.Equal(t, "10\n11", DecodeKVPair(auth.StoreKey, decoders, cdc, kvA, kvB))
+
+	// the pair of the store holding a key is decoded against itself
+	kvB = cmn.KVPair{Key: auth.GlobalAccountNumberKey}
+	require.Equal(t,
+		fmt.Sprintf("%X missing from store B\n10\n10", kvA.Key),
+		DecodeKVPair(auth.StoreKey, decoders, cdc, kvA, kvB),
+	)
+
+	// pairs the decoder fails to decode are printed as raw bytes
+	kvA = cmn.KVPair{Key: auth.GlobalAccountNumberKey, Value: []byte{0xFF}}
+	kvB = cmn.KVPair{Key: auth.GlobalAccountNumberKey, Value: []byte{0xFF}}
+	require.Equal(t,
+		fmt.Sprintf("store A %X => FF\nstore B %X => FF\n", kvA.Key, kvB.Key),
+		DecodeKVPair(auth.StoreKey, decoders, cdc, kvA, kvB),
+	)
+}
+
+func TestDiffKVStoresByKey(t *testing.T) {
+	storeA := dbadapter.Store{DB: dbm.NewMemDB()}
+	storeB := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	storeA.Set([]byte{0x01}, []byte("same"))
+	storeB.Set([]byte{0x01}, []byte("same"))
+	storeA.Set([]byte{0x02}, []byte("removed"))
+	storeA.Set([]byte{0x03}, []byte("before"))
+	storeB.Set([]byte{0x03}, []byte("after"))
+	storeB.Set([]byte{0x04}, []byte("added"))
+
+	kvAs, kvBs := DiffKVStoresByKey(storeA, storeB)
+	require.Equal(t, []cmn.KVPair{
+		{Key: []byte{0x02}, Value: []byte("removed")},
+		{Key: []byte{0x03}, Value: []byte("before")},
+		{Key: []byte{0x04}},
+	}, kvAs)
+	require.Equal(t, []cmn.KVPair{
+		{Key: []byte{0x02}},
+		{Key: []byte{0x03}, Value: []byte("after")},
+		{Key: []byte{0x04}, Value: []byte("added")},
+	}, kvBs)
+
+	kvAs, kvBs = DiffKVStoresByKey(storeA, storeA)
+	require.Empty(t, kvAs)
+	require.Empty(t, kvBs)
+}
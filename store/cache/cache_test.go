@@ -61,3 +61,31 @@ func TestStoreCache(t *testing.T) {
 		require.Nil(t, store.Get(key))
 	}
 }
+
+func BenchmarkStoreCacheGet(b *testing.B) {
+	db := dbm.NewMemDB()
+	store := iavlstore.UnsafeNewStore(iavl.NewMutableTree(db, 100), 10, 10)
+
+	keys := make([][]byte, 100)
+	for i := range keys {
+		keys[i] = []byte(fmt.Sprintf("key_%d", i))
+		store.Set(keys[i], []byte(fmt.Sprintf("value_%d", i)))
+	}
+	store.Commit()
+
+	benchmarks := []struct {
+		name  string
+		store types.KVStore
+	}{
+		{"uncached", store},
+		{"cached", cache.NewCommitKVStoreCache(store, cache.DefaultCommitKVStoreCacheSize)},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = bm.store.Get(keys[i%len(keys)])
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package iavl
+
+import (
+	"sync/atomic"
+
+	"github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	// DefaultCacheSize is the size of the node cache of an IAVL store, in
+	// nodes, unless configured otherwise.
+	DefaultCacheSize = 10000
+
+	// nodeKeyPrefix is the prefix of the keys of the nodes in the database of
+	// an IAVL tree.
+	nodeKeyPrefix = 'n'
+)
+
+// CacheConfig defines the sizes of the node caches of the IAVL stores of a
+// multistore, and the metrics they report their usage to.
+type CacheConfig struct {
+	// Sizes of the node caches of the stores, in nodes, by store name.
+	Sizes map[string]int
+
+	// Budget of nodes shared by the stores without a size in Sizes. It is split
+	// across them in proportion to their number of keys when they are loaded.
+	// Zero gives each of them DefaultCacheSize nodes instead.
+	Budget int
+
+	// Metrics the stores report the usage of their node cache to; nil disables
+	// them.
+	Metrics *Metrics
+}
+
+// CacheSizes returns the sizes of the node caches of the named stores, given
+// their number of keys. The stores configured with a size get it, while the
+// others share the budget, if any.
+func (c CacheConfig) CacheSizes(numKeys map[string]int64) map[string]int {
+	sizes := make(map[string]int, len(numKeys))
+
+	var shared []string
+	for name := range numKeys {
+		if size, ok := c.Sizes[name]; ok {
+			sizes[name] = size
+			continue
+		}
+
+		if c.Budget <= 0 {
+			sizes[name] = DefaultCacheSize
+			continue
+		}
+
+		shared = append(shared, name)
+	}
+
+	// every store counts one key more so that empty stores get a share too
+	var total int64
+	for _, name := range shared {
+		total += numKeys[name] + 1
+	}
+
+	for _, name := range shared {
+		sizes[name] = int(int64(c.Budget) * (numKeys[name] + 1) / total)
+	}
+
+	return sizes
+}
+
+// NumKeys returns the number of keys of the version of an IAVL tree stored in
+// a database, loading only the root of that version. A version without a root
+// hash is the one of an empty tree.
+func NumKeys(db dbm.DB, id types.CommitID) (int64, error) {
+	if len(id.Hash) == 0 {
+		return 0, nil
+	}
+
+	tree := iavl.NewMutableTree(db, 0)
+	if _, err := tree.LazyLoadVersion(id.Version); err != nil {
+		return 0, err
+	}
+
+	return tree.Size(), nil
+}
+
+// meteredDB is the database of an IAVL tree which counts the nodes read from
+// it, which are the node lookups missed by the node cache of the tree.
+type meteredDB struct {
+	dbm.DB
+
+	nodeReads uint64 // accessed atomically
+	metrics   *Metrics
+}
+
+func newMeteredDB(db dbm.DB, metrics *Metrics) *meteredDB {
+	return &meteredDB{DB: db, metrics: metrics}
+}
+
+// Get implements dbm.DB.
+func (db *meteredDB) Get(key []byte) []byte {
+	if len(key) > 0 && key[0] == nodeKeyPrefix {
+		atomic.AddUint64(&db.nodeReads, 1)
+		db.metrics.NodeReads.Add(1)
+	}
+
+	return db.DB.Get(key)
+}
+
+// NodeReads returns the number of nodes read so far.
+func (db *meteredDB) NodeReads() uint64 {
+	return atomic.LoadUint64(&db.nodeReads)
+}
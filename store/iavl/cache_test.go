@@ -0,0 +1,97 @@
+package iavl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-kit/kit/metrics/generic"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestCacheSizes(t *testing.T) {
+	numKeys := map[string]int64{"acc": 299, "staking": 99, "gov": 0, "params": 599}
+
+	// without a budget, the stores without a size get the default one
+	config := CacheConfig{Sizes: map[string]int{"params": 500}}
+	require.Equal(t, map[string]int{
+		"acc": DefaultCacheSize, "staking": DefaultCacheSize, "gov": DefaultCacheSize, "params": 500,
+	}, config.CacheSizes(numKeys))
+
+	// the budget is split in proportion to the number of keys, with empty
+	// stores getting a share too
+	config.Budget = 4010
+	require.Equal(t, map[string]int{
+		"acc": 3000, "staking": 1000, "gov": 10, "params": 500,
+	}, config.CacheSizes(numKeys))
+}
+
+func TestNumKeys(t *testing.T) {
+	db := dbm.NewMemDB()
+	n, err := NumKeys(db, types.CommitID{})
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	tree, cID := newAlohaTree(t, db)
+	tree.Set([]byte("hola"), []byte("adios"))
+	hash, ver, err := tree.SaveVersion()
+	require.NoError(t, err)
+
+	n, err = NumKeys(db, cID)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(treeData)), n)
+
+	n, err = NumKeys(db, types.CommitID{Version: ver, Hash: hash})
+	require.NoError(t, err)
+	require.Equal(t, int64(len(treeData)+1), n)
+
+	_, err = NumKeys(db, types.CommitID{Version: ver + 1, Hash: hash})
+	require.Error(t, err)
+
+	// the versions of an empty tree have no root hash
+	emptyTree := iavl.NewMutableTree(dbm.NewMemDB(), cacheSize)
+	hash, ver, err = emptyTree.SaveVersion()
+	require.NoError(t, err)
+	n, err = NumKeys(db, types.CommitID{Version: ver, Hash: hash})
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func TestCacheMetrics(t *testing.T) {
+	db := dbm.NewMemDB()
+	store, err := LoadStore(db, types.CommitID{}, types.PruneNothing, false)
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		store.(*Store).Set([]byte(fmt.Sprintf("key%03d", i)), []byte("value"))
+	}
+	cID := store.Commit()
+
+	metrics := &Metrics{
+		CacheSize:   generic.NewGauge("cache_size"),
+		CacheHits:   generic.NewCounter("cache_hits"),
+		CacheMisses: generic.NewCounter("cache_misses"),
+		NodeReads:   generic.NewCounter("node_reads"),
+	}
+	store, err = LoadStoreWithCache(db, cID, types.PruneNothing, false, 1000, metrics)
+	require.NoError(t, err)
+	require.Equal(t, float64(1000), metrics.CacheSize.(*generic.Gauge).Value())
+
+	// the first read loads the nodes on its path from disk, the second one
+	// finds them in the node cache
+	kvStore := store.(*Store)
+	require.Equal(t, []byte("value"), kvStore.Get([]byte("key042")))
+	require.Equal(t, float64(0), metrics.CacheHits.(*generic.Counter).Value())
+	require.Equal(t, float64(1), metrics.CacheMisses.(*generic.Counter).Value())
+
+	nodeReads := metrics.NodeReads.(*generic.Counter).Value()
+	require.True(t, nodeReads > 0)
+	require.Equal(t, float64(kvStore.db.NodeReads()), nodeReads)
+
+	require.True(t, kvStore.Has([]byte("key042")))
+	require.Equal(t, float64(1), metrics.CacheHits.(*generic.Counter).Value())
+	require.Equal(t, float64(1), metrics.CacheMisses.(*generic.Counter).Value())
+	require.Equal(t, nodeReads, metrics.NodeReads.(*generic.Counter).Value())
+}
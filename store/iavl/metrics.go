@@ -0,0 +1,91 @@
+package iavl
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by the
+	// IAVL stores.
+	MetricsSubsystem = "iavl"
+
+	// MetricsStoreLabel is the label holding the store name.
+	MetricsStoreLabel = "store"
+)
+
+// Metrics contains metrics exposed by the IAVL stores.
+//
+// The node cache of the IAVL trees doesn't report its lookups, so the hits and
+// misses are counted per read of the store (Get or Has): a read is a miss if
+// any of the nodes it visits has to be read from disk. The nodes read by the
+// iterators open on the same store while a read runs are counted against it.
+type Metrics struct {
+	// Size of the node cache of each store, in nodes.
+	CacheSize metrics.Gauge
+	// Reads of a store served by the node cache alone.
+	CacheHits metrics.Counter
+	// Reads of a store which read nodes from disk.
+	CacheMisses metrics.Counter
+	// Nodes read from disk as they were missing from the node cache.
+	NodeReads metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics built using the Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{MetricsStoreLabel}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+
+	return &Metrics{
+		CacheSize: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_size",
+			Help:      "Size of the node cache of a store, in nodes.",
+		}, labels).With(labelsAndValues...),
+		CacheHits: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_hits",
+			Help:      "Number of reads of a store served by the node cache alone.",
+		}, labels).With(labelsAndValues...),
+		CacheMisses: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_misses",
+			Help:      "Number of reads of a store which read nodes from disk.",
+		}, labels).With(labelsAndValues...),
+		NodeReads: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "node_reads",
+			Help:      "Number of nodes of a store read from disk as they were missing from the node cache.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		CacheSize:   discard.NewGauge(),
+		CacheHits:   discard.NewCounter(),
+		CacheMisses: discard.NewCounter(),
+		NodeReads:   discard.NewCounter(),
+	}
+}
+
+// WithStore returns the metrics of the store with the given name.
+func (m *Metrics) WithStore(name string) *Metrics {
+	return &Metrics{
+		CacheSize:   m.CacheSize.With(MetricsStoreLabel, name),
+		CacheHits:   m.CacheHits.With(MetricsStoreLabel, name),
+		CacheMisses: m.CacheMisses.With(MetricsStoreLabel, name),
+		NodeReads:   m.NodeReads.With(MetricsStoreLabel, name),
+	}
+}
@@ -18,15 +18,17 @@ import (
 	dbm "github.com/tendermint/tm-db"
 )
 
-const (
-	defaultIAVLCacheSize = 10000
-)
+// pruneHeightsKey is the key of the released versions pending deletion in the
+// database of the tree, outside of the prefixes of the IAVL nodes, orphans and
+// roots.
+var pruneHeightsKey = []byte("s/pruneheights")
 
 var (
 	_ types.KVStore       = (*Store)(nil)
 	_ types.CommitStore   = (*Store)(nil)
 	_ types.CommitKVStore = (*Store)(nil)
 	_ types.Queryable     = (*Store)(nil)
+	_ types.StatsReporter = (*Store)(nil)
 )
 
 // Store Implements types.KVStore and CommitKVStore.
@@ -44,13 +46,46 @@ type Store struct {
 	// By default this value should be set the same across all nodes,
 	// so that nodes can know the waypoints their peers store.
 	storeEvery int64
+
+	// The number of commits between two deletions of the released versions,
+	// which are kept in pruneHeights in the meantime. They are persisted to
+	// pruneDB, if set, so that those pending when the node stops are deleted
+	// after it restarts.
+	pruneInterval int64
+	pruneHeights  []int64
+	pruneDB       dbm.DB
+
+	// The database of the tree counting the nodes read from disk, and the
+	// metrics the node cache usage is reported to; nil if metrics are disabled.
+	db      *meteredDB
+	metrics *Metrics
 }
 
 // LoadStore returns an IAVL Store as a CommitKVStore. Internally it will load the
 // store's version (id) from the provided DB. An error is returned if the version
 // fails to load.
 func LoadStore(db dbm.DB, id types.CommitID, pruning types.PruningOptions, lazyLoading bool) (types.CommitKVStore, error) {
-	tree := iavl.NewMutableTree(db, defaultIAVLCacheSize)
+	return LoadStoreWithCache(db, id, pruning, lazyLoading, DefaultCacheSize, nil)
+}
+
+// LoadStoreWithCache behaves like LoadStore but the node cache of the tree
+// holds up to cacheSize nodes. If metrics are provided, the store reports the
+// usage of its node cache to them.
+func LoadStoreWithCache(
+	db dbm.DB, id types.CommitID, pruning types.PruningOptions, lazyLoading bool,
+	cacheSize int, metrics *Metrics,
+) (types.CommitKVStore, error) {
+
+	pruneDB := db
+
+	var mdb *meteredDB
+	if metrics != nil {
+		mdb = newMeteredDB(db, metrics)
+		db = mdb
+		metrics.CacheSize.Set(float64(cacheSize))
+	}
+
+	tree := iavl.NewMutableTree(db, cacheSize)
 
 	var err error
 	if lazyLoading {
@@ -65,18 +100,57 @@ func LoadStore(db dbm.DB, id types.CommitID, pruning types.PruningOptions, lazyL
 
 	iavl := UnsafeNewStore(tree, int64(0), int64(0))
 	iavl.SetPruning(pruning)
+	iavl.db, iavl.metrics = mdb, metrics
+	iavl.loadPruneHeights(pruneDB)
 
 	return iavl, nil
 }
 
+// loadPruneHeights loads the released versions pending deletion from the given
+// database, which they are persisted to from then on. The versions after the
+// loaded one are dropped, as the tree no longer has them.
+func (st *Store) loadPruneHeights(db dbm.DB) {
+	st.pruneDB = db
+
+	bz := db.Get(pruneHeightsKey)
+	if bz == nil {
+		return
+	}
+
+	var heights []int64
+	cdc.MustUnmarshalBinaryBare(bz, &heights)
+
+	version := st.tree.Version()
+	for _, height := range heights {
+		if height <= version {
+			st.pruneHeights = append(st.pruneHeights, height)
+		}
+	}
+}
+
+// savePruneHeights persists the released versions pending deletion, if the
+// store was loaded from a database.
+func (st *Store) savePruneHeights() {
+	if st.pruneDB == nil {
+		return
+	}
+
+	if len(st.pruneHeights) == 0 {
+		st.pruneDB.Delete(pruneHeightsKey)
+		return
+	}
+	st.pruneDB.Set(pruneHeightsKey, cdc.MustMarshalBinaryBare(st.pruneHeights))
+}
+
 // UnsafeNewStore returns a reference to a new IAVL Store.
 //
 // CONTRACT: The IAVL tree should be fully loaded.
 func UnsafeNewStore(tree *iavl.MutableTree, numRecent int64, storeEvery int64) *Store {
 	return &Store{
-		tree:       tree,
-		numRecent:  numRecent,
-		storeEvery: storeEvery,
+		tree:          tree,
+		numRecent:     numRecent,
+		storeEvery:    storeEvery,
+		pruneInterval: 1,
 	}
 }
 
@@ -96,9 +170,12 @@ func (st *Store) GetImmutable(version int64) (*Store, error) {
 	}
 
 	return &Store{
-		tree:       &immutableTree{iTree},
-		numRecent:  0,
-		storeEvery: 0,
+		tree:          &immutableTree{iTree},
+		numRecent:     0,
+		storeEvery:    0,
+		pruneInterval: 1,
+		db:            st.db,
+		metrics:       st.metrics,
 	}, nil
 }
 
@@ -112,15 +189,28 @@ func (st *Store) Commit() types.CommitID {
 	}
 
 	// Release an old version of history, if not a sync waypoint.
+	pending := len(st.pruneHeights)
 	previous := version - 1
 	if st.numRecent < previous {
 		toRelease := previous - st.numRecent
 		if st.storeEvery == 0 || toRelease%st.storeEvery != 0 {
-			err := st.tree.DeleteVersion(toRelease)
+			st.pruneHeights = append(st.pruneHeights, toRelease)
+		}
+	}
+
+	// Delete the released versions once every pruning interval.
+	if st.pruneInterval <= 1 || version%st.pruneInterval == 0 {
+		for _, height := range st.pruneHeights {
+			err := st.tree.DeleteVersion(height)
 			if errCause := errors.Cause(err); errCause != nil && errCause != iavl.ErrVersionDoesNotExist {
 				panic(err)
 			}
 		}
+		st.pruneHeights = st.pruneHeights[:0]
+	}
+
+	if len(st.pruneHeights) != pending {
+		st.savePruneHeights()
 	}
 
 	return types.CommitID{
@@ -139,8 +229,9 @@ func (st *Store) LastCommitID() types.CommitID {
 
 // Implements Committer.
 func (st *Store) SetPruning(opt types.PruningOptions) {
-	st.numRecent = opt.KeepRecent()
-	st.storeEvery = opt.KeepEvery()
+	st.numRecent = opt.KeepRecent
+	st.storeEvery = opt.KeepEvery
+	st.pruneInterval = opt.Interval
 }
 
 // VersionExists returns whether or not a given version is stored.
@@ -171,13 +262,43 @@ func (st *Store) Set(key, value []byte) {
 
 // Implements types.KVStore.
 func (st *Store) Get(key []byte) []byte {
+	nodeReads := st.nodeReads()
 	_, value := st.tree.Get(key)
+	st.meterRead(nodeReads)
+
 	return value
 }
 
 // Implements types.KVStore.
 func (st *Store) Has(key []byte) (exists bool) {
-	return st.tree.Has(key)
+	nodeReads := st.nodeReads()
+	exists = st.tree.Has(key)
+	st.meterRead(nodeReads)
+
+	return exists
+}
+
+// nodeReads returns the number of nodes read from disk so far, if metrics are
+// enabled.
+func (st *Store) nodeReads() uint64 {
+	if st.db == nil {
+		return 0
+	}
+	return st.db.NodeReads()
+}
+
+// meterRead reports a read of the store as a node cache hit if no node was
+// read from disk since it started, and as a miss otherwise.
+func (st *Store) meterRead(nodeReadsBefore uint64) {
+	if st.db == nil {
+		return
+	}
+
+	if st.db.NodeReads() == nodeReadsBefore {
+		st.metrics.CacheHits.Add(1)
+	} else {
+		st.metrics.CacheMisses.Add(1)
+	}
 }
 
 // Implements types.KVStore.
@@ -227,6 +348,32 @@ func getHeight(tree Tree, req abci.RequestQuery) int64 {
 	return height
 }
 
+// Stats implements types.StatsReporter. The number of keys is read from the
+// tree while the total size requires iterating over the latest version, which
+// stops after maxKeys keys if maxKeys is positive.
+func (st *Store) Stats(maxKeys int64) types.StoreStats {
+	stats := types.StoreStats{
+		NumKeys:  st.tree.Size(),
+		Versions: int64(len(st.tree.AvailableVersions())),
+	}
+
+	iterator := st.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var count int64
+	for ; iterator.Valid(); iterator.Next() {
+		if maxKeys > 0 && count >= maxKeys {
+			stats.Truncated = true
+			break
+		}
+
+		stats.TotalSize += int64(len(iterator.Key()) + len(iterator.Value()))
+		count++
+	}
+
+	return stats
+}
+
 // Query implements ABCI interface, allows queries
 //
 // by default we will return from (latest height -1),
@@ -11,6 +11,7 @@ import (
 	cmn "github.com/tendermint/tendermint/libs/common"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/errors"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
@@ -355,6 +356,90 @@ func TestIAVLReversePrefixIterator(t *testing.T) {
 	require.Equal(t, len(expected), i)
 }
 
+func TestIAVLReversePrefixIteratorAfter(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, numRecent, storeEvery)
+
+	iavlStore.Set([]byte("test1"), []byte("test1"))
+	iavlStore.Set([]byte("test2"), []byte("test2"))
+	iavlStore.Set([]byte("test3"), []byte("test3"))
+	iavlStore.Set([]byte("tesu"), []byte("tesu"))
+	iavlStore.Set([]byte{byte(255), byte(255), byte(0)}, []byte("test4"))
+	iavlStore.Set([]byte{byte(255), byte(255), byte(255)}, []byte("test4"))
+
+	// the cache wraps the IAVL store with unpersisted writes on top of it
+	cacheStore := cachekv.NewStore(iavlStore)
+	cacheStore.Set([]byte("test4"), []byte("test4"))
+	cacheStore.Delete([]byte("test2"))
+
+	testCases := []struct {
+		store      types.KVStore
+		prefix     []byte
+		startAfter []byte
+		expected   []string
+	}{
+		{iavlStore, []byte("test"), nil, []string{"test3", "test2", "test1"}},
+		{iavlStore, []byte("test"), []byte("test3"), []string{"test2", "test1"}},
+		{iavlStore, []byte("test"), []byte("test25"), []string{"test2", "test1"}},
+		{iavlStore, []byte("test"), []byte("test1"), []string{}},
+		{iavlStore, []byte("test"), []byte("tes"), []string{}},
+		{iavlStore, []byte("test"), []byte("tesu"), []string{"test3", "test2", "test1"}},
+		{iavlStore, []byte("test"), []byte("z"), []string{"test3", "test2", "test1"}},
+		{iavlStore, []byte{byte(255), byte(255)}, []byte{byte(255), byte(255), byte(255)}, []string{string([]byte{byte(255), byte(255), byte(0)})}},
+		{cacheStore, []byte("test"), nil, []string{"test4", "test3", "test1"}},
+		{cacheStore, []byte("test"), []byte("test4"), []string{"test3", "test1"}},
+	}
+
+	for i, tc := range testCases {
+		iter := types.KVStoreReversePrefixIteratorAfter(tc.store, tc.prefix, tc.startAfter)
+		keys := []string{}
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, string(iter.Key()))
+		}
+		iter.Close()
+		require.Equal(t, tc.expected, keys, "test case %d", i)
+	}
+}
+
+func TestIAVLIterateDescending(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, numRecent, storeEvery)
+
+	for i := 0; i < 5; i++ {
+		key := []byte(fmt.Sprintf("test%d", i))
+		iavlStore.Set(key, key)
+	}
+
+	// page through the keys, two at a time
+	var (
+		pages  [][]string
+		cursor []byte
+	)
+	for {
+		var page []string
+		cursor = types.KVStoreIterateDescending(iavlStore, []byte("test"), cursor, 2, func(key, _ []byte) bool {
+			page = append(page, string(key))
+			return false
+		})
+		if cursor == nil {
+			break
+		}
+		pages = append(pages, page)
+	}
+	require.Equal(t, [][]string{{"test4", "test3"}, {"test2", "test1"}, {"test0"}}, pages)
+
+	// the callback can stop the iteration early
+	var keys []string
+	last := types.KVStoreIterateDescending(iavlStore, []byte("test"), nil, 0, func(key, _ []byte) bool {
+		keys = append(keys, string(key))
+		return len(keys) == 3
+	})
+	require.Equal(t, []string{"test4", "test3", "test2"}, keys)
+	require.Equal(t, []byte("test2"), last)
+}
+
 func nextVersion(iavl *Store) {
 	key := []byte(fmt.Sprintf("Key for tree: %d", iavl.LastCommitID().Version))
 	value := []byte(fmt.Sprintf("Value for tree: %d", iavl.LastCommitID().Version))
@@ -467,6 +552,78 @@ func TestIAVLPruneEverything(t *testing.T) {
 	}
 }
 
+func TestIAVLPruningInterval(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, int64(0), int64(0))
+	iavlStore.SetPruning(types.NewPruningOptions(2, 5, 4))
+
+	for version := int64(1); version <= 40; version++ {
+		nextVersion(iavlStore)
+
+		// the versions released up to the last multiple of the interval are deleted
+		pruned := version - version%4 - 3
+		for ver := int64(1); ver <= version; ver++ {
+			expected := ver > pruned || ver%5 == 0
+			require.Equal(t, expected, iavlStore.VersionExists(ver),
+				"version %d with latest version %d", ver, version)
+		}
+	}
+}
+
+func TestIAVLPruningIntervalReload(t *testing.T) {
+	db := dbm.NewMemDB()
+	pruning := types.NewPruningOptions(2, 0, 10)
+
+	store, err := LoadStore(db, types.CommitID{}, pruning, false)
+	require.NoError(t, err)
+	iavlStore := store.(*Store)
+
+	// versions 1 to 3 are released but not deleted before the node stops
+	for version := int64(1); version <= 6; version++ {
+		nextVersion(iavlStore)
+	}
+	for ver := int64(1); ver <= 6; ver++ {
+		require.True(t, iavlStore.VersionExists(ver), "version %d", ver)
+	}
+
+	store, err = LoadStore(db, iavlStore.LastCommitID(), pruning, false)
+	require.NoError(t, err)
+	iavlStore = store.(*Store)
+	require.Equal(t, []int64{1, 2, 3}, iavlStore.pruneHeights)
+
+	// the versions released before the restart are deleted along with the
+	// others at the next pruning interval
+	for version := int64(7); version <= 10; version++ {
+		nextVersion(iavlStore)
+	}
+	for ver := int64(1); ver <= 10; ver++ {
+		require.Equal(t, ver > 7, iavlStore.VersionExists(ver), "version %d", ver)
+	}
+	require.Nil(t, db.Get(pruneHeightsKey))
+}
+
+func TestIAVLStoreStats(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree, _ := newAlohaTree(t, db)
+	iavlStore := UnsafeNewStore(tree, numRecent, storeEvery)
+
+	// "hello" + "goodbye" + "aloha" + "shalom"
+	stats := iavlStore.Stats(0)
+	require.Equal(t, int64(2), stats.NumKeys)
+	require.Equal(t, int64(23), stats.TotalSize)
+	require.Equal(t, int64(1), stats.Versions)
+	require.False(t, stats.Truncated)
+
+	iavlStore.Set([]byte("hola"), []byte("adios"))
+	iavlStore.Commit()
+
+	stats = iavlStore.Stats(1)
+	require.Equal(t, int64(3), stats.NumKeys)
+	require.Equal(t, int64(2), stats.Versions)
+	require.True(t, stats.Truncated)
+}
+
 func TestIAVLStoreQuery(t *testing.T) {
 	db := dbm.NewMemDB()
 	tree := iavl.NewMutableTree(db, cacheSize)
@@ -29,6 +29,8 @@ type (
 		GetVersioned(key []byte, version int64) (int64, []byte)
 		GetVersionedWithProof(key []byte, version int64) ([]byte, *iavl.RangeProof, error)
 		GetImmutable(version int64) (*iavl.ImmutableTree, error)
+		Size() int64
+		AvailableVersions() []int
 	}
 
 	// immutableTree is a simple wrapper around a reference to an iavl.ImmutableTree
@@ -82,3 +84,7 @@ func (it *immutableTree) GetImmutable(version int64) (*iavl.ImmutableTree, error
 
 	return it.ImmutableTree, nil
 }
+
+func (it *immutableTree) AvailableVersions() []int {
+	return []int{int(it.Version())}
+}
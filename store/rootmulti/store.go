@@ -3,6 +3,7 @@ package rootmulti
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -40,10 +41,14 @@ type Store struct {
 	traceContext types.TraceContext
 
 	interBlockCache types.MultiStorePersistentCache
+
+	iavlCache      iavl.CacheConfig
+	iavlCacheSizes map[string]int // node cache sizes of the IAVL stores, by store name
 }
 
 var _ types.CommitMultiStore = (*Store)(nil)
 var _ types.Queryable = (*Store)(nil)
+var _ types.MultiStoreStatsReporter = (*Store)(nil)
 
 // nolint
 func NewStore(db dbm.DB) *Store {
@@ -68,6 +73,13 @@ func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
 }
 
+// SetIAVLCacheConfig sets the sizes of the node caches of the IAVL stores and
+// the metrics they report their usage to. It applies to the stores loaded
+// afterwards.
+func (rs *Store) SetIAVLCacheConfig(config iavl.CacheConfig) {
+	rs.iavlCache = config
+}
+
 // Implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -153,6 +165,12 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		lastCommitID = cInfo.CommitID()
 	}
 
+	cacheSizes, err := rs.loadIAVLCacheSizes(infos)
+	if err != nil {
+		return err
+	}
+	rs.iavlCacheSizes = cacheSizes
+
 	// load each Store (note this doesn't panic on unmounted keys now)
 	var newStores = make(map[types.StoreKey]types.CommitKVStore)
 	for key, storeParams := range rs.storesParams {
@@ -195,6 +213,32 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	return nil
 }
 
+// loadIAVLCacheSizes returns the sizes of the node caches of the IAVL stores.
+// The number of keys of the stores sharing the cache budget, if any, is read
+// from the version of their tree about to be loaded.
+func (rs *Store) loadIAVLCacheSizes(infos map[string]storeInfo) (map[string]int, error) {
+	numKeys := make(map[string]int64)
+	for key, params := range rs.storesParams {
+		if params.typ != types.StoreTypeIAVL {
+			continue
+		}
+
+		name := key.Name()
+		numKeys[name] = 0
+		if _, ok := rs.iavlCache.Sizes[name]; ok || rs.iavlCache.Budget <= 0 {
+			continue
+		}
+
+		n, err := iavl.NumKeys(rs.storeDB(params), rs.getCommitID(infos, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to count the keys of Store %s: %v", name, err)
+		}
+		numKeys[name] = n
+	}
+
+	return rs.iavlCache.CacheSizes(numKeys), nil
+}
+
 func (rs *Store) getCommitID(infos map[string]storeInfo, name string) types.CommitID {
 	info, ok := infos[name]
 	if !ok {
@@ -397,6 +441,26 @@ func (rs *Store) getStoreByName(name string) types.Store {
 	return rs.GetCommitKVStore(key)
 }
 
+// StoreStats implements types.MultiStoreStatsReporter. It returns the size
+// statistics of every mounted store able to report them, sorted by store name.
+func (rs *Store) StoreStats(maxKeys int64) []types.StoreStats {
+	stats := make([]types.StoreStats, 0, len(rs.stores))
+	for key := range rs.stores {
+		// unwrap any inter-block cache to get to the underlying store
+		reporter, ok := rs.GetCommitKVStore(key).(types.StatsReporter)
+		if !ok {
+			continue
+		}
+
+		s := reporter.Stats(maxKeys)
+		s.Name = key.Name()
+		stats = append(stats, s)
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats
+}
+
 //---------------------- Query ------------------
 
 // Query calls substore.Query with the same `req` where `req.Path` is
@@ -473,21 +537,24 @@ func parsePath(path string) (storeName string, subpath string, err errors.Error)
 //----------------------------------------
 // Note: why do we use key and params.key in different places. Seems like there should be only one key used.
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
-	var db dbm.DB
-
-	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
-	}
+	db := rs.storeDB(params)
 
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
 
 	case types.StoreTypeIAVL:
-		store, err := iavl.LoadStore(db, id, rs.pruningOpts, rs.lazyLoading)
+		cacheSize, ok := rs.iavlCacheSizes[key.Name()]
+		if !ok {
+			cacheSize = iavl.DefaultCacheSize
+		}
+
+		var metrics *iavl.Metrics
+		if rs.iavlCache.Metrics != nil {
+			metrics = rs.iavlCache.Metrics.WithStore(key.Name())
+		}
+
+		store, err := iavl.LoadStoreWithCache(db, id, rs.pruningOpts, rs.lazyLoading, cacheSize, metrics)
 		if err != nil {
 			return nil, err
 		}
@@ -517,6 +584,17 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 	}
 }
 
+// storeDB returns the database of a store, which is either the database it
+// was mounted with or a prefix of the multistore database.
+func (rs *Store) storeDB(params storeParams) dbm.DB {
+	if params.db != nil {
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
+	}
+
+	prefix := "s/k:" + params.key.Name() + "/"
+	return dbm.NewPrefixDB(rs.db, []byte(prefix))
+}
+
 //----------------------------------------
 // storeParams
 
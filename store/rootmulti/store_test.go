@@ -358,6 +358,54 @@ func TestMultiStoreQuery(t *testing.T) {
 	require.Equal(t, v2, qres.Value)
 }
 
+func TestMultistoreStoreStats(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db)
+	require.Nil(t, multi.LoadLatestVersion())
+
+	store2 := multi.getStoreByName("store2").(types.KVStore)
+	store2.Set([]byte("key"), []byte("value"))
+	multi.Commit()
+
+	stats := multi.StoreStats(0)
+	require.Len(t, stats, 3)
+	require.Equal(t, "store1", stats[0].Name)
+	require.Equal(t, int64(0), stats[0].NumKeys)
+	require.Equal(t, "store2", stats[1].Name)
+	require.Equal(t, int64(1), stats[1].NumKeys)
+	require.Equal(t, int64(8), stats[1].TotalSize)
+	require.Equal(t, "store3", stats[2].Name)
+}
+
+func TestMultistoreIAVLCacheConfig(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db)
+	require.Nil(t, multi.LoadLatestVersion())
+	require.Equal(t, map[string]int{
+		"store1": iavl.DefaultCacheSize, "store2": iavl.DefaultCacheSize, "store3": iavl.DefaultCacheSize,
+	}, multi.iavlCacheSizes)
+
+	store2 := multi.getStoreByName("store2").(types.KVStore)
+	for _, key := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		store2.Set([]byte(key), []byte("value"))
+	}
+	multi.Commit()
+
+	// the budget is shared by the stores without a size on the next load, in
+	// proportion to the number of keys they hold
+	multi = newMultiStoreWithMounts(db)
+	multi.SetIAVLCacheConfig(iavl.CacheConfig{
+		Sizes:   map[string]int{"store3": 42},
+		Budget:  1000,
+		Metrics: iavl.NopMetrics(),
+	})
+	require.Nil(t, multi.LoadLatestVersion())
+	require.Equal(t, map[string]int{"store1": 100, "store2": 900, "store3": 42}, multi.iavlCacheSizes)
+
+	store2 = multi.getStoreByName("store2").(types.KVStore)
+	require.Equal(t, []byte("value"), store2.Get([]byte("a")))
+}
+
 //-----------------------------------------------------------------------
 // utils
 
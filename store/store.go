@@ -1,6 +1,8 @@
 package store
 
 import (
+	"fmt"
+
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store/cache"
@@ -13,6 +15,7 @@ const (
 	PruningStrategyNothing    = "nothing"
 	PruningStrategyEverything = "everything"
 	PruningStrategySyncable   = "syncable"
+	PruningStrategyCustom     = "custom"
 )
 
 func NewCommitMultiStore(db dbm.DB) types.CommitMultiStore {
@@ -23,6 +26,18 @@ func NewCommitKVStoreCacheManager() types.MultiStorePersistentCache {
 	return cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
 }
 
+// NewCommitKVStoreCacheManagerWithSize returns an inter-block cache keeping up
+// to size entries per store, or the default number of entries if size is zero.
+func NewCommitKVStoreCacheManagerWithSize(size uint) types.MultiStorePersistentCache {
+	if size == 0 {
+		size = cache.DefaultCommitKVStoreCacheSize
+	}
+	return cache.NewCommitKVStoreCacheManager(size)
+}
+
+// NewPruningOptionsFromString returns the pruning options of a predefined
+// strategy, defaulting to the syncable one. The custom strategy has no
+// predefined options, see NewPruningOptionsFromConfig.
 func NewPruningOptionsFromString(strategy string) (opt PruningOptions) {
 	switch strategy {
 	case PruningStrategyNothing:
@@ -36,3 +51,23 @@ func NewPruningOptionsFromString(strategy string) (opt PruningOptions) {
 	}
 	return
 }
+
+// NewPruningOptionsFromConfig returns the pruning options of the given strategy,
+// or the custom keepRecent, keepEvery and interval values for the custom one.
+// An error is returned for an unknown strategy or invalid custom values.
+func NewPruningOptionsFromConfig(strategy string, keepRecent, keepEvery, interval int64) (PruningOptions, error) {
+	switch strategy {
+	case PruningStrategyNothing, PruningStrategyEverything, PruningStrategySyncable:
+		return NewPruningOptionsFromString(strategy), nil
+
+	case PruningStrategyCustom:
+		opts := types.NewPruningOptions(keepRecent, keepEvery, interval)
+		if err := opts.Validate(); err != nil {
+			return PruningOptions{}, err
+		}
+		return opts, nil
+
+	default:
+		return PruningOptions{}, fmt.Errorf("unknown pruning strategy %q", strategy)
+	}
+}
@@ -1,35 +1,55 @@
 package types
 
-// PruningStrategy specifies how old states will be deleted over time where
-// keepRecent can be used with keepEvery to create a pruning "strategy".
+import "fmt"
+
+// PruningOptions specifies how old states will be deleted over time where
+// KeepRecent can be used with KeepEvery to create a pruning "strategy", and
+// Interval sets how often the states due for deletion are actually deleted.
 type PruningOptions struct {
-	keepRecent int64
-	keepEvery  int64
+	// KeepRecent is how much recent state will be kept. Older state will be
+	// deleted.
+	KeepRecent int64
+
+	// KeepEvery keeps every Nth state, deleting others. A value of 1 keeps
+	// every state and a value of 0 keeps none of the older states.
+	KeepEvery int64
+
+	// Interval is the number of blocks between two deletions of the states due
+	// for deletion. Larger values batch the deletions, trading disk space for
+	// less work at most commits. A value of 1 deletes them at every commit.
+	Interval int64
 }
 
-func NewPruningOptions(keepRecent, keepEvery int64) PruningOptions {
+// NewPruningOptions returns the pruning options keeping the last keepRecent
+// states and every keepEvery-th one, deleting the others every interval blocks.
+func NewPruningOptions(keepRecent, keepEvery, interval int64) PruningOptions {
 	return PruningOptions{
-		keepRecent: keepRecent,
-		keepEvery:  keepEvery,
+		KeepRecent: keepRecent,
+		KeepEvery:  keepEvery,
+		Interval:   interval,
 	}
 }
 
-// How much recent state will be kept. Older state will be deleted.
-func (po PruningOptions) KeepRecent() int64 {
-	return po.keepRecent
-}
-
-// Keeps every N stated, deleting others.
-func (po PruningOptions) KeepEvery() int64 {
-	return po.keepEvery
+// Validate returns an error if the pruning options are invalid.
+func (po PruningOptions) Validate() error {
+	if po.KeepRecent < 0 {
+		return fmt.Errorf("pruning keep-recent must not be negative: %d", po.KeepRecent)
+	}
+	if po.KeepEvery < 0 {
+		return fmt.Errorf("pruning keep-every must not be negative: %d", po.KeepEvery)
+	}
+	if po.Interval <= 0 {
+		return fmt.Errorf("pruning interval must be positive: %d", po.Interval)
+	}
+	return nil
 }
 
 // default pruning strategies
 var (
 	// PruneEverything means all saved states will be deleted, storing only the current state
-	PruneEverything = NewPruningOptions(0, 0)
+	PruneEverything = NewPruningOptions(0, 0, 1)
 	// PruneNothing means all historic states will be saved, nothing will be deleted
-	PruneNothing = NewPruningOptions(0, 1)
+	PruneNothing = NewPruningOptions(0, 1, 1)
 	// PruneSyncable means only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
-	PruneSyncable = NewPruningOptions(100, 10000)
+	PruneSyncable = NewPruningOptions(100, 10000, 1)
 )
@@ -35,6 +35,29 @@ type Queryable interface {
 	Query(abci.RequestQuery) abci.ResponseQuery
 }
 
+// StoreStats defines size statistics of a single store, used to attribute disk
+// growth to specific modules.
+type StoreStats struct {
+	Name      string `json:"name"`
+	NumKeys   int64  `json:"num_keys"`
+	TotalSize int64  `json:"total_size"` // size in bytes of all iterated keys and values
+	Versions  int64  `json:"versions"`   // number of persisted versions
+	Truncated bool   `json:"truncated"`  // true if the key cap was hit while computing TotalSize
+}
+
+// StatsReporter is implemented by stores which are able to report size
+// statistics. maxKeys caps the number of keys iterated over; a non-positive
+// value means no cap.
+type StatsReporter interface {
+	Stats(maxKeys int64) StoreStats
+}
+
+// MultiStoreStatsReporter is implemented by multistores which are able to
+// report the size statistics of each mounted store.
+type MultiStoreStatsReporter interface {
+	StoreStats(maxKeys int64) []StoreStats
+}
+
 //----------------------------------------
 // MultiStore
 
@@ -16,6 +16,49 @@ func KVStoreReversePrefixIterator(kvs KVStore, prefix []byte) Iterator {
 	return kvs.ReverseIterator(prefix, PrefixEndBytes(prefix))
 }
 
+// KVStoreReversePrefixIteratorAfter returns an iterator over all the keys with
+// a certain prefix in descending order, starting right after the startAfter
+// cursor, i.e. with the greatest key strictly lower than startAfter. A nil
+// cursor iterates over the whole prefix.
+func KVStoreReversePrefixIteratorAfter(kvs KVStore, prefix, startAfter []byte) Iterator {
+	end := PrefixEndBytes(prefix)
+
+	switch {
+	case startAfter == nil:
+	case bytes.Compare(startAfter, prefix) <= 0:
+		// nothing sorts before the cursor within the prefix
+		end = prefix
+	case end == nil || bytes.Compare(startAfter, end) < 0:
+		end = startAfter
+	}
+
+	return kvs.ReverseIterator(prefix, end)
+}
+
+// KVStoreIterateDescending iterates over at most limit keys with a certain
+// prefix in descending order, starting right after the startAfter cursor, and
+// calls cb on each of them until it returns true. A non-positive limit does
+// not bound the iteration. It returns the last key visited, which can be used
+// as the cursor to fetch the next page, or nil if no key was visited.
+func KVStoreIterateDescending(
+	kvs KVStore, prefix, startAfter []byte, limit int, cb func(key, value []byte) (stop bool),
+) (last []byte) {
+
+	iterator := KVStoreReversePrefixIteratorAfter(kvs, prefix, startAfter)
+	defer iterator.Close()
+
+	for count := 0; iterator.Valid() && (limit <= 0 || count < limit); iterator.Next() {
+		last = iterator.Key()
+		count++
+
+		if cb(last, iterator.Value()) {
+			break
+		}
+	}
+
+	return last
+}
+
 // DiffKVStores compares two KVstores and returns all the key/value pairs
 // that differ from one another. It also skips value comparison for a set of provided prefixes
 func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []cmn.KVPair) {
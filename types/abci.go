@@ -1,6 +1,10 @@
 package types
 
-import abci "github.com/tendermint/tendermint/abci/types"
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
 
 // InitChainer initializes application state at genesis
 type InitChainer func(ctx Context, req abci.RequestInitChain) abci.ResponseInitChain
@@ -19,3 +23,24 @@ type EndBlocker func(ctx Context, req abci.RequestEndBlock) abci.ResponseEndBloc
 
 // PeerFilter responds to p2p filtering queries from Tendermint
 type PeerFilter func(info string) abci.ResponseQuery
+
+// ModuleBlockTiming defines the time spent by a single module in BeginBlock and
+// EndBlock.
+type ModuleBlockTiming struct {
+	Module     string        `json:"module" yaml:"module"`
+	BeginBlock time.Duration `json:"begin_block" yaml:"begin_block"`
+	EndBlock   time.Duration `json:"end_block" yaml:"end_block"`
+}
+
+// BlockTimings defines the per-module BeginBlock and EndBlock durations of the
+// block at the given height.
+type BlockTimings struct {
+	Height  int64               `json:"height" yaml:"height"`
+	Modules []ModuleBlockTiming `json:"modules" yaml:"modules"`
+}
+
+// BlockTimingsReporter defines a type which reports the per-module durations of
+// the last executed block, e.g. the module manager.
+type BlockTimingsReporter interface {
+	BlockTimings() BlockTimings
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -22,6 +23,10 @@ const (
 	// bytes required to represent the above precision
 	// Ceiling[Log2[999 999 999 999 999 999]]
 	DecimalPrecisionBits = 60
+
+	// MaxDecBitLen is the maximum bit length of the absolute value of the
+	// integer representation of a Dec, its value times 10^Precision
+	MaxDecBitLen = MaxBitLen + DecimalPrecisionBits
 )
 
 var (
@@ -124,9 +129,20 @@ func NewDecFromIntWithPrec(i Int, prec int64) Dec {
 //
 // CONTRACT - This function does not mutate the input str.
 func NewDecFromStr(str string) (d Dec, err Error) {
+	d, parseErr := newDecFromStr(str)
+	if parseErr != nil {
+		return d, ErrUnknownRequest(parseErr.Error())
+	}
+	return d, nil
+}
+
+// newDecFromStr implements NewDecFromStr, returning the overflows as errors
+// wrapping ErrIntOverflow
+func newDecFromStr(str string) (d Dec, err error) {
 	if len(str) == 0 {
-		return d, ErrUnknownRequest("decimal string is empty")
+		return d, errors.New("decimal string is empty")
 	}
+	original := str
 
 	// first extract any negative symbol
 	neg := false
@@ -136,7 +152,7 @@ func NewDecFromStr(str string) (d Dec, err Error) {
 	}
 
 	if len(str) == 0 {
-		return d, ErrUnknownRequest("decimal string is empty")
+		return d, errors.New("decimal string is empty")
 	}
 
 	strs := strings.Split(str, ".")
@@ -146,17 +162,22 @@ func NewDecFromStr(str string) (d Dec, err Error) {
 	if len(strs) == 2 { // has a decimal place
 		lenDecs = len(strs[1])
 		if lenDecs == 0 || len(combinedStr) == 0 {
-			return d, ErrUnknownRequest("bad decimal length")
+			return d, errors.New("bad decimal length")
 		}
 		combinedStr += strs[1]
 
 	} else if len(strs) > 2 {
-		return d, ErrUnknownRequest("too many periods to be a decimal string")
+		return d, errors.New("too many periods to be a decimal string")
+	}
+
+	for _, c := range combinedStr {
+		if c < '0' || c > '9' {
+			return d, fmt.Errorf("invalid decimal %q: unexpected character %q", original, c)
+		}
 	}
 
 	if lenDecs > Precision {
-		return d, ErrUnknownRequest(
-			fmt.Sprintf("too much precision, maximum %v, len decimal %v", Precision, lenDecs))
+		return d, fmt.Errorf("too much precision, maximum %v, len decimal %v", Precision, lenDecs)
 	}
 
 	// add some extra zero's to correct to the Precision factor
@@ -166,7 +187,10 @@ func NewDecFromStr(str string) (d Dec, err Error) {
 
 	combined, ok := new(big.Int).SetString(combinedStr, 10) // base 10
 	if !ok {
-		return d, ErrUnknownRequest(fmt.Sprintf("bad string to integer conversion, combinedStr: %v", combinedStr))
+		return d, fmt.Errorf("bad string to integer conversion, combinedStr: %v", combinedStr)
+	}
+	if combined.BitLen() > MaxDecBitLen {
+		return d, fmt.Errorf("%w: decimal %s has %d bits, the maximum is %d", ErrIntOverflow, original, combined.BitLen(), MaxDecBitLen)
 	}
 	if neg {
 		combined = new(big.Int).Neg(combined)
@@ -201,7 +225,7 @@ func (d Dec) Abs() Dec          { return Dec{new(big.Int).Abs(d.Int)} } // absol
 func (d Dec) Add(d2 Dec) Dec {
 	res := new(big.Int).Add(d.Int, d2.Int)
 
-	if res.BitLen() > 255+DecimalPrecisionBits {
+	if res.BitLen() > MaxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{res}
@@ -211,7 +235,7 @@ func (d Dec) Add(d2 Dec) Dec {
 func (d Dec) Sub(d2 Dec) Dec {
 	res := new(big.Int).Sub(d.Int, d2.Int)
 
-	if res.BitLen() > 255+DecimalPrecisionBits {
+	if res.BitLen() > MaxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{res}
@@ -222,7 +246,7 @@ func (d Dec) Mul(d2 Dec) Dec {
 	mul := new(big.Int).Mul(d.Int, d2.Int)
 	chopped := chopPrecisionAndRound(mul)
 
-	if chopped.BitLen() > 255+DecimalPrecisionBits {
+	if chopped.BitLen() > MaxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{chopped}
@@ -233,7 +257,7 @@ func (d Dec) MulTruncate(d2 Dec) Dec {
 	mul := new(big.Int).Mul(d.Int, d2.Int)
 	chopped := chopPrecisionAndTruncate(mul)
 
-	if chopped.BitLen() > 255+DecimalPrecisionBits {
+	if chopped.BitLen() > MaxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{chopped}
@@ -243,7 +267,7 @@ func (d Dec) MulTruncate(d2 Dec) Dec {
 func (d Dec) MulInt(i Int) Dec {
 	mul := new(big.Int).Mul(d.Int, i.i)
 
-	if mul.BitLen() > 255+DecimalPrecisionBits {
+	if mul.BitLen() > MaxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{mul}
@@ -253,7 +277,7 @@ func (d Dec) MulInt(i Int) Dec {
 func (d Dec) MulInt64(i int64) Dec {
 	mul := new(big.Int).Mul(d.Int, big.NewInt(i))
 
-	if mul.BitLen() > 255+DecimalPrecisionBits {
+	if mul.BitLen() > MaxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{mul}
@@ -269,7 +293,7 @@ func (d Dec) Quo(d2 Dec) Dec {
 	quo := new(big.Int).Quo(mul, d2.Int)
 	chopped := chopPrecisionAndRound(quo)
 
-	if chopped.BitLen() > 255+DecimalPrecisionBits {
+	if chopped.BitLen() > MaxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{chopped}
@@ -285,7 +309,7 @@ func (d Dec) QuoTruncate(d2 Dec) Dec {
 	quo := new(big.Int).Quo(mul, d2.Int)
 	chopped := chopPrecisionAndTruncate(quo)
 
-	if chopped.BitLen() > 255+DecimalPrecisionBits {
+	if chopped.BitLen() > MaxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{chopped}
@@ -300,7 +324,7 @@ func (d Dec) QuoRoundUp(d2 Dec) Dec {
 	quo := new(big.Int).Quo(mul, d2.Int)
 	chopped := chopPrecisionAndRoundUp(quo)
 
-	if chopped.BitLen() > 255+DecimalPrecisionBits {
+	if chopped.BitLen() > MaxDecBitLen {
 		panic("Int overflow")
 	}
 	return Dec{chopped}
@@ -583,7 +607,7 @@ func (d Dec) MarshalAmino() (string, error) {
 // requires a valid JSON string - strings quotes and calls UnmarshalText
 func (d *Dec) UnmarshalAmino(text string) (err error) {
 	tempInt := new(big.Int)
-	err = tempInt.UnmarshalText([]byte(text))
+	err = unmarshalText(tempInt, text, MaxDecBitLen)
 	if err != nil {
 		return err
 	}
@@ -609,10 +633,10 @@ func (d *Dec) UnmarshalJSON(bz []byte) error {
 	var text string
 	err := json.Unmarshal(bz, &text)
 	if err != nil {
-		return err
+		return fmt.Errorf("decimal must be encoded as a JSON string: %w", err)
 	}
 	// TODO: Reuse dec allocation
-	newDec, err := NewDecFromStr(text)
+	newDec, err := newDecFromStr(text)
 	if err != nil {
 		return err
 	}
@@ -656,6 +680,37 @@ func MaxDec(d1, d2 Dec) Dec {
 	return d1
 }
 
+// MaxSortableDec is the largest Dec that can be passed to SortableDecBytes, the
+// reciprocal of SmallestDec. Its negative form is the least one.
+var MaxSortableDec = OneDec().Quo(SmallestDec())
+
+// sortableDecWidth is the width of the strings of the sortable decimals, the
+// one of MaxSortableDec
+var sortableDecWidth = len(MaxSortableDec.String())
+
+// ValidSortableDec returns whether a Dec is within the bounds of the sortable
+// decimals, [-MaxSortableDec, MaxSortableDec].
+func ValidSortableDec(dec Dec) bool {
+	return dec.Abs().LTE(MaxSortableDec)
+}
+
+// SortableDecBytes returns a representation of a Dec whose byte order follows
+// the order of the decimals, for use in store keys. The non-negative decimals
+// are left padded with zeros, while the negative ones are prefixed with a minus
+// sign, which sorts before the digits, followed by the padded difference
+// between MaxSortableDec and their absolute value. It panics if the Dec isn't
+// within the sortable bounds.
+func SortableDecBytes(dec Dec) []byte {
+	if !ValidSortableDec(dec) {
+		panic(fmt.Sprintf("decimal %s is out of the sortable bounds", dec))
+	}
+
+	if dec.IsNegative() {
+		return []byte(fmt.Sprintf("-%0*s", sortableDecWidth, MaxSortableDec.Add(dec).String()))
+	}
+	return []byte(fmt.Sprintf("%0*s", sortableDecWidth, dec.String()))
+}
+
 // intended to be used with require/assert:  require.True(DecEq(...))
 func DecEq(t *testing.T, exp, got Dec) (*testing.T, bool, string, string, string) {
 	return t, exp.Equal(got), "expected:\t%v\ngot:\t\t%v", exp.String(), got.String()
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"bytes"
+	"errors"
 	"math/big"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -443,3 +446,119 @@ func TestApproxSqrt(t *testing.T) {
 		require.Equal(t, tc.expected, res, "unexpected result for test case %d, input: %v", i, tc.input)
 	}
 }
+
+func TestDecUnmarshalStrict(t *testing.T) {
+	cases := []struct {
+		text  string
+		valid bool
+	}{
+		{"0", true},
+		{"-1.5", true},
+		{"0.000000000000000001", true},
+		{"--5", false},
+		{"-+5", false},
+		{"+5", false},
+		{"1.+5", false},
+		{"1. 5", false},
+		{"1e5", false},
+		{"0x10", false},
+		{"1_000", false},
+		{"1..0", false},
+		{".5", false},
+		{"0.0000000000000000001", false},
+	}
+
+	for tcnum, tc := range cases {
+		var d Dec
+		bz, _ := cdc.MarshalJSON(tc.text)
+		err := (&d).UnmarshalJSON(bz)
+		require.Equal(t, tc.valid, err == nil, "tc #%d %q: %v", tcnum, tc.text, err)
+	}
+
+	// decimals must be JSON strings
+	d := ZeroDec()
+	require.Error(t, (&d).UnmarshalJSON([]byte("5")))
+}
+
+func TestDecUnmarshalOverflowError(t *testing.T) {
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), MaxDecBitLen), big.NewInt(1))
+	over := new(big.Int).Lsh(big.NewInt(1), MaxDecBitLen)
+
+	// amino encodes the integer representation of the decimal
+	var d Dec
+	require.NoError(t, (&d).UnmarshalAmino(max.String()))
+	require.Equal(t, max, d.Int)
+	err := (&d).UnmarshalAmino(over.String())
+	require.True(t, errors.Is(err, ErrIntOverflow), "%v", err)
+
+	// JSON encodes the decimal string
+	maxDec := Dec{max}
+	bz, err := maxDec.MarshalJSON()
+	require.NoError(t, err)
+	require.NoError(t, (&d).UnmarshalJSON(bz))
+	require.True(t, maxDec.Equal(d))
+
+	bz, err = Dec{over}.MarshalJSON()
+	require.NoError(t, err)
+	err = (&d).UnmarshalJSON(bz)
+	require.True(t, errors.Is(err, ErrIntOverflow), "%v", err)
+
+	_, sdkErr := NewDecFromStr(Dec{over}.String())
+	require.Error(t, sdkErr)
+}
+
+// TestDecUnmarshalFuzz decodes random strings, which must either fail or be
+// decimals within bounds encoded back to the same value.
+func TestDecUnmarshalFuzz(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	alphabet := "0123456789-+.xeE_ "
+
+	for n := 0; n < 10000; n++ {
+		bz := make([]byte, r.Intn(110))
+		for j := range bz {
+			if r.Intn(5) == 0 {
+				bz[j] = alphabet[r.Intn(len(alphabet))]
+			} else {
+				bz[j] = byte('0' + r.Intn(10))
+			}
+		}
+
+		d, err := newDecFromStr(string(bz))
+		if err != nil {
+			continue
+		}
+		require.True(t, d.Int.BitLen() <= MaxDecBitLen, "%q", bz)
+
+		bz, err = d.MarshalJSON()
+		require.NoError(t, err)
+		decoded := ZeroDec()
+		require.NoError(t, (&decoded).UnmarshalJSON(bz))
+		require.True(t, d.Equal(decoded), "%s != %s", d, decoded)
+	}
+}
+
+func TestSortableDecBytes(t *testing.T) {
+	decs := []Dec{
+		MaxSortableDec.Neg(),
+		NewDec(-1000),
+		NewDec(-1),
+		NewDecWithPrec(-5, 1),
+		SmallestDec().Neg(),
+		ZeroDec(),
+		SmallestDec(),
+		NewDecWithPrec(5, 1),
+		OneDec(),
+		NewDec(1000),
+		MaxSortableDec,
+	}
+
+	for i := 1; i < len(decs); i++ {
+		prev, cur := SortableDecBytes(decs[i-1]), SortableDecBytes(decs[i])
+		require.True(t, bytes.Compare(prev, cur) < 0, "%s >= %s", prev, cur)
+	}
+
+	require.Equal(t, []byte("0000000000000000001.000000000000000000"), SortableDecBytes(OneDec()))
+	require.True(t, ValidSortableDec(MaxSortableDec.Neg()))
+	require.False(t, ValidSortableDec(MaxSortableDec.Add(SmallestDec())))
+	require.Panics(t, func() { SortableDecBytes(MaxSortableDec.Add(SmallestDec())) })
+}
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
+	"strings"
 )
 
 // denomUnits contains a mapping of denomination mapped to their respective unit
@@ -62,3 +66,272 @@ func ConvertCoin(coin Coin, denom string) (Coin, error) {
 
 	return NewCoin(denom, coin.Amount.ToDec().Mul(srcUnit.Quo(dstUnit)).TruncateInt()), nil
 }
+
+// displayDenoms contains a mapping of base denominations to the denomination
+// their amounts are displayed in (e.g. uatom amounts displayed in atom).
+var displayDenoms = map[string]string{}
+
+// RegisterDisplayDenom registers the denomination in which amounts of a base
+// denomination are displayed to users. Both denominations must have been
+// registered with RegisterDenom. Amounts always remain denominated in the base
+// denomination on the wire; the display denomination is only used to render
+// them. If the base denomination already has a display denomination, an error
+// will be returned.
+func RegisterDisplayDenom(baseDenom, displayDenom string) error {
+	if _, ok := GetDenomUnit(baseDenom); !ok {
+		return fmt.Errorf("base denom not registered: %s", baseDenom)
+	}
+
+	if _, ok := GetDenomUnit(displayDenom); !ok {
+		return fmt.Errorf("display denom not registered: %s", displayDenom)
+	}
+
+	if _, ok := displayDenoms[baseDenom]; ok {
+		return fmt.Errorf("display denom of %s already registered", baseDenom)
+	}
+
+	displayDenoms[baseDenom] = displayDenom
+	return nil
+}
+
+// GetDisplayDenom returns the display denomination of a base denomination if
+// it exists. A boolean is returned if the display denomination is registered.
+func GetDisplayDenom(baseDenom string) (string, bool) {
+	displayDenom, ok := displayDenoms[baseDenom]
+	return displayDenom, ok
+}
+
+// ConvertDecCoin attempts to convert a decimal coin to a given denomination
+// without truncating its amount. If the given denomination is invalid or if
+// neither denomination is registered, an error is returned.
+func ConvertDecCoin(coin DecCoin, denom string) (DecCoin, error) {
+	if err := validateDenom(denom); err != nil {
+		return DecCoin{}, err
+	}
+
+	srcUnit, ok := GetDenomUnit(coin.Denom)
+	if !ok {
+		return DecCoin{}, fmt.Errorf("source denom not registered: %s", coin.Denom)
+	}
+
+	dstUnit, ok := GetDenomUnit(denom)
+	if !ok {
+		return DecCoin{}, fmt.Errorf("destination denom not registered: %s", denom)
+	}
+
+	if srcUnit.Equal(dstUnit) {
+		return NewDecCoinFromDec(denom, coin.Amount), nil
+	}
+
+	return NewDecCoinFromDec(denom, coin.Amount.Mul(srcUnit).Quo(dstUnit)), nil
+}
+
+// DisplayCoinsJSON renders the coins found in a JSON document in their display
+// denomination. Every object holding only a "denom" and an "amount" string,
+// such as the JSON encoding of Coin and DecCoin, whose denomination has a
+// registered display denomination is converted, while the rest of the
+// document, including the order of its keys, is left untouched. The result is
+// compact JSON.
+func DisplayCoinsJSON(bz []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	value, err := decodeOrderedJSON(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid JSON document: trailing data")
+	}
+
+	value, err = displayCoins(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(value)
+}
+
+// displayCoins converts the coins found in a decoded JSON value to their
+// display denomination
+func displayCoins(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case orderedJSONObject:
+		if coin, ok := v.coin(); ok {
+			return displayCoin(v, coin)
+		}
+
+		for i := range v {
+			field, err := displayCoins(v[i].Value)
+			if err != nil {
+				return nil, err
+			}
+			v[i].Value = field
+		}
+
+	case []interface{}:
+		for i := range v {
+			elem, err := displayCoins(v[i])
+			if err != nil {
+				return nil, err
+			}
+			v[i] = elem
+		}
+	}
+
+	return value, nil
+}
+
+func displayCoin(obj orderedJSONObject, coin DecCoin) (interface{}, error) {
+	displayDenom, ok := GetDisplayDenom(coin.Denom)
+	if !ok {
+		return obj, nil
+	}
+
+	displayCoin, err := ConvertDecCoin(coin, displayDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// trim the trailing zeros of the decimal amount
+	amount := strings.TrimRight(displayCoin.Amount.String(), "0")
+	amount = strings.TrimSuffix(amount, ".")
+
+	return orderedJSONObject{
+		{Key: "denom", Value: displayCoin.Denom},
+		{Key: "amount", Value: amount},
+	}, nil
+}
+
+// orderedJSONObject is a decoded JSON object which keeps the order of its keys
+type orderedJSONObject []orderedJSONField
+
+type orderedJSONField struct {
+	Key   string
+	Value interface{}
+}
+
+// coin returns the coin the object encodes, if it holds only a denomination
+// and an amount
+func (obj orderedJSONObject) coin() (DecCoin, bool) {
+	if len(obj) != 2 {
+		return DecCoin{}, false
+	}
+
+	var denom, amount string
+	for _, field := range obj {
+		s, ok := field.Value.(string)
+		if !ok {
+			return DecCoin{}, false
+		}
+
+		switch field.Key {
+		case "denom":
+			denom = s
+		case "amount":
+			amount = s
+		default:
+			return DecCoin{}, false
+		}
+	}
+
+	if validateDenom(denom) != nil {
+		return DecCoin{}, false
+	}
+
+	dec, err := NewDecFromStr(amount)
+	if err != nil {
+		return DecCoin{}, false
+	}
+
+	return DecCoin{Denom: denom, Amount: dec}, true
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (obj orderedJSONObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for i, field := range obj {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(field.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// decodeOrderedJSON decodes the next JSON value of the decoder, decoding
+// objects into orderedJSONObjects
+func decodeOrderedJSON(dec *json.Decoder) (interface{}, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+
+	switch delim {
+	case '{':
+		obj := orderedJSONObject{}
+		for dec.More() {
+			keyToken, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := decodeOrderedJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			obj = append(obj, orderedJSONField{Key: keyToken.(string), Value: value})
+		}
+
+		// consume the closing delimiter
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+
+		return obj, nil
+
+	case '[':
+		arr := []interface{}{}
+		for dec.More() {
+			value, err := decodeOrderedJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			arr = append(arr, value)
+		}
+
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+
+		return arr, nil
+
+	default:
+		return nil, fmt.Errorf("invalid JSON document: unexpected %s", delim)
+	}
+}
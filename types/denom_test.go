@@ -81,3 +81,56 @@ func TestConvertCoins(t *testing.T) {
 	// reset registration
 	denomUnits = map[string]Dec{}
 }
+
+func TestDisplayCoinsJSON(t *testing.T) {
+	require.NoError(t, RegisterDenom(atom, OneDec()))
+	require.NoError(t, RegisterDenom(uatom, NewDecWithPrec(1, 6)))
+
+	require.Error(t, RegisterDisplayDenom("foo", atom))
+	require.Error(t, RegisterDisplayDenom(uatom, "foo"))
+	require.NoError(t, RegisterDisplayDenom(uatom, atom))
+	require.Error(t, RegisterDisplayDenom(uatom, atom))
+
+	displayDenom, ok := GetDisplayDenom(uatom)
+	require.True(t, ok)
+	require.Equal(t, atom, displayDenom)
+
+	_, ok = GetDisplayDenom(atom)
+	require.False(t, ok)
+
+	res, err := ConvertDecCoin(NewDecCoin(uatom, NewInt(1500000)), atom)
+	require.NoError(t, err)
+	require.Equal(t, NewDecCoinFromDec(atom, NewDecWithPrec(15, 1)), res)
+
+	testCases := []struct {
+		input  string
+		output string
+		expErr bool
+	}{
+		{`{"denom":"uatom","amount":"1500000"}`, `{"denom":"atom","amount":"1.5"}`, false},
+		{`{"amount":"2000000","denom":"uatom"}`, `{"denom":"atom","amount":"2"}`, false},
+		{`{"denom":"uatom","amount":"1.000000000000000000"}`, `{"denom":"atom","amount":"0.000001"}`, false},
+		{`{"denom":"stake","amount":"10"}`, `{"denom":"stake","amount":"10"}`, false},
+		{`{"denom":"uatom","amount":10}`, `{"denom":"uatom","amount":10}`, false},
+		{`{"denom":"uatom","amount":"10","extra":true}`, `{"denom":"uatom","amount":"10","extra":true}`, false},
+		{
+			`{"height":"5","result":{"coins":[{"denom":"stake","amount":"7"},{"denom":"uatom","amount":"250000"}],"address":null}}`,
+			`{"height":"5","result":{"coins":[{"denom":"stake","amount":"7"},{"denom":"atom","amount":"0.25"}],"address":null}}`,
+			false,
+		},
+		{`{"denom":"uatom"`, ``, true},
+		{`{} {}`, ``, true},
+	}
+
+	for i, tc := range testCases {
+		res, err := DisplayCoinsJSON([]byte(tc.input))
+		require.Equal(t, tc.expErr, err != nil, "unexpected error; tc: #%d, input: %s", i+1, tc.input)
+		if !tc.expErr {
+			require.Equal(t, tc.output, string(res), "invalid result; tc: #%d, input: %s", i+1, tc.input)
+		}
+	}
+
+	// reset registration
+	denomUnits = map[string]Dec{}
+	displayDenoms = map[string]string{}
+}
@@ -71,6 +71,10 @@ var (
 	// ErrJSONUnmarshal defines an ABCI typed JSON unmarshalling error
 	ErrJSONUnmarshal = Register(RootCodespace, 18, "failed to unmarshal JSON bytes")
 
+	// ErrTxInMempoolCache defines an ABCI typed error where a tx identical to
+	// one recently accepted into the mempool is received again
+	ErrTxInMempoolCache = Register(RootCodespace, 19, "tx already in mempool")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = Register(UndefinedCodespace, 111222, "panic")
@@ -3,6 +3,24 @@ package types
 // Handler defines the core of the state transition function of an application.
 type Handler func(ctx Context, msg Msg) Result
 
+// HandlerMiddleware wraps the Handler of a route to perform custom pre- and
+// post-processing of its messages, e.g. logging, metrics, gas surcharges or
+// access control. It is given the route of the wrapped Handler.
+type HandlerMiddleware func(route string, next Handler) Handler
+
+// ChainHandlerMiddlewares wraps the Handler of a route with the given
+// middlewares and returns a single Handler.
+//
+// NOTE: The first element is the outermost middleware, which runs first before
+// the message is handled and last after.
+func ChainHandlerMiddlewares(route string, h Handler, chain ...HandlerMiddleware) Handler {
+	for i := len(chain) - 1; i >= 0; i-- {
+		h = chain[i](route, h)
+	}
+
+	return h
+}
+
 // AnteHandler authenticates transactions, before their internal messages are handled.
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, err error)
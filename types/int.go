@@ -2,13 +2,24 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
 	"math/big"
 )
 
-const maxBitLen = 255
+const (
+	// MaxBitLen is the maximum bit length of the absolute value of an Int
+	MaxBitLen = 255
+
+	// maxBitLen is kept for the arithmetic checks
+	maxBitLen = MaxBitLen
+)
+
+// ErrIntOverflow is wrapped by the errors returned when decoding an Int, a Uint
+// or a Dec whose value doesn't fit in its maximum bit length.
+var ErrIntOverflow = errors.New("integer overflow")
 
 func newIntegerFromString(s string) (*big.Int, bool) {
 	return new(big.Int).SetString(s, 0)
@@ -58,21 +69,38 @@ func marshalAmino(i *big.Int) (string, error) {
 	return string(bz), err
 }
 
-func unmarshalText(i *big.Int, text string) error {
-	if err := i.UnmarshalText([]byte(text)); err != nil {
-		return err
+// unmarshalText strictly decodes a base 10 integer, made of an optional minus
+// sign followed by digits, whose absolute value fits in maxBits bits. The
+// integer is left untouched on error.
+func unmarshalText(i *big.Int, text string, maxBits int) error {
+	digits := text
+	if len(digits) > 0 && digits[0] == '-' {
+		digits = digits[1:]
+	}
+	if len(digits) == 0 {
+		return fmt.Errorf("invalid integer %q: no digits", text)
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return fmt.Errorf("invalid integer %q: unexpected character %q", text, c)
+		}
 	}
 
-	if i.BitLen() > maxBitLen {
-		return fmt.Errorf("integer out of range: %s", text)
+	parsed, ok := new(big.Int).SetString(text, 10)
+	if !ok {
+		return fmt.Errorf("invalid integer %q", text)
+	}
+	if parsed.BitLen() > maxBits {
+		return fmt.Errorf("%w: %s has %d bits, the maximum is %d", ErrIntOverflow, text, parsed.BitLen(), maxBits)
 	}
 
+	i.Set(parsed)
 	return nil
 }
 
 // UnmarshalAmino for custom decoding scheme
 func unmarshalAmino(i *big.Int, text string) (err error) {
-	return unmarshalText(i, text)
+	return unmarshalText(i, text, MaxBitLen)
 }
 
 // MarshalJSON for custom encoding scheme
@@ -91,10 +119,10 @@ func unmarshalJSON(i *big.Int, bz []byte) error {
 	var text string
 	err := json.Unmarshal(bz, &text)
 	if err != nil {
-		return err
+		return fmt.Errorf("integer must be encoded as a JSON string: %w", err)
 	}
 
-	return unmarshalText(i, text)
+	return unmarshalText(i, text, MaxBitLen)
 }
 
 // Int wraps integer with 256 bit range bound
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+	"errors"
 	"math/big"
 	"math/rand"
 	"strconv"
@@ -397,3 +399,98 @@ func TestSerializationOverflow(t *testing.T) {
 	err = y.UnmarshalJSON(bz)
 	require.Error(t, err)
 }
+
+func TestUnmarshalStrict(t *testing.T) {
+	cases := []struct {
+		text  string
+		valid bool
+	}{
+		{"0", true},
+		{"-0", true},
+		{"123", true},
+		{"-123", true},
+		{"007", true},
+		{"", false},
+		{"-", false},
+		{"--5", false},
+		{"+5", false},
+		{" 5", false},
+		{"5 ", false},
+		{"0x10", false},
+		{"0b1", false},
+		{"1_000", false},
+		{"1e5", false},
+		{"1.0", false},
+	}
+
+	for tcnum, tc := range cases {
+		var i Int
+		err := (&i).UnmarshalAmino(tc.text)
+		require.Equal(t, tc.valid, err == nil, "tc #%d %q: %v", tcnum, tc.text, err)
+
+		bz, _ := json.Marshal(tc.text)
+		err = (&i).UnmarshalJSON(bz)
+		require.Equal(t, tc.valid, err == nil, "tc #%d %q: %v", tcnum, tc.text, err)
+	}
+
+	// integers must be JSON strings
+	var i Int
+	require.Error(t, (&i).UnmarshalJSON([]byte("5")))
+	require.Error(t, (&i).UnmarshalJSON([]byte("null")))
+}
+
+func TestUnmarshalOverflowError(t *testing.T) {
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), MaxBitLen), big.NewInt(1))
+	over := new(big.Int).Lsh(big.NewInt(1), MaxBitLen)
+
+	var i Int
+	require.NoError(t, (&i).UnmarshalAmino(max.String()))
+	require.Equal(t, max, i.BigInt())
+	require.NoError(t, (&i).UnmarshalAmino("-"+max.String()))
+
+	// the integer is left untouched on error
+	i = NewInt(7)
+	err := (&i).UnmarshalAmino(over.String())
+	require.True(t, errors.Is(err, ErrIntOverflow), "%v", err)
+	require.Equal(t, NewInt(7), i)
+
+	err = (&i).UnmarshalJSON([]byte(`"-` + over.String() + `"`))
+	require.True(t, errors.Is(err, ErrIntOverflow), "%v", err)
+	require.Equal(t, NewInt(7), i)
+}
+
+// TestUnmarshalFuzz decodes random strings, which must either fail or be
+// decimal integers within bounds encoded back to their canonical form.
+func TestUnmarshalFuzz(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	alphabet := "0123456789-+.xeE_ "
+
+	for n := 0; n < 10000; n++ {
+		bz := make([]byte, r.Intn(90))
+		for j := range bz {
+			if r.Intn(4) == 0 {
+				bz[j] = alphabet[r.Intn(len(alphabet))]
+			} else {
+				bz[j] = byte('0' + r.Intn(10))
+			}
+		}
+		text := string(bz)
+
+		var i Int
+		err := (&i).UnmarshalAmino(text)
+		expected, ok := new(big.Int).SetString(text, 10)
+		valid := ok && len(text) > 0 && text[0] != '+' && expected.BitLen() <= MaxBitLen
+		if !valid {
+			require.Error(t, err, "%q", text)
+			continue
+		}
+		require.NoError(t, err, "%q", text)
+		require.Equal(t, expected.String(), i.String())
+
+		bz, err = i.MarshalJSON()
+		require.NoError(t, err)
+		var decoded Int
+		require.NoError(t, (&decoded).UnmarshalJSON(bz))
+		require.True(t, i.Equal(decoded))
+	}
+}
@@ -1,6 +1,9 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // An Invariant is a function which tests a particular invariant.
 // The invariant returns a descriptive message about what happened
@@ -16,6 +19,34 @@ type InvariantRegistry interface {
 	RegisterRoute(moduleName, route string, invar Invariant)
 }
 
+// InvariantsChecker defines a type which runs all of its registered invariants
+// at once, e.g. the crisis keeper. It returns an error reporting the module and
+// route of every broken invariant, if any.
+type InvariantsChecker interface {
+	CheckInvariants(ctx Context) error
+}
+
+// BrokenInvariant describes an invariant found broken by an InvariantsChecker
+type BrokenInvariant struct {
+	ModuleName string `json:"module"`
+	Route      string `json:"route"`
+	Message    string `json:"message"`
+}
+
+// BrokenInvariantsError is the error returned by an InvariantsChecker which
+// found invariants broken, allowing callers to inspect each of them
+type BrokenInvariantsError []BrokenInvariant
+
+// Error implements the error interface
+func (e BrokenInvariantsError) Error() string {
+	broken := make([]string, len(e))
+	for i, invar := range e {
+		broken[i] = fmt.Sprintf("%s/%s: %s", invar.ModuleName, invar.Route, strings.TrimSpace(invar.Message))
+	}
+
+	return fmt.Sprintf("%d invariant(s) broken:\n%s", len(e), strings.Join(broken, "\n"))
+}
+
 // FormatInvariant returns a standardized invariant message.
 func FormatInvariant(module, name, msg string) string {
 	return fmt.Sprintf("%s: %s invariant\n%s\n", module, name, msg)
@@ -0,0 +1,60 @@
+package module
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by the
+	// module manager.
+	MetricsSubsystem = "module"
+
+	// MetricsModuleLabel is the label holding the module name.
+	MetricsModuleLabel = "module"
+)
+
+// Metrics contains metrics exposed by the module manager.
+type Metrics struct {
+	// Time spent by each module in BeginBlock, in seconds.
+	BeginBlockDuration metrics.Histogram
+	// Time spent by each module in EndBlock, in seconds.
+	EndBlockDuration metrics.Histogram
+}
+
+// PrometheusMetrics returns Metrics built using the Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{MetricsModuleLabel}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+
+	return &Metrics{
+		BeginBlockDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "begin_block_duration_seconds",
+			Help:      "Time spent by a module in BeginBlock, in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.0001, 4, 8),
+		}, labels).With(labelsAndValues...),
+		EndBlockDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "end_block_duration_seconds",
+			Help:      "Time spent by a module in EndBlock, in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.0001, 4, 8),
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		BeginBlockDuration: discard.NewHistogram(),
+		EndBlockDuration:   discard.NewHistogram(),
+	}
+}
@@ -30,6 +30,11 @@ package module
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
@@ -77,6 +82,72 @@ func (bm BasicManager) RegisterCodec(cdc *codec.Codec) {
 	}
 }
 
+// ValidateCodec registers the codec of every module on a codec of its own and
+// returns an error listing the amino names registered by more than one module
+// as well as distinct names sharing the same prefix. Conflicting registrations
+// would otherwise only surface as an amino panic or as silently mismatched
+// encodings, so applications should call it before building their codec.
+func (bm BasicManager) ValidateCodec() error {
+	names := make([]string, 0, len(bm))
+	for name := range bm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var (
+		conflicts []string
+		routes    = make(map[string]string) // amino name -> module
+		prefixes  = make(map[string]string) // amino prefix -> amino name
+	)
+
+	for _, name := range names {
+		types, err := moduleCodecTypes(bm[name])
+		if err != nil {
+			conflicts = append(conflicts, fmt.Sprintf("module %s: %s", name, err))
+			continue
+		}
+
+		for _, t := range types {
+			if other, ok := routes[t.Name]; ok {
+				conflicts = append(conflicts, fmt.Sprintf(
+					"type %s registered as %s by module %s is already registered by module %s",
+					t.Type, t.Name, name, other,
+				))
+				continue
+			}
+			routes[t.Name] = name
+
+			if other, ok := prefixes[t.Prefix]; ok {
+				conflicts = append(conflicts, fmt.Sprintf(
+					"name %s registered by module %s has the same prefix %s as %s registered by module %s",
+					t.Name, name, t.Prefix, other, routes[other],
+				))
+				continue
+			}
+			prefixes[t.Prefix] = t.Name
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return fmt.Errorf("conflicting codec registrations:\n%s", strings.Join(conflicts, "\n"))
+	}
+	return nil
+}
+
+// moduleCodecTypes returns the concrete types a module registers on its codec,
+// converting a registration panic into an error.
+func moduleCodecTypes(b AppModuleBasic) (types []codec.RegisteredType, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	cdc := codec.New()
+	b.RegisterCodec(cdc)
+	return codec.RegisteredTypes(cdc)
+}
+
 // DefaultGenesis provides default genesis information for all modules
 func (bm BasicManager) DefaultGenesis() map[string]json.RawMessage {
 	genesis := make(map[string]json.RawMessage)
@@ -195,6 +266,16 @@ type Manager struct {
 	OrderExportGenesis []string
 	OrderBeginBlockers []string
 	OrderEndBlockers   []string
+
+	metrics *Metrics
+
+	// per-module BeginBlock durations of the block being executed
+	beginBlockDurations map[string]time.Duration
+
+	// per-module durations of the last executed block, guarded by mtx as they
+	// may be read by queries while a block is being executed
+	mtx          sync.RWMutex
+	blockTimings sdk.BlockTimings
 }
 
 // NewManager creates a new Manager object
@@ -213,9 +294,16 @@ func NewManager(modules ...AppModule) *Manager {
 		OrderExportGenesis: modulesStr,
 		OrderBeginBlockers: modulesStr,
 		OrderEndBlockers:   modulesStr,
+		metrics:            NopMetrics(),
 	}
 }
 
+// SetMetrics sets the metrics the module manager reports the BeginBlock and
+// EndBlock durations of each module to.
+func (m *Manager) SetMetrics(metrics *Metrics) {
+	m.metrics = metrics
+}
+
 // SetOrderInitGenesis sets the order of init genesis calls
 func (m *Manager) SetOrderInitGenesis(moduleNames ...string) {
 	m.OrderInitGenesis = moduleNames
@@ -292,9 +380,15 @@ func (m *Manager) ExportGenesis(ctx sdk.Context) map[string]json.RawMessage {
 // modules.
 func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	m.beginBlockDurations = make(map[string]time.Duration, len(m.OrderBeginBlockers))
 
 	for _, moduleName := range m.OrderBeginBlockers {
+		start := time.Now()
 		m.Modules[moduleName].BeginBlock(ctx, req)
+
+		duration := time.Since(start)
+		m.beginBlockDurations[moduleName] = duration
+		m.getMetrics().BeginBlockDuration.With(MetricsModuleLabel, moduleName).Observe(duration.Seconds())
 	}
 
 	return abci.ResponseBeginBlock{
@@ -308,10 +402,16 @@ func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	validatorUpdates := []abci.ValidatorUpdate{}
+	endBlockDurations := make(map[string]time.Duration, len(m.OrderEndBlockers))
 
 	for _, moduleName := range m.OrderEndBlockers {
+		start := time.Now()
 		moduleValUpdates := m.Modules[moduleName].EndBlock(ctx, req)
 
+		duration := time.Since(start)
+		endBlockDurations[moduleName] = duration
+		m.getMetrics().EndBlockDuration.With(MetricsModuleLabel, moduleName).Observe(duration.Seconds())
+
 		// use these validator updates if provided, the module manager assumes
 		// only one module will update the validator set
 		if len(moduleValUpdates) > 0 {
@@ -323,8 +423,65 @@ func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 		}
 	}
 
+	m.setBlockTimings(ctx.BlockHeight(), endBlockDurations)
+
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: validatorUpdates,
 		Events:           ctx.EventManager().ABCIEvents(),
 	}
 }
+
+// BlockTimings returns the time spent by each module in BeginBlock and EndBlock
+// during the last executed block. Modules are ordered by their BeginBlock
+// order, followed by the modules which only run an EndBlock. It implements the
+// sdk.BlockTimingsReporter interface.
+func (m *Manager) BlockTimings() sdk.BlockTimings {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	timings := m.blockTimings
+	timings.Modules = make([]sdk.ModuleBlockTiming, len(m.blockTimings.Modules))
+	copy(timings.Modules, m.blockTimings.Modules)
+
+	return timings
+}
+
+// setBlockTimings combines the BeginBlock durations recorded for the current
+// block with the given EndBlock durations and publishes them.
+func (m *Manager) setBlockTimings(height int64, endBlockDurations map[string]time.Duration) {
+	modules := make([]sdk.ModuleBlockTiming, 0, len(m.OrderBeginBlockers))
+	for _, moduleName := range m.OrderBeginBlockers {
+		modules = append(modules, sdk.ModuleBlockTiming{
+			Module:     moduleName,
+			BeginBlock: m.beginBlockDurations[moduleName],
+			EndBlock:   endBlockDurations[moduleName],
+		})
+	}
+
+	for _, moduleName := range m.OrderEndBlockers {
+		if _, ok := m.beginBlockDurations[moduleName]; ok {
+			continue
+		}
+
+		modules = append(modules, sdk.ModuleBlockTiming{
+			Module:   moduleName,
+			EndBlock: endBlockDurations[moduleName],
+		})
+	}
+
+	m.beginBlockDurations = nil
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.blockTimings = sdk.BlockTimings{Height: height, Modules: modules}
+}
+
+// getMetrics returns the manager metrics, falling back to no-op metrics for
+// managers which were not created through NewManager.
+func (m *Manager) getMetrics() *Metrics {
+	if m.metrics == nil {
+		m.metrics = NopMetrics()
+	}
+
+	return m.metrics
+}
@@ -2,9 +2,14 @@ package module
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestSetOrderBeginBlockers(t *testing.T) {
@@ -14,3 +19,98 @@ func TestSetOrderBeginBlockers(t *testing.T) {
 	require.Equal(t, 3, len(obb))
 	assert.Equal(t, []string{"a", "b", "c"}, obb)
 }
+
+type timedAppModule struct {
+	AppModule
+
+	name            string
+	beginBlockSleep time.Duration
+	endBlockSleep   time.Duration
+}
+
+func (m timedAppModule) Name() string { return m.name }
+
+func (m timedAppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {
+	time.Sleep(m.beginBlockSleep)
+}
+
+func (m timedAppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	time.Sleep(m.endBlockSleep)
+	return nil
+}
+
+func TestManagerBlockTimings(t *testing.T) {
+	mm := NewManager(
+		timedAppModule{name: "a", beginBlockSleep: 10 * time.Millisecond},
+		timedAppModule{name: "b", endBlockSleep: 10 * time.Millisecond},
+		timedAppModule{name: "c"},
+	)
+	mm.SetOrderBeginBlockers("a", "c")
+	mm.SetOrderEndBlockers("b", "c")
+
+	require.Equal(t, sdk.BlockTimings{Modules: []sdk.ModuleBlockTiming{}}, mm.BlockTimings())
+
+	ctx := sdk.Context{}.WithBlockHeight(5)
+	mm.BeginBlock(ctx, abci.RequestBeginBlock{})
+	mm.EndBlock(ctx, abci.RequestEndBlock{})
+
+	timings := mm.BlockTimings()
+	require.Equal(t, int64(5), timings.Height)
+	require.Len(t, timings.Modules, 3)
+
+	require.Equal(t, "a", timings.Modules[0].Module)
+	require.True(t, timings.Modules[0].BeginBlock >= 10*time.Millisecond)
+	require.Zero(t, timings.Modules[0].EndBlock)
+
+	require.Equal(t, "c", timings.Modules[1].Module)
+
+	require.Equal(t, "b", timings.Modules[2].Module)
+	require.Zero(t, timings.Modules[2].BeginBlock)
+	require.True(t, timings.Modules[2].EndBlock >= 10*time.Millisecond)
+}
+
+type codecAppModuleBasic struct {
+	AppModuleBasic
+
+	name     string
+	register func(*codec.Codec)
+}
+
+func (b codecAppModuleBasic) Name() string                   { return b.name }
+func (b codecAppModuleBasic) RegisterCodec(cdc *codec.Codec) { b.register(cdc) }
+
+type codecTypeA struct{}
+type codecTypeB struct{}
+
+func TestBasicManagerValidateCodec(t *testing.T) {
+	registerA := func(cdc *codec.Codec) { cdc.RegisterConcrete(codecTypeA{}, "test/A", nil) }
+	registerB := func(cdc *codec.Codec) { cdc.RegisterConcrete(codecTypeB{}, "test/B", nil) }
+
+	bm := NewBasicManager(
+		codecAppModuleBasic{name: "a", register: registerA},
+		codecAppModuleBasic{name: "b", register: registerB},
+	)
+	require.NoError(t, bm.ValidateCodec())
+
+	// the same route registered by two modules
+	bm = NewBasicManager(
+		codecAppModuleBasic{name: "a", register: registerA},
+		codecAppModuleBasic{name: "b", register: func(cdc *codec.Codec) {
+			cdc.RegisterConcrete(codecTypeB{}, "test/A", nil)
+		}},
+	)
+	err := bm.ValidateCodec()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "codecTypeB registered as test/A by module b is already registered by module a")
+
+	// a module whose own registrations conflict
+	bm = NewBasicManager(
+		codecAppModuleBasic{name: "a", register: func(cdc *codec.Codec) {
+			registerA(cdc)
+			registerA(cdc)
+		}},
+	)
+	err = bm.ValidateCodec()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "module a:")
+}
@@ -21,6 +21,12 @@ type AppModuleSimulation interface {
 
 	// randomized module parameters for param change proposals
 	RandomizedParams(r *rand.Rand) []simulation.ParamChange
+
+	// randomized governance proposal contents submitted by the gov module
+	ProposalContents(simState SimulationState) []simulation.WeightedProposalContent
+
+	// simulation operations (i.e msgs) with their respective weight
+	WeightedOperations(simState SimulationState) []simulation.WeightedOperation
 }
 
 // SimulationManager defines a simulation manager that provides the high level utility
@@ -67,16 +73,43 @@ func (sm *SimulationManager) GenerateParamChanges(seed int64) (paramChanges []si
 	return
 }
 
+// GetProposalContents returns each module's proposal content generator
+// functions with their default operation weight and key
+func (sm *SimulationManager) GetProposalContents(simState SimulationState) []simulation.WeightedProposalContent {
+	var wContents []simulation.WeightedProposalContent
+	for _, module := range sm.Modules {
+		wContents = append(wContents, module.ProposalContents(simState)...)
+	}
+
+	return wContents
+}
+
+// WeightedOperations returns all the modules' weighted operations of an
+// application
+func (sm *SimulationManager) WeightedOperations(simState SimulationState) []simulation.WeightedOperation {
+	var wOps []simulation.WeightedOperation
+	for _, module := range sm.Modules {
+		wOps = append(wOps, module.WeightedOperations(simState)...)
+	}
+
+	return wOps
+}
+
 // SimulationState is the input parameters used on each of the module's randomized
-// GenesisState generator function
+// GenesisState generator, proposal contents and weighted operations functions
 type SimulationState struct {
 	AppParams    simulation.AppParams
 	Cdc          *codec.Codec               // application codec
 	Rand         *rand.Rand                 // random number
 	GenState     map[string]json.RawMessage // genesis state
 	Accounts     []simulation.Account       // simulation accounts
+	BondDenom    string                     // bond denomination
+	BondExponent uint                       // number of decimal places of the bond denomination
 	InitialStake int64                      // initial coins per account
 	NumBonded    int64                      // number of initially bonded acconts
 	GenTimestamp time.Time                  // genesis timestamp
 	UnbondTime   time.Duration              // staking unbond time stored to use it as the slashing maximum evidence duration
+
+	ParamChanges []simulation.ParamChange             // simulated parameter changes from modules
+	Contents     []simulation.WeightedProposalContent // proposal content generator functions with their default weight and app sim key
 }
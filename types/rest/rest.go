@@ -214,8 +214,11 @@ func ParseFloat64OrReturnBadRequest(w http.ResponseWriter, s string, defaultIfEm
 }
 
 // ParseQueryHeightOrReturnBadRequest sets the height to execute a query if set by the http request.
-// It returns false if there was an error parsing the height.
+// It returns false if there was an error parsing the height. The queries of the
+// returned context are cancelled along with the request.
 func ParseQueryHeightOrReturnBadRequest(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) (context.CLIContext, bool) {
+	cliCtx = cliCtx.WithContext(r.Context())
+
 	heightStr := r.FormValue("height")
 	if heightStr != "" {
 		height, err := strconv.ParseInt(heightStr, 10, 64)
@@ -264,6 +267,12 @@ func PostProcessResponseBare(w http.ResponseWriter, cliCtx context.CLIContext, b
 		}
 	}
 
+	resp, err = cliCtx.FormatDisplayUnits(resp)
+	if err != nil {
+		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	_, _ = w.Write(resp)
 }
@@ -297,12 +306,15 @@ func PostProcessResponse(w http.ResponseWriter, cliCtx context.CLIContext, resp
 		}
 	}
 
+	result, err := cliCtx.FormatDisplayUnits(result)
+	if err != nil {
+		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
 	wrappedResp := NewResponseWithHeight(cliCtx.Height, result)
 
-	var (
-		output []byte
-		err    error
-	)
+	var output []byte
 
 	if cliCtx.Indent {
 		output, err = cliCtx.Codec.MarshalJSONIndent(wrappedResp, "", "  ")
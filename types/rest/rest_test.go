@@ -135,6 +135,7 @@ func TestParseQueryHeight(t *testing.T) {
 			if tt.expectedOk {
 				require.True(t, ok)
 				require.Equal(t, tt.expectedHeight, cliCtx.Height)
+				require.Equal(t, tt.req.Context(), cliCtx.Ctx)
 			} else {
 				require.False(t, ok)
 				require.Empty(t, tt.expectedHeight, cliCtx.Height)
@@ -198,6 +199,32 @@ func TestProcessPostResponse(t *testing.T) {
 	runPostProcessResponse(t, ctx, acc, expectedWithIndent, true)
 }
 
+func TestPostProcessResponseDisplayUnits(t *testing.T) {
+	require.NoError(t, types.RegisterDenom("display", types.OneDec()))
+	require.NoError(t, types.RegisterDenom("udisplay", types.NewDecWithPrec(1, 6)))
+	require.NoError(t, types.RegisterDisplayDenom("udisplay", "display"))
+
+	ctx := context.NewCLIContext().WithCodec(codec.New()).WithHeight(10)
+	coins := types.NewCoins(types.NewInt64Coin("stake", 5), types.NewInt64Coin("udisplay", 2500000))
+
+	// base units are rendered unless display units are enabled
+	w := httptest.NewRecorder()
+	PostProcessResponse(w, ctx, coins)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t,
+		`{"height":"10","result":[{"denom":"stake","amount":"5"},{"denom":"udisplay","amount":"2500000"}]}`,
+		w.Body.String(),
+	)
+
+	w = httptest.NewRecorder()
+	PostProcessResponse(w, ctx.WithDisplayUnits(true), coins)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t,
+		`{"height":"10","result":[{"denom":"stake","amount":"5"},{"denom":"display","amount":"2.5"}]}`,
+		w.Body.String(),
+	)
+}
+
 // asserts that ResponseRecorder returns the expected code and body
 // runs PostProcessResponse on the objects regular interface and on
 // the marshalled struct.
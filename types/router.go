@@ -9,6 +9,7 @@ var IsAlphaNumeric = regexp.MustCompile(`^[a-zA-Z0-9]+$`).MatchString
 // Router provides handlers for each transaction type.
 type Router interface {
 	AddRoute(r string, h Handler) Router
+	AddMiddleware(m HandlerMiddleware, routes ...string) Router
 	Route(path string) Handler
 }
 
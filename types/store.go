@@ -24,6 +24,9 @@ type (
 	MultiStorePersistentCache = types.MultiStorePersistentCache
 	KVStore                   = types.KVStore
 	Iterator                  = types.Iterator
+	StoreStats                = types.StoreStats
+	StatsReporter             = types.StatsReporter
+	MultiStoreStatsReporter   = types.MultiStoreStatsReporter
 )
 
 // StoreDecoderRegistry defines each of the modules store decoders. Used for ImportExport
@@ -40,6 +43,21 @@ func KVStoreReversePrefixIterator(kvs KVStore, prefix []byte) Iterator {
 	return types.KVStoreReversePrefixIterator(kvs, prefix)
 }
 
+// Iterator over all the keys with a certain prefix in descending order,
+// starting right after the startAfter cursor.
+func KVStoreReversePrefixIteratorAfter(kvs KVStore, prefix, startAfter []byte) Iterator {
+	return types.KVStoreReversePrefixIteratorAfter(kvs, prefix, startAfter)
+}
+
+// KVStoreIterateDescending iterates over at most limit keys with a certain
+// prefix in descending order, starting right after the startAfter cursor. It
+// returns the last key visited.
+func KVStoreIterateDescending(
+	kvs KVStore, prefix, startAfter []byte, limit int, cb func(key, value []byte) (stop bool),
+) []byte {
+	return types.KVStoreIterateDescending(kvs, prefix, startAfter, limit, cb)
+}
+
 // DiffKVStores compares two KVstores and returns all the key/value pairs
 // that differ from one another. It also skips value comparison for a set of provided prefixes
 func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []cmn.KVPair) {
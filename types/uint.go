@@ -1,11 +1,15 @@
 package types
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 )
 
+// MaxUintBitLen is the maximum bit length of a Uint
+const MaxUintBitLen = 256
+
 // Uint wraps integer with 256 bit range bound
 // Checks overflow, underflow and division by zero
 // Exists in range from 0 to 2^256-1
@@ -119,7 +123,7 @@ func (u *Uint) UnmarshalAmino(text string) error {
 	if u.i == nil { // Necessary since default Uint initialization has i.i as nil
 		u.i = new(big.Int)
 	}
-	return unmarshalAmino(u.i, text)
+	return unmarshalUintText(u.i, text)
 }
 
 // MarshalJSON defines custom encoding scheme
@@ -135,7 +139,20 @@ func (u *Uint) UnmarshalJSON(bz []byte) error {
 	if u.i == nil { // Necessary since default Uint initialization has i.i as nil
 		u.i = new(big.Int)
 	}
-	return unmarshalJSON(u.i, bz)
+	var text string
+	if err := json.Unmarshal(bz, &text); err != nil {
+		return fmt.Errorf("integer must be encoded as a JSON string: %w", err)
+	}
+	return unmarshalUintText(u.i, text)
+}
+
+// unmarshalUintText strictly decodes a base 10 unsigned integer which fits in
+// MaxUintBitLen bits
+func unmarshalUintText(i *big.Int, text string) error {
+	if len(text) > 0 && text[0] == '-' {
+		return fmt.Errorf("invalid unsigned integer %q: negative", text)
+	}
+	return unmarshalText(i, text, MaxUintBitLen)
 }
 
 //__________________________________________________________________________
@@ -146,8 +163,8 @@ func UintOverflow(i *big.Int) error {
 	if i.Sign() < 0 {
 		return errors.New("non-positive integer")
 	}
-	if i.BitLen() > 256 {
-		return fmt.Errorf("bit length %d greater than 256", i.BitLen())
+	if i.BitLen() > MaxUintBitLen {
+		return fmt.Errorf("bit length %d greater than %d", i.BitLen(), MaxUintBitLen)
 	}
 	return nil
 }
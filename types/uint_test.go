@@ -253,3 +253,18 @@ func TestParseUint(t *testing.T) {
 func randuint() Uint {
 	return NewUint(rand.Uint64())
 }
+
+func TestUintUnmarshalStrict(t *testing.T) {
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), MaxUintBitLen), big.NewInt(1))
+
+	var u Uint
+	require.NoError(t, (&u).UnmarshalAmino(max.String()))
+	require.Equal(t, NewUintFromBigInt(max), u)
+	require.NoError(t, (&u).UnmarshalJSON([]byte(`"`+max.String()+`"`)))
+
+	require.Error(t, (&u).UnmarshalAmino("-1"))
+	require.Error(t, (&u).UnmarshalJSON([]byte(`"-1"`)))
+	require.Error(t, (&u).UnmarshalJSON([]byte(`"0x10"`)))
+	require.Error(t, (&u).UnmarshalJSON([]byte(`1`)))
+	require.Error(t, (&u).UnmarshalAmino(new(big.Int).Add(max, big.NewInt(1)).String()))
+}
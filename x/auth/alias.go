@@ -47,6 +47,7 @@ var (
 	SanitizeGenesisAccounts           = types.SanitizeGenesisAccounts
 	AddressStoreKey                   = types.AddressStoreKey
 	NewParams                         = types.NewParams
+	NewMemoExemption                  = types.NewMemoExemption
 	ParamKeyTable                     = types.ParamKeyTable
 	DefaultParams                     = types.DefaultParams
 	NewQueryAccountParams             = types.NewQueryAccountParams
@@ -71,6 +72,7 @@ var (
 	KeyTxSizeCostPerByte      = types.KeyTxSizeCostPerByte
 	KeySigVerifyCostED25519   = types.KeySigVerifyCostED25519
 	KeySigVerifyCostSecp256k1 = types.KeySigVerifyCostSecp256k1
+	KeyMemoExemptions         = types.KeyMemoExemptions
 )
 
 type (
@@ -81,6 +83,8 @@ type (
 	AccountRetriever                 = types.AccountRetriever
 	GenesisState                     = types.GenesisState
 	Params                           = types.Params
+	MemoExemption                    = types.MemoExemption
+	MemoExemptions                   = types.MemoExemptions
 	QueryAccountParams               = types.QueryAccountParams
 	StdSignMsg                       = types.StdSignMsg
 	StdTx                            = types.StdTx
@@ -45,7 +45,9 @@ type TxWithMemo interface {
 }
 
 // ValidateMemoDecorator will validate memo given the parameters passed in
-// If memo is too large decorator returns with error, otherwise call next AnteHandler
+// If memo is too large decorator returns with error, otherwise call next AnteHandler.
+// The memo limit can be overridden per message route through the memo exemptions
+// parameter.
 // CONTRACT: Tx must implement TxWithMemo interface
 type ValidateMemoDecorator struct {
 	ak keeper.AccountKeeper
@@ -65,11 +67,13 @@ func (vmd ValidateMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 
 	params := vmd.ak.GetParams(ctx)
 
+	maxMemoCharacters := params.MaxMemoCharactersForMsgs(memoTx.GetMsgs())
+
 	memoLength := len(memoTx.GetMemo())
-	if uint64(memoLength) > params.MaxMemoCharacters {
+	if uint64(memoLength) > maxMemoCharacters {
 		return ctx, err.Wrapf(err.ErrMemoTooLarge,
 			"maximum number of characters is %d but received %d characters",
-			params.MaxMemoCharacters, memoLength,
+			maxMemoCharacters, memoLength,
 		)
 	}
 
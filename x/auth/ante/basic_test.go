@@ -80,6 +80,44 @@ func TestValidateMemo(t *testing.T) {
 	require.Nil(t, err, "ValidateBasicDecorator returned error on valid tx. err: %v", err)
 }
 
+func TestValidateMemoExemption(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msg1 := types.NewTestMsg(addr1)
+	fee := types.NewTestStdFee()
+
+	msgs := []sdk.Msg{msg1}
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	longMemoTx := types.NewTestTxWithMemo(ctx, msgs, privs, accNums, seqs, fee, strings.Repeat("01234567890", 50))
+
+	vmd := ante.NewValidateMemoDecorator(app.AccountKeeper)
+	antehandler := sdk.ChainAnteDecorators(vmd)
+
+	_, err := antehandler(ctx, longMemoTx, false)
+	require.NotNil(t, err, "Did not error on tx with high memo")
+
+	// raise the memo limit of the test msg route
+	params := app.AccountKeeper.GetParams(ctx)
+	params.MemoExemptions = types.MemoExemptions{types.NewMemoExemption(msg1.Route(), 1000)}
+	app.AccountKeeper.SetParams(ctx, params)
+
+	_, err = antehandler(ctx, longMemoTx, false)
+	require.Nil(t, err, "ValidateMemoDecorator returned error on exempted tx. err: %v", err)
+
+	// lower the memo limit of the test msg route below the default limit
+	params.MemoExemptions = types.MemoExemptions{types.NewMemoExemption(msg1.Route(), 10)}
+	app.AccountKeeper.SetParams(ctx, params)
+
+	shortMemoTx := types.NewTestTxWithMemo(ctx, msgs, privs, accNums, seqs, fee, strings.Repeat("01234567890", 2))
+	_, err = antehandler(ctx, shortMemoTx, false)
+	require.NotNil(t, err, "Did not error on tx with memo above its route limit")
+}
+
 func TestConsumeGasForTxSize(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
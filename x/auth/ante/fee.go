@@ -12,7 +12,8 @@ import (
 )
 
 var (
-	_ FeeTx = (*types.StdTx)(nil) // assert StdTx implements FeeTx
+	_ FeeTx        = (*types.StdTx)(nil) // assert StdTx implements FeeTx
+	_ GrantedFeeTx = (*types.StdTx)(nil) // assert StdTx implements GrantedFeeTx
 )
 
 // FeeTx defines the interface to be implemented by Tx to use the FeeDecorators
@@ -23,6 +24,13 @@ type FeeTx interface {
 	FeePayer() sdk.AccAddress
 }
 
+// GrantedFeeTx defines the interface to be implemented by Tx whose fees may be
+// paid by an account other than the fee payer through a fee allowance.
+type GrantedFeeTx interface {
+	FeeTx
+	FeeGranter() sdk.AccAddress
+}
+
 // MempoolFeeDecorator will check if the transaction's fee is at least as large
 // as the local validator's minimum gasFee (defined in validator config).
 // If fee is too low, decorator returns error and tx is rejected from mempool.
@@ -94,6 +102,12 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		panic(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
 	}
 
+	// fees paid through a fee allowance must be handled by a decorator that
+	// knows about fee grants, otherwise the fee payer would be charged instead
+	if grantedTx, ok := tx.(GrantedFeeTx); ok && !grantedTx.FeeGranter().Empty() {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "fee grants are not supported")
+	}
+
 	feePayer := feeTx.FeePayer()
 	feePayerAcc := dfd.ak.GetAccount(ctx, feePayer)
 
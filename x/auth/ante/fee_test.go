@@ -96,3 +96,37 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestDeductFeesWithFeeAccount(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	_, _, addr2 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msg1 := types.NewTestMsg(addr1)
+	fee := types.NewTestStdFee()
+	fee.FeeAccount = addr2
+
+	msgs := []sdk.Msg{msg1}
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	// Set both accounts with sufficient funds
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		acc.SetCoins([]sdk.Coin{sdk.NewCoin("atom", sdk.NewInt(200))})
+		app.AccountKeeper.SetAccount(ctx, acc)
+	}
+
+	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.SupplyKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	_, err := antehandler(ctx, tx, false)
+
+	require.NotNil(t, err, "Tx did not error when fee grants are not supported")
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(200))), app.AccountKeeper.GetAccount(ctx, addr1).GetCoins())
+}
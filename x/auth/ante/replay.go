@@ -0,0 +1,111 @@
+package ante
+
+import (
+	"sync"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// seenTx defines a transaction hash accepted by CheckTx at a given height.
+type seenTx struct {
+	hash   string
+	height int64
+}
+
+// TxReplayDecorator keeps a bounded, rolling set of the hashes of the
+// transactions accepted by CheckTx within the last window blocks and rejects
+// the exact duplicates of those transactions, e.g. the ones re-broadcast by
+// aggressive client retry loops, before they churn through the rest of the
+// ante handler chain. Once the set holds maxSize hashes, the oldest ones are
+// evicted first.
+//
+// The set is local to the node and is only used in CheckTx: ReCheckTx,
+// DeliverTx and simulations are left untouched. The decorator should be placed
+// right after the SetUpContextDecorator.
+type TxReplayDecorator struct {
+	window  int64
+	maxSize int
+
+	mtx   sync.Mutex
+	seen  map[string]int64 // tx hash -> height at which it was accepted
+	queue []seenTx         // accepted tx hashes, oldest first
+}
+
+// NewTxReplayDecorator returns a TxReplayDecorator remembering at most maxSize
+// transactions accepted within the last window blocks.
+func NewTxReplayDecorator(window int64, maxSize int) *TxReplayDecorator {
+	if window <= 0 {
+		panic("tx replay window must be positive")
+	}
+	if maxSize <= 0 {
+		panic("tx replay set size must be positive")
+	}
+
+	return &TxReplayDecorator{
+		window:  window,
+		maxSize: maxSize,
+		seen:    make(map[string]int64),
+	}
+}
+
+func (trd *TxReplayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !ctx.IsCheckTx() || ctx.IsReCheckTx() || simulate || len(ctx.TxBytes()) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	hash := string(tmhash.Sum(ctx.TxBytes()))
+
+	trd.mtx.Lock()
+	trd.prune(ctx.BlockHeight())
+	_, ok := trd.seen[hash]
+	trd.mtx.Unlock()
+
+	if ok {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrTxInMempoolCache, "tx %X", []byte(hash))
+	}
+
+	newCtx, err = next(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+
+	trd.mtx.Lock()
+	defer trd.mtx.Unlock()
+
+	// the same tx may have been accepted concurrently
+	if _, ok := trd.seen[hash]; !ok {
+		trd.seen[hash] = ctx.BlockHeight()
+		trd.queue = append(trd.queue, seenTx{hash: hash, height: ctx.BlockHeight()})
+		trd.prune(ctx.BlockHeight())
+	}
+
+	return newCtx, nil
+}
+
+// Len returns the number of transaction hashes currently remembered.
+func (trd *TxReplayDecorator) Len() int {
+	trd.mtx.Lock()
+	defer trd.mtx.Unlock()
+
+	return len(trd.seen)
+}
+
+// prune evicts the hashes accepted before the window ending at the given
+// height as well as the oldest hashes exceeding the maximum size of the set.
+//
+// CONTRACT: the caller must hold the lock.
+func (trd *TxReplayDecorator) prune(height int64) {
+	for len(trd.queue) > 0 {
+		oldest := trd.queue[0]
+		if oldest.height > height-trd.window && len(trd.queue) <= trd.maxSize {
+			break
+		}
+
+		delete(trd.seen, oldest.hash)
+		trd.queue[0] = seenTx{}
+		trd.queue = trd.queue[1:]
+	}
+}
@@ -0,0 +1,70 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestTxReplayDecorator(t *testing.T) {
+	// setup
+	_, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(10)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, types.NewTestStdFee())
+
+	var failNext bool
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		if failNext {
+			return ctx, errors.New("rejected")
+		}
+		return ctx, nil
+	}
+
+	trd := ante.NewTxReplayDecorator(2, 2)
+	checkTx := func(ctx sdk.Context, txBytes string, simulate bool) error {
+		_, err := trd.AnteHandle(ctx.WithTxBytes([]byte(txBytes)), tx, simulate, next)
+		return err
+	}
+
+	// rejected txs are not remembered
+	failNext = true
+	require.Error(t, checkTx(ctx, "tx1", false))
+	require.Equal(t, 0, trd.Len())
+
+	failNext = false
+	require.NoError(t, checkTx(ctx, "tx1", false))
+	require.Equal(t, 1, trd.Len())
+
+	// exact duplicates are rejected in CheckTx only
+	err := checkTx(ctx, "tx1", false)
+	require.True(t, sdkerrors.ErrTxInMempoolCache.Is(err), err)
+	require.NoError(t, checkTx(ctx, "tx1", true))
+	require.NoError(t, checkTx(ctx.WithIsReCheckTx(true), "tx1", false))
+	require.NoError(t, checkTx(ctx.WithIsCheckTx(false), "tx1", false))
+
+	// the oldest txs are evicted once the set is full
+	require.NoError(t, checkTx(ctx.WithBlockHeight(11), "tx2", false))
+	require.NoError(t, checkTx(ctx.WithBlockHeight(11), "tx3", false))
+	require.Equal(t, 2, trd.Len())
+	require.NoError(t, checkTx(ctx.WithBlockHeight(11), "tx1", false))
+
+	// txs are forgotten once out of the window
+	err = checkTx(ctx.WithBlockHeight(12), "tx3", false)
+	require.True(t, sdkerrors.ErrTxInMempoolCache.Is(err), err)
+	require.NoError(t, checkTx(ctx.WithBlockHeight(13), "tx3", false))
+	require.Equal(t, 1, trd.Len())
+}
@@ -14,29 +14,44 @@ import (
 // GetBroadcastCommand returns the tx broadcast command.
 func GetBroadcastCommand(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "broadcast [file_path]",
+		Use:   "broadcast [file_path...]",
 		Short: "Broadcast transactions generated offline",
 		Long: strings.TrimSpace(`Broadcast transactions created with the --generate-only
 flag and signed with the sign command. Read a transaction from [file_path] and
 broadcast it to a node. If you supply a dash (-) argument in place of an input
 filename, the command reads from standard input.
 
+Several transactions may be given, in which case they are broadcasted one after
+the other in order. Broadcasting stops at the first transaction that fails.
+
 $ <appcli> tx broadcast ./mytxn.json
+$ <appcli> tx broadcast ./mytxn1.json ./mytxn2.json
 `),
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			stdTx, err := utils.ReadStdTxFromFile(cliCtx.Codec, args[0])
-			if err != nil {
-				return
+
+			txsBytes := make([][]byte, len(args))
+			for i, filename := range args {
+				stdTx, err := utils.ReadStdTxFromFile(cliCtx.Codec, filename)
+				if err != nil {
+					return err
+				}
+
+				txsBytes[i], err = cliCtx.Codec.MarshalBinaryLengthPrefixed(stdTx)
+				if err != nil {
+					return err
+				}
 			}
 
-			txBytes, err := cliCtx.Codec.MarshalBinaryLengthPrefixed(stdTx)
-			if err != nil {
-				return
+			if len(txsBytes) == 1 {
+				res, err := cliCtx.BroadcastTx(txsBytes[0])
+				cliCtx.PrintOutput(res)
+
+				return err
 			}
 
-			res, err := cliCtx.BroadcastTx(txBytes)
+			res, err := cliCtx.BroadcastTxs(txsBytes)
 			cliCtx.PrintOutput(res)
 
 			return err
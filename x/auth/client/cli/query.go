@@ -23,6 +23,8 @@ const (
 	flagTags  = "tags"
 	flagPage  = "page"
 	flagLimit = "limit"
+
+	flagPending = "pending"
 )
 
 // GetQueryCmd returns the transaction commands for this module
@@ -35,7 +37,10 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(GetAccountCmd(cdc))
+	cmd.AddCommand(
+		GetAccountCmd(cdc),
+		GetSignBytesCmd(cdc),
+	)
 
 	return cmd
 }
@@ -46,11 +51,24 @@ func GetAccountCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "account [address]",
 		Short: "Query account balance",
-		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`
+Query the account at the given address. With the --pending flag, the sequence of the account is
+the next sequence it can sign a tx with, i.e. its on-chain sequence plus the number of its txs
+still pending in the node's mempool. Only the latest height can be queried with --pending.
+
+Example:
+$ <appcli> query auth account cosmos1... --pending
+`),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			accGetter := types.NewAccountRetriever(cliCtx)
 
+			pending := viper.GetBool(flagPending)
+			if pending && cliCtx.Height != 0 {
+				return fmt.Errorf("the --%s and --%s flags are mutually exclusive", flagPending, flags.FlagHeight)
+			}
+
 			key, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
@@ -61,10 +79,73 @@ func GetAccountCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			if pending {
+				count, err := utils.QueryPendingTxsCount(cliCtx, key)
+				if err != nil {
+					return err
+				}
+
+				if err := acc.SetSequence(acc.GetSequence() + count); err != nil {
+					return err
+				}
+			}
+
 			return cliCtx.PrintOutput(acc)
 		},
 	}
 
+	cmd.Flags().Bool(flagPending, false, "Include the account's txs pending in the mempool in its sequence")
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetSignBytesCmd returns a query command that displays the canonical bytes
+// the node expects to be signed for a transaction generated offline.
+func GetSignBytesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-bytes [file]",
+		Short: "Query the canonical sign bytes of an unsigned transaction",
+		Long: strings.TrimSpace(`
+Read a transaction generated with the --generate-only flag from [file] and ask the node for the exact
+bytes a signer must sign given the chain-id, account number and sequence. This allows third-party
+signers to verify that their serialization matches the chain's codec. If --chain-id is not provided,
+the node's chain-id is used.
+
+Example:
+$ <appcli> query auth sign-bytes tx.json --account-number 3 --sequence 7
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			stdTx, err := utils.ReadStdTxFromFile(cliCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQuerySignBytesParams(
+				stdTx, cliCtx.ChainID, viper.GetUint64(flags.FlagAccountNumber), viper.GetUint64(flags.FlagSequence),
+			)
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySignBytes), bz)
+			if err != nil {
+				return err
+			}
+
+			var resp types.QuerySignBytesResponse
+			cdc.MustUnmarshalJSON(res, &resp)
+			return cliCtx.PrintOutput(resp)
+		},
+	}
+
+	cmd.Flags().Uint64(flags.FlagAccountNumber, 0, "The account number of the signing account")
+	cmd.Flags().Uint64(flags.FlagSequence, 0, "The sequence number of the signing account")
+
 	return flags.GetCommands(cmd)[0]
 }
 
@@ -0,0 +1,358 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	flagEncrypt = "encrypt"
+)
+
+// GetBundleCommand returns the transaction bundle command and its subcommands.
+func GetBundleCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Coordinate offline signing of transactions through armored bundles",
+		Long: strings.TrimSpace(`
+A transaction bundle is an armored document holding an unsigned transaction, the
+metadata required to sign it (chain-id, and account number and sequence of each
+signer) and the signatures collected so far. Bundles may be encrypted with a passphrase shared
+among the signers.
+`),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetBundleCreateCommand(cdc),
+		GetBundleSignCommand(cdc),
+		GetBundleAssembleCommand(cdc),
+	)
+
+	return cmd
+}
+
+// GetBundleCreateCommand returns the command to create a bundle from a
+// transaction generated offline.
+func GetBundleCreateCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [file]",
+		Short: "Create a transaction bundle from a transaction generated offline",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a transaction bundle from the transaction read from [file].
+
+The account numbers and sequences of the signers of the transaction are
+queried unless the --offline flag is on, in which case the transaction must
+have a single signer whose account number and sequence are set via the
+--account-number and --sequence flags.
+
+Example:
+$ %s tx auth bundle create transaction.json --chain-id=testnet --encrypt > bundle.txt
+`,
+				version.ClientName,
+			),
+		),
+		RunE: makeBundleCreateCmd(cdc),
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().Bool(flagOffline, false, "Offline mode. Do not query a full node")
+	cmd.Flags().Bool(flagEncrypt, false, "Encrypt the bundle with a passphrase")
+	cmd.Flags().String(flagOutfile, "", "The document will be written to the given file instead of STDOUT")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func makeBundleCreateCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+		if err != nil {
+			return err
+		}
+
+		signers := stdTx.GetSigners()
+		if len(signers) == 0 {
+			return fmt.Errorf("transaction has no signers")
+		}
+
+		cliCtx := context.NewCLIContext().WithCodec(cdc)
+		txBldr := types.NewTxBuilderFromCLI()
+
+		bundleSigners := make([]types.BundleSigner, len(signers))
+		if viper.GetBool(flagOffline) {
+			if len(signers) != 1 {
+				return fmt.Errorf("the %d signers of the transaction must be queried, the --offline flag requires a single signer", len(signers))
+			}
+			bundleSigners[0] = types.NewBundleSigner(signers[0], txBldr.AccountNumber(), txBldr.Sequence())
+		} else {
+			accRetriever := types.NewAccountRetriever(cliCtx)
+			for i, signer := range signers {
+				accnum, seq, err := accRetriever.GetAccountNumberSequence(signer)
+				if err != nil {
+					return err
+				}
+				bundleSigners[i] = types.NewBundleSigner(signer, accnum, seq)
+			}
+		}
+
+		bundle := types.NewTxBundle(stdTx, txBldr.ChainID(), bundleSigners)
+		if err := bundle.ValidateBasic(); err != nil {
+			return err
+		}
+
+		var passphrase string
+		if viper.GetBool(flagEncrypt) {
+			buf := bufio.NewReader(cmd.InOrStdin())
+			passphrase, err = input.GetCheckPassword(
+				"Enter a passphrase to encrypt the bundle:",
+				"Repeat the passphrase:", buf,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return writeTxBundle(cdc, bundle, viper.GetBool(flagEncrypt), passphrase)
+	}
+}
+
+// GetBundleSignCommand returns the command to sign a transaction bundle.
+func GetBundleSignCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [bundle]",
+		Short: "Sign a transaction bundle",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign the transaction held by [bundle] with the key given by the --from flag
+and append the signature to the bundle. The updated bundle is encrypted again
+with the same passphrase if the original one was encrypted.
+
+Example:
+$ %s tx auth bundle sign bundle.txt --from=k1 > bundle-k1.txt
+`,
+				version.ClientName,
+			),
+		),
+		RunE: makeBundleSignCmd(cdc),
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().String(flagOutfile, "", "The document will be written to the given file instead of STDOUT")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func makeBundleSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		bundle, encrypted, passphrase, err := readTxBundle(cmd, cdc, args[0])
+		if err != nil {
+			return err
+		}
+
+		cliCtx := context.NewCLIContext().WithCodec(cdc)
+		name := cliCtx.GetFromName()
+		if name == "" {
+			return fmt.Errorf("required flag %q not set", flags.FlagFrom)
+		}
+
+		keybase, err := keys.NewKeyBaseFromDir(viper.GetString(cli.HomeFlag))
+		if err != nil {
+			return err
+		}
+
+		info, err := keybase.Get(name)
+		if err != nil {
+			return err
+		}
+
+		signBytes, err := bundle.SignBytes(info.GetAddress())
+		if err != nil {
+			return err
+		}
+
+		keyPassphrase, err := keys.GetPassphrase(name)
+		if err != nil {
+			return err
+		}
+
+		sig, pubKey, err := keybase.Sign(name, keyPassphrase, signBytes)
+		if err != nil {
+			return err
+		}
+
+		bundle, err = bundle.AddSignature(types.StdSignature{PubKey: pubKey, Signature: sig})
+		if err != nil {
+			return err
+		}
+
+		return writeTxBundle(cdc, bundle, encrypted, passphrase)
+	}
+}
+
+// GetBundleAssembleCommand returns the command to assemble a signed
+// transaction out of a transaction bundle.
+func GetBundleAssembleCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assemble [bundle] [[multisig-name]]",
+		Short: "Assemble a signed transaction out of a transaction bundle",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Attach the signatures collected in [bundle] to the bundled transaction
+and print the signed transaction.
+
+If [multisig-name] is given, the collected signatures are combined into a
+signature compliant to the multisig key [multisig-name]. Otherwise each signer
+of the transaction must have signed the bundle.
+
+Example:
+$ %s tx auth bundle assemble bundle.txt k1k2k3 > signed.json
+`,
+				version.ClientName,
+			),
+		),
+		RunE: makeBundleAssembleCmd(cdc),
+		Args: cobra.RangeArgs(1, 2),
+	}
+
+	cmd.Flags().String(flagOutfile, "", "The document will be written to the given file instead of STDOUT")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+func makeBundleAssembleCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		bundle, _, _, err := readTxBundle(cmd, cdc, args[0])
+		if err != nil {
+			return err
+		}
+
+		var stdTx types.StdTx
+		if len(args) == 2 {
+			stdTx, err = assembleMultisigTx(cdc, bundle, args[1])
+		} else {
+			stdTx, err = bundle.SignedTx()
+		}
+		if err != nil {
+			return err
+		}
+
+		cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+		var json []byte
+		if cliCtx.Indent {
+			json, err = cdc.MarshalJSONIndent(stdTx, "", "  ")
+		} else {
+			json, err = cdc.MarshalJSON(stdTx)
+		}
+		if err != nil {
+			return err
+		}
+
+		return writeOutput(json)
+	}
+}
+
+func assembleMultisigTx(cdc *codec.Codec, bundle types.TxBundle, name string) (types.StdTx, error) {
+	keybase, err := keys.NewKeyBaseFromDir(viper.GetString(cli.HomeFlag))
+	if err != nil {
+		return types.StdTx{}, err
+	}
+
+	multisigInfo, err := keybase.Get(name)
+	if err != nil {
+		return types.StdTx{}, err
+	}
+	if multisigInfo.GetType() != crkeys.TypeMulti {
+		return types.StdTx{}, fmt.Errorf("%q must be of type %s: %s", name, crkeys.TypeMulti, multisigInfo.GetType())
+	}
+
+	multisigPub := multisigInfo.GetPubKey().(multisig.PubKeyMultisigThreshold)
+	multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+
+	for _, sig := range bundle.Signatures {
+		if err := multisigSig.AddSignatureFromPubKey(sig.Signature, sig.PubKey, multisigPub.PubKeys); err != nil {
+			return types.StdTx{}, err
+		}
+	}
+
+	newStdSig := types.StdSignature{Signature: cdc.MustMarshalBinaryBare(multisigSig), PubKey: multisigPub}
+	return types.NewStdTx(bundle.Tx.GetMsgs(), bundle.Tx.Fee, []types.StdSignature{newStdSig}, bundle.Tx.GetMemo()), nil
+}
+
+// readTxBundle reads and decodes the bundle stored in the given file, prompting
+// for the passphrase if the bundle is encrypted.
+func readTxBundle(cmd *cobra.Command, cdc *codec.Codec, filename string) (
+	bundle types.TxBundle, encrypted bool, passphrase string, err error) {
+
+	armorStr, err := utils.ReadTxBundleFromFile(filename)
+	if err != nil {
+		return
+	}
+
+	encrypted, err = utils.IsTxBundleEncrypted(armorStr)
+	if err != nil {
+		return
+	}
+
+	if encrypted {
+		buf := bufio.NewReader(cmd.InOrStdin())
+		passphrase, err = input.GetPassword("Enter the passphrase to decrypt the bundle:", buf)
+		if err != nil {
+			return
+		}
+	}
+
+	bundle, err = utils.UnarmorTxBundle(cdc, armorStr, passphrase)
+	if err != nil {
+		return
+	}
+
+	err = bundle.ValidateBasic()
+	return
+}
+
+func writeTxBundle(cdc *codec.Codec, bundle types.TxBundle, encrypt bool, passphrase string) error {
+	var (
+		armorStr string
+		err      error
+	)
+
+	if encrypt {
+		armorStr, err = utils.EncryptArmorTxBundle(cdc, bundle, passphrase)
+	} else {
+		armorStr, err = utils.ArmorTxBundle(cdc, bundle)
+	}
+	if err != nil {
+		return err
+	}
+
+	return writeOutput([]byte(armorStr))
+}
+
+func writeOutput(bz []byte) error {
+	if viper.GetString(flagOutfile) == "" {
+		fmt.Printf("%s\n", bz)
+		return nil
+	}
+
+	return ioutil.WriteFile(viper.GetString(flagOutfile), append(bz, '\n'), 0644)
+}
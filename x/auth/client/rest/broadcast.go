@@ -1,10 +1,13 @@
 package rest
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -20,6 +23,9 @@ type BroadcastReq struct {
 // broadcasted via a sync|async|block mechanism.
 func BroadcastTxRequest(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		// the broadcast is cancelled along with the request
+		cliCtx := cliCtx.WithContext(r.Context())
+
 		var req BroadcastReq
 
 		body, err := ioutil.ReadAll(r.Body)
@@ -40,6 +46,11 @@ func BroadcastTxRequest(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		if !isValidBroadcastMode(req.Mode) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, invalidBroadcastModeMsg(req.Mode))
+			return
+		}
+
 		cliCtx = cliCtx.WithBroadcastMode(req.Mode)
 
 		res, err := cliCtx.BroadcastTx(txBytes)
@@ -51,3 +62,96 @@ func BroadcastTxRequest(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponseBare(w, cliCtx, res)
 	}
 }
+
+// BroadcastTxsReq defines a request to broadcast an ordered list of txs.
+type BroadcastTxsReq struct {
+	Txs  []types.StdTx `json:"txs" yaml:"txs"`
+	Mode string        `json:"mode" yaml:"mode"`
+}
+
+// BroadcastTxsResponse defines the response to a request to broadcast a list
+// of txs: the results of the txs broadcasted so far, in order, along with the
+// error which interrupted the broadcast, if any.
+type BroadcastTxsResponse struct {
+	Results []sdk.TxResponse `json:"results" yaml:"results"`
+	Error   string           `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// BroadcastTxsRequest implements a handler that broadcasts a list of valid and
+// signed txs to a full node, one after the other and in order. Broadcasting
+// stops at the first tx that fails, and the results of the txs broadcasted so
+// far are returned in a single response, together with the error which
+// interrupted the broadcast if any.
+func BroadcastTxsRequest(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// the broadcasts are cancelled along with the request
+		cliCtx := cliCtx.WithContext(r.Context())
+
+		var req BroadcastTxsReq
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = cliCtx.Codec.UnmarshalJSON(body, &req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if len(req.Txs) == 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "no transactions to broadcast")
+			return
+		}
+
+		if !isValidBroadcastMode(req.Mode) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, invalidBroadcastModeMsg(req.Mode))
+			return
+		}
+
+		txsBytes := make([][]byte, len(req.Txs))
+		for i, tx := range req.Txs {
+			txsBytes[i], err = cliCtx.Codec.MarshalBinaryLengthPrefixed(tx)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+		}
+
+		cliCtx = cliCtx.WithBroadcastMode(req.Mode)
+
+		res, err := cliCtx.BroadcastTxs(txsBytes)
+		if err != nil {
+			// the txs broadcasted before the failure are part of the response, so
+			// that the client knows which ones it has to broadcast again
+			bz, mErr := cliCtx.Codec.MarshalJSON(BroadcastTxsResponse{Results: res, Error: err.Error()})
+			if mErr != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, mErr.Error())
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write(bz)
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, BroadcastTxsResponse{Results: res})
+	}
+}
+
+// isValidBroadcastMode returns true if the given broadcast mode is supported.
+func isValidBroadcastMode(mode string) bool {
+	switch mode {
+	case flags.BroadcastSync, flags.BroadcastAsync, flags.BroadcastBlock:
+		return true
+	default:
+		return false
+	}
+}
+
+func invalidBroadcastModeMsg(mode string) string {
+	return fmt.Sprintf("unsupported broadcast mode %q; supported modes: sync, async, block", mode)
+}
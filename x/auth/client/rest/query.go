@@ -2,6 +2,7 @@ package rest
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"strconv"
 	"strings"
@@ -33,6 +34,12 @@ func QueryAccountRequestHandlerFn(storeName string, cliCtx context.CLIContext) h
 			return
 		}
 
+		pending := r.FormValue("pending") == "true"
+		if pending && cliCtx.Height != 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "pending and height can't be queried together")
+			return
+		}
+
 		accGetter := types.NewAccountRetriever(cliCtx)
 
 		account, height, err := accGetter.GetAccountWithHeight(addr)
@@ -49,11 +56,60 @@ func QueryAccountRequestHandlerFn(storeName string, cliCtx context.CLIContext) h
 			return
 		}
 
+		// the sequence includes the account's txs pending in the mempool
+		if pending {
+			count, err := utils.QueryPendingTxsCount(cliCtx, addr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+
+			if err := account.SetSequence(account.GetSequence() + count); err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+		}
+
 		cliCtx = cliCtx.WithHeight(height)
 		rest.PostProcessResponse(w, cliCtx, account)
 	}
 }
 
+// QuerySignBytesRequestHandlerFn implements a REST handler that returns the
+// canonical bytes the node expects to be signed for the given unsigned
+// transaction, chain-id, account number and sequence.
+func QuerySignBytesRequestHandlerFn(storeName string, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.QuerySignBytesParams
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = cliCtx.Codec.UnmarshalJSON(body, &req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, types.QuerySignBytes), body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // QueryTxsHandlerFn implements a REST handler that searches for transactions.
 // Genesis transactions are returned if the height parameter is set to zero,
 // otherwise the transactions are searched for by events.
@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/crypto/bcrypt"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/armor"
+	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/keyerror"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	blockTypeTxBundle = "COSMOS TX BUNDLE"
+	txBundleVersion   = "1"
+)
+
+// ArmorTxBundle encodes the given bundle into a plaintext armored string.
+func ArmorTxBundle(cdc *codec.Codec, bundle authtypes.TxBundle) (string, error) {
+	bz, err := cdc.MarshalJSON(bundle)
+	if err != nil {
+		return "", err
+	}
+
+	header := map[string]string{
+		"version": txBundleVersion,
+	}
+
+	return armor.EncodeArmor(blockTypeTxBundle, header, bz), nil
+}
+
+// EncryptArmorTxBundle encrypts the given bundle with the passphrase and
+// encodes it into an armored string. The encryption key is derived from the
+// passphrase with bcrypt, as done for private keys.
+func EncryptArmorTxBundle(cdc *codec.Codec, bundle authtypes.TxBundle, passphrase string) (string, error) {
+	bz, err := cdc.MarshalJSON(bundle)
+	if err != nil {
+		return "", err
+	}
+
+	saltBytes := crypto.CRandBytes(16)
+	key, err := bundleEncryptionKey(saltBytes, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	header := map[string]string{
+		"version": txBundleVersion,
+		"kdf":     "bcrypt",
+		"salt":    fmt.Sprintf("%X", saltBytes),
+	}
+
+	return armor.EncodeArmor(blockTypeTxBundle, header, xsalsa20symmetric.EncryptSymmetric(bz, key)), nil
+}
+
+// UnarmorTxBundle decodes an armored bundle. The passphrase is only used if
+// the bundle is encrypted.
+func UnarmorTxBundle(cdc *codec.Codec, armorStr, passphrase string) (bundle authtypes.TxBundle, err error) {
+	blockType, header, bz, err := armor.DecodeArmor(armorStr)
+	if err != nil {
+		return bundle, err
+	}
+
+	if blockType != blockTypeTxBundle {
+		return bundle, fmt.Errorf("unrecognized armor type %q, expected: %q", blockType, blockTypeTxBundle)
+	}
+
+	if header["version"] != txBundleVersion {
+		return bundle, fmt.Errorf("unsupported tx bundle version %q", header["version"])
+	}
+
+	if kdf, ok := header["kdf"]; ok {
+		if kdf != "bcrypt" {
+			return bundle, fmt.Errorf("unrecognized KDF type: %v", kdf)
+		}
+
+		if header["salt"] == "" {
+			return bundle, errors.New("missing salt bytes")
+		}
+
+		saltBytes, err := hex.DecodeString(header["salt"])
+		if err != nil {
+			return bundle, errors.Wrap(err, "error decoding salt")
+		}
+
+		key, err := bundleEncryptionKey(saltBytes, passphrase)
+		if err != nil {
+			return bundle, err
+		}
+
+		bz, err = xsalsa20symmetric.DecryptSymmetric(bz, key)
+		if err != nil {
+			return bundle, keyerror.NewErrWrongPassword()
+		}
+	}
+
+	if err := cdc.UnmarshalJSON(bz, &bundle); err != nil {
+		return bundle, err
+	}
+
+	return bundle, nil
+}
+
+// IsTxBundleEncrypted returns true if the given armored bundle is encrypted.
+func IsTxBundleEncrypted(armorStr string) (bool, error) {
+	_, header, _, err := armor.DecodeArmor(armorStr)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := header["kdf"]
+	return ok, nil
+}
+
+// ReadTxBundleFromFile reads an armored bundle from the given file and returns
+// its raw content.
+func ReadTxBundleFromFile(filename string) (string, error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+func bundleEncryptionKey(saltBytes []byte, passphrase string) ([]byte, error) {
+	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), mintkey.BcryptSecurityParameter)
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating bcrypt key from passphrase")
+	}
+
+	return crypto.Sha256(key), nil // get 32 bytes
+}
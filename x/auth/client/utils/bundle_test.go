@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestArmorTxBundle(t *testing.T) {
+	mintkey.BcryptSecurityParameter = 1
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	authtypes.RegisterCodec(cdc)
+	cdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/Test", nil)
+
+	msg := sdk.NewTestMsg(addr)
+	tx := authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.NewTestStdFee(), nil, "memo")
+	bundle := authtypes.NewTxBundle(tx, "test-chain", []authtypes.BundleSigner{authtypes.NewBundleSigner(addr, 1, 2)})
+
+	signBytes, err := bundle.SignBytes(addr)
+	require.NoError(t, err)
+	sig, err := priv.Sign(signBytes)
+	require.NoError(t, err)
+	bundle, err = bundle.AddSignature(authtypes.StdSignature{PubKey: priv.PubKey(), Signature: sig})
+	require.NoError(t, err)
+
+	// plaintext
+	armorStr, err := ArmorTxBundle(cdc, bundle)
+	require.NoError(t, err)
+	encrypted, err := IsTxBundleEncrypted(armorStr)
+	require.NoError(t, err)
+	require.False(t, encrypted)
+
+	decoded, err := UnarmorTxBundle(cdc, armorStr, "")
+	require.NoError(t, err)
+	requireTxBundleEqual(t, bundle, decoded)
+
+	// encrypted
+	armorStr, err = EncryptArmorTxBundle(cdc, bundle, "passphrase")
+	require.NoError(t, err)
+	encrypted, err = IsTxBundleEncrypted(armorStr)
+	require.NoError(t, err)
+	require.True(t, encrypted)
+
+	_, err = UnarmorTxBundle(cdc, armorStr, "wrong")
+	require.Error(t, err)
+
+	decoded, err = UnarmorTxBundle(cdc, armorStr, "passphrase")
+	require.NoError(t, err)
+	requireTxBundleEqual(t, bundle, decoded)
+
+	// invalid armor
+	_, err = UnarmorTxBundle(cdc, "foo", "")
+	require.Error(t, err)
+}
+
+// requireTxBundleEqual compares bundles field by field as the test message does
+// not survive a JSON round trip.
+func requireTxBundleEqual(t *testing.T, expected, actual authtypes.TxBundle) {
+	require.Equal(t, expected.ChainID, actual.ChainID)
+	require.Equal(t, expected.Signers, actual.Signers)
+	require.Equal(t, expected.Tx.Memo, actual.Tx.Memo)
+	require.True(t, expected.Tx.Fee.Amount.IsEqual(actual.Tx.Fee.Amount))
+	require.Equal(t, expected.Tx.Fee.Gas, actual.Tx.Fee.Gas)
+	require.Equal(t, expected.Signatures, actual.Signatures)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -108,6 +109,49 @@ func QueryTx(cliCtx context.CLIContext, hashHexStr string) (sdk.TxResponse, erro
 	return out, nil
 }
 
+// MaxPendingTxs defines the maximum number of unconfirmed txs returned by the
+// node's mempool, and thus inspected by QueryPendingTxsCount.
+const MaxPendingTxs = 100
+
+// QueryPendingTxsCount returns the number of txs signed by the given address
+// that are still pending in the node's mempool. Added to the on-chain sequence
+// of the account, it gives the next sequence the account can sign a tx with.
+// Only the first MaxPendingTxs unconfirmed txs of the mempool are inspected.
+func QueryPendingTxsCount(cliCtx context.CLIContext, addr sdk.AccAddress) (uint64, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+
+	resTxs, err := node.UnconfirmedTxs(MaxPendingTxs)
+	if err != nil {
+		return 0, err
+	}
+
+	return countPendingTxs(cliCtx.Codec, resTxs.Txs, addr), nil
+}
+
+// countPendingTxs counts the given txs signed by the given address, skipping
+// the ones that can't be decoded.
+func countPendingTxs(cdc *codec.Codec, txs []tmtypes.Tx, addr sdk.AccAddress) uint64 {
+	var count uint64
+	for _, txBytes := range txs {
+		tx, err := parseTx(cdc, txBytes)
+		if err != nil {
+			continue
+		}
+
+		for _, signer := range tx.(types.StdTx).GetSigners() {
+			if signer.Equals(addr) {
+				count++
+				break
+			}
+		}
+	}
+
+	return count
+}
+
 // formatTxResults parses the indexed txs into a slice of TxResponse objects.
 func formatTxResults(cdc *codec.Codec, resTxs []*ctypes.ResultTx, resBlocks map[int64]*ctypes.ResultBlock) ([]sdk.TxResponse, error) {
 	var err error
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// signersMsg is a message with the given signers which, unlike sdk.TestMsg,
+// are kept when the message is encoded.
+type signersMsg struct {
+	Signers []sdk.AccAddress
+}
+
+func (msg signersMsg) Route() string                { return "signers" }
+func (msg signersMsg) Type() string                 { return "signers" }
+func (msg signersMsg) ValidateBasic() sdk.Error     { return nil }
+func (msg signersMsg) GetSignBytes() []byte         { return nil }
+func (msg signersMsg) GetSigners() []sdk.AccAddress { return msg.Signers }
+
+func TestCountPendingTxs(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	authtypes.RegisterCodec(cdc)
+	cdc.RegisterConcrete(signersMsg{}, "cosmos-sdk/Signers", nil)
+
+	_, _, other := authtypes.KeyTestPubAddr()
+
+	encode := func(signers ...sdk.AccAddress) tmtypes.Tx {
+		msg := signersMsg{Signers: signers}
+		tx := authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.NewTestStdFee(), nil, "")
+		return cdc.MustMarshalBinaryLengthPrefixed(tx)
+	}
+
+	txs := []tmtypes.Tx{
+		encode(addr),
+		encode(other),
+		encode(other, addr),
+		encode(addr, addr),
+		[]byte("fuzzy"),
+	}
+
+	require.Equal(t, uint64(3), countPendingTxs(cdc, txs, addr))
+	require.Equal(t, uint64(2), countPendingTxs(cdc, txs, other))
+	require.Equal(t, uint64(0), countPendingTxs(cdc, nil, addr))
+}
@@ -16,6 +16,8 @@ func NewQuerier(keeper AccountKeeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryAccount:
 			return queryAccount(ctx, req, keeper)
+		case types.QuerySignBytes:
+			return querySignBytes(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -40,3 +42,26 @@ func queryAccount(ctx sdk.Context, req abci.RequestQuery, keeper AccountKeeper)
 
 	return bz, nil
 }
+
+func querySignBytes(ctx sdk.Context, req abci.RequestQuery, keeper AccountKeeper) ([]byte, sdk.Error) {
+	var params types.QuerySignBytesParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	chainID := params.ChainID
+	if chainID == "" {
+		chainID = ctx.ChainID()
+	}
+
+	signBytes := types.StdSignBytes(
+		chainID, params.AccountNumber, params.Sequence, params.Tx.Fee, params.Tx.GetMsgs(), params.Tx.GetMemo(),
+	)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, types.NewQuerySignBytesResponse(signBytes))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -8,9 +8,11 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	keep "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 )
 
 func TestQueryAccount(t *testing.T) {
@@ -61,3 +63,43 @@ func TestQueryAccount(t *testing.T) {
 	err2 := cdc.UnmarshalJSON(res, &account)
 	require.Nil(t, err2)
 }
+
+func TestQuerySignBytes(t *testing.T) {
+	app, ctx := createTestApp(true)
+	cdc := app.Codec()
+	ctx = ctx.WithChainID("test-chain")
+
+	path := []string{types.QuerySignBytes}
+	querier := keep.NewQuerier(app.AccountKeeper)
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySignBytes),
+		Data: []byte{},
+	}
+	res, err := querier(ctx, path, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	_, _, addr := types.KeyTestPubAddr()
+	msgs := []sdk.Msg{bank.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))}
+	fee := types.NewTestStdFee()
+	tx := types.NewStdTx(msgs, fee, nil, "memo")
+
+	// explicit chain-id
+	req.Data = cdc.MustMarshalJSON(types.NewQuerySignBytesParams(tx, "other-chain", 3, 7))
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var resp types.QuerySignBytesResponse
+	require.NoError(t, cdc.UnmarshalJSON(res, &resp))
+	expected := types.StdSignBytes("other-chain", 3, 7, fee, msgs, "memo")
+	require.Equal(t, expected, []byte(resp.SignBytes))
+	require.Equal(t, string(expected), resp.SignDoc)
+
+	// defaults to the node's chain-id
+	req.Data = cdc.MustMarshalJSON(types.NewQuerySignBytesParams(tx, "", 3, 7))
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalJSON(res, &resp))
+	require.Equal(t, types.StdSignBytes("test-chain", 3, 7, fee, msgs, "memo"), []byte(resp.SignBytes))
+}
@@ -85,7 +85,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	)
 
 	params := types.NewParams(maxMemoChars, txSigLimit, txSizeCostPerByte,
-		sigVerifyCostED25519, sigVerifyCostSECP256K1)
+		sigVerifyCostED25519, sigVerifyCostSECP256K1, nil)
 	genesisAccs := RandomGenesisAccounts(simState)
 
 	authGenesis := types.NewGenesisState(params, genesisAccs)
@@ -97,7 +97,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 // RandomGenesisAccounts returns randomly generated genesis accounts
 func RandomGenesisAccounts(simState *module.SimulationState) (genesisAccs exported.GenesisAccounts) {
 	for i, acc := range simState.Accounts {
-		coins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(simState.InitialStake))}
+		coins := sdk.Coins{sdk.NewCoin(simState.BondDenom, sdk.NewInt(simState.InitialStake))}
 		bacc := types.NewBaseAccountWithAddress(acc.Address)
 		if err := bacc.SetCoins(coins); err != nil {
 			panic(err)
@@ -119,10 +119,14 @@ func RandomGenesisAccounts(simState *module.SimulationState) (genesisAccs export
 				endTime = int64(simulation.RandIntBetween(simState.Rand, int(startTime)+1, int(startTime+(60*60*12))))
 			}
 
-			if simState.Rand.Intn(100) < 50 {
+			switch simState.Rand.Intn(3) {
+			case 0:
 				gacc = vestingtypes.NewContinuousVestingAccount(&bacc, startTime, endTime)
-			} else {
+			case 1:
 				gacc = vestingtypes.NewDelayedVestingAccount(&bacc, endTime)
+			default:
+				periods := RandomVestingPeriods(simState.Rand, coins, startTime, endTime)
+				gacc = vestingtypes.NewPeriodicVestingAccount(&bacc, startTime, periods)
 			}
 		}
 		genesisAccs = append(genesisAccs, gacc)
@@ -130,3 +134,35 @@ func RandomGenesisAccounts(simState *module.SimulationState) (genesisAccs export
 
 	return genesisAccs
 }
+
+// RandomVestingPeriods splits the vesting of the given coins between the start
+// and end times into a random number of periods of random lengths.
+func RandomVestingPeriods(r *rand.Rand, coins sdk.Coins, startTime, endTime int64) vestingtypes.Periods {
+	duration := endTime - startTime
+	numPeriods := int64(simulation.RandIntBetween(r, 1, 6))
+	if numPeriods > duration {
+		numPeriods = duration
+	}
+
+	periods := make(vestingtypes.Periods, numPeriods)
+	var elapsed int64
+	vesting := coins
+	for i := int64(0); i < numPeriods-1; i++ {
+		// leave at least a second to each of the remaining periods
+		length := int64(simulation.RandIntBetween(r, 1, int(duration-elapsed-(numPeriods-i-1))+1))
+		elapsed += length
+
+		var amount sdk.Coins
+		for _, coin := range coins {
+			amount = append(amount, sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(numPeriods)))
+		}
+		amount = sdk.NewCoins(amount...)
+		vesting = vesting.Sub(amount)
+
+		periods[i] = vestingtypes.Period{Length: length, Amount: amount}
+	}
+
+	// the last period vests the remaining coins
+	periods[numPeriods-1] = vestingtypes.Period{Length: duration - elapsed, Amount: vesting}
+	return periods
+}
@@ -0,0 +1,51 @@
+package simulation
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRandomVestingPeriods(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("token", 7))
+	startTime := int64(1000)
+
+	tests := []struct {
+		name     string
+		duration int64
+	}{
+		{"one second", 1},
+		{"a few seconds", 3},
+		{"half a day", 60 * 60 * 12},
+		{"a month", 60 * 60 * 24 * 30},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			for seed := int64(0); seed < 50; seed++ {
+				endTime := startTime + tt.duration
+				periods := RandomVestingPeriods(rand.New(rand.NewSource(seed)), coins, startTime, endTime)
+				require.NotEmpty(t, periods)
+
+				var length int64
+				amount := sdk.NewCoins()
+				for _, period := range periods {
+					require.True(t, period.Length > 0, "period of %d seconds", period.Length)
+					length += period.Length
+					amount = amount.Add(period.Amount)
+				}
+
+				// the periods vest all the coins by the end time
+				require.Equal(t, tt.duration, length, "seed %d", seed)
+				require.Equal(t, coins, amount, "seed %d", seed)
+
+				// the same seed always generates the same periods
+				require.Equal(t, periods, RandomVestingPeriods(rand.New(rand.NewSource(seed)), coins, startTime, endTime))
+			}
+		})
+	}
+}
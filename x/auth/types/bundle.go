@@ -0,0 +1,203 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BundleSigner holds the account number and sequence a signer of a bundled
+// transaction signs it with.
+type BundleSigner struct {
+	Address       sdk.AccAddress `json:"address" yaml:"address"`
+	AccountNumber uint64         `json:"account_number" yaml:"account_number"`
+	Sequence      uint64         `json:"sequence" yaml:"sequence"`
+}
+
+// NewBundleSigner returns a new BundleSigner.
+func NewBundleSigner(addr sdk.AccAddress, accNum, seq uint64) BundleSigner {
+	return BundleSigner{
+		Address:       addr,
+		AccountNumber: accNum,
+		Sequence:      seq,
+	}
+}
+
+// TxBundle bundles an unsigned transaction together with the metadata required
+// to sign it and the partial signatures collected so far. It allows the signers
+// of a transaction, e.g. the members of a multisig account spread across
+// organizations, to coordinate offline by passing a single document around.
+//
+// As in a StdSignDoc, each signer signs with its own account number and
+// sequence, held by Signers in the order of the transaction signers.
+type TxBundle struct {
+	Tx         StdTx          `json:"tx" yaml:"tx"`
+	ChainID    string         `json:"chain_id" yaml:"chain_id"`
+	Signers    []BundleSigner `json:"signers" yaml:"signers"`
+	Signatures []StdSignature `json:"signatures" yaml:"signatures"`
+}
+
+// NewTxBundle returns a new TxBundle for the given transaction, signed with the
+// account numbers and sequences of the given signers. Any signature already
+// attached to the transaction is dropped.
+func NewTxBundle(tx StdTx, chainID string, signers []BundleSigner) TxBundle {
+	tx.Signatures = nil
+
+	return TxBundle{
+		Tx:         tx,
+		ChainID:    chainID,
+		Signers:    signers,
+		Signatures: []StdSignature{},
+	}
+}
+
+// signer returns the bundle signer the given address signs for. The members
+// of a multisig account don't sign the transaction with their own account, so
+// any address signs for the only signer of a single signer transaction.
+func (b TxBundle) signer(addr sdk.AccAddress) (BundleSigner, error) {
+	for _, s := range b.Signers {
+		if s.Address.Equals(addr) {
+			return s, nil
+		}
+	}
+
+	if len(b.Signers) == 1 {
+		return b.Signers[0], nil
+	}
+
+	return BundleSigner{}, fmt.Errorf("%s is not a signer of the bundled transaction", addr)
+}
+
+// SignBytes returns the canonical bytes the given address must sign, using the
+// account number and sequence of the signer it signs for.
+func (b TxBundle) SignBytes(addr sdk.AccAddress) ([]byte, error) {
+	s, err := b.signer(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return StdSignBytes(b.ChainID, s.AccountNumber, s.Sequence, b.Tx.Fee, b.Tx.Msgs, b.Tx.Memo), nil
+}
+
+// verifySignature checks the given signature against the sign bytes of the
+// signer of its public key.
+func (b TxBundle) verifySignature(sig StdSignature) error {
+	if sig.PubKey == nil {
+		return errors.New("signature has no public key")
+	}
+
+	signBytes, err := b.SignBytes(sdk.AccAddress(sig.PubKey.Address()))
+	if err != nil {
+		return err
+	}
+
+	if !sig.PubKey.VerifyBytes(signBytes, sig.Signature) {
+		return fmt.Errorf("invalid signature for public key %X", sig.PubKey.Bytes())
+	}
+
+	return nil
+}
+
+// AddSignature verifies the given signature against the bundle sign bytes of
+// its signer and returns a copy of the bundle with the signature appended. An
+// error is returned if the signature is invalid or if the bundle already holds
+// a signature for the same public key.
+func (b TxBundle) AddSignature(sig StdSignature) (TxBundle, error) {
+	if err := b.verifySignature(sig); err != nil {
+		return b, err
+	}
+
+	for _, s := range b.Signatures {
+		if s.PubKey.Equals(sig.PubKey) {
+			return b, fmt.Errorf("bundle already contains a signature for public key %X", sig.PubKey.Bytes())
+		}
+	}
+
+	sigs := make([]StdSignature, len(b.Signatures), len(b.Signatures)+1)
+	copy(sigs, b.Signatures)
+	b.Signatures = append(sigs, sig)
+
+	return b, nil
+}
+
+// SignedTx returns the bundled transaction with the collected signatures
+// attached in the order of the transaction signers. An error is returned if a
+// signer has not signed the bundle yet.
+func (b TxBundle) SignedTx() (StdTx, error) {
+	signers := b.Tx.GetSigners()
+	sigs := make([]StdSignature, len(signers))
+
+	for i, signer := range signers {
+		found := false
+		for _, sig := range b.Signatures {
+			if bytes.Equal(sig.PubKey.Address(), signer) {
+				sigs[i] = sig
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return StdTx{}, fmt.Errorf("missing signature from signer %s", signer)
+		}
+	}
+
+	return NewStdTx(b.Tx.Msgs, b.Tx.Fee, sigs, b.Tx.Memo), nil
+}
+
+// ValidateBasic performs a stateless validation of the bundle, checking that
+// it holds the metadata of every transaction signer and verifying all the
+// collected signatures.
+func (b TxBundle) ValidateBasic() error {
+	if b.ChainID == "" {
+		return errors.New("bundle chain-id cannot be empty")
+	}
+
+	if len(b.Tx.Msgs) == 0 {
+		return errors.New("bundle must contain at least one message")
+	}
+
+	signers := b.Tx.GetSigners()
+	if len(b.Signers) != len(signers) {
+		return fmt.Errorf("bundle has the metadata of %d signers, the transaction has %d", len(b.Signers), len(signers))
+	}
+	for i, signer := range signers {
+		if !b.Signers[i].Address.Equals(signer) {
+			return fmt.Errorf("bundle signer #%d is %s, expected %s", i, b.Signers[i].Address, signer)
+		}
+	}
+
+	for i, sig := range b.Signatures {
+		if err := b.verifySignature(sig); err != nil {
+			return fmt.Errorf("invalid signature #%d: %w", i, err)
+		}
+
+		for _, other := range b.Signatures[:i] {
+			if other.PubKey.Equals(sig.PubKey) {
+				return fmt.Errorf("duplicate signature #%d", i)
+			}
+		}
+	}
+
+	return nil
+}
+
+// SignedBy returns the addresses of the keys which signed the bundle so far.
+func (b TxBundle) SignedBy() []sdk.AccAddress {
+	signers := make([]sdk.AccAddress, len(b.Signatures))
+	for i, sig := range b.Signatures {
+		signers[i] = sdk.AccAddress(sig.PubKey.Address())
+	}
+
+	return signers
+}
+
+// String implements the Stringer interface.
+func (b TxBundle) String() string {
+	out, _ := yaml.Marshal(b)
+	return string(out)
+}
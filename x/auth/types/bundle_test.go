@@ -0,0 +1,112 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTxBundle(t *testing.T) {
+	priv1, _, addr1 := KeyTestPubAddr()
+	priv2, _, addr2 := KeyTestPubAddr()
+	_, _, addr3 := KeyTestPubAddr()
+
+	msg := NewTestMsg(addr1, addr2)
+	tx := NewStdTx([]sdk.Msg{msg}, NewTestStdFee(), []StdSignature{{}}, "memo")
+
+	// the signers sign with their own account number and sequence
+	signers := []BundleSigner{NewBundleSigner(addr1, 3, 7), NewBundleSigner(addr2, 5, 1)}
+	bundle := NewTxBundle(tx, "test-chain", signers)
+	require.Nil(t, bundle.Tx.Signatures)
+	require.NoError(t, bundle.ValidateBasic())
+
+	signBytes1, err := bundle.SignBytes(addr1)
+	require.NoError(t, err)
+	require.Equal(t, StdSignBytes("test-chain", 3, 7, tx.Fee, tx.Msgs, tx.Memo), signBytes1)
+	signBytes2, err := bundle.SignBytes(addr2)
+	require.NoError(t, err)
+	require.Equal(t, StdSignBytes("test-chain", 5, 1, tx.Fee, tx.Msgs, tx.Memo), signBytes2)
+	_, err = bundle.SignBytes(addr3)
+	require.Error(t, err)
+
+	// a signature over different bytes is rejected
+	badSig, err := priv1.Sign([]byte("foo"))
+	require.NoError(t, err)
+	_, err = bundle.AddSignature(StdSignature{PubKey: priv1.PubKey(), Signature: badSig})
+	require.Error(t, err)
+
+	// a signature over the sign bytes of another signer is rejected
+	badSig, err = priv1.Sign(signBytes2)
+	require.NoError(t, err)
+	_, err = bundle.AddSignature(StdSignature{PubKey: priv1.PubKey(), Signature: badSig})
+	require.Error(t, err)
+
+	// not all signers signed yet
+	sig1, err := priv1.Sign(signBytes1)
+	require.NoError(t, err)
+	bundle, err = bundle.AddSignature(StdSignature{PubKey: priv1.PubKey(), Signature: sig1})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{addr1}, bundle.SignedBy())
+	_, err = bundle.SignedTx()
+	require.Error(t, err)
+
+	// duplicate signatures are rejected
+	_, err = bundle.AddSignature(StdSignature{PubKey: priv1.PubKey(), Signature: sig1})
+	require.Error(t, err)
+
+	// signatures are ordered as the signers regardless of the signing order
+	sig2, err := priv2.Sign(signBytes2)
+	require.NoError(t, err)
+	bundle, err = bundle.AddSignature(StdSignature{PubKey: priv2.PubKey(), Signature: sig2})
+	require.NoError(t, err)
+	require.NoError(t, bundle.ValidateBasic())
+
+	signedTx, err := bundle.SignedTx()
+	require.NoError(t, err)
+	require.Len(t, signedTx.Signatures, 2)
+	require.Equal(t, priv1.PubKey(), signedTx.Signatures[0].PubKey)
+	require.Equal(t, priv2.PubKey(), signedTx.Signatures[1].PubKey)
+
+	// the signatures verify against the sign doc of each signer, as the ante
+	// handler checks them
+	for i, sig := range signedTx.Signatures {
+		signBytes := StdSignBytes("test-chain", signers[i].AccountNumber, signers[i].Sequence, signedTx.Fee, signedTx.Msgs, signedTx.Memo)
+		require.True(t, sig.PubKey.VerifyBytes(signBytes, sig.Signature))
+	}
+
+	// tampering with the metadata invalidates the collected signatures
+	bundle.Signers = []BundleSigner{NewBundleSigner(addr1, 3, 7), NewBundleSigner(addr2, 5, 2)}
+	require.Error(t, bundle.ValidateBasic())
+
+	// the metadata must match the transaction signers
+	bundle.Signers = []BundleSigner{NewBundleSigner(addr2, 5, 1), NewBundleSigner(addr1, 3, 7)}
+	require.Error(t, bundle.ValidateBasic())
+	bundle.Signers = signers[:1]
+	require.Error(t, bundle.ValidateBasic())
+
+	bundle.Signers = signers
+	bundle.ChainID = ""
+	require.Error(t, bundle.ValidateBasic())
+}
+
+func TestTxBundleMultisig(t *testing.T) {
+	priv1, _, _ := KeyTestPubAddr()
+	_, _, multisigAddr := KeyTestPubAddr()
+
+	msg := NewTestMsg(multisigAddr)
+	tx := NewStdTx([]sdk.Msg{msg}, NewTestStdFee(), nil, "memo")
+	bundle := NewTxBundle(tx, "test-chain", []BundleSigner{NewBundleSigner(multisigAddr, 2, 4)})
+
+	// the members of a multisig account sign with the account of the single signer
+	signBytes, err := bundle.SignBytes(sdk.AccAddress(priv1.PubKey().Address()))
+	require.NoError(t, err)
+	require.Equal(t, StdSignBytes("test-chain", 2, 4, tx.Fee, tx.Msgs, tx.Memo), signBytes)
+
+	sig, err := priv1.Sign(signBytes)
+	require.NoError(t, err)
+	bundle, err = bundle.AddSignature(StdSignature{PubKey: priv1.PubKey(), Signature: sig})
+	require.NoError(t, err)
+	require.NoError(t, bundle.ValidateBasic())
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 )
 
@@ -27,22 +28,24 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyMemoExemptions         = []byte("MemoExemptions")
 )
 
 var _ subspace.ParamSet = &Params{}
 
 // Params defines the parameters for the auth module.
 type Params struct {
-	MaxMemoCharacters      uint64 `json:"max_memo_characters" yaml:"max_memo_characters"`
-	TxSigLimit             uint64 `json:"tx_sig_limit" yaml:"tx_sig_limit"`
-	TxSizeCostPerByte      uint64 `json:"tx_size_cost_per_byte" yaml:"tx_size_cost_per_byte"`
-	SigVerifyCostED25519   uint64 `json:"sig_verify_cost_ed25519" yaml:"sig_verify_cost_ed25519"`
-	SigVerifyCostSecp256k1 uint64 `json:"sig_verify_cost_secp256k1" yaml:"sig_verify_cost_secp256k1"`
+	MaxMemoCharacters      uint64         `json:"max_memo_characters" yaml:"max_memo_characters"`
+	TxSigLimit             uint64         `json:"tx_sig_limit" yaml:"tx_sig_limit"`
+	TxSizeCostPerByte      uint64         `json:"tx_size_cost_per_byte" yaml:"tx_size_cost_per_byte"`
+	SigVerifyCostED25519   uint64         `json:"sig_verify_cost_ed25519" yaml:"sig_verify_cost_ed25519"`
+	SigVerifyCostSecp256k1 uint64         `json:"sig_verify_cost_secp256k1" yaml:"sig_verify_cost_secp256k1"`
+	MemoExemptions         MemoExemptions `json:"memo_exemptions,omitempty" yaml:"memo_exemptions,omitempty"`
 }
 
 // NewParams creates a new Params object
 func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte,
-	sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64) Params {
+	sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64, memoExemptions MemoExemptions) Params {
 
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
@@ -50,9 +53,55 @@ func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte,
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		MemoExemptions:         memoExemptions,
 	}
 }
 
+// MemoExemption overrides the maximum number of memo characters of the
+// transactions whose messages all belong to the given route.
+type MemoExemption struct {
+	Route             string `json:"route" yaml:"route"`
+	MaxMemoCharacters uint64 `json:"max_memo_characters" yaml:"max_memo_characters"`
+}
+
+// NewMemoExemption creates a new MemoExemption object
+func NewMemoExemption(route string, maxMemoCharacters uint64) MemoExemption {
+	return MemoExemption{
+		Route:             route,
+		MaxMemoCharacters: maxMemoCharacters,
+	}
+}
+
+// MemoExemptions defines a list of memo exemptions, one per message route.
+type MemoExemptions []MemoExemption
+
+// MaxMemoCharactersForMsgs returns the maximum number of memo characters of a
+// transaction holding the given messages. Each message is subject to the limit
+// of its route exemption, if any, or to the default MaxMemoCharacters, and the
+// lowest of these limits applies to the whole transaction.
+func (p Params) MaxMemoCharactersForMsgs(msgs []sdk.Msg) uint64 {
+	if len(msgs) == 0 {
+		return p.MaxMemoCharacters
+	}
+
+	var maxMemoCharacters uint64
+	for i, msg := range msgs {
+		limit := p.MaxMemoCharacters
+		for _, exemption := range p.MemoExemptions {
+			if exemption.Route == msg.Route() {
+				limit = exemption.MaxMemoCharacters
+				break
+			}
+		}
+
+		if i == 0 || limit < maxMemoCharacters {
+			maxMemoCharacters = limit
+		}
+	}
+
+	return maxMemoCharacters
+}
+
 // ParamKeyTable for auth module
 func ParamKeyTable() subspace.KeyTable {
 	return subspace.NewKeyTable().RegisterParamSet(&Params{})
@@ -60,14 +109,14 @@ func ParamKeyTable() subspace.KeyTable {
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // pairs of auth module's parameters.
-// nolint
 func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 	return subspace.ParamSetPairs{
-		{KeyMaxMemoCharacters, &p.MaxMemoCharacters},
-		{KeyTxSigLimit, &p.TxSigLimit},
-		{KeyTxSizeCostPerByte, &p.TxSizeCostPerByte},
-		{KeySigVerifyCostED25519, &p.SigVerifyCostED25519},
-		{KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1},
+		subspace.NewParamSetPair(KeyMaxMemoCharacters, &p.MaxMemoCharacters, validateMaxMemoCharacters),
+		subspace.NewParamSetPair(KeyTxSigLimit, &p.TxSigLimit, validateTxSigLimit),
+		subspace.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
+		subspace.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
+		subspace.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		subspace.NewParamSetPair(KeyMemoExemptions, &p.MemoExemptions, validateMemoExemptions),
 	}
 }
 
@@ -98,25 +147,81 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("TxSizeCostPerByte: %d\n", p.TxSizeCostPerByte))
 	sb.WriteString(fmt.Sprintf("SigVerifyCostED25519: %d\n", p.SigVerifyCostED25519))
 	sb.WriteString(fmt.Sprintf("SigVerifyCostSecp256k1: %d\n", p.SigVerifyCostSecp256k1))
+	for _, exemption := range p.MemoExemptions {
+		sb.WriteString(fmt.Sprintf("MemoExemption: %s: %d\n", exemption.Route, exemption.MaxMemoCharacters))
+	}
 	return sb.String()
 }
 
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
-	if p.TxSigLimit == 0 {
-		return fmt.Errorf("invalid tx signature limit: %d", p.TxSigLimit)
+	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
+		return err
+	}
+	if err := validateSigVerifyCostED25519(p.SigVerifyCostED25519); err != nil {
+		return err
+	}
+	if err := validateSigVerifyCostSecp256k1(p.SigVerifyCostSecp256k1); err != nil {
+		return err
+	}
+	if err := validateMaxMemoCharacters(p.MaxMemoCharacters); err != nil {
+		return err
 	}
-	if p.SigVerifyCostED25519 == 0 {
-		return fmt.Errorf("invalid ED25519 signature verification cost: %d", p.SigVerifyCostED25519)
+	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
+		return err
 	}
-	if p.SigVerifyCostSecp256k1 == 0 {
-		return fmt.Errorf("invalid SECK256k1 signature verification cost: %d", p.SigVerifyCostSecp256k1)
+	return validateMemoExemptions(p.MemoExemptions)
+}
+
+func validatePositiveUint64(name string, i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if p.MaxMemoCharacters == 0 {
-		return fmt.Errorf("invalid max memo characters: %d", p.MaxMemoCharacters)
+	if v == 0 {
+		return fmt.Errorf("invalid %s: %d", name, v)
 	}
-	if p.TxSizeCostPerByte == 0 {
-		return fmt.Errorf("invalid tx size cost per byte: %d", p.TxSizeCostPerByte)
+	return nil
+}
+
+func validateMaxMemoCharacters(i interface{}) error {
+	return validatePositiveUint64("max memo characters", i)
+}
+
+func validateTxSigLimit(i interface{}) error {
+	return validatePositiveUint64("tx signature limit", i)
+}
+
+func validateTxSizeCostPerByte(i interface{}) error {
+	return validatePositiveUint64("tx size cost per byte", i)
+}
+
+func validateSigVerifyCostED25519(i interface{}) error {
+	return validatePositiveUint64("ED25519 signature verification cost", i)
+}
+
+func validateSigVerifyCostSecp256k1(i interface{}) error {
+	return validatePositiveUint64("SECK256k1 signature verification cost", i)
+}
+
+func validateMemoExemptions(i interface{}) error {
+	exemptions, ok := i.(MemoExemptions)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	routes := make(map[string]bool, len(exemptions))
+	for _, exemption := range exemptions {
+		if strings.TrimSpace(exemption.Route) == "" {
+			return fmt.Errorf("invalid memo exemption route: %q", exemption.Route)
+		}
+		if routes[exemption.Route] {
+			return fmt.Errorf("duplicate memo exemption for route: %s", exemption.Route)
+		}
+		if exemption.MaxMemoCharacters == 0 {
+			return fmt.Errorf("invalid max memo characters for route %s: %d", exemption.Route, exemption.MaxMemoCharacters)
+		}
+		routes[exemption.Route] = true
 	}
 	return nil
 }
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -14,3 +16,35 @@ func TestParamsEqual(t *testing.T) {
 	p1.TxSigLimit += 10
 	require.NotEqual(t, p1, p2)
 }
+
+func TestParamsValidateMemoExemptions(t *testing.T) {
+	p := DefaultParams()
+	require.NoError(t, p.Validate())
+
+	p.MemoExemptions = MemoExemptions{NewMemoExemption("bank", 512), NewMemoExemption("gov", 1024)}
+	require.NoError(t, p.Validate())
+
+	p.MemoExemptions = MemoExemptions{NewMemoExemption("", 512)}
+	require.Error(t, p.Validate())
+
+	p.MemoExemptions = MemoExemptions{NewMemoExemption("bank", 0)}
+	require.Error(t, p.Validate())
+
+	p.MemoExemptions = MemoExemptions{NewMemoExemption("bank", 512), NewMemoExemption("bank", 1024)}
+	require.Error(t, p.Validate())
+}
+
+func TestMaxMemoCharactersForMsgs(t *testing.T) {
+	_, _, addr := KeyTestPubAddr()
+	msg := NewTestMsg(addr) // route "TestMsg"
+
+	p := DefaultParams()
+	require.Equal(t, DefaultMaxMemoCharacters, p.MaxMemoCharactersForMsgs(nil))
+	require.Equal(t, DefaultMaxMemoCharacters, p.MaxMemoCharactersForMsgs([]sdk.Msg{msg}))
+
+	p.MemoExemptions = MemoExemptions{NewMemoExemption("bank", 100), NewMemoExemption(msg.Route(), 1024)}
+	require.Equal(t, uint64(1024), p.MaxMemoCharactersForMsgs([]sdk.Msg{msg, msg}))
+
+	p.MemoExemptions = MemoExemptions{NewMemoExemption(msg.Route(), 64)}
+	require.Equal(t, uint64(64), p.MaxMemoCharactersForMsgs([]sdk.Msg{msg}))
+}
@@ -1,12 +1,18 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // query endpoints supported by the auth Querier
 const (
-	QueryAccount = "account"
+	QueryAccount   = "account"
+	QuerySignBytes = "sign_bytes"
 )
 
 // QueryAccountParams defines the params for querying accounts.
@@ -18,3 +24,44 @@ type QueryAccountParams struct {
 func NewQueryAccountParams(addr sdk.AccAddress) QueryAccountParams {
 	return QueryAccountParams{Address: addr}
 }
+
+// QuerySignBytesParams defines the params for querying the bytes a signer of
+// the given transaction must sign. If ChainID is empty, the chain-id of the
+// queried node is used.
+type QuerySignBytesParams struct {
+	Tx            StdTx  `json:"tx" yaml:"tx"`
+	ChainID       string `json:"chain_id" yaml:"chain_id"`
+	AccountNumber uint64 `json:"account_number" yaml:"account_number"`
+	Sequence      uint64 `json:"sequence" yaml:"sequence"`
+}
+
+// NewQuerySignBytesParams creates a new instance of QuerySignBytesParams.
+func NewQuerySignBytesParams(tx StdTx, chainID string, accNum, sequence uint64) QuerySignBytesParams {
+	return QuerySignBytesParams{
+		Tx:            tx,
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      sequence,
+	}
+}
+
+// QuerySignBytesResponse defines the response of a sign bytes query. SignDoc
+// is the canonical JSON document that is signed and SignBytes its exact byte
+// representation.
+type QuerySignBytesResponse struct {
+	SignDoc   string       `json:"sign_doc" yaml:"sign_doc"`
+	SignBytes cmn.HexBytes `json:"sign_bytes" yaml:"sign_bytes"`
+}
+
+// NewQuerySignBytesResponse creates a new instance of QuerySignBytesResponse.
+func NewQuerySignBytesResponse(signBytes []byte) QuerySignBytesResponse {
+	return QuerySignBytesResponse{
+		SignDoc:   string(signBytes),
+		SignBytes: signBytes,
+	}
+}
+
+func (res QuerySignBytesResponse) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Sign Doc:   %s
+Sign Bytes: %s`, res.SignDoc, res.SignBytes))
+}
@@ -153,14 +153,23 @@ func (tx StdTx) FeePayer() sdk.AccAddress {
 	return sdk.AccAddress{}
 }
 
+// FeeGranter returns the account paying the fee through a fee allowance granted
+// to the fee payer. It is empty if the fee payer pays the fee itself.
+func (tx StdTx) FeeGranter() sdk.AccAddress { return tx.Fee.FeeAccount }
+
 //__________________________________________________________
 
 // StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
 // which must be above some miminum to be accepted into the mempool.
+//
+// FeeAccount optionally names an account that granted the fee payer a fee
+// allowance and pays the fee on its behalf. It is omitted from the sign bytes
+// when empty.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount" yaml:"amount"`
-	Gas    uint64    `json:"gas" yaml:"gas"`
+	Amount     sdk.Coins      `json:"amount" yaml:"amount"`
+	Gas        uint64         `json:"gas" yaml:"gas"`
+	FeeAccount sdk.AccAddress `json:"fee_account,omitempty" yaml:"fee_account,omitempty"`
 }
 
 // NewStdFee returns a new instance of StdFee
@@ -12,6 +12,7 @@ import (
 	yaml "gopkg.in/yaml.v2"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -58,6 +59,14 @@ func TestStdSignBytes(t *testing.T) {
 	}
 }
 
+func TestOffChainSignBytes(t *testing.T) {
+	// off-chain sign bytes must be a StdSignDoc that no chain would accept
+	data := []byte("hello")
+	msgs := []sdk.Msg{sdkcrypto.NewMsgSignData(addr, data)}
+	want := StdSignBytes("", 0, 0, NewStdFee(0, nil), msgs, "")
+	require.Equal(t, string(want), string(sdkcrypto.OffChainSignBytes(addr, data)))
+}
+
 func TestTxValidateBasic(t *testing.T) {
 	ctx := sdk.NewContext(nil, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
 
@@ -26,6 +26,7 @@ type TxBuilder struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feeAccount         sdk.AccAddress
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -70,6 +71,15 @@ func NewTxBuilderFromCLI() TxBuilder {
 	txbldr = txbldr.WithFees(viper.GetString(flags.FlagFees))
 	txbldr = txbldr.WithGasPrices(viper.GetString(flags.FlagGasPrices))
 
+	if feeAccount := viper.GetString(flags.FlagFeeAccount); feeAccount != "" {
+		addr, err := sdk.AccAddressFromBech32(feeAccount)
+		if err != nil {
+			panic(err)
+		}
+
+		txbldr = txbldr.WithFeeAccount(addr)
+	}
+
 	return txbldr
 }
 
@@ -107,6 +117,9 @@ func (bldr TxBuilder) Fees() sdk.Coins { return bldr.fees }
 // GasPrices returns the gas prices set for the transaction, if any.
 func (bldr TxBuilder) GasPrices() sdk.DecCoins { return bldr.gasPrices }
 
+// FeeAccount returns the account paying the fees through a fee allowance, if any.
+func (bldr TxBuilder) FeeAccount() sdk.AccAddress { return bldr.feeAccount }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -147,6 +160,12 @@ func (bldr TxBuilder) WithGasPrices(gasPrices string) TxBuilder {
 	return bldr
 }
 
+// WithFeeAccount returns a copy of the context with an updated fee account.
+func (bldr TxBuilder) WithFeeAccount(feeAccount sdk.AccAddress) TxBuilder {
+	bldr.feeAccount = feeAccount
+	return bldr
+}
+
 // WithKeybase returns a copy of the context with updated keybase.
 func (bldr TxBuilder) WithKeybase(keybase crkeys.Keybase) TxBuilder {
 	bldr.keybase = keybase
@@ -196,13 +215,16 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		}
 	}
 
+	fee := NewStdFee(bldr.gas, fees)
+	fee.FeeAccount = bldr.feeAccount
+
 	return StdSignMsg{
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
 		Sequence:      bldr.sequence,
 		Memo:          bldr.memo,
 		Msgs:          msgs,
-		Fee:           NewStdFee(bldr.gas, fees),
+		Fee:           fee,
 	}, nil
 }
 
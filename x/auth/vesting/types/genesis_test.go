@@ -42,3 +42,33 @@ func TestValidateGenesisInvalidAccounts(t *testing.T) {
 	genAccs[0] = NewContinuousVestingAccountRaw(baseVestingAcc, 1548888000)
 	require.Error(t, authtypes.ValidateGenAccounts(genAccs))
 }
+
+// require periodic vesting accounts load from and validate in genesis
+func TestGenesisPeriodicVestingAccount(t *testing.T) {
+	acc := authtypes.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
+	acc.Coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150))
+	periods := Periods{
+		Period{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))},
+		Period{Length: 50, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))},
+	}
+	pva := NewPeriodicVestingAccount(&acc, 1548775410, periods)
+
+	genState := authtypes.NewGenesisState(authtypes.DefaultParams(), exported.GenesisAccounts{pva})
+	bz, err := authtypes.ModuleCdc.MarshalJSON(genState)
+	require.NoError(t, err)
+
+	var loaded authtypes.GenesisState
+	require.NoError(t, authtypes.ModuleCdc.UnmarshalJSON(bz, &loaded))
+	require.Len(t, loaded.Accounts, 1)
+	loadedPva, ok := loaded.Accounts[0].(*PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, pva.String(), loadedPva.String())
+	require.NoError(t, authtypes.ValidateGenesis(loaded))
+
+	// vesting period with a non positive length
+	invalid := NewPeriodicVestingAccount(&acc, 1548775410, Periods{
+		Period{Length: 200, Amount: periods[0].Amount},
+		Period{Length: -50, Amount: periods[1].Amount},
+	})
+	require.Error(t, authtypes.ValidateGenAccounts(exported.GenesisAccounts{invalid}))
+}
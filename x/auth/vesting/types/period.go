@@ -25,8 +25,8 @@ func (p Period) String() string {
 // String Periods implements stringer interface
 func (vp Periods) String() string {
 	periodsListString := make([]string, len(vp))
-	for _, period := range vp {
-		periodsListString = append(periodsListString, period.String())
+	for i, period := range vp {
+		periodsListString[i] = period.String()
 	}
 	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
 		%s`, strings.Join(periodsListString, ", ")))
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -587,7 +588,13 @@ func (pva PeriodicVestingAccount) Validate() error {
 	}
 	endTime := pva.StartTime
 	originalVesting := sdk.NewCoins()
-	for _, p := range pva.VestingPeriods {
+	for i, p := range pva.VestingPeriods {
+		if p.Length <= 0 {
+			return fmt.Errorf("vesting period %d must have a positive length", i)
+		}
+		if !p.Amount.IsValid() {
+			return fmt.Errorf("vesting period %d has invalid amount %s", i, p.Amount)
+		}
 		endTime += p.Length
 		originalVesting = originalVesting.Add(p.Amount)
 	}
@@ -0,0 +1,72 @@
+package authz
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// nolint
+
+const (
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	QueryAuthorization           = types.QueryAuthorization
+	QueryAuthorizations          = types.QueryAuthorizations
+	DefaultCodespace             = types.DefaultCodespace
+	CodeNoAuthorization          = types.CodeNoAuthorization
+	CodeAuthorizationExpired     = types.CodeAuthorizationExpired
+	CodeInvalidExpiration        = types.CodeInvalidExpiration
+	CodeInvalidAuthorization     = types.CodeInvalidAuthorization
+	TypeMsgGrantAuthorization    = types.TypeMsgGrantAuthorization
+	TypeMsgRevokeAuthorization   = types.TypeMsgRevokeAuthorization
+	TypeMsgExecAuthorized        = types.TypeMsgExecAuthorized
+	EventTypeGrantAuthorization  = types.EventTypeGrantAuthorization
+	EventTypeRevokeAuthorization = types.EventTypeRevokeAuthorization
+	EventTypeExecAuthorization   = types.EventTypeExecAuthorization
+	AttributeValueCategory       = types.AttributeValueCategory
+	AttributeKeyGranter          = types.AttributeKeyGranter
+	AttributeKeyGrantee          = types.AttributeKeyGrantee
+	AttributeKeyMsgType          = types.AttributeKeyMsgType
+)
+
+var (
+	NewKeeper  = keeper.NewKeeper
+	NewQuerier = keeper.NewQuerier
+
+	NewGenericAuthorization      = types.NewGenericAuthorization
+	NewDelegateAuthorization     = types.NewDelegateAuthorization
+	NewAuthorizationGrant        = types.NewAuthorizationGrant
+	NewMsgGrantAuthorization     = types.NewMsgGrantAuthorization
+	NewMsgRevokeAuthorization    = types.NewMsgRevokeAuthorization
+	NewMsgExecAuthorized         = types.NewMsgExecAuthorized
+	NewQueryAuthorizationParams  = types.NewQueryAuthorizationParams
+	NewQueryAuthorizationsParams = types.NewQueryAuthorizationsParams
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	RegisterCodec                = types.RegisterCodec
+	ModuleCdc                    = types.ModuleCdc
+	MsgType                      = types.MsgType
+	ErrNoAuthorization           = types.ErrNoAuthorization
+	ErrAuthorizationExpired      = types.ErrAuthorizationExpired
+	ErrInvalidExpiration         = types.ErrInvalidExpiration
+	ErrInvalidAuthorization      = types.ErrInvalidAuthorization
+	AuthorizationKey             = types.AuthorizationKey
+	AuthorizationsPrefix         = types.AuthorizationsPrefix
+	AuthorizationKeyPrefix       = types.AuthorizationKeyPrefix
+)
+
+type (
+	Keeper = keeper.Keeper
+
+	GenericAuthorization      = types.GenericAuthorization
+	DelegateAuthorization     = types.DelegateAuthorization
+	AuthorizationGrant        = types.AuthorizationGrant
+	MsgGrantAuthorization     = types.MsgGrantAuthorization
+	MsgRevokeAuthorization    = types.MsgRevokeAuthorization
+	MsgExecAuthorized         = types.MsgExecAuthorized
+	QueryAuthorizationParams  = types.QueryAuthorizationParams
+	QueryAuthorizationsParams = types.QueryAuthorizationsParams
+	GenesisState              = types.GenesisState
+)
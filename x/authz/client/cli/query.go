@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// GetQueryCmd returns the query commands for the authz module.
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	authzQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzQueryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryAuthorization(queryRoute, cdc),
+		GetCmdQueryAuthorizations(queryRoute, cdc),
+	)...)
+
+	return authzQueryCmd
+}
+
+// GetCmdQueryAuthorization returns the command to query the authorization of
+// a message type from a granter to a grantee.
+func GetCmdQueryAuthorization(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorization [granter] [grantee] [msg-type]",
+		Short: "Query the authorization of a message type from a granter to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the authorization of a message type from a granter to a grantee.
+
+Example:
+$ %s query %s authorization cosmos1h9z... cosmos1skjw... bank/send
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAuthorizationParams(granter, grantee, args[2]))
+			if err != nil {
+				return fmt.Errorf("failed to marshal query params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAuthorization)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grant types.AuthorizationGrant
+			if err := cdc.UnmarshalJSON(res, &grant); err != nil {
+				return fmt.Errorf("failed to unmarshal authorization: %w", err)
+			}
+
+			return cliCtx.PrintOutput(grant)
+		},
+	}
+}
+
+// GetCmdQueryAuthorizations returns the command to query all authorizations
+// from a granter to a grantee.
+func GetCmdQueryAuthorizations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorizations [granter] [grantee]",
+		Short: "Query all authorizations from a granter to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all authorizations from a granter to a grantee.
+
+Example:
+$ %s query %s authorizations cosmos1h9z... cosmos1skjw...
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAuthorizationsParams(granter, grantee))
+			if err != nil {
+				return fmt.Errorf("failed to marshal query params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAuthorizations)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grants []types.AuthorizationGrant
+			if err := cdc.UnmarshalJSON(res, &grants); err != nil {
+				return fmt.Errorf("failed to unmarshal authorizations: %w", err)
+			}
+
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+}
@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+const (
+	flagSpendLimit = "spend-limit"
+	flagExpiration = "expiration"
+)
+
+// GetTxCmd returns the transaction commands for the authz module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	authzTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzTxCmd.AddCommand(client.PostCommands(
+		GetCmdGrantAuthorization(cdc),
+		GetCmdRevokeAuthorization(cdc),
+		GetCmdExecAuthorized(cdc),
+	)...)
+
+	return authzTxCmd
+}
+
+// GetCmdGrantAuthorization returns the command to grant an authorization to a
+// grantee.
+func GetCmdGrantAuthorization(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [msg-type]",
+		Short: "Grant an address the authorization to execute messages on your behalf",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an address the authorization to execute messages of the given
+type, formatted as <route>/<type>, on behalf of the --from account. A spend
+limit may only be given for staking/delegate messages and caps the total amount
+delegated by the grantee. The authorization may expire at a block time.
+
+Example:
+$ %s tx %s grant cosmos1skjw... bank/send --expiration=2021-01-01T00:00:00Z --from=mykey
+$ %s tx %s grant cosmos1skjw... staking/delegate --spend-limit=1000stake --from=mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			authorization, err := authorizationFromFlags(args[1])
+			if err != nil {
+				return err
+			}
+
+			var expiration time.Time
+			if exp := viper.GetString(flagExpiration); exp != "" {
+				expiration, err = time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return fmt.Errorf("invalid expiration time: %w", err)
+				}
+			}
+
+			msg := types.NewMsgGrantAuthorization(cliCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagSpendLimit, "", "Total amount the grantee may delegate, for staking/delegate authorizations")
+	cmd.Flags().String(flagExpiration, "", "RFC3339 block time at which the authorization expires")
+
+	return cmd
+}
+
+// GetCmdRevokeAuthorization returns the command to revoke an authorization
+// from a grantee.
+func GetCmdRevokeAuthorization(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee] [msg-type]",
+		Short: "Revoke the authorization of a message type granted to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization to execute messages of the given type granted to
+an address by the --from account.
+
+Example:
+$ %s tx %s revoke cosmos1skjw... bank/send --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAuthorization(cliCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdExecAuthorized returns the command to execute the messages of an
+// unsigned transaction on behalf of their signers.
+func GetCmdExecAuthorized(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exec [tx-json-file]",
+		Short: "Execute messages on behalf of the accounts that authorized you",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of a transaction generated with --generate-only on
+behalf of their signers, using the authorizations they granted to the --from
+account.
+
+Example:
+$ %s tx %s exec tx.json --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExecAuthorized(cliCtx.GetFromAddress(), stdTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// authorizationFromFlags builds a delegate authorization if a spend limit is
+// given, or a generic authorization for msgType otherwise.
+func authorizationFromFlags(msgType string) (exported.Authorization, error) {
+	limit := viper.GetString(flagSpendLimit)
+	if limit == "" {
+		return types.NewGenericAuthorization(msgType), nil
+	}
+
+	authorization := types.NewDelegateAuthorization(nil)
+	if msgType != authorization.MsgType() {
+		return nil, fmt.Errorf("--%s is only supported for %s authorizations", flagSpendLimit, authorization.MsgType())
+	}
+
+	spendLimit, err := sdk.ParseCoins(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewDelegateAuthorization(spendLimit), nil
+}
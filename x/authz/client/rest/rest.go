@@ -0,0 +1,20 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/gorilla/mux"
+)
+
+// REST query and parameter values
+const (
+	RestParamGranter = "granter"
+	RestParamGrantee = "grantee"
+
+	MethodGet = "GET"
+)
+
+// RegisterRoutes registers the authz module's REST service handlers.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
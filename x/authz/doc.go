@@ -0,0 +1,32 @@
+/*
+Package authz provides functionality for one account (the granter) to authorize
+another account (the grantee) to execute messages on its behalf.
+
+A granter gives a grantee an authorization for a single message type with
+MsgGrantAuthorization, optionally expiring at a block time, and may take it
+back at any time with MsgRevokeAuthorization. Message types are formatted as
+"<route>/<type>", e.g. "bank/send" or "staking/delegate".
+
+Authorizations
+
+Two authorization types are supported:
+
+	- GenericAuthorization lets the grantee execute any message of the given
+	type on behalf of the granter.
+	- DelegateAuthorization lets the grantee delegate up to SpendLimit of the
+	granter's tokens in total. It is removed once the limit is used up.
+
+Executing Messages
+
+The grantee wraps the messages to execute in a MsgExecAuthorized, which only
+the grantee signs. Each wrapped message must have a single signer: messages
+signed by the grantee itself are executed as usual, others are only executed
+if their signer granted the grantee an unexpired authorization accepting them.
+The messages are dispatched through the application's router, so the authz
+keeper must be given the router of the BaseApp:
+
+	app.AuthzKeeper = authz.NewKeeper(
+		app.cdc, keys[authz.StoreKey], app.Router(), authz.DefaultCodespace,
+	)
+*/
+package authz
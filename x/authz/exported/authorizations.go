@@ -0,0 +1,21 @@
+package exported
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authorization represents the permission given by a granter to a grantee to
+// execute messages of a single type on its behalf.
+type Authorization interface {
+	// MsgType returns the type of the messages the authorization allows to
+	// execute, formatted as "<route>/<type>".
+	MsgType() string
+
+	// Accept determines whether the grantee may execute msg. On success it
+	// returns the updated authorization to store, or remove set to true if
+	// the authorization is used up and must be deleted.
+	Accept(msg sdk.Msg) (updated Authorization, remove bool, err error)
+
+	// ValidateBasic performs stateless validation of the authorization.
+	ValidateBasic() error
+}
@@ -0,0 +1,29 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the authz module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	for _, grant := range gs.Authorizations {
+		k.Grant(ctx, grant)
+	}
+}
+
+// ExportGenesis returns the authz module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	grants := k.GetAllAuthorizationGrants(ctx)
+	if grants == nil {
+		grants = []AuthorizationGrant{}
+	}
+
+	return NewGenesisState(grants)
+}
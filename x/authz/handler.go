@@ -0,0 +1,79 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgGrantAuthorization:
+			return handleGrantAuthorization(ctx, k, msg)
+
+		case MsgRevokeAuthorization:
+			return handleRevokeAuthorization(ctx, k, msg)
+
+		case MsgExecAuthorized:
+			return handleExecAuthorized(ctx, k, msg)
+
+		default:
+			return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)).Result()
+		}
+	}
+}
+
+func handleGrantAuthorization(ctx sdk.Context, k Keeper, msg MsgGrantAuthorization) sdk.Result {
+	grant := NewAuthorizationGrant(msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration)
+	if grant.IsExpired(ctx.BlockTime()) {
+		return sdk.ConvertError(ErrInvalidExpiration(DefaultCodespace, "expiration is in the past")).Result()
+	}
+
+	k.Grant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleRevokeAuthorization(ctx sdk.Context, k Keeper, msg MsgRevokeAuthorization) sdk.Result {
+	if err := k.Revoke(ctx, msg.Granter, msg.Grantee, msg.AuthorizationMsgType); err != nil {
+		return sdk.ConvertError(err).Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleExecAuthorized(ctx sdk.Context, k Keeper, msg MsgExecAuthorized) sdk.Result {
+	res := k.DispatchActions(ctx, msg.Grantee, msg.Msgs)
+	if !res.IsOK() {
+		return res
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Grantee.String()),
+		),
+	)
+
+	return sdk.Result{Events: res.Events.AppendEvents(ctx.EventManager().Events())}
+}
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// Keeper manages the authorizations granted between accounts and executes
+// messages on behalf of their granters. It must have a codec with all
+// available authorizations registered.
+type Keeper struct {
+	cdc       *codec.Codec
+	storeKey  sdk.StoreKey
+	router    sdk.Router
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates an authz Keeper. The router is used to dispatch the
+// messages executed on behalf of a granter.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router sdk.Router, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		router:    router,
+		codespace: codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Grant stores a new authorization grant, overwriting any existing grant of
+// the same message type from the same granter to the same grantee.
+func (k Keeper) Grant(ctx sdk.Context, grant types.AuthorizationGrant) {
+	k.setAuthorizationGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, grant.Authorization.MsgType()),
+		),
+	)
+}
+
+// Revoke removes the authorization of the given message type from granter to
+// grantee. It returns an error if there is no such authorization.
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) error {
+	if _, found := k.GetAuthorizationGrant(ctx, granter, grantee, msgType); !found {
+		return types.ErrNoAuthorization(k.codespace, granter, grantee, msgType)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AuthorizationKey(granter, grantee, msgType))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthorization returns the unexpired authorization of the given message
+// type from granter to grantee. If there is none, it returns nil.
+func (k Keeper) GetAuthorization(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string,
+) exported.Authorization {
+
+	grant, found := k.GetAuthorizationGrant(ctx, granter, grantee, msgType)
+	if !found || grant.IsExpired(ctx.BlockTime()) {
+		return nil
+	}
+
+	return grant.Authorization
+}
+
+// GetAuthorizationGrant returns the entire AuthorizationGrant of the given
+// message type from granter to grantee, whether it has expired or not.
+func (k Keeper) GetAuthorizationGrant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string,
+) (types.AuthorizationGrant, bool) {
+
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.AuthorizationKey(granter, grantee, msgType))
+	if len(bz) == 0 {
+		return types.AuthorizationGrant{}, false
+	}
+
+	var grant types.AuthorizationGrant
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+
+	return grant, true
+}
+
+// GetAuthorizationGrants returns all the grants from granter to grantee.
+func (k Keeper) GetAuthorizationGrants(ctx sdk.Context, granter, grantee sdk.AccAddress) (grants []types.AuthorizationGrant) {
+	k.iterateAuthorizationGrants(ctx, types.AuthorizationsPrefix(granter, grantee), func(grant types.AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+
+	return grants
+}
+
+// IterateAllAuthorizationGrants iterates over all the grants in the store.
+// Callback to get all data, returns true to stop, false to keep reading
+// Calling this without pagination is very expensive and only designed for export genesis
+func (k Keeper) IterateAllAuthorizationGrants(ctx sdk.Context, cb func(types.AuthorizationGrant) bool) {
+	k.iterateAuthorizationGrants(ctx, types.AuthorizationKeyPrefix, cb)
+}
+
+// GetAllAuthorizationGrants returns all the grants in the store.
+func (k Keeper) GetAllAuthorizationGrants(ctx sdk.Context) (grants []types.AuthorizationGrant) {
+	k.IterateAllAuthorizationGrants(ctx, func(grant types.AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+
+	return grants
+}
+
+// DispatchActions executes msgs on behalf of their signers. A message signed by
+// someone other than the grantee is only executed if its signer granted the
+// grantee an unexpired authorization accepting it. The authorization is then
+// updated, or removed once it is used up.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) sdk.Result {
+	var events sdk.Events
+
+	for _, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return sdk.ErrUnauthorized("executed messages must have exactly one signer").Result()
+		}
+
+		granter := signers[0]
+		if !granter.Equals(grantee) {
+			if err := k.useAuthorization(ctx, granter, grantee, msg); err != nil {
+				return sdk.ConvertError(err).Result()
+			}
+		}
+
+		handler := k.router.Route(msg.Route())
+		if handler == nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized message route: %s", msg.Route())).Result()
+		}
+
+		res := handler(ctx, msg)
+		if !res.IsOK() {
+			return res
+		}
+
+		events = events.AppendEvents(res.Events)
+	}
+
+	return sdk.Result{Events: events}
+}
+
+func (k Keeper) useAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) error {
+	msgType := types.MsgType(msg)
+
+	grant, found := k.GetAuthorizationGrant(ctx, granter, grantee, msgType)
+	if !found {
+		return types.ErrNoAuthorization(k.codespace, granter, grantee, msgType)
+	}
+	if grant.IsExpired(ctx.BlockTime()) {
+		return types.ErrAuthorizationExpired(k.codespace)
+	}
+
+	updated, remove, err := grant.Authorization.Accept(msg)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+
+	if remove {
+		return k.Revoke(ctx, granter, grantee, msgType)
+	}
+
+	grant.Authorization = updated
+	k.setAuthorizationGrant(ctx, grant)
+	return nil
+}
+
+func (k Keeper) iterateAuthorizationGrants(ctx sdk.Context, prefix []byte, cb func(types.AuthorizationGrant) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setAuthorizationGrant(ctx sdk.Context, grant types.AuthorizationGrant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(grant)
+	store.Set(types.AuthorizationKey(grant.Granter, grant.Grantee, grant.Authorization.MsgType()), bz)
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx     sdk.Context
+	querier sdk.Querier
+	keeper  keeper.Keeper
+	app     *simapp.SimApp
+
+	addr  sdk.AccAddress
+	addr2 sdk.AccAddress
+	addr3 sdk.AccAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = app.BaseApp.NewContext(checkTx, abci.Header{Height: 1, Time: now})
+	suite.keeper = app.AuthzKeeper
+	suite.querier = keeper.NewQuerier(app.AuthzKeeper)
+	suite.app = app
+
+	suite.addr = sdk.AccAddress([]byte("addr1_______________"))
+	suite.addr2 = sdk.AccAddress([]byte("addr2_______________"))
+	suite.addr3 = sdk.AccAddress([]byte("addr3_______________"))
+}
+
+func (suite *KeeperTestSuite) TestKeeperCrud() {
+	ctx := suite.ctx
+	k := suite.keeper
+
+	send := types.NewGenericAuthorization("bank/send")
+	delegate := types.NewDelegateAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 555)))
+	expiration := ctx.BlockTime().Add(time.Hour)
+
+	k.Grant(ctx, types.NewAuthorizationGrant(suite.addr, suite.addr2, send, expiration))
+	k.Grant(ctx, types.NewAuthorizationGrant(suite.addr, suite.addr2, delegate, time.Time{}))
+	k.Grant(ctx, types.NewAuthorizationGrant(suite.addr, suite.addr3, send, expiration))
+	k.Grant(ctx, types.NewAuthorizationGrant(suite.addr2, suite.addr, delegate, expiration))
+
+	// revoke one, fail to revoke a missing one
+	suite.NoError(k.Revoke(ctx, suite.addr, suite.addr3, send.MsgType()))
+	suite.Error(k.Revoke(ctx, suite.addr, suite.addr3, send.MsgType()))
+	suite.Error(k.Revoke(ctx, suite.addr2, suite.addr, send.MsgType()))
+
+	// end state:
+	// addr -> addr2 (send, delegate)
+	// addr2 -> addr (delegate)
+	suite.Equal(send, k.GetAuthorization(ctx, suite.addr, suite.addr2, send.MsgType()))
+	suite.Equal(delegate, k.GetAuthorization(ctx, suite.addr, suite.addr2, delegate.MsgType()))
+	suite.Nil(k.GetAuthorization(ctx, suite.addr, suite.addr3, send.MsgType()))
+	suite.Nil(k.GetAuthorization(ctx, suite.addr2, suite.addr, send.MsgType()))
+	suite.Equal(delegate, k.GetAuthorization(ctx, suite.addr2, suite.addr, delegate.MsgType()))
+
+	suite.Len(k.GetAuthorizationGrants(ctx, suite.addr, suite.addr2), 2)
+	suite.Len(k.GetAuthorizationGrants(ctx, suite.addr, suite.addr3), 0)
+	suite.Len(k.GetAllAuthorizationGrants(ctx), 3)
+
+	// expired authorizations are not returned, but remain in the store
+	later := ctx.WithBlockTime(expiration)
+	suite.Nil(k.GetAuthorization(later, suite.addr, suite.addr2, send.MsgType()))
+	suite.Equal(delegate, k.GetAuthorization(later, suite.addr, suite.addr2, delegate.MsgType()))
+	_, found := k.GetAuthorizationGrant(later, suite.addr, suite.addr2, send.MsgType())
+	suite.True(found)
+}
+
+func (suite *KeeperTestSuite) TestDispatchActions() {
+	ctx := suite.ctx
+	k := suite.keeper
+	ak := suite.app.AccountKeeper
+
+	granter := ak.NewAccountWithAddress(ctx, suite.addr)
+	suite.NoError(granter.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 100))))
+	ak.SetAccount(ctx, granter)
+
+	grantee := ak.NewAccountWithAddress(ctx, suite.addr2)
+	suite.NoError(grantee.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 10))))
+	ak.SetAccount(ctx, grantee)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 30))
+	sendFromGranter := bank.NewMsgSend(suite.addr, suite.addr3, coins)
+	sendFromGrantee := bank.NewMsgSend(suite.addr2, suite.addr3, sdk.NewCoins(sdk.NewInt64Coin("atom", 5)))
+
+	// no authorization
+	res := k.DispatchActions(ctx, suite.addr2, []sdk.Msg{sendFromGranter})
+	suite.False(res.IsOK())
+
+	// messages signed by the grantee need no authorization
+	res = k.DispatchActions(ctx, suite.addr2, []sdk.Msg{sendFromGrantee})
+	suite.True(res.IsOK(), res.Log)
+
+	expiration := ctx.BlockTime().Add(time.Hour)
+	k.Grant(ctx, types.NewAuthorizationGrant(suite.addr, suite.addr2, types.NewGenericAuthorization("bank/send"), expiration))
+
+	res = k.DispatchActions(ctx, suite.addr2, []sdk.Msg{sendFromGranter, sendFromGranter})
+	suite.True(res.IsOK(), res.Log)
+	suite.Equal(int64(40), suite.app.BankKeeper.GetCoins(ctx, suite.addr).AmountOf("atom").Int64())
+	suite.Equal(int64(65), suite.app.BankKeeper.GetCoins(ctx, suite.addr3).AmountOf("atom").Int64())
+
+	// a generic authorization is not used up
+	suite.NotNil(k.GetAuthorization(ctx, suite.addr, suite.addr2, "bank/send"))
+
+	// the authorization only applies to its grantee
+	res = k.DispatchActions(ctx, suite.addr3, []sdk.Msg{sendFromGranter})
+	suite.False(res.IsOK())
+
+	// failing messages fail the dispatch
+	res = k.DispatchActions(ctx, suite.addr2, []sdk.Msg{bank.NewMsgSend(suite.addr, suite.addr3, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))})
+	suite.False(res.IsOK())
+
+	// expired authorizations can't be used
+	res = k.DispatchActions(ctx.WithBlockTime(expiration), suite.addr2, []sdk.Msg{sendFromGranter})
+	suite.False(res.IsOK())
+	suite.Equal(types.CodeAuthorizationExpired, res.Code)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryAuthorization() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	cdc := suite.app.Codec()
+
+	authorization := types.NewGenericAuthorization("bank/send")
+	grant := types.NewAuthorizationGrant(suite.addr, suite.addr2, authorization, time.Time{})
+	suite.keeper.Grant(ctx, grant)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", types.QuerierRoute, types.QueryAuthorization}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryAuthorizationParams(suite.addr, suite.addr2, "bank/send")),
+	}
+
+	bz, err := suite.querier(ctx, []string{types.QueryAuthorization}, query)
+	suite.Nil(err)
+	suite.NotNil(bz)
+
+	var res types.AuthorizationGrant
+	suite.Nil(cdc.UnmarshalJSON(bz, &res))
+	suite.Equal(grant, res)
+
+	// no authorization the other way around
+	query.Data = cdc.MustMarshalJSON(types.NewQueryAuthorizationParams(suite.addr2, suite.addr, "bank/send"))
+	bz, err = suite.querier(ctx, []string{types.QueryAuthorization}, query)
+	suite.NotNil(err)
+	suite.Nil(bz)
+}
+
+func (suite *KeeperTestSuite) TestQueryAuthorizations() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	cdc := suite.app.Codec()
+
+	delegate := types.NewDelegateAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 555)))
+	suite.keeper.Grant(ctx, types.NewAuthorizationGrant(suite.addr, suite.addr2, types.NewGenericAuthorization("bank/send"), time.Time{}))
+	suite.keeper.Grant(ctx, types.NewAuthorizationGrant(suite.addr, suite.addr2, delegate, time.Time{}))
+	suite.keeper.Grant(ctx, types.NewAuthorizationGrant(suite.addr, suite.addr3, delegate, time.Time{}))
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", types.QuerierRoute, types.QueryAuthorizations}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryAuthorizationsParams(suite.addr, suite.addr2)),
+	}
+
+	bz, err := suite.querier(ctx, []string{types.QueryAuthorizations}, query)
+	suite.Nil(err)
+
+	var res []types.AuthorizationGrant
+	suite.Nil(cdc.UnmarshalJSON(bz, &res))
+	suite.Len(res, 2)
+
+	query.Data = cdc.MustMarshalJSON(types.NewQueryAuthorizationsParams(suite.addr2, suite.addr))
+	bz, err = suite.querier(ctx, []string{types.QueryAuthorizations}, query)
+	suite.Nil(err)
+
+	suite.Nil(cdc.UnmarshalJSON(bz, &res))
+	suite.Len(res, 0)
+}
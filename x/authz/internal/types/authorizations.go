@@ -0,0 +1,85 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	_ exported.Authorization = GenericAuthorization{}
+	_ exported.Authorization = DelegateAuthorization{}
+)
+
+// GenericAuthorization gives the grantee unrestricted permission to execute
+// messages of the given type on behalf of the granter.
+type GenericAuthorization struct {
+	MessageType string `json:"msg_type" yaml:"msg_type"`
+}
+
+// NewGenericAuthorization returns a new GenericAuthorization.
+func NewGenericAuthorization(msgType string) GenericAuthorization {
+	return GenericAuthorization{MessageType: msgType}
+}
+
+// MsgType implements Authorization.
+func (a GenericAuthorization) MsgType() string {
+	return a.MessageType
+}
+
+// Accept implements Authorization. Every message of the authorized type is
+// accepted and the authorization is never used up.
+func (a GenericAuthorization) Accept(msg sdk.Msg) (exported.Authorization, bool, error) {
+	return a, false, nil
+}
+
+// ValidateBasic implements Authorization.
+func (a GenericAuthorization) ValidateBasic() error {
+	if parts := strings.Split(a.MessageType, "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, "message type must be formatted as <route>/<type>")
+	}
+	return nil
+}
+
+// DelegateAuthorization gives the grantee permission to delegate up to
+// SpendLimit of the granter's tokens in total.
+type DelegateAuthorization struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+}
+
+// NewDelegateAuthorization returns a new DelegateAuthorization.
+func NewDelegateAuthorization(spendLimit sdk.Coins) DelegateAuthorization {
+	return DelegateAuthorization{SpendLimit: spendLimit}
+}
+
+// MsgType implements Authorization.
+func (a DelegateAuthorization) MsgType() string {
+	return MsgType(staking.MsgDelegate{})
+}
+
+// Accept implements Authorization. The delegated amount is deducted from the
+// spend limit, and the authorization is removed once the limit is used up.
+func (a DelegateAuthorization) Accept(msg sdk.Msg) (exported.Authorization, bool, error) {
+	delegate, ok := msg.(staking.MsgDelegate)
+	if !ok {
+		return nil, false, ErrInvalidAuthorization(DefaultCodespace, "expected a delegate message")
+	}
+
+	left, invalid := a.SpendLimit.SafeSub(sdk.NewCoins(delegate.Amount))
+	if invalid {
+		return nil, false, ErrInvalidAuthorization(DefaultCodespace, "delegation exceeds the spend limit")
+	}
+
+	return DelegateAuthorization{SpendLimit: left}, left.IsZero(), nil
+}
+
+// ValidateBasic implements Authorization.
+func (a DelegateAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsValid() || a.SpendLimit.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit is invalid: %s", a.SpendLimit)
+	}
+	return nil
+}
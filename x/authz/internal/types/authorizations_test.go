@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestGenericAuthorization(t *testing.T) {
+	require.NoError(t, NewGenericAuthorization("bank/send").ValidateBasic())
+	require.Error(t, NewGenericAuthorization("").ValidateBasic())
+	require.Error(t, NewGenericAuthorization("bank").ValidateBasic())
+	require.Error(t, NewGenericAuthorization("/send").ValidateBasic())
+
+	authorization := NewGenericAuthorization("bank/send")
+	updated, remove, err := authorization.Accept(sdk.NewTestMsg())
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, authorization, updated)
+}
+
+func TestDelegateAuthorization(t *testing.T) {
+	delegator := sdk.AccAddress([]byte("delegator___________"))
+	validator := sdk.ValAddress([]byte("validator___________"))
+	delegate := func(amount int64) sdk.Msg {
+		return staking.NewMsgDelegate(delegator, validator, sdk.NewInt64Coin("atom", amount))
+	}
+
+	authorization := NewDelegateAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "staking/delegate", authorization.MsgType())
+	require.Error(t, NewDelegateAuthorization(nil).ValidateBasic())
+
+	// wrong message
+	_, _, err := authorization.Accept(sdk.NewTestMsg(delegator))
+	require.Error(t, err)
+
+	// over the limit
+	_, _, err = authorization.Accept(delegate(101))
+	require.Error(t, err)
+
+	// within the limit
+	updated, remove, err := authorization.Accept(delegate(40))
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, NewDelegateAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 60))), updated)
+
+	// use up the limit
+	_, remove, err = updated.Accept(delegate(60))
+	require.NoError(t, err)
+	require.True(t, remove)
+}
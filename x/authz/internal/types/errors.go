@@ -0,0 +1,55 @@
+// DONTCOVER
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Error codes specific to the authz module
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeNoAuthorization      sdk.CodeType = 1
+	CodeAuthorizationExpired sdk.CodeType = 2
+	CodeInvalidExpiration    sdk.CodeType = 3
+	CodeInvalidAuthorization sdk.CodeType = 4
+)
+
+// ErrNoAuthorization returns a typed ABCI error for a granter and grantee pair
+// without an authorization for the given message type.
+func ErrNoAuthorization(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress, msgType string) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeNoAuthorization),
+		fmt.Sprintf("no %s authorization from %s to %s", msgType, granter, grantee),
+	)
+}
+
+// ErrAuthorizationExpired returns a typed ABCI error for an authorization that
+// has expired.
+func ErrAuthorizationExpired(codespace sdk.CodespaceType) error {
+	return sdkerrors.New(string(codespace), uint32(CodeAuthorizationExpired), "authorization expired")
+}
+
+// ErrInvalidExpiration returns a typed ABCI error for an expiration time that
+// has already passed.
+func ErrInvalidExpiration(codespace sdk.CodespaceType, msg string) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeInvalidExpiration),
+		fmt.Sprintf("invalid expiration: %s", msg),
+	)
+}
+
+// ErrInvalidAuthorization returns a typed ABCI error for an authorization that
+// fails validation or rejects a message.
+func ErrInvalidAuthorization(codespace sdk.CodespaceType, msg string) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeInvalidAuthorization),
+		fmt.Sprintf("invalid authorization: %s", msg),
+	)
+}
@@ -0,0 +1,27 @@
+package types
+
+// GenesisState contains a set of authorization grants, persisted from the store
+type GenesisState struct {
+	Authorizations []AuthorizationGrant `json:"authorizations" yaml:"authorizations"`
+}
+
+func NewGenesisState(grants []AuthorizationGrant) GenesisState {
+	return GenesisState{Authorizations: grants}
+}
+
+// DefaultGenesisState returns the authz module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{Authorizations: []AuthorizationGrant{}}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, grant := range gs.Authorizations {
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+// AuthorizationGrant is stored in the KVStore to record an authorization with
+// full context. A zero Expiration never expires.
+type AuthorizationGrant struct {
+	Granter       sdk.AccAddress         `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress         `json:"grantee" yaml:"grantee"`
+	Authorization exported.Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time              `json:"expiration" yaml:"expiration"`
+}
+
+// NewAuthorizationGrant creates a new AuthorizationGrant.
+func NewAuthorizationGrant(
+	granter, grantee sdk.AccAddress, authorization exported.Authorization, expiration time.Time,
+) AuthorizationGrant {
+
+	return AuthorizationGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// IsExpired returns true if the grant has expired at the given block time.
+func (g AuthorizationGrant) IsExpired(blockTime time.Time) bool {
+	return !g.Expiration.IsZero() && !blockTime.Before(g.Expiration)
+}
+
+// ValidateBasic performs basic validation on AuthorizationGrant
+func (g AuthorizationGrant) ValidateBasic() error {
+	if g.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if g.Grantee.Equals(g.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant authorization")
+	}
+	if g.Authorization == nil {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing authorization")
+	}
+
+	return g.Authorization.ValidateBasic()
+}
+
+// String implements the fmt.Stringer interface
+func (g AuthorizationGrant) String() string {
+	out, _ := yaml.Marshal(g)
+	return string(out)
+}
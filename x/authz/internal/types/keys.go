@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "authz"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// AuthorizationKeyPrefix is the prefix of the kvstore for authorization grants
+	AuthorizationKeyPrefix = []byte{0x00}
+)
+
+// AuthorizationKey is the canonical key to store the authorization of the
+// given message type from granter to grantee.
+func AuthorizationKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(AuthorizationsPrefix(granter, grantee), []byte(msgType)...)
+}
+
+// AuthorizationsPrefix returns a prefix to scan for all the authorizations
+// from granter to grantee.
+func AuthorizationsPrefix(granter, grantee sdk.AccAddress) []byte {
+	key := append(AuthorizationKeyPrefix, granter.Bytes()...)
+	return append(key, grantee.Bytes()...)
+}
+
+// MsgType returns the type of msg used to look up authorizations, formatted
+// as "<route>/<type>".
+func MsgType(msg sdk.Msg) string {
+	return msg.Route() + "/" + msg.Type()
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter_____________"))
+	grantee := sdk.AccAddress([]byte("grantee_____________"))
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	expiration := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		msg       MsgGrantAuthorization
+		expectErr bool
+	}{
+		{NewMsgGrantAuthorization(granter, grantee, NewGenericAuthorization("bank/send"), expiration), false},
+		{NewMsgGrantAuthorization(granter, grantee, NewDelegateAuthorization(atom), time.Time{}), false},
+		{NewMsgGrantAuthorization(nil, grantee, NewDelegateAuthorization(atom), expiration), true},
+		{NewMsgGrantAuthorization(granter, nil, NewDelegateAuthorization(atom), expiration), true},
+		{NewMsgGrantAuthorization(granter, granter, NewDelegateAuthorization(atom), expiration), true},
+		{NewMsgGrantAuthorization(granter, grantee, nil, expiration), true},
+		{NewMsgGrantAuthorization(granter, grantee, NewGenericAuthorization("send"), expiration), true},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, RouterKey, tc.msg.Route(), "unexpected result for tc #%d", i)
+		require.Equal(t, TypeMsgGrantAuthorization, tc.msg.Type(), "unexpected result for tc #%d", i)
+		require.Equal(t, []sdk.AccAddress{tc.msg.Granter}, tc.msg.GetSigners(), "unexpected result for tc #%d", i)
+
+		if tc.expectErr {
+			require.NotNil(t, tc.msg.ValidateBasic(), "unexpected result for tc #%d", i)
+		} else {
+			require.Nil(t, tc.msg.ValidateBasic(), "unexpected result for tc #%d", i)
+			require.NotPanics(t, func() { tc.msg.GetSignBytes() }, "unexpected result for tc #%d", i)
+		}
+	}
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter_____________"))
+	grantee := sdk.AccAddress([]byte("grantee_____________"))
+
+	testCases := []struct {
+		msg       MsgRevokeAuthorization
+		expectErr bool
+	}{
+		{NewMsgRevokeAuthorization(granter, grantee, "bank/send"), false},
+		{NewMsgRevokeAuthorization(nil, grantee, "bank/send"), true},
+		{NewMsgRevokeAuthorization(granter, nil, "bank/send"), true},
+		{NewMsgRevokeAuthorization(granter, grantee, ""), true},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, RouterKey, tc.msg.Route(), "unexpected result for tc #%d", i)
+		require.Equal(t, TypeMsgRevokeAuthorization, tc.msg.Type(), "unexpected result for tc #%d", i)
+		require.Equal(t, []sdk.AccAddress{tc.msg.Granter}, tc.msg.GetSigners(), "unexpected result for tc #%d", i)
+
+		if tc.expectErr {
+			require.NotNil(t, tc.msg.ValidateBasic(), "unexpected result for tc #%d", i)
+		} else {
+			require.Nil(t, tc.msg.ValidateBasic(), "unexpected result for tc #%d", i)
+		}
+	}
+}
+
+func TestMsgExecAuthorized(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter_____________"))
+	grantee := sdk.AccAddress([]byte("grantee_____________"))
+
+	testCases := []struct {
+		msg       MsgExecAuthorized
+		expectErr bool
+	}{
+		{NewMsgExecAuthorized(grantee, []sdk.Msg{sdk.NewTestMsg(granter)}), false},
+		{NewMsgExecAuthorized(grantee, []sdk.Msg{sdk.NewTestMsg(granter), sdk.NewTestMsg(grantee)}), false},
+		{NewMsgExecAuthorized(nil, []sdk.Msg{sdk.NewTestMsg(granter)}), true},
+		{NewMsgExecAuthorized(grantee, nil), true},
+		{NewMsgExecAuthorized(grantee, []sdk.Msg{sdk.NewTestMsg(granter, grantee)}), true},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, RouterKey, tc.msg.Route(), "unexpected result for tc #%d", i)
+		require.Equal(t, TypeMsgExecAuthorized, tc.msg.Type(), "unexpected result for tc #%d", i)
+		require.Equal(t, []sdk.AccAddress{grantee}, NewMsgExecAuthorized(grantee, nil).GetSigners())
+
+		if tc.expectErr {
+			require.NotNil(t, tc.msg.ValidateBasic(), "unexpected result for tc #%d", i)
+		} else {
+			require.Nil(t, tc.msg.ValidateBasic(), "unexpected result for tc #%d", i)
+			require.NotPanics(t, func() { tc.msg.GetSignBytes() }, "unexpected result for tc #%d", i)
+		}
+	}
+}
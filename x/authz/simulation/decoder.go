@@ -0,0 +1,25 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding authz type
+func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.AuthorizationKeyPrefix):
+		var grantA, grantB types.AuthorizationGrant
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &grantA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &grantB)
+		return fmt.Sprintf("%v\n%v", grantA, grantB)
+
+	default:
+		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+	}
+}
@@ -0,0 +1,87 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation parameter constants
+const (
+	Authorizations = "authorizations"
+)
+
+// GenAuthorizations returns random authorization grants between the
+// simulation accounts.
+func GenAuthorizations(r *rand.Rand, accs []simulation.Account, denom string, genesisTime time.Time) []types.AuthorizationGrant {
+	grants := []types.AuthorizationGrant{}
+	if len(accs) < 2 {
+		return grants
+	}
+
+	seen := make(map[string]bool)
+	for i := 0; i < r.Intn(len(accs)); i++ {
+		granter, _ := simulation.RandomAcc(r, accs)
+		grantee, _ := simulation.RandomAcc(r, accs)
+		authorization := RandomAuthorization(r, denom)
+
+		key := string(types.AuthorizationKey(granter.Address, grantee.Address, authorization.MsgType()))
+		if granter.Address.Equals(grantee.Address) || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		grants = append(grants, types.NewAuthorizationGrant(
+			granter.Address, grantee.Address, authorization, RandomExpiration(r, genesisTime),
+		))
+	}
+
+	return grants
+}
+
+// RandomAuthorization returns a random generic authorization to send coins
+// or a delegate authorization with a spend limit in the given denomination.
+func RandomAuthorization(r *rand.Rand, denom string) exported.Authorization {
+	if r.Intn(2) == 0 {
+		return types.NewGenericAuthorization(types.MsgType(bank.MsgSend{}))
+	}
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(denom, int64(simulation.RandIntBetween(r, 1, 1000000))))
+	return types.NewDelegateAuthorization(spendLimit)
+}
+
+// RandomExpiration returns either no expiration or a random time within a
+// month after the given time.
+func RandomExpiration(r *rand.Rand, now time.Time) time.Time {
+	if r.Intn(2) == 0 {
+		return time.Time{}
+	}
+
+	return now.Add(time.Duration(simulation.RandIntBetween(r, 1, 60*60*24*30)) * time.Second)
+}
+
+// RandomizedGenState generates a random GenesisState for authz
+func RandomizedGenState(simState *module.SimulationState) {
+	var grants []types.AuthorizationGrant
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Authorizations, &grants, simState.Rand,
+		func(r *rand.Rand) {
+			grants = GenAuthorizations(r, simState.Accounts, simState.BondDenom, simState.GenTimestamp)
+		},
+	)
+
+	authzGenesis := types.NewGenesisState(grants)
+
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, codec.MustMarshalJSONIndent(simState.Cdc, authzGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(authzGenesis)
+}
@@ -0,0 +1,213 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgGrantAuthorization  = "op_weight_msg_grant_authorization"
+	OpWeightMsgRevokeAuthorization = "op_weight_msg_revoke_authorization"
+	OpWeightMsgExecAuthorized      = "op_weight_msg_exec_authorized"
+)
+
+// WeightedOperations returns all the operations from the module with their
+// respective weights. The authorizations granted are denominated in the bond
+// denom of the simulation.
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, ak types.AccountKeeper,
+	bk types.BankKeeper, k keeper.Keeper, bondDenom string) simulation.WeightedOperations {
+
+	var weightMsgGrantAuthorization int
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrantAuthorization, &weightMsgGrantAuthorization, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrantAuthorization = 50
+		},
+	)
+
+	var weightMsgRevokeAuthorization int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeAuthorization, &weightMsgRevokeAuthorization, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeAuthorization = 20
+		},
+	)
+
+	var weightMsgExecAuthorized int
+	appParams.GetOrGenerate(cdc, OpWeightMsgExecAuthorized, &weightMsgExecAuthorized, nil,
+		func(_ *rand.Rand) {
+			weightMsgExecAuthorized = 50
+		},
+	)
+
+	return simulation.WeightedOperations{
+		{Weight: weightMsgGrantAuthorization, Op: SimulateMsgGrantAuthorization(ak, k, bondDenom)},
+		{Weight: weightMsgRevokeAuthorization, Op: SimulateMsgRevokeAuthorization(ak, k)},
+		{Weight: weightMsgExecAuthorized, Op: SimulateMsgExecAuthorized(ak, bk, k)},
+	}
+}
+
+// SimulateMsgGrantAuthorization generates a MsgGrantAuthorization with a
+// random authorization of coins of the given denom from a random account to
+// another one.
+func SimulateMsgGrantAuthorization(ak types.AccountKeeper, k keeper.Keeper, denom string) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		granter, _ := simulation.RandomAcc(r, accs)
+		grantee, _ := simulation.RandomAcc(r, accs)
+		if granter.Address.Equals(grantee.Address) {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgGrantAuthorization, "granter and grantee are the same"), nil, nil
+		}
+
+		msg := types.NewMsgGrantAuthorization(
+			granter.Address, grantee.Address,
+			RandomAuthorization(r, denom), RandomExpiration(r, ctx.BlockTime()),
+		)
+
+		account := ak.GetAccount(ctx, granter.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName, msg.Type(), ""), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName, msg.Type(), ""), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRevokeAuthorization generates a MsgRevokeAuthorization for a
+// random existing authorization granted by a simulation account.
+func SimulateMsgRevokeAuthorization(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		grants := k.GetAllAuthorizationGrants(ctx)
+		if len(grants) == 0 {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgRevokeAuthorization, "no authorizations"), nil, nil
+		}
+
+		grant := grants[r.Intn(len(grants))]
+
+		granter, found := simulation.FindAccount(accs, grant.Granter)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgRevokeAuthorization, "granter is not a simulation account"), nil, nil
+		}
+
+		msg := types.NewMsgRevokeAuthorization(grant.Granter, grant.Grantee, grant.Authorization.MsgType())
+
+		account := ak.GetAccount(ctx, granter.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName, msg.Type(), ""), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName, msg.Type(), ""), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgExecAuthorized generates a MsgExecAuthorized by which a grantee
+// sends coins of its granter to a random account, using an unexpired
+// authorization to send coins.
+func SimulateMsgExecAuthorized(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		sendMsgType := types.MsgType(bank.MsgSend{})
+
+		var grants []types.AuthorizationGrant
+		k.IterateAllAuthorizationGrants(ctx, func(grant types.AuthorizationGrant) bool {
+			if grant.Authorization.MsgType() == sendMsgType && !grant.IsExpired(ctx.BlockTime()) {
+				grants = append(grants, grant)
+			}
+			return false
+		})
+
+		if len(grants) == 0 {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgExecAuthorized, "no send authorizations"), nil, nil
+		}
+
+		grant := grants[r.Intn(len(grants))]
+
+		grantee, found := simulation.FindAccount(accs, grant.Grantee)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgExecAuthorized, "grantee is not a simulation account"), nil, nil
+		}
+
+		granterAcc := ak.GetAccount(ctx, grant.Granter)
+		coins := simulation.RandSubsetCoins(r, ctx, granterAcc.SpendableCoins(ctx.BlockTime()))
+		if coins.Empty() {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgExecAuthorized, "no coins to send"), nil, nil
+		}
+
+		if err := bk.IsSendEnabledCoins(ctx, coins...); err != nil {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgExecAuthorized, "transfers are not enabled"), nil, nil
+		}
+
+		recipient, _ := simulation.RandomAcc(r, accs)
+		msg := types.NewMsgExecAuthorized(grant.Grantee, []sdk.Msg{bank.NewMsgSend(grant.Granter, recipient.Address, coins)})
+
+		account := ak.GetAccount(ctx, grantee.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName, msg.Type(), ""), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			grantee.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName, msg.Type(), ""), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
@@ -15,15 +15,26 @@ const (
 	DefaultCodespace         = types.DefaultCodespace
 	CodeSendDisabled         = types.CodeSendDisabled
 	CodeInvalidInputsOutputs = types.CodeInvalidInputsOutputs
+	CodeInvalidHold          = types.CodeInvalidHold
+	CodeUnknownHold          = types.CodeUnknownHold
+	CodeSendRateLimited      = types.CodeSendRateLimited
+	MaxHoldIDLength          = types.MaxHoldIDLength
 	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
 	QuerierRoute             = types.QuerierRoute
 	RouterKey                = types.RouterKey
+	TypeMsgSend              = types.TypeMsgSend
+	TypeMsgMultiSend         = types.TypeMsgMultiSend
 	DefaultParamspace        = types.DefaultParamspace
 	DefaultSendEnabled       = types.DefaultSendEnabled
 
 	EventTypeTransfer      = types.EventTypeTransfer
 	AttributeKeyRecipient  = types.AttributeKeyRecipient
 	AttributeKeySender     = types.AttributeKeySender
+	AttributeKeyAccount    = types.AttributeKeyAccount
+	AttributeKeyHoldID     = types.AttributeKeyHoldID
+	EventTypeHold          = types.EventTypeHold
+	EventTypeReleaseHold   = types.EventTypeReleaseHold
 	AttributeValueCategory = types.AttributeValueCategory
 )
 
@@ -31,15 +42,27 @@ var (
 	// functions aliases
 	RegisterInvariants          = keeper.RegisterInvariants
 	NonnegativeBalanceInvariant = keeper.NonnegativeBalanceInvariant
+	HeldCoinsInvariant          = keeper.HeldCoinsInvariant
 	NewBaseKeeper               = keeper.NewBaseKeeper
 	NewBaseSendKeeper           = keeper.NewBaseSendKeeper
 	NewBaseViewKeeper           = keeper.NewBaseViewKeeper
+	NewSendRateLimitHooks       = keeper.NewSendRateLimitHooks
 	NewQuerier                  = keeper.NewQuerier
 	RegisterCodec               = types.RegisterCodec
 	ErrNoInputs                 = types.ErrNoInputs
 	ErrNoOutputs                = types.ErrNoOutputs
 	ErrInputOutputMismatch      = types.ErrInputOutputMismatch
 	ErrSendDisabled             = types.ErrSendDisabled
+	ErrSendDisabledDenom        = types.ErrSendDisabledDenom
+	ErrInvalidHold              = types.ErrInvalidHold
+	ErrUnknownHold              = types.ErrUnknownHold
+	ErrSendRateLimited          = types.ErrSendRateLimited
+	NewHold                     = types.NewHold
+	ValidateHoldID              = types.ValidateHoldID
+	HoldsKey                    = types.HoldsKey
+	HoldKey                     = types.HoldKey
+	SplitHoldKey                = types.SplitHoldKey
+	SendBucketKey               = types.SendBucketKey
 	NewGenesisState             = types.NewGenesisState
 	DefaultGenesisState         = types.DefaultGenesisState
 	ValidateGenesis             = types.ValidateGenesis
@@ -49,11 +72,18 @@ var (
 	NewOutput                   = types.NewOutput
 	ValidateInputsOutputs       = types.ValidateInputsOutputs
 	ParamKeyTable               = types.ParamKeyTable
+	NewSendEnabled              = types.NewSendEnabled
+	NewSendRateLimit            = types.NewSendRateLimit
+	NewSendBucket               = types.NewSendBucket
 	NewQueryBalanceParams       = types.NewQueryBalanceParams
 
 	// variable aliases
-	ModuleCdc                = types.ModuleCdc
-	ParamStoreKeySendEnabled = types.ParamStoreKeySendEnabled
+	ModuleCdc                      = types.ModuleCdc
+	ParamStoreKeySendEnabled       = types.ParamStoreKeySendEnabled
+	ParamStoreKeySendEnabledDenoms = types.ParamStoreKeySendEnabledDenoms
+	ParamStoreKeySendRateLimits    = types.ParamStoreKeySendRateLimits
+	HoldKeyPrefix                  = types.HoldKeyPrefix
+	SendBucketKeyPrefix            = types.SendBucketKeyPrefix
 )
 
 type (
@@ -63,7 +93,15 @@ type (
 	BaseSendKeeper     = keeper.BaseSendKeeper
 	ViewKeeper         = keeper.ViewKeeper
 	BaseViewKeeper     = keeper.BaseViewKeeper
+	SendRateLimitHooks = keeper.SendRateLimitHooks
 	GenesisState       = types.GenesisState
+	Hold               = types.Hold
+	SendEnabled        = types.SendEnabled
+	SendEnabledDenoms  = types.SendEnabledDenoms
+	SendRateLimit      = types.SendRateLimit
+	SendRateLimits     = types.SendRateLimits
+	SendBucket         = types.SendBucket
+	SendHooks          = types.SendHooks
 	MsgSend            = types.MsgSend
 	MsgMultiSend       = types.MsgMultiSend
 	Input              = types.Input
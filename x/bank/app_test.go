@@ -13,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 )
 
@@ -118,6 +119,28 @@ func TestSendNotEnoughBalance(t *testing.T) {
 	require.Equal(t, res2.GetSequence(), origSeq+1)
 }
 
+// The transfers of a denomination can be disabled regardless of SendEnabled
+func TestSendDisabledDenom(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	handler := bank.NewHandler(app.BankKeeper)
+
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	require.NoError(t, app.BankKeeper.SetCoins(ctx, addr1, coins))
+
+	app.BankKeeper.SetSendEnabledDenoms(ctx, types.SendEnabledDenoms{types.NewSendEnabled("foocoin", false)})
+	for _, msg := range []sdk.Msg{sendMsg1, multiSendMsg1} {
+		res := handler(ctx, msg)
+		require.False(t, res.IsOK())
+		require.Equal(t, types.CodeSendDisabled, res.Code)
+	}
+	require.Equal(t, coins, app.BankKeeper.GetCoins(ctx, addr1))
+
+	app.BankKeeper.SetSendEnabledDenoms(ctx, types.SendEnabledDenoms{types.NewSendEnabled("barcoin", false)})
+	require.True(t, handler(ctx, sendMsg1).IsOK())
+	require.Equal(t, coins, app.BankKeeper.GetCoins(ctx, addr2))
+}
+
 // A module account cannot be the recipient of bank sends
 func TestSendToModuleAcc(t *testing.T) {
 	acc := &auth.BaseAccount{
@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BalanceProof defines the balance of an account at a given height along with
+// the multistore Merkle proof of the raw account stored under the account
+// store key. It allows light clients, such as audit tools, to check a balance
+// against the application hash of the header following that height.
+type BalanceProof struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Height  int64          `json:"height" yaml:"height"`
+	Coins   sdk.Coins      `json:"coins" yaml:"coins"`
+	Key     []byte         `json:"key" yaml:"key"`     // account store key
+	Value   []byte         `json:"value" yaml:"value"` // raw account bytes, empty if the account does not exist
+	Proof   *merkle.Proof  `json:"proof" yaml:"proof"`
+}
+
+// NewBalanceProof creates a new BalanceProof instance from the response of a
+// proven account store key query.
+func NewBalanceProof(cdc *codec.Codec, addr sdk.AccAddress, resp abci.ResponseQuery) (BalanceProof, error) {
+	coins, err := decodeBalance(cdc, addr, resp.Value)
+	if err != nil {
+		return BalanceProof{}, err
+	}
+
+	return BalanceProof{
+		Address: addr,
+		Height:  resp.Height,
+		Coins:   coins,
+		Key:     resp.Key,
+		Value:   resp.Value,
+		Proof:   resp.Proof,
+	}, nil
+}
+
+// Verify checks the proof against the given application hash, i.e. the one of
+// the header at Height + 1, and that the proven account holds the balance.
+func (bp BalanceProof) Verify(cdc *codec.Codec, appHash []byte) error {
+	if !bytes.Equal(bp.Key, authtypes.AddressStoreKey(bp.Address)) {
+		return fmt.Errorf("proof key %X does not belong to account %s", bp.Key, bp.Address)
+	}
+
+	resp := abci.ResponseQuery{Key: bp.Key, Value: bp.Value, Proof: bp.Proof, Height: bp.Height}
+	if err := context.VerifyStoreProof(authtypes.StoreKey, resp, appHash); err != nil {
+		return err
+	}
+
+	coins, err := decodeBalance(cdc, bp.Address, bp.Value)
+	if err != nil {
+		return err
+	}
+
+	if !coins.IsEqual(bp.Coins) {
+		return fmt.Errorf("proven balance %s does not match balance %s", coins, bp.Coins)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer
+func (bp BalanceProof) String() string {
+	return fmt.Sprintf(`Balance Proof:
+  Address: %s
+  Height:  %d
+  Coins:   %s`, bp.Address, bp.Height, bp.Coins)
+}
+
+// decodeBalance returns the coins held by the raw account, an empty value
+// standing for an account which does not exist.
+func decodeBalance(cdc *codec.Codec, addr sdk.AccAddress, value []byte) (sdk.Coins, error) {
+	if len(value) == 0 {
+		return sdk.NewCoins(), nil
+	}
+
+	var acc exported.Account
+	if err := cdc.UnmarshalBinaryBare(value, &acc); err != nil {
+		return nil, fmt.Errorf("failed to decode account: %s", err)
+	}
+
+	if !acc.GetAddress().Equals(addr) {
+		return nil, fmt.Errorf("proven account %s does not match address %s", acc.GetAddress(), addr)
+	}
+
+	return acc.GetCoins(), nil
+}
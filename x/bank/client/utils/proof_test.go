@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestBalanceProof(t *testing.T) {
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	authtypes.RegisterCodec(cdc)
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	key := sdk.NewKVStoreKey(authtypes.StoreKey)
+	store := rootmulti.NewStore(dbm.NewMemDB())
+	store.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	acc := authtypes.NewBaseAccountWithAddress(addr)
+	require.NoError(t, acc.SetCoins(coins))
+	store.GetKVStore(key).Set(authtypes.AddressStoreKey(addr), cdc.MustMarshalBinaryBare(&acc))
+	appHash := store.Commit().Hash
+
+	query := func(addr sdk.AccAddress) abci.ResponseQuery {
+		return store.Query(abci.RequestQuery{
+			Path:  "/acc/key",
+			Data:  authtypes.AddressStoreKey(addr),
+			Prove: true,
+		})
+	}
+
+	proof, err := NewBalanceProof(cdc, addr, query(addr))
+	require.NoError(t, err)
+	require.Equal(t, coins, proof.Coins)
+	require.NoError(t, proof.Verify(cdc, appHash))
+
+	// the proof must not be accepted for another application hash
+	require.Error(t, proof.Verify(cdc, []byte("invalid")))
+
+	// a tampered balance must be rejected
+	tampered := proof
+	tampered.Coins = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.Error(t, tampered.Verify(cdc, appHash))
+
+	// the proof of an account must not be accepted for another address
+	tampered = proof
+	tampered.Address = other
+	require.Error(t, tampered.Verify(cdc, appHash))
+
+	// missing accounts are proven absent with an empty balance
+	proof, err = NewBalanceProof(cdc, other, query(other))
+	require.NoError(t, err)
+	require.True(t, proof.Coins.Empty())
+	require.NoError(t, proof.Verify(cdc, appHash))
+}
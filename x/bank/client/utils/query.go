@@ -0,0 +1,21 @@
+package utils
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// QueryBalanceProof queries the balance of an account along with the
+// multistore Merkle proof of the account at the context height, or at the
+// latest height if none is set. The proof is checked against the verified
+// header unless the node is trusted, and can be checked again later on by
+// calling Verify on the result.
+func QueryBalanceProof(cliCtx context.CLIContext, addr sdk.AccAddress) (BalanceProof, error) {
+	resp, err := cliCtx.QueryStoreWithProof(authtypes.AddressStoreKey(addr), authtypes.StoreKey)
+	if err != nil {
+		return BalanceProof{}, err
+	}
+
+	return NewBalanceProof(cliCtx.Codec, addr, resp)
+}
@@ -7,9 +7,24 @@ import (
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetSendEnabled(ctx, data.SendEnabled)
+	keeper.SetSendEnabledDenoms(ctx, data.SendEnabledDenoms)
+	keeper.SetSendRateLimits(ctx, data.SendRateLimits)
+
+	for _, hold := range data.Holds {
+		keeper.SetHold(ctx, hold.Address, hold.ID, hold.Amount)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSendEnabled(ctx))
+	holds := []Hold{}
+	keeper.IterateAllHolds(ctx, func(hold Hold) bool {
+		holds = append(holds, hold)
+		return false
+	})
+
+	genState := NewGenesisState(keeper.GetSendEnabled(ctx), holds)
+	genState.SendEnabledDenoms = keeper.GetSendEnabledDenoms(ctx)
+	genState.SendRateLimits = keeper.GetSendRateLimits(ctx)
+	return genState
 }
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+)
+
+// SpendableCoins returns the coins of an account that can be spent at the
+// current block time, i.e. its coins that are neither vesting nor held.
+func (keeper BaseViewKeeper) SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	acc := keeper.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdk.NewCoins()
+	}
+
+	return subHeldCoins(acc.SpendableCoins(ctx.BlockHeader().Time), keeper.GetHeldCoins(ctx, addr))
+}
+
+// GetHold returns the coins held by an account under a hold ID
+func (keeper BaseViewKeeper) GetHold(ctx sdk.Context, addr sdk.AccAddress, holdID string) sdk.Coins {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.HoldKey(addr, holdID))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	var amt sdk.Coins
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &amt)
+	return amt
+}
+
+// GetHeldCoins returns the total coins held by an account across all its holds
+func (keeper BaseViewKeeper) GetHeldCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	held := sdk.NewCoins()
+	keeper.IterateHolds(ctx, addr, func(hold types.Hold) bool {
+		held = held.Add(hold.Amount)
+		return false
+	})
+
+	return held
+}
+
+// IterateHolds iterates over the holds placed on an account
+func (keeper BaseViewKeeper) IterateHolds(ctx sdk.Context, addr sdk.AccAddress, cb func(hold types.Hold) (stop bool)) {
+	keeper.iterateHolds(ctx, types.HoldsKey(addr), cb)
+}
+
+// IterateAllHolds iterates over the holds placed on all accounts
+func (keeper BaseViewKeeper) IterateAllHolds(ctx sdk.Context, cb func(hold types.Hold) (stop bool)) {
+	keeper.iterateHolds(ctx, types.HoldKeyPrefix, cb)
+}
+
+func (keeper BaseViewKeeper) iterateHolds(ctx sdk.Context, prefix []byte, cb func(hold types.Hold) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr, holdID := types.SplitHoldKey(iterator.Key())
+
+		var amt sdk.Coins
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &amt)
+
+		if cb(types.NewHold(addr, holdID, amt)) {
+			break
+		}
+	}
+}
+
+// PlaceHold locks amt spendable coins of an account under a hold ID, adding
+// them to the coins already held under it. Held coins stay in the account but
+// can't be spent, sent nor delegated until the hold is released.
+func (keeper BaseSendKeeper) PlaceHold(ctx sdk.Context, addr sdk.AccAddress, holdID string, amt sdk.Coins) sdk.Error {
+	if err := types.ValidateHoldID(holdID); err != nil {
+		return types.ErrInvalidHold(keeper.codespace, err.Error())
+	}
+
+	if !amt.IsValid() || amt.Empty() {
+		return sdk.ErrInvalidCoins(amt.String())
+	}
+
+	spendableCoins := keeper.SpendableCoins(ctx, addr)
+	if _, hasNeg := spendableCoins.SafeSub(amt); hasNeg {
+		return sdk.ErrInsufficientCoins(
+			fmt.Sprintf("insufficient spendable account funds; %s < %s", spendableCoins, amt),
+		)
+	}
+
+	keeper.SetHold(ctx, addr, holdID, keeper.GetHold(ctx, addr, holdID).Add(amt))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHold,
+			sdk.NewAttribute(types.AttributeKeyAccount, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyHoldID, holdID),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+	)
+
+	return nil
+}
+
+// ReleaseHold unlocks amt coins held by an account under a hold ID, making them
+// spendable again. The hold is removed once all its coins are released.
+func (keeper BaseSendKeeper) ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, holdID string, amt sdk.Coins) sdk.Error {
+	if !amt.IsValid() || amt.Empty() {
+		return sdk.ErrInvalidCoins(amt.String())
+	}
+
+	held := keeper.GetHold(ctx, addr, holdID)
+	if held.Empty() {
+		return types.ErrUnknownHold(keeper.codespace, addr, holdID)
+	}
+
+	remaining, hasNeg := held.SafeSub(amt)
+	if hasNeg {
+		return types.ErrInvalidHold(keeper.codespace,
+			fmt.Sprintf("cannot release %s from hold %s of account %s holding %s", amt, holdID, addr, held))
+	}
+
+	keeper.SetHold(ctx, addr, holdID, remaining)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleaseHold,
+			sdk.NewAttribute(types.AttributeKeyAccount, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyHoldID, holdID),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+	)
+
+	return nil
+}
+
+// SetHold sets the coins held by an account under a hold ID, removing the hold
+// if amt is empty.
+//
+// CONTRACT: the hold ID and amt are valid and the account has enough spendable
+// coins to hold amt.
+func (keeper BaseSendKeeper) SetHold(ctx sdk.Context, addr sdk.AccAddress, holdID string, amt sdk.Coins) {
+	store := ctx.KVStore(keeper.storeKey)
+	if amt.Empty() {
+		store.Delete(types.HoldKey(addr, holdID))
+		return
+	}
+
+	store.Set(types.HoldKey(addr, holdID), keeper.cdc.MustMarshalBinaryLengthPrefixed(amt))
+}
+
+// subHeldCoins subtracts the held coins from the coins of an account, flooring
+// every denomination at zero.
+func subHeldCoins(coins, held sdk.Coins) sdk.Coins {
+	var unheld sdk.Coins
+	for _, coin := range coins {
+		if amt := coin.Amount.Sub(held.AmountOf(coin.Denom)); amt.IsPositive() {
+			unheld = append(unheld, sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	return sdk.NewCoins(unheld...)
+}
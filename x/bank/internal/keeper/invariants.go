@@ -8,9 +8,11 @@ import (
 )
 
 // RegisterInvariants registers the bank module invariants
-func RegisterInvariants(ir sdk.InvariantRegistry, ak types.AccountKeeper) {
+func RegisterInvariants(ir sdk.InvariantRegistry, ak types.AccountKeeper, k ViewKeeper) {
 	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding",
 		NonnegativeBalanceInvariant(ak))
+	ir.RegisterRoute(types.ModuleName, "held-coins",
+		HeldCoinsInvariant(ak, k))
 }
 
 // NonnegativeBalanceInvariant checks that all accounts in the application have non-negative balances
@@ -35,3 +37,34 @@ func NonnegativeBalanceInvariant(ak types.AccountKeeper) sdk.Invariant {
 			fmt.Sprintf("amount of negative accounts found %d\n%s", count, msg)), broken
 	}
 }
+
+// HeldCoinsInvariant checks that no account holds more coins than it owns
+func HeldCoinsInvariant(ak types.AccountKeeper, k ViewKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		held := make(map[string]sdk.Coins)
+		var addrs []sdk.AccAddress
+		k.IterateAllHolds(ctx, func(hold types.Hold) bool {
+			key := hold.Address.String()
+			if _, ok := held[key]; !ok {
+				addrs = append(addrs, hold.Address)
+			}
+			held[key] = held[key].Add(hold.Amount)
+			return false
+		})
+
+		for _, addr := range addrs {
+			coins := k.GetCoins(ctx, addr)
+			if !coins.IsAllGTE(held[addr.String()]) {
+				count++
+				msg += fmt.Sprintf("\t%s holds %s but owns %s\n", addr, held[addr.String()], coins)
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "held-coins",
+			fmt.Sprintf("amount of accounts holding more coins than they own found %d\n%s", count, msg)), broken
+	}
+}
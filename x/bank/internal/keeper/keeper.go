@@ -6,6 +6,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
@@ -33,21 +34,31 @@ type BaseKeeper struct {
 }
 
 // NewBaseKeeper returns a new BaseKeeper
-func NewBaseKeeper(ak types.AccountKeeper,
+func NewBaseKeeper(cdc *codec.Codec, key sdk.StoreKey, ak types.AccountKeeper,
 	paramSpace params.Subspace,
 	codespace sdk.CodespaceType, blacklistedAddrs map[string]bool) BaseKeeper {
 
 	ps := paramSpace.WithKeyTable(types.ParamKeyTable())
 	return BaseKeeper{
-		BaseSendKeeper: NewBaseSendKeeper(ak, ps, codespace, blacklistedAddrs),
+		BaseSendKeeper: NewBaseSendKeeper(cdc, key, ak, ps, codespace, blacklistedAddrs),
 		ak:             ak,
 		paramSpace:     ps,
 	}
 }
 
+// SetHooks sets the send hooks of the keeper, which are called before any
+// coins are sent between accounts
+func (keeper BaseKeeper) SetHooks(sh types.SendHooks) BaseKeeper {
+	if keeper.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+	keeper.hooks = sh
+	return keeper
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
-// vesting and vested coins.
+// vesting and vested coins. Coins held by the account can't be delegated.
 // The coins are then transferred from the delegator address to a ModuleAccount address.
 // If any of the delegation amounts are negative, an error is returned.
 func (keeper BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
@@ -66,12 +77,12 @@ func (keeper BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAcc
 		return sdk.ErrInvalidCoins(amt.String())
 	}
 
-	oldCoins := delegatorAcc.GetCoins()
+	unheldCoins := subHeldCoins(delegatorAcc.GetCoins(), keeper.GetHeldCoins(ctx, delegatorAddr))
 
-	_, hasNeg := oldCoins.SafeSub(amt)
+	_, hasNeg := unheldCoins.SafeSub(amt)
 	if hasNeg {
 		return sdk.ErrInsufficientCoins(
-			fmt.Sprintf("insufficient account funds; %s < %s", oldCoins, amt),
+			fmt.Sprintf("insufficient unheld account funds; %s < %s", unheldCoins, amt),
 		)
 	}
 
@@ -146,8 +157,18 @@ type SendKeeper interface {
 
 	GetSendEnabled(ctx sdk.Context) bool
 	SetSendEnabled(ctx sdk.Context, enabled bool)
+	GetSendEnabledDenoms(ctx sdk.Context) types.SendEnabledDenoms
+	SetSendEnabledDenoms(ctx sdk.Context, denoms types.SendEnabledDenoms)
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) sdk.Error
+	GetSendRateLimits(ctx sdk.Context) types.SendRateLimits
+	SetSendRateLimits(ctx sdk.Context, limits types.SendRateLimits)
+	ConsumeSendRateLimit(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
 
 	BlacklistedAddr(addr sdk.AccAddress) bool
+
+	PlaceHold(ctx sdk.Context, addr sdk.AccAddress, holdID string, amt sdk.Coins) sdk.Error
+	ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, holdID string, amt sdk.Coins) sdk.Error
+	SetHold(ctx sdk.Context, addr sdk.AccAddress, holdID string, amt sdk.Coins)
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -162,14 +183,16 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blacklistedAddrs map[string]bool
+
+	hooks types.SendHooks
 }
 
 // NewBaseSendKeeper returns a new BaseSendKeeper.
-func NewBaseSendKeeper(ak types.AccountKeeper,
+func NewBaseSendKeeper(cdc *codec.Codec, key sdk.StoreKey, ak types.AccountKeeper,
 	paramSpace params.Subspace, codespace sdk.CodespaceType, blacklistedAddrs map[string]bool) BaseSendKeeper {
 
 	return BaseSendKeeper{
-		BaseViewKeeper:   NewBaseViewKeeper(ak, codespace),
+		BaseViewKeeper:   NewBaseViewKeeper(cdc, key, ak, codespace),
 		ak:               ak,
 		paramSpace:       paramSpace,
 		blacklistedAddrs: blacklistedAddrs,
@@ -184,6 +207,12 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 		return err
 	}
 
+	if keeper.hooks != nil {
+		if err := keeper.hooks.BeforeSend(ctx, inputs, outputs); err != nil {
+			return err
+		}
+	}
+
 	for _, in := range inputs {
 		_, err := keeper.SubtractCoins(ctx, in.Address, in.Coins)
 		if err != nil {
@@ -217,6 +246,13 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 
 // SendCoins moves coins from one account to another
 func (keeper BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if keeper.hooks != nil {
+		err := keeper.hooks.BeforeSend(ctx, []types.Input{types.NewInput(fromAddr, amt)}, []types.Output{types.NewOutput(toAddr, amt)})
+		if err != nil {
+			return err
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
@@ -244,7 +280,8 @@ func (keeper BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress,
 
 // SubtractCoins subtracts amt from the coins at the addr.
 //
-// CONTRACT: If the account is a vesting account, the amount has to be spendable.
+// CONTRACT: If the account is a vesting account or has coins on hold, the amount
+// has to be spendable.
 func (keeper BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
 
 	if !amt.IsValid() {
@@ -256,11 +293,12 @@ func (keeper BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress,
 	acc := keeper.ak.GetAccount(ctx, addr)
 	if acc != nil {
 		oldCoins = acc.GetCoins()
-		spendableCoins = acc.SpendableCoins(ctx.BlockHeader().Time)
+		spendableCoins = subHeldCoins(acc.SpendableCoins(ctx.BlockHeader().Time), keeper.GetHeldCoins(ctx, addr))
 	}
 
-	// For non-vesting accounts, spendable coins will simply be the original coins.
-	// So the check here is sufficient instead of subtracting from oldCoins.
+	// For non-vesting accounts without holds, spendable coins will simply be the
+	// original coins. So the check here is sufficient instead of subtracting from
+	// oldCoins.
 	_, hasNeg := spendableCoins.SafeSub(amt)
 	if hasNeg {
 		return amt, sdk.ErrInsufficientCoins(
@@ -327,6 +365,44 @@ func (keeper BaseSendKeeper) SetSendEnabled(ctx sdk.Context, enabled bool) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeySendEnabled, &enabled)
 }
 
+// GetSendEnabledDenoms returns the denominations whose transfers are enabled or
+// disabled regardless of SendEnabled
+func (keeper BaseSendKeeper) GetSendEnabledDenoms(ctx sdk.Context) types.SendEnabledDenoms {
+	denoms := types.SendEnabledDenoms{}
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeySendEnabledDenoms, &denoms)
+	return denoms
+}
+
+// SetSendEnabledDenoms sets the denominations whose transfers are enabled or
+// disabled regardless of SendEnabled
+func (keeper BaseSendKeeper) SetSendEnabledDenoms(ctx sdk.Context, denoms types.SendEnabledDenoms) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeySendEnabledDenoms, &denoms)
+}
+
+// IsSendEnabledCoins returns an error if the transfers of any of the coins are
+// disabled. The transfers of a denomination are enabled according to its entry
+// in SendEnabledDenoms, if any, and to SendEnabled otherwise.
+func (keeper BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) sdk.Error {
+	sendEnabled := keeper.GetSendEnabled(ctx)
+	denoms := keeper.GetSendEnabledDenoms(ctx)
+
+	for _, coin := range coins {
+		enabled, found := denoms.IsSendEnabled(coin.Denom)
+		if !found {
+			enabled = sendEnabled
+		}
+
+		if !enabled {
+			if len(denoms) == 0 {
+				return types.ErrSendDisabled(keeper.codespace)
+			}
+			return types.ErrSendDisabledDenom(keeper.codespace, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
 // BlacklistedAddr checks if a given address is blacklisted (i.e restricted from
 // receiving funds)
 func (keeper BaseSendKeeper) BlacklistedAddr(addr sdk.AccAddress) bool {
@@ -340,19 +416,27 @@ var _ ViewKeeper = (*BaseViewKeeper)(nil)
 type ViewKeeper interface {
 	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+
+	GetHold(ctx sdk.Context, addr sdk.AccAddress, holdID string) sdk.Coins
+	GetHeldCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	IterateHolds(ctx sdk.Context, addr sdk.AccAddress, cb func(hold types.Hold) (stop bool))
+	IterateAllHolds(ctx sdk.Context, cb func(hold types.Hold) (stop bool))
 
 	Codespace() sdk.CodespaceType
 }
 
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
 type BaseViewKeeper struct {
+	cdc       *codec.Codec
+	storeKey  sdk.StoreKey
 	ak        types.AccountKeeper
 	codespace sdk.CodespaceType
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
-func NewBaseViewKeeper(ak types.AccountKeeper, codespace sdk.CodespaceType) BaseViewKeeper {
-	return BaseViewKeeper{ak: ak, codespace: codespace}
+func NewBaseViewKeeper(cdc *codec.Codec, key sdk.StoreKey, ak types.AccountKeeper, codespace sdk.CodespaceType) BaseViewKeeper {
+	return BaseViewKeeper{cdc: cdc, storeKey: key, ak: ak, codespace: codespace}
 }
 
 // Logger returns a module-specific logger.
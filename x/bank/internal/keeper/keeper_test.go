@@ -107,7 +107,7 @@ func TestSendKeeper(t *testing.T) {
 	blacklistedAddrs := make(map[string]bool)
 
 	paramSpace := app.ParamsKeeper.Subspace("newspace")
-	sendKeeper := keep.NewBaseSendKeeper(app.AccountKeeper, paramSpace, types.DefaultCodespace, blacklistedAddrs)
+	sendKeeper := keep.NewBaseSendKeeper(app.Codec(), app.GetKey(types.StoreKey), app.AccountKeeper, paramSpace, types.DefaultCodespace, blacklistedAddrs)
 	app.BankKeeper.SetSendEnabled(ctx, true)
 
 	addr := sdk.AccAddress([]byte("addr1"))
@@ -151,6 +151,81 @@ func TestSendKeeper(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSendEnabledDenoms(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	foo, bar := sdk.NewInt64Coin("foocoin", 10), sdk.NewInt64Coin("barcoin", 10)
+
+	app.BankKeeper.SetSendEnabled(ctx, true)
+	require.Empty(t, app.BankKeeper.GetSendEnabledDenoms(ctx))
+	require.NoError(t, app.BankKeeper.IsSendEnabledCoins(ctx, foo, bar))
+
+	// a denom entry overrides SendEnabled
+	denoms := types.SendEnabledDenoms{types.NewSendEnabled("barcoin", false)}
+	app.BankKeeper.SetSendEnabledDenoms(ctx, denoms)
+	require.Equal(t, denoms, app.BankKeeper.GetSendEnabledDenoms(ctx))
+	require.NoError(t, app.BankKeeper.IsSendEnabledCoins(ctx, foo))
+	err := app.BankKeeper.IsSendEnabledCoins(ctx, foo, bar)
+	require.Error(t, err)
+	require.Equal(t, types.CodeSendDisabled, err.Code())
+	require.Contains(t, err.Error(), "barcoin")
+
+	app.BankKeeper.SetSendEnabled(ctx, false)
+	app.BankKeeper.SetSendEnabledDenoms(ctx, types.SendEnabledDenoms{types.NewSendEnabled("barcoin", true)})
+	require.NoError(t, app.BankKeeper.IsSendEnabledCoins(ctx, bar))
+	require.Error(t, app.BankKeeper.IsSendEnabledCoins(ctx, foo, bar))
+}
+
+func TestSendRateLimit(t *testing.T) {
+	app, ctx := createTestApp(false)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1000, 0)})
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	feeCollector := app.SupplyKeeper.GetModuleAddress(auth.FeeCollectorName)
+	app.BankKeeper.SetCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1000), sdk.NewInt64Coin("barcoin", 1000)))
+
+	// sends aren't limited without rate limits
+	require.Empty(t, app.BankKeeper.GetSendRateLimits(ctx))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 200))))
+
+	limits := types.SendRateLimits{types.NewSendRateLimit("foocoin", sdk.NewInt(100), time.Minute)}
+	app.BankKeeper.SetSendRateLimits(ctx, limits)
+	require.Equal(t, limits, app.BankKeeper.GetSendRateLimits(ctx))
+
+	// the bucket starts full and is drained by the sends
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 60))))
+	err := app.BankKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 50), sdk.NewInt64Coin("barcoin", 50)))
+	require.Error(t, err)
+	require.Equal(t, types.CodeSendRateLimited, err.Code())
+	require.Contains(t, err.Error(), "40foocoin available")
+
+	// the other denominations aren't limited
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 500))))
+
+	// the sends to the module accounts are exempted
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr, feeCollector, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100))))
+
+	// the bucket refills over time, up to its capacity
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1030, 0)})
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 90))))
+	require.Error(t, app.BankKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1))))
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(2000, 0)})
+	require.Error(t, app.BankKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 101))))
+	bucket, found := app.BankKeeper.(keep.BaseKeeper).GetSendBucket(ctx, addr, "foocoin")
+	require.True(t, found)
+	require.Equal(t, sdk.ZeroInt(), bucket.Tokens)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100))))
+
+	// the inputs of multi sends are limited
+	inputs := []types.Input{types.NewInput(addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 101)))}
+	outputs := []types.Output{types.NewOutput(addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 101)))}
+	err = app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	require.Error(t, err)
+	require.Equal(t, types.CodeSendRateLimited, err.Code())
+}
+
 func TestMsgSendEvents(t *testing.T) {
 	app, ctx := createTestApp(false)
 
@@ -200,7 +275,7 @@ func TestViewKeeper(t *testing.T) {
 	app, ctx := createTestApp(false)
 
 	//paramSpace := app.ParamsKeeper.Subspace(types.DefaultParamspace)
-	viewKeeper := keep.NewBaseViewKeeper(app.AccountKeeper, types.DefaultCodespace)
+	viewKeeper := keep.NewBaseViewKeeper(app.Codec(), app.GetKey(types.StoreKey), app.AccountKeeper, types.DefaultCodespace)
 
 	addr := sdk.AccAddress([]byte("addr1"))
 	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
@@ -457,3 +532,76 @@ func TestUndelegateCoins(t *testing.T) {
 	require.Equal(t, origCoins, vacc.GetCoins())
 	require.True(t, macc.GetCoins().Empty())
 }
+
+func TestHolds(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("barcoin", 10), sdk.NewInt64Coin("foocoin", 100))
+	app.BankKeeper.SetCoins(ctx, addr1, origCoins)
+
+	// holds can only be placed on spendable coins
+	holdCoins := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 60))
+	require.Error(t, app.BankKeeper.PlaceHold(ctx, addr1, "escrow", origCoins.Add(holdCoins)))
+	require.Error(t, app.BankKeeper.PlaceHold(ctx, addr1, "", holdCoins))
+	require.Error(t, app.BankKeeper.PlaceHold(ctx, addr1, "escrow", sdk.NewCoins()))
+	require.Error(t, app.BankKeeper.PlaceHold(ctx, addr2, "escrow", holdCoins))
+
+	require.NoError(t, app.BankKeeper.PlaceHold(ctx, addr1, "escrow", holdCoins))
+	require.NoError(t, app.BankKeeper.PlaceHold(ctx, addr1, "order", sdk.NewCoins(sdk.NewInt64Coin("foocoin", 30))))
+	require.Error(t, app.BankKeeper.PlaceHold(ctx, addr1, "order", sdk.NewCoins(sdk.NewInt64Coin("foocoin", 11))))
+
+	require.Equal(t, holdCoins, app.BankKeeper.GetHold(ctx, addr1, "escrow"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 90)), app.BankKeeper.GetHeldCoins(ctx, addr1))
+	require.Equal(t, origCoins, app.BankKeeper.GetCoins(ctx, addr1))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 10), sdk.NewInt64Coin("foocoin", 10)), app.BankKeeper.SpendableCoins(ctx, addr1))
+
+	// held coins can't be sent
+	require.Error(t, app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 11))))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))))
+	require.Empty(t, app.BankKeeper.SpendableCoins(ctx, addr1).AmountOf("foocoin").Int64())
+
+	// release part of a hold
+	require.Error(t, app.BankKeeper.ReleaseHold(ctx, addr1, "escrow", holdCoins.Add(holdCoins)))
+	require.Error(t, app.BankKeeper.ReleaseHold(ctx, addr1, "unknown", holdCoins))
+	require.NoError(t, app.BankKeeper.ReleaseHold(ctx, addr1, "escrow", sdk.NewCoins(sdk.NewInt64Coin("foocoin", 20))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 40)), app.BankKeeper.GetHold(ctx, addr1, "escrow"))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 20))))
+
+	// releasing all the coins of a hold removes it
+	require.NoError(t, app.BankKeeper.ReleaseHold(ctx, addr1, "escrow", sdk.NewCoins(sdk.NewInt64Coin("foocoin", 40))))
+	require.True(t, app.BankKeeper.GetHold(ctx, addr1, "escrow").Empty())
+
+	var holds []types.Hold
+	app.BankKeeper.IterateAllHolds(ctx, func(hold types.Hold) bool {
+		holds = append(holds, hold)
+		return false
+	})
+	require.Equal(t, []types.Hold{types.NewHold(addr1, "order", sdk.NewCoins(sdk.NewInt64Coin("foocoin", 30)))}, holds)
+
+	// the invariant breaks if an account holds more coins than it owns
+	invariant := keep.HeldCoinsInvariant(app.AccountKeeper, app.BankKeeper)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	app.BankKeeper.SetCoins(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 29)))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
+
+func TestHoldsDelegateCoins(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addrModule := sdk.AccAddress([]byte("moduleAcc"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrModule))
+	app.BankKeeper.SetCoins(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	require.NoError(t, app.BankKeeper.PlaceHold(ctx, addr1, "escrow", sdk.NewCoins(sdk.NewInt64Coin("stake", 70))))
+
+	// held coins can't be delegated
+	require.Error(t, app.BankKeeper.DelegateCoins(ctx, addr1, addrModule, sdk.NewCoins(sdk.NewInt64Coin("stake", 31))))
+	require.NoError(t, app.BankKeeper.DelegateCoins(ctx, addr1, addrModule, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 70)), app.BankKeeper.GetCoins(ctx, addr1))
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+)
+
+// GetSendRateLimits returns the rate limits of the sends of the denominations
+func (keeper BaseSendKeeper) GetSendRateLimits(ctx sdk.Context) types.SendRateLimits {
+	limits := types.SendRateLimits{}
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeySendRateLimits, &limits)
+	return limits
+}
+
+// SetSendRateLimits sets the rate limits of the sends of the denominations
+func (keeper BaseSendKeeper) SetSendRateLimits(ctx sdk.Context, limits types.SendRateLimits) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeySendRateLimits, &limits)
+}
+
+// GetSendBucket returns the send rate limit bucket of an account for a
+// denomination, if any
+func (keeper BaseSendKeeper) GetSendBucket(ctx sdk.Context, addr sdk.AccAddress, denom string) (bucket types.SendBucket, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.SendBucketKey(addr, denom))
+	if bz == nil {
+		return bucket, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &bucket)
+	return bucket, true
+}
+
+// SetSendBucket sets the send rate limit bucket of an account for a denomination
+func (keeper BaseSendKeeper) SetSendBucket(ctx sdk.Context, addr sdk.AccAddress, denom string, bucket types.SendBucket) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.SendBucketKey(addr, denom), keeper.cdc.MustMarshalBinaryLengthPrefixed(bucket))
+}
+
+// ConsumeSendRateLimit consumes the tokens of the send rate limit buckets of an
+// account for the rate limited coins it sends. The buckets are refilled for the
+// time elapsed since they were last updated, and start full. It returns an
+// error, leaving the buckets untouched, if any of them doesn't hold enough
+// tokens.
+func (keeper BaseSendKeeper) ConsumeSendRateLimit(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	limits := keeper.GetSendRateLimits(ctx)
+	if len(limits) == 0 {
+		return nil
+	}
+
+	blockTime := ctx.BlockHeader().Time
+	buckets := make(map[string]types.SendBucket)
+	for _, coin := range amt {
+		limit, found := limits.RateLimit(coin.Denom)
+		if !found {
+			continue
+		}
+
+		tokens := limit.Capacity
+		if bucket, found := keeper.GetSendBucket(ctx, addr, coin.Denom); found {
+			tokens = limit.Refill(bucket.Tokens, blockTime.Sub(bucket.LastUpdated))
+		}
+
+		if coin.Amount.GT(tokens) {
+			return types.ErrSendRateLimited(keeper.codespace, addr, coin, sdk.NewCoin(coin.Denom, tokens))
+		}
+
+		buckets[coin.Denom] = types.NewSendBucket(tokens.Sub(coin.Amount), blockTime)
+	}
+
+	// the coins are sorted, so are the buckets updated
+	for _, coin := range amt {
+		if bucket, ok := buckets[coin.Denom]; ok {
+			keeper.SetSendBucket(ctx, addr, coin.Denom, bucket)
+		}
+	}
+
+	return nil
+}
+
+var _ types.SendHooks = SendRateLimitHooks{}
+
+// SendRateLimitHooks are send hooks limiting the rate at which the accounts
+// send the denominations listed in the SendRateLimits parameter. The sends
+// from or to the exempted addresses, such as the module accounts, aren't rate
+// limited.
+type SendRateLimitHooks struct {
+	k           SendKeeper
+	exemptAddrs map[string]bool
+}
+
+// NewSendRateLimitHooks returns new send rate limit hooks consuming the buckets
+// of the accounts through the given keeper.
+func NewSendRateLimitHooks(k SendKeeper, exemptAddrs map[string]bool) SendRateLimitHooks {
+	return SendRateLimitHooks{k: k, exemptAddrs: exemptAddrs}
+}
+
+// BeforeSend implements the SendHooks interface. An input is rate limited
+// unless its address is exempted or all the outputs are.
+func (h SendRateLimitHooks) BeforeSend(ctx sdk.Context, inputs []types.Input, outputs []types.Output) sdk.Error {
+	exemptOutputs := true
+	for _, out := range outputs {
+		if !h.exemptAddrs[out.Address.String()] {
+			exemptOutputs = false
+			break
+		}
+	}
+	if exemptOutputs {
+		return nil
+	}
+
+	for _, in := range inputs {
+		if h.exemptAddrs[in.Address.String()] {
+			continue
+		}
+		if err := h.k.ConsumeSendRateLimit(ctx, in.Address, in.Coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,6 +12,9 @@ const (
 
 	CodeSendDisabled         sdk.CodeType = 101
 	CodeInvalidInputsOutputs sdk.CodeType = 102
+	CodeInvalidHold          sdk.CodeType = 103
+	CodeUnknownHold          sdk.CodeType = 104
+	CodeSendRateLimited      sdk.CodeType = 105
 )
 
 // ErrNoInputs is an error
@@ -27,7 +32,28 @@ func ErrInputOutputMismatch(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInputsOutputs, "sum inputs != sum outputs")
 }
 
+// ErrInvalidHold is an error
+func ErrInvalidHold(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidHold, msg)
+}
+
+// ErrUnknownHold is an error
+func ErrUnknownHold(codespace sdk.CodespaceType, addr sdk.AccAddress, holdID string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownHold, fmt.Sprintf("no hold %s placed on account %s", holdID, addr))
+}
+
 // ErrSendDisabled is an error
 func ErrSendDisabled(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSendDisabled, "send transactions are currently disabled")
 }
+
+// ErrSendDisabledDenom is an error
+func ErrSendDisabledDenom(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeSendDisabled, fmt.Sprintf("%s transfers are currently disabled", denom))
+}
+
+// ErrSendRateLimited is an error
+func ErrSendRateLimited(codespace sdk.CodespaceType, addr sdk.AccAddress, amt, available sdk.Coin) sdk.Error {
+	return sdk.NewError(codespace, CodeSendRateLimited,
+		fmt.Sprintf("account %s can't send %s over its send rate limit; %s available", addr, amt, available))
+}
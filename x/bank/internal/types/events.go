@@ -2,10 +2,14 @@ package types
 
 // bank module event types
 const (
-	EventTypeTransfer = "transfer"
+	EventTypeTransfer    = "transfer"
+	EventTypeHold        = "hold"
+	EventTypeReleaseHold = "release_hold"
 
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySender    = "sender"
+	AttributeKeyAccount   = "account"
+	AttributeKeyHoldID    = "hold_id"
 
 	AttributeValueCategory = ModuleName
 )
@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // AccountKeeper defines the account contract that must be fulfilled when
@@ -15,4 +16,13 @@ type AccountKeeper interface {
 	SetAccount(ctx sdk.Context, acc exported.Account)
 
 	IterateAccounts(ctx sdk.Context, process func(exported.Account) bool)
+
+	GetParams(ctx sdk.Context) authtypes.Params
+}
+
+// SendHooks event hooks for the coins sent between accounts
+type SendHooks interface {
+	// BeforeSend is called before the coins of the inputs are sent to the
+	// outputs, the send being aborted if it returns an error.
+	BeforeSend(ctx sdk.Context, inputs []Input, outputs []Output) sdk.Error
 }
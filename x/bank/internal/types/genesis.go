@@ -1,18 +1,51 @@
 package types
 
+import (
+	"fmt"
+)
+
 // GenesisState is the bank state that must be provided at genesis.
 type GenesisState struct {
-	SendEnabled bool `json:"send_enabled" yaml:"send_enabled"`
+	SendEnabled       bool              `json:"send_enabled" yaml:"send_enabled"`
+	SendEnabledDenoms SendEnabledDenoms `json:"send_enabled_denoms,omitempty" yaml:"send_enabled_denoms,omitempty"`
+	SendRateLimits    SendRateLimits    `json:"send_rate_limits,omitempty" yaml:"send_rate_limits,omitempty"`
+	Holds             []Hold            `json:"holds" yaml:"holds"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled}
+func NewGenesisState(sendEnabled bool, holds []Hold) GenesisState {
+	return GenesisState{
+		SendEnabled: sendEnabled,
+		Holds:       holds,
+	}
 }
 
 // DefaultGenesisState returns a default genesis state
-func DefaultGenesisState() GenesisState { return NewGenesisState(true) }
+func DefaultGenesisState() GenesisState { return NewGenesisState(true, []Hold{}) }
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	if err := data.SendEnabledDenoms.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.SendRateLimits.Validate(); err != nil {
+		return err
+	}
+
+	seenHolds := make(map[string]bool)
+	for _, hold := range data.Holds {
+		if err := hold.Validate(); err != nil {
+			return err
+		}
+
+		key := string(HoldKey(hold.Address, hold.ID))
+		if seenHolds[key] {
+			return fmt.Errorf("duplicate hold %s placed on account %s", hold.ID, hold.Address)
+		}
+		seenHolds[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
+
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+	require.NoError(t, ValidateGenesis(NewGenesisState(true, []Hold{
+		NewHold(addr, "escrow", coins), NewHold(addr, "order", coins),
+	})))
+
+	invalidHolds := [][]Hold{
+		{NewHold(nil, "escrow", coins)},
+		{NewHold(addr, "", coins)},
+		{NewHold(addr, strings.Repeat("a", MaxHoldIDLength+1), coins)},
+		{NewHold(addr, "escrow", sdk.NewCoins())},
+		{NewHold(addr, "escrow", sdk.Coins{sdk.NewInt64Coin("foocoin", 0)})},
+		{NewHold(addr, "escrow", coins), NewHold(addr, "escrow", coins)},
+	}
+	for i, holds := range invalidHolds {
+		require.Error(t, ValidateGenesis(NewGenesisState(true, holds)), "test case #%d", i)
+	}
+}
+
+func TestValidateGenesisSendEnabledDenoms(t *testing.T) {
+	genState := DefaultGenesisState()
+	genState.SendEnabledDenoms = SendEnabledDenoms{NewSendEnabled("foocoin", false), NewSendEnabled("barcoin", true)}
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.SendEnabledDenoms = SendEnabledDenoms{NewSendEnabled("FOO COIN", false)}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.SendEnabledDenoms = SendEnabledDenoms{NewSendEnabled("foocoin", false), NewSendEnabled("foocoin", true)}
+	require.Error(t, ValidateGenesis(genState))
+}
+
+func TestValidateGenesisSendRateLimits(t *testing.T) {
+	genState := DefaultGenesisState()
+	genState.SendRateLimits = SendRateLimits{NewSendRateLimit("foocoin", sdk.NewInt(100), time.Hour)}
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.SendRateLimits = SendRateLimits{NewSendRateLimit("foocoin", sdk.ZeroInt(), time.Hour)}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.SendRateLimits = SendRateLimits{NewSendRateLimit("foocoin", sdk.NewInt(100), 0)}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.SendRateLimits = SendRateLimits{
+		NewSendRateLimit("foocoin", sdk.NewInt(100), time.Hour),
+		NewSendRateLimit("foocoin", sdk.NewInt(10), time.Hour),
+	}
+	require.Error(t, ValidateGenesis(genState))
+}
+
+func TestSendRateLimitRefill(t *testing.T) {
+	limit := NewSendRateLimit("foocoin", sdk.NewInt(100), time.Minute)
+	require.Equal(t, sdk.NewInt(10), limit.Refill(sdk.NewInt(10), 0))
+	require.Equal(t, sdk.NewInt(60), limit.Refill(sdk.NewInt(10), 30*time.Second))
+	require.Equal(t, sdk.NewInt(100), limit.Refill(sdk.NewInt(90), 30*time.Second))
+	require.Equal(t, sdk.NewInt(100), limit.Refill(sdk.ZeroInt(), time.Hour))
+}
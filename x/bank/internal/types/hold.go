@@ -0,0 +1,66 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxHoldIDLength is the maximum length of a hold ID
+const MaxHoldIDLength = 128
+
+// Hold defines an amount of coins of an account locked under a hold ID. Held
+// coins stay in the account but can't be spent until the hold is released.
+type Hold struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	ID      string         `json:"id" yaml:"id"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewHold creates a new Hold instance
+func NewHold(addr sdk.AccAddress, id string, amount sdk.Coins) Hold {
+	return Hold{
+		Address: addr,
+		ID:      id,
+		Amount:  amount,
+	}
+}
+
+// String implements the Stringer interface
+func (h Hold) String() string {
+	return fmt.Sprintf(`Hold:
+  Address: %s
+  ID:      %s
+  Amount:  %s`, h.Address, h.ID, h.Amount)
+}
+
+// Validate performs a stateless validation of the hold
+func (h Hold) Validate() error {
+	if h.Address.Empty() {
+		return errors.New("hold address cannot be empty")
+	}
+
+	if err := ValidateHoldID(h.ID); err != nil {
+		return err
+	}
+
+	if !h.Amount.IsValid() || h.Amount.Empty() {
+		return fmt.Errorf("invalid amount %s held by %s under %s", h.Amount, h.Address, h.ID)
+	}
+
+	return nil
+}
+
+// ValidateHoldID checks that a hold ID is neither empty nor too long
+func ValidateHoldID(id string) error {
+	if len(id) == 0 {
+		return errors.New("hold ID cannot be empty")
+	}
+
+	if len(id) > MaxHoldIDLength {
+		return fmt.Errorf("hold ID length %d exceeds the maximum of %d", len(id), MaxHoldIDLength)
+	}
+
+	return nil
+}
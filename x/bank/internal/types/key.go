@@ -1,7 +1,59 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// module name
-	ModuleName   = "bank"
+	ModuleName = "bank"
+
+	// StoreKey is the default store key for bank
+	StoreKey = ModuleName
+
 	QuerierRoute = ModuleName
 )
+
+// Keys for bank store
+// Items are stored with the following key: values
+//
+// - 0x00<accAddrLen (1 Byte)><accAddr_Bytes><holdID_Bytes>: sdk.Coins
+//
+// - 0x01<accAddrLen (1 Byte)><accAddr_Bytes><denom_Bytes>: SendBucket
+var (
+	HoldKeyPrefix       = []byte{0x00} // prefix for the coins held by accounts
+	SendBucketKeyPrefix = []byte{0x01} // prefix for the send rate limit buckets of accounts
+)
+
+// HoldsKey returns the prefix of the keys of the holds placed on an account
+func HoldsKey(addr sdk.AccAddress) []byte {
+	key := append([]byte{}, HoldKeyPrefix...)
+	key = append(key, byte(len(addr)))
+	return append(key, addr.Bytes()...)
+}
+
+// HoldKey returns the key of a hold placed on an account
+func HoldKey(addr sdk.AccAddress, holdID string) []byte {
+	return append(HoldsKey(addr), []byte(holdID)...)
+}
+
+// SplitHoldKey returns the account address and the hold ID of a hold key
+func SplitHoldKey(key []byte) (sdk.AccAddress, string) {
+	if len(key) < 2 || len(key) < 2+int(key[1]) {
+		panic(fmt.Sprintf("unexpected key length %d", len(key)))
+	}
+
+	addrLen := int(key[1])
+	return sdk.AccAddress(key[2 : 2+addrLen]), string(key[2+addrLen:])
+}
+
+// SendBucketKey returns the key of the send rate limit bucket of an account for
+// a denomination
+func SendBucketKey(addr sdk.AccAddress, denom string) []byte {
+	key := append([]byte{}, SendBucketKeyPrefix...)
+	key = append(key, byte(len(addr)))
+	key = append(key, addr.Bytes()...)
+	return append(key, []byte(denom)...)
+}
@@ -7,6 +7,12 @@ import (
 // RouterKey is they name of the bank module
 const RouterKey = ModuleName
 
+// bank message types
+const (
+	TypeMsgSend      = "send"
+	TypeMsgMultiSend = "multisend"
+)
+
 // MsgSend - high level transaction of the coin module
 type MsgSend struct {
 	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
@@ -25,7 +31,7 @@ func NewMsgSend(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins) MsgSend {
 func (msg MsgSend) Route() string { return RouterKey }
 
 // Type Implements Msg.
-func (msg MsgSend) Type() string { return "send" }
+func (msg MsgSend) Type() string { return TypeMsgSend }
 
 // ValidateBasic Implements Msg.
 func (msg MsgSend) ValidateBasic() sdk.Error {
@@ -71,7 +77,7 @@ func NewMsgMultiSend(in []Input, out []Output) MsgMultiSend {
 func (msg MsgMultiSend) Route() string { return RouterKey }
 
 // Type Implements Msg
-func (msg MsgMultiSend) Type() string { return "multisend" }
+func (msg MsgMultiSend) Type() string { return TypeMsgMultiSend }
 
 // ValidateBasic Implements Msg.
 func (msg MsgMultiSend) ValidateBasic() sdk.Error {
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -11,12 +14,89 @@ const (
 	DefaultSendEnabled = true
 )
 
-// ParamStoreKeySendEnabled is store's key for SendEnabled
-var ParamStoreKeySendEnabled = []byte("sendenabled")
+var (
+	// ParamStoreKeySendEnabled is store's key for SendEnabled
+	ParamStoreKeySendEnabled = []byte("sendenabled")
+	// ParamStoreKeySendEnabledDenoms is store's key for SendEnabledDenoms
+	ParamStoreKeySendEnabledDenoms = []byte("sendenableddenoms")
+	// ParamStoreKeySendRateLimits is store's key for SendRateLimits
+	ParamStoreKeySendRateLimits = []byte("sendratelimits")
+)
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable(
-		ParamStoreKeySendEnabled, false,
-	)
+	return params.NewKeyTable().
+		RegisterTypeWithValidator(ParamStoreKeySendEnabled, false, validateSendEnabled).
+		RegisterTypeWithValidator(ParamStoreKeySendEnabledDenoms, SendEnabledDenoms{}, validateSendEnabledDenoms).
+		RegisterTypeWithValidator(ParamStoreKeySendRateLimits, SendRateLimits{}, validateSendRateLimits)
+}
+
+func validateSendEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSendEnabledDenoms(i interface{}) error {
+	v, ok := i.(SendEnabledDenoms)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
+func validateSendRateLimits(i interface{}) error {
+	v, ok := i.(SendRateLimits)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
+// SendEnabled enables or disables the transfers of a single denomination,
+// overriding the SendEnabled parameter for it.
+type SendEnabled struct {
+	Denom   string `json:"denom" yaml:"denom"`
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+}
+
+// NewSendEnabled creates a new SendEnabled instance
+func NewSendEnabled(denom string, enabled bool) SendEnabled {
+	return SendEnabled{
+		Denom:   denom,
+		Enabled: enabled,
+	}
+}
+
+// SendEnabledDenoms is the list of the denominations whose transfers are
+// enabled or disabled regardless of the SendEnabled parameter.
+type SendEnabledDenoms []SendEnabled
+
+// Validate checks that the denominations are valid and set at most once.
+func (s SendEnabledDenoms) Validate() error {
+	seen := make(map[string]bool)
+	for _, se := range s {
+		if !(sdk.Coin{Denom: se.Denom, Amount: sdk.ZeroInt()}).IsValid() {
+			return fmt.Errorf("invalid send enabled denom %q", se.Denom)
+		}
+		if seen[se.Denom] {
+			return fmt.Errorf("duplicate send enabled denom %s", se.Denom)
+		}
+		seen[se.Denom] = true
+	}
+
+	return nil
+}
+
+// IsSendEnabled returns whether the transfers of the denomination are enabled
+// and whether it is set at all, in which case it overrides SendEnabled.
+func (s SendEnabledDenoms) IsSendEnabled(denom string) (enabled, found bool) {
+	for _, se := range s {
+		if se.Denom == denom {
+			return se.Enabled, true
+		}
+	}
+
+	return false, false
 }
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRateLimit limits the amount of a denomination an account can send with a
+// token bucket: the bucket of an account holds up to Capacity tokens, each sent
+// coin consumes one of them, and an empty bucket refills over RefillPeriod.
+type SendRateLimit struct {
+	Denom        string        `json:"denom" yaml:"denom"`
+	Capacity     sdk.Int       `json:"capacity" yaml:"capacity"`
+	RefillPeriod time.Duration `json:"refill_period" yaml:"refill_period"`
+}
+
+// NewSendRateLimit creates a new SendRateLimit instance
+func NewSendRateLimit(denom string, capacity sdk.Int, refillPeriod time.Duration) SendRateLimit {
+	return SendRateLimit{
+		Denom:        denom,
+		Capacity:     capacity,
+		RefillPeriod: refillPeriod,
+	}
+}
+
+// Refill returns the tokens of a bucket holding the given tokens once refilled
+// for the elapsed time, up to the capacity.
+func (l SendRateLimit) Refill(tokens sdk.Int, elapsed time.Duration) sdk.Int {
+	if elapsed <= 0 {
+		return tokens
+	}
+	if elapsed >= l.RefillPeriod {
+		return l.Capacity
+	}
+
+	refilled := tokens.Add(l.Capacity.MulRaw(int64(elapsed)).QuoRaw(int64(l.RefillPeriod)))
+	if refilled.GT(l.Capacity) {
+		return l.Capacity
+	}
+	return refilled
+}
+
+// SendRateLimits is the list of the denominations whose sends are rate limited
+// per account.
+type SendRateLimits []SendRateLimit
+
+// Validate checks that the denominations are valid and limited at most once,
+// with a positive capacity and refill period.
+func (s SendRateLimits) Validate() error {
+	seen := make(map[string]bool)
+	for _, l := range s {
+		if !(sdk.Coin{Denom: l.Denom, Amount: sdk.ZeroInt()}).IsValid() {
+			return fmt.Errorf("invalid send rate limit denom %q", l.Denom)
+		}
+		if seen[l.Denom] {
+			return fmt.Errorf("duplicate send rate limit denom %s", l.Denom)
+		}
+		seen[l.Denom] = true
+
+		if (l.Capacity == sdk.Int{}) || !l.Capacity.IsPositive() {
+			return fmt.Errorf("send rate limit capacity of %s must be positive, is %s", l.Denom, l.Capacity)
+		}
+		if l.RefillPeriod <= 0 {
+			return fmt.Errorf("send rate limit refill period of %s must be positive, is %s", l.Denom, l.RefillPeriod)
+		}
+	}
+
+	return nil
+}
+
+// RateLimit returns the rate limit of the sends of a denomination, if any.
+func (s SendRateLimits) RateLimit(denom string) (limit SendRateLimit, found bool) {
+	for _, l := range s {
+		if l.Denom == denom {
+			return l, true
+		}
+	}
+
+	return SendRateLimit{}, false
+}
+
+// SendBucket is the token bucket of an account for a rate limited denomination,
+// holding its tokens when it was last updated.
+type SendBucket struct {
+	Tokens      sdk.Int   `json:"tokens" yaml:"tokens"`
+	LastUpdated time.Time `json:"last_updated" yaml:"last_updated"`
+}
+
+// NewSendBucket creates a new SendBucket instance
+func NewSendBucket(tokens sdk.Int, lastUpdated time.Time) SendBucket {
+	return SendBucket{
+		Tokens:      tokens,
+		LastUpdated: lastUpdated,
+	}
+}
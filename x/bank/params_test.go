@@ -0,0 +1,35 @@
+package bank_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func TestParamChangeProposalValidation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	hdlr := params.NewParamChangeProposalHandler(app.ParamsKeeper)
+
+	invalid := []params.ParamChange{
+		params.NewParamChange(bank.DefaultParamspace, string(bank.ParamStoreKeySendEnabledDenoms), `[{"denom":"x","enabled":false}]`),
+		params.NewParamChange(bank.DefaultParamspace, string(bank.ParamStoreKeySendEnabledDenoms), `[{"denom":"stake","enabled":false},{"denom":"stake","enabled":true}]`),
+		params.NewParamChange(bank.DefaultParamspace, string(bank.ParamStoreKeySendRateLimits), `[{"denom":"stake","capacity":"0","refill_period":"1000000000"}]`),
+		params.NewParamChange(bank.DefaultParamspace, string(bank.ParamStoreKeySendRateLimits), `[{"denom":"stake","capacity":"10","refill_period":"0"}]`),
+	}
+	for _, pc := range invalid {
+		require.Error(t, hdlr(ctx, params.NewParameterChangeProposal("Test", "description", []params.ParamChange{pc})), pc.Value)
+	}
+
+	require.Empty(t, app.BankKeeper.GetSendEnabledDenoms(ctx))
+	require.Empty(t, app.BankKeeper.GetSendRateLimits(ctx))
+
+	pc := params.NewParamChange(bank.DefaultParamspace, string(bank.ParamStoreKeySendEnabledDenoms), `[{"denom":"stake","enabled":false}]`)
+	require.NoError(t, hdlr(ctx, params.NewParameterChangeProposal("Test", "description", []params.ParamChange{pc})))
+	require.Equal(t, bank.SendEnabledDenoms{bank.NewSendEnabled("stake", false)}, app.BankKeeper.GetSendEnabledDenoms(ctx))
+}
@@ -0,0 +1,25 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding bank type
+func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.HoldKeyPrefix):
+		var holdA, holdB sdk.Coins
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &holdA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &holdB)
+		return fmt.Sprintf("%v\n%v", holdA, holdB)
+	default:
+		panic(fmt.Sprintf("invalid bank key prefix %X", kvA.Key[:1]))
+	}
+}
@@ -13,7 +13,8 @@ import (
 
 // Simulation parameter constants
 const (
-	SendEnabled = "send_enabled"
+	SendEnabled       = "send_enabled"
+	SendEnabledDenoms = "send_enabled_denoms"
 )
 
 // GenSendEnabled randomized SendEnabled
@@ -21,6 +22,15 @@ func GenSendEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of transfers being enabled
 }
 
+// GenSendEnabledDenoms randomized SendEnabledDenoms
+func GenSendEnabledDenoms(r *rand.Rand, bondDenom string) types.SendEnabledDenoms {
+	// 10% chance of overriding SendEnabled for the bond denom
+	if r.Int63n(101) > 10 {
+		return types.SendEnabledDenoms{}
+	}
+	return types.SendEnabledDenoms{types.NewSendEnabled(bondDenom, GenSendEnabled(r))}
+}
+
 // RandomizedGenState generates a random GenesisState for bank
 func RandomizedGenState(simState *module.SimulationState) {
 	var sendEnabled bool
@@ -29,7 +39,14 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { sendEnabled = GenSendEnabled(r) },
 	)
 
-	bankGenesis := types.NewGenesisState(sendEnabled)
+	var sendEnabledDenoms types.SendEnabledDenoms
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SendEnabledDenoms, &sendEnabledDenoms, simState.Rand,
+		func(r *rand.Rand) { sendEnabledDenoms = GenSendEnabledDenoms(r, simState.BondDenom) },
+	)
+
+	bankGenesis := types.NewGenesisState(sendEnabled, []types.Hold{})
+	bankGenesis.SendEnabledDenoms = sendEnabledDenoms
 
 	fmt.Printf("Selected randomly generated bank parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, bankGenesis))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(bankGenesis)
@@ -7,6 +7,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/keeper"
@@ -14,6 +15,45 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
+// Simulation operation weights constants
+const (
+	OpWeightMsgSend         = "op_weight_msg_send"
+	OpWeightMsgMultiSend    = "op_weight_msg_multisend"
+	OpWeightMsgMultisigSend = "op_weight_msg_multisig_send"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, ak types.AccountKeeper,
+	bk keeper.Keeper) simulation.WeightedOperations {
+
+	var weightMsgSend int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
+		func(_ *rand.Rand) {
+			weightMsgSend = 100
+		},
+	)
+
+	var weightMsgMultiSend int
+	appParams.GetOrGenerate(cdc, OpWeightMsgMultiSend, &weightMsgMultiSend, nil,
+		func(_ *rand.Rand) {
+			weightMsgMultiSend = 40
+		},
+	)
+
+	var weightMsgMultisigSend int
+	appParams.GetOrGenerate(cdc, OpWeightMsgMultisigSend, &weightMsgMultisigSend, nil,
+		func(_ *rand.Rand) {
+			weightMsgMultisigSend = 20
+		},
+	)
+
+	return simulation.WeightedOperations{
+		{Weight: weightMsgSend, Op: SimulateMsgSend(ak, bk)},
+		{Weight: weightMsgMultiSend, Op: SimulateMsgMultiSend(ak, bk)},
+		{Weight: weightMsgMultisigSend, Op: SimulateMsgMultisigSend(ak, bk)},
+	}
+}
+
 // SimulateMsgSend tests and runs a single msg send where both
 // accounts already exist.
 // nolint: funlen
@@ -23,24 +63,24 @@ func SimulateMsgSend(ak types.AccountKeeper, bk keeper.Keeper) simulation.Operat
 		accs []simulation.Account, chainID string,
 	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
 
-		if !bk.GetSendEnabled(ctx) {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
-		}
-
 		simAccount, toSimAcc, coins, skip, err := randomSendFields(r, ctx, accs, ak)
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSend, ""), nil, err
 		}
 
 		if skip {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSend, "no coins to send"), nil, nil
+		}
+
+		if err := bk.IsSendEnabledCoins(ctx, coins...); err != nil {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSend, "transfers are not enabled"), nil, nil
 		}
 
 		msg := types.NewMsgSend(simAccount.Address, toSimAcc.Address, coins)
 
 		err = sendMsgSend(r, app, ak, msg, ctx, chainID, []crypto.PrivKey{simAccount.PrivKey})
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSend, ""), nil, err
 		}
 
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
@@ -74,6 +114,10 @@ func sendMsgSend(
 		privkeys...,
 	)
 
+	if err := simulation.CheckUnderpricedTx(app, ctx, tx); err != nil {
+		return err
+	}
+
 	res := app.Deliver(tx)
 	if !res.IsOK() {
 		return errors.New(res.Log)
@@ -82,7 +126,64 @@ func sendMsgSend(
 	return nil
 }
 
-// SimulateMsgMultiSend tests and runs a single msg multisend, with randomized, capped number of inputs/outputs.
+// SimulateMsgMultisigSend tests and runs a single msg send from a random
+// threshold multisig account whose keys are held by simulation accounts. The
+// multisig account is funded by a random account beforehand.
+// nolint: funlen
+func SimulateMsgMultisigSend(ak types.AccountKeeper, bk keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		multisigAcc := simulation.RandomMultisigAccount(r, accs)
+
+		// fund the multisig account
+		funder, _, coins, skip, err := randomSendFields(r, ctx, accs, ak)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSend, ""), nil, err
+		}
+
+		if skip {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSend, "no coins to fund the multisig account"), nil, nil
+		}
+
+		if err := bk.IsSendEnabledCoins(ctx, coins...); err != nil {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSend, "transfers are not enabled"), nil, nil
+		}
+
+		fundMsg := types.NewMsgSend(funder.Address, multisigAcc.Address, coins)
+		err = sendMsgSend(r, app, ak, fundMsg, ctx, chainID, []crypto.PrivKey{funder.PrivKey})
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSend, ""), nil, err
+		}
+
+		// send coins from the multisig account, signed by threshold of its keys
+		toSimAcc, _ := simulation.RandomAcc(r, accs)
+		spendable := ak.GetAccount(ctx, multisigAcc.Address).SpendableCoins(ctx.BlockTime())
+
+		sendCoins := simulation.RandSubsetCoins(r, ctx, spendable)
+		if sendCoins.Empty() {
+			sendCoins = spendable
+		}
+
+		msg := types.NewMsgSend(multisigAcc.Address, toSimAcc.Address, sendCoins)
+		err = sendMsgSend(r, app, ak, msg, ctx, chainID, []crypto.PrivKey{multisigAcc.PrivKey})
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSend, ""), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, "multisig"), nil, nil
+	}
+}
+
+// maxMultiSendParties is the maximum number of inputs or outputs of a
+// simulated MsgMultiSend.
+const maxMultiSendParties = 10
+
+// SimulateMsgMultiSend tests and runs a single msg multisend, with a random
+// shape: a few inputs and outputs, many inputs to a single output or a single
+// input to many outputs.
 // all accounts in msg fields exist in state
 // nolint: funlen
 func SimulateMsgMultiSend(ak types.AccountKeeper, bk keeper.Keeper) simulation.Operation {
@@ -91,75 +192,61 @@ func SimulateMsgMultiSend(ak types.AccountKeeper, bk keeper.Keeper) simulation.O
 		accs []simulation.Account, chainID string,
 	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
 
-		if !bk.GetSendEnabled(ctx) {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		// inputs use distinct accounts and each input is a signer
+		maxInputs := len(accs)
+		if sigLimit := int(ak.GetParams(ctx).TxSigLimit); sigLimit < maxInputs {
+			maxInputs = sigLimit
 		}
 
-		// random number of inputs/outputs between [1, 3]
-		inputs := make([]types.Input, r.Intn(3)+1)
-		outputs := make([]types.Output, r.Intn(3)+1)
+		numInputs, numOutputs, shape := randomMultiSendShape(r, maxInputs)
 
-		// collect signer privKeys
-		privs := make([]crypto.PrivKey, len(inputs))
+		inputs := make([]types.Input, 0, numInputs)
 
-		// use map to check if address already exists as input
-		usedAddrs := make(map[string]bool)
+		// collect signer privKeys
+		privs := make([]crypto.PrivKey, 0, numInputs)
 
+		// pick distinct input accounts with coins to send
 		var totalSentCoins sdk.Coins
-		for i := range inputs {
-			// generate random input fields, ignore to address
-			simAccount, _, coins, skip, err := randomSendFields(r, ctx, accs, ak)
-
-			// make sure account is fresh and not used in previous input
-			for usedAddrs[simAccount.Address.String()] {
-				simAccount, _, coins, skip, err = randomSendFields(r, ctx, accs, ak)
+		for _, idx := range r.Perm(len(accs)) {
+			if len(inputs) == numInputs {
+				break
 			}
 
-			if err != nil {
-				return simulation.NoOpMsg(types.ModuleName), nil, err
+			simAccount := accs[idx]
+			acc := ak.GetAccount(ctx, simAccount.Address)
+			if acc == nil {
+				continue
 			}
-			if skip {
-				return simulation.NoOpMsg(types.ModuleName), nil, nil
-			}
-
-			// set input address in used address map
-			usedAddrs[simAccount.Address.String()] = true
 
-			// set signer privkey
-			privs[i] = simAccount.PrivKey
+			coins := simulation.RandSubsetCoins(r, ctx, acc.SpendableCoins(ctx.BlockHeader().Time))
+			if coins.Empty() {
+				continue
+			}
 
-			// set next input and accumulate total sent coins
-			inputs[i] = types.NewInput(simAccount.Address, coins)
+			// set signer privkey, next input and accumulate total sent coins
+			privs = append(privs, simAccount.PrivKey)
+			inputs = append(inputs, types.NewInput(simAccount.Address, coins))
 			totalSentCoins = totalSentCoins.Add(coins)
 		}
 
-		for o := range outputs {
-			outAddr, _ := simulation.RandomAcc(r, accs)
-
-			var outCoins sdk.Coins
-			// split total sent coins into random subsets for output
-			if o == len(outputs)-1 {
-				outCoins = totalSentCoins
-			} else {
-				// take random subset of remaining coins for output
-				// and update remaining coins
-				outCoins = simulation.RandSubsetCoins(r, totalSentCoins)
-				totalSentCoins = totalSentCoins.Sub(outCoins)
-			}
+		if len(inputs) == 0 {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgMultiSend, "no coins to send"), nil, nil
+		}
 
-			outputs[o] = types.NewOutput(outAddr.Address, outCoins)
+		if err := bk.IsSendEnabledCoins(ctx, totalSentCoins...); err != nil {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgMultiSend, "transfers are not enabled"), nil, nil
 		}
 
-		// remove any output that has no coins
-		i := 0
-		for i < len(outputs) {
-			if outputs[i].Coins.Empty() {
-				outputs[i] = outputs[len(outputs)-1]
-				outputs = outputs[:len(outputs)-1]
-			} else {
-				// continue onto next coin
-				i++
+		// split total sent coins into random parts for the outputs, removing
+		// any output that has no coins
+		outputs := make([]types.Output, 0, numOutputs)
+		for _, outCoins := range splitCoins(r, ctx, totalSentCoins, numOutputs) {
+			if outCoins.Empty() {
+				continue
 			}
+
+			outAddr, _ := simulation.RandomAcc(r, accs)
+			outputs = append(outputs, types.NewOutput(outAddr.Address, outCoins))
 		}
 
 		msg := types.MsgMultiSend{
@@ -169,13 +256,66 @@ func SimulateMsgMultiSend(ak types.AccountKeeper, bk keeper.Keeper) simulation.O
 
 		err := sendMsgMultiSend(r, app, ak, msg, ctx, chainID, privs)
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgMultiSend, ""), nil, err
 		}
 
-		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+		return simulation.NewOperationMsg(msg, true, shape), nil, nil
 	}
 }
 
+// randomMultiSendShape returns the random number of inputs and outputs of a
+// MsgMultiSend along with a description of its shape, with at most maxInputs
+// inputs.
+func randomMultiSendShape(r *rand.Rand, maxInputs int) (numInputs, numOutputs int, shape string) {
+	maxParties := maxMultiSendParties
+	if maxInputs < maxParties {
+		maxParties = maxInputs
+	}
+
+	switch {
+	case maxParties < 2:
+		return 1, 1, "one to one"
+
+	case r.Intn(3) == 0:
+		return simulation.RandIntBetween(r, 2, maxParties+1), 1, "many to one"
+
+	case r.Intn(2) == 0:
+		return 1, simulation.RandIntBetween(r, 2, maxMultiSendParties+1), "one to many"
+
+	default:
+		// random number of inputs/outputs between [1, 3]
+		if maxParties > 3 {
+			maxParties = 3
+		}
+		return r.Intn(maxParties) + 1, r.Intn(3) + 1, "few to few"
+	}
+}
+
+// splitCoins splits the coins into n random parts, with the last part holding
+// the remainder. Parts may be empty.
+func splitCoins(r *rand.Rand, ctx sdk.Context, coins sdk.Coins, n int) []sdk.Coins {
+	parts := make([]sdk.Coins, n)
+
+	remaining := coins
+	for i := 0; i < n-1; i++ {
+		var part sdk.Coins
+		for _, coin := range remaining {
+			// on average, share the remaining amount evenly between the
+			// remaining parts
+			amt := simulation.RandomAmount(r, ctx, coin.Amount.MulRaw(2).QuoRaw(int64(n-i)))
+			if amt.IsPositive() {
+				part = append(part, sdk.NewCoin(coin.Denom, amt))
+			}
+		}
+
+		parts[i] = part
+		remaining = remaining.Sub(part)
+	}
+
+	parts[n-1] = remaining
+	return parts
+}
+
 // sendMsgMultiSend sends a transaction with a MsgMultiSend from a provided random
 // account.
 func sendMsgMultiSend(
@@ -214,6 +354,10 @@ func sendMsgMultiSend(
 		privkeys...,
 	)
 
+	if err := simulation.CheckUnderpricedTx(app, ctx, tx); err != nil {
+		return err
+	}
+
 	res := app.Deliver(tx)
 	if !res.IsOK() {
 		return errors.New(res.Log)
@@ -243,7 +387,7 @@ func randomSendFields(
 
 	coins := acc.SpendableCoins(ctx.BlockHeader().Time)
 
-	sendCoins := simulation.RandSubsetCoins(r, coins)
+	sendCoins := simulation.RandSubsetCoins(r, ctx, coins)
 	if sendCoins.Empty() {
 		return simAccount, toSimAcc, nil, true, nil // skip error
 	}
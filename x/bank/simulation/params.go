@@ -13,7 +13,8 @@ import (
 const keySendEnabled = "sendenabled"
 
 // ParamChanges defines the parameters that can be modified by param change proposals
-// on the simulation
+// on the simulation. SendEnabledDenoms is left out as the bond denom of the
+// simulation isn't known here.
 func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 	return []simulation.ParamChange{
 		simulation.NewSimParamChange(types.ModuleName, keySendEnabled, "",
@@ -10,10 +10,11 @@ import (
 )
 
 const (
-	DefaultCodespace  = types.DefaultCodespace
-	CodeInvalidInput  = types.CodeInvalidInput
-	ModuleName        = types.ModuleName
-	DefaultParamspace = types.DefaultParamspace
+	DefaultCodespace    = types.DefaultCodespace
+	CodeInvalidInput    = types.CodeInvalidInput
+	ModuleName          = types.ModuleName
+	DefaultParamspace   = types.DefaultParamspace
+	MaxDumpStoreEntries = types.MaxDumpStoreEntries
 
 	EventTypeInvariant   = types.EventTypeInvariant
 	AttributeValueCrisis = types.AttributeValueCrisis
@@ -30,6 +31,7 @@ var (
 	NewMsgVerifyInvariant = types.NewMsgVerifyInvariant
 	ParamKeyTable         = types.ParamKeyTable
 	NewInvarRoute         = types.NewInvarRoute
+	NewInvariantDump      = types.NewInvariantDump
 	NewKeeper             = keeper.NewKeeper
 
 	// variable aliases
@@ -41,5 +43,8 @@ type (
 	GenesisState       = types.GenesisState
 	MsgVerifyInvariant = types.MsgVerifyInvariant
 	InvarRoute         = types.InvarRoute
+	InvariantDump      = types.InvariantDump
+	StoreDump          = types.StoreDump
+	StorePair          = types.StorePair
 	Keeper             = keeper.Keeper
 )
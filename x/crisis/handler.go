@@ -42,9 +42,11 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg types.MsgVerifyInvariant, k k
 
 	var res string
 	var stop bool
+	var route types.InvarRoute
 	for _, invarRoute := range k.Routes() {
 		if invarRoute.FullRoute() == msgFullRoute {
 			res, stop = invarRoute.Invar(cacheCtx)
+			route = invarRoute
 			found = true
 			break
 		}
@@ -70,6 +72,8 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg types.MsgVerifyInvariant, k k
 		//"WARNING: insufficient funds to allocate to sender from fee pool, err: %s", err))
 		//}
 
+		k.LogBrokenInvariantDump(cacheCtx, route, res)
+
 		// TODO replace with circuit breaker
 		panic(res)
 	}
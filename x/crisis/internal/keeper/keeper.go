@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/internal/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -20,6 +24,9 @@ type Keeper struct {
 	supplyKeeper types.SupplyKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	dumpDir    string                    // directory of the broken invariant dumps
+	dumpStores map[string][]sdk.StoreKey // stores included in the dumps by module
 }
 
 // NewKeeper creates a new Keeper object
@@ -34,6 +41,7 @@ func NewKeeper(
 		invCheckPeriod:   invCheckPeriod,
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
+		dumpStores:       make(map[string][]sdk.StoreKey),
 	}
 }
 
@@ -72,11 +80,16 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 
 	for _, ir := range invarRoutes {
 		if res, stop := ir.Invar(ctx); stop {
+			var dump string
+			if path := k.LogBrokenInvariantDump(ctx, ir, res); path != "" {
+				dump = fmt.Sprintf("\n\tinvariant dump written to %s", path)
+			}
+
 			// TODO: Include app name as part of context to allow for this to be
 			// variable.
 			panic(fmt.Errorf("invariant broken: %s\n"+
 				"\tCRITICAL please submit the following transaction:\n"+
-				"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route))
+				"\t\t tx crisis invariant-broken %s %s%s", res, ir.ModuleName, ir.Route, dump))
 		}
 	}
 
@@ -86,6 +99,107 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
 }
 
+// CheckInvariants runs all registered invariants once and returns an
+// sdk.BrokenInvariantsError reporting the module and route of every broken
+// invariant, if any. Unlike AssertInvariants it does not panic.
+func (k Keeper) CheckInvariants(ctx sdk.Context) error {
+	var broken sdk.BrokenInvariantsError
+	for _, ir := range k.Routes() {
+		if res, stop := ir.Invar(ctx); stop {
+			broken = append(broken, sdk.BrokenInvariant{ModuleName: ir.ModuleName, Route: ir.Route, Message: res})
+		}
+	}
+
+	if len(broken) == 0 {
+		return nil
+	}
+
+	return broken
+}
+
+// SetInvariantDumpDir sets the directory the dumps of the broken invariants are
+// written to before the chain halts. Dumps are disabled when the directory is
+// empty, which is the default.
+func (k *Keeper) SetInvariantDumpDir(dir string) {
+	k.dumpDir = dir
+}
+
+// RegisterDumpStores registers the stores whose entries are included in the
+// dumps of the broken invariants of the given module.
+func (k *Keeper) RegisterDumpStores(moduleName string, keys ...sdk.StoreKey) {
+	k.dumpStores[moduleName] = append(k.dumpStores[moduleName], keys...)
+}
+
+// DumpBrokenInvariant writes the dump of a broken invariant, including a
+// snapshot of the stores registered for its module, and returns the path of
+// the written file. It returns an empty path when dumps are disabled.
+func (k Keeper) DumpBrokenInvariant(ctx sdk.Context, ir types.InvarRoute, msg string) (string, error) {
+	if k.dumpDir == "" {
+		return "", nil
+	}
+
+	keys := k.dumpStores[ir.ModuleName]
+	stores := make([]types.StoreDump, len(keys))
+	for i, key := range keys {
+		stores[i] = snapshotStore(ctx.KVStore(key), key.Name())
+	}
+
+	dump := types.NewInvariantDump(
+		ctx.ChainID(), ctx.BlockHeight(), ctx.BlockTime(), ir.ModuleName, ir.Route, msg, stores,
+	)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, dump)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(k.dumpDir, 0755); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(k.dumpDir, dump.FileName())
+	if err := ioutil.WriteFile(path, bz, 0644); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// LogBrokenInvariantDump writes the dump of a broken invariant and logs its
+// path, or the error preventing it from being written, as the chain halts
+// regardless. It returns the path of the written file, if any.
+func (k Keeper) LogBrokenInvariantDump(ctx sdk.Context, ir types.InvarRoute, msg string) string {
+	path, err := k.DumpBrokenInvariant(ctx, ir, msg)
+	if err != nil {
+		k.Logger(ctx).Error("failed to write invariant dump", "route", ir.FullRoute(), "err", err)
+		return ""
+	}
+
+	if path != "" {
+		k.Logger(ctx).Error("invariant dump written", "route", ir.FullRoute(), "path", path)
+	}
+	return path
+}
+
+// snapshotStore returns the first MaxDumpStoreEntries entries of the store.
+func snapshotStore(store sdk.KVStore, name string) types.StoreDump {
+	dump := types.StoreDump{Name: name, Entries: []types.StorePair{}}
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if len(dump.Entries) == types.MaxDumpStoreEntries {
+			dump.Truncated = true
+			break
+		}
+
+		dump.Entries = append(dump.Entries, types.StorePair{Key: iterator.Key(), Value: iterator.Value()})
+	}
+
+	return dump
+}
+
 // InvCheckPeriod returns the invariant checks period.
 func (k Keeper) InvCheckPeriod() uint { return k.invCheckPeriod }
 
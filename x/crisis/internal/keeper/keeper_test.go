@@ -1,12 +1,18 @@
 package keeper_test
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
 func TestLogger(t *testing.T) {
@@ -36,3 +42,61 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestCheckInvariants(t *testing.T) {
+	app := createTestApp()
+	ctx := app.NewContext(true, abci.Header{})
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute1", func(sdk.Context) (string, bool) { return "", false })
+	require.NoError(t, app.CrisisKeeper.CheckInvariants(ctx))
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "broken", true })
+	err := app.CrisisKeeper.CheckInvariants(ctx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "testModule/testRoute2: broken")
+	require.NotContains(t, err.Error(), "testRoute1")
+}
+
+func TestInvariantDump(t *testing.T) {
+	app := createTestApp()
+	ctx := app.NewContext(true, abci.Header{Height: 10, ChainID: "test-chain"})
+
+	dir, err := ioutil.TempDir("", "invariant-dump")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	route := types.NewInvarRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "broken", true })
+
+	// dumps are disabled by default
+	path, err := app.CrisisKeeper.DumpBrokenInvariant(ctx, route, "broken")
+	require.NoError(t, err)
+	require.Empty(t, path)
+
+	app.CrisisKeeper.SetInvariantDumpDir(dir)
+	app.CrisisKeeper.RegisterDumpStores("testModule", app.GetKey(supply.StoreKey))
+	app.CrisisKeeper.RegisterRoute(route.ModuleName, route.Route, route.Invar)
+
+	expectedPath := filepath.Join(dir, "invariant-10-testModule-testRoute.json")
+	var panicValue interface{}
+	func() {
+		defer func() { panicValue = recover() }()
+		app.CrisisKeeper.AssertInvariants(ctx)
+	}()
+	require.NotNil(t, panicValue)
+	require.Contains(t, fmt.Sprint(panicValue), "invariant dump written to "+expectedPath)
+
+	bz, err := ioutil.ReadFile(expectedPath)
+	require.NoError(t, err)
+
+	var dump types.InvariantDump
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &dump))
+	require.Equal(t, "test-chain", dump.ChainID)
+	require.Equal(t, int64(10), dump.Height)
+	require.Equal(t, "testModule", dump.ModuleName)
+	require.Equal(t, "testRoute", dump.Route)
+	require.Equal(t, "broken", dump.Message)
+	require.Len(t, dump.Stores, 1)
+	require.Equal(t, supply.StoreKey, dump.Stores[0].Name)
+	require.NotEmpty(t, dump.Stores[0].Entries)
+	require.False(t, dump.Stores[0].Truncated)
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// MaxDumpStoreEntries is the maximum number of entries of each store included
+// in an invariant dump.
+const MaxDumpStoreEntries = 10000
+
+// InvariantDump is the record of a broken invariant written to a file before
+// the chain halts.
+type InvariantDump struct {
+	ChainID    string      `json:"chain_id" yaml:"chain_id"`
+	Height     int64       `json:"height" yaml:"height"`
+	Time       time.Time   `json:"time" yaml:"time"`
+	ModuleName string      `json:"module_name" yaml:"module_name"`
+	Route      string      `json:"route" yaml:"route"`
+	Message    string      `json:"message" yaml:"message"`
+	Stores     []StoreDump `json:"stores" yaml:"stores"`
+}
+
+// NewInvariantDump creates a new InvariantDump instance
+func NewInvariantDump(
+	chainID string, height int64, t time.Time, moduleName, route, msg string, stores []StoreDump,
+) InvariantDump {
+
+	return InvariantDump{
+		ChainID:    chainID,
+		Height:     height,
+		Time:       t,
+		ModuleName: moduleName,
+		Route:      route,
+		Message:    msg,
+		Stores:     stores,
+	}
+}
+
+// FileName returns the name of the file the dump is written to.
+func (d InvariantDump) FileName() string {
+	route := strings.ReplaceAll(d.ModuleName+"-"+d.Route, "/", "-")
+	return fmt.Sprintf("invariant-%d-%s.json", d.Height, route)
+}
+
+// StoreDump is a snapshot of the entries of a store. Truncated is set when the
+// store holds more than MaxDumpStoreEntries entries.
+type StoreDump struct {
+	Name      string      `json:"name" yaml:"name"`
+	Entries   []StorePair `json:"entries" yaml:"entries"`
+	Truncated bool        `json:"truncated" yaml:"truncated"`
+}
+
+// StorePair is a key/value pair of a store snapshot.
+type StorePair struct {
+	Key   cmn.HexBytes `json:"key" yaml:"key"`
+	Value cmn.HexBytes `json:"value" yaml:"value"`
+}
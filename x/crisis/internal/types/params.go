@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
@@ -17,7 +19,17 @@ var (
 
 // type declaration for parameters
 func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable(
-		ParamStoreKeyConstantFee, sdk.Coin{},
-	)
+	return params.NewKeyTable().
+		RegisterTypeWithValidator(ParamStoreKeyConstantFee, sdk.Coin{}, validateConstantFee)
+}
+
+func validateConstantFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsValid() || !v.IsPositive() {
+		return fmt.Errorf("crisis parameter ConstantFee must be a valid positive coin, is %s", v)
+	}
+	return nil
 }
@@ -0,0 +1,30 @@
+package crisis_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func TestParamChangeProposalValidation(t *testing.T) {
+	app, ctx, _ := createTestApp()
+	hdlr := params.NewParamChangeProposalHandler(app.ParamsKeeper)
+
+	invalid := []params.ParamChange{
+		params.NewParamChange(crisis.DefaultParamspace, string(crisis.ParamStoreKeyConstantFee), `{"denom":"stake","amount":"0"}`),
+		params.NewParamChange(crisis.DefaultParamspace, string(crisis.ParamStoreKeyConstantFee), `{"denom":"stake","amount":"-10"}`),
+		params.NewParamChange(crisis.DefaultParamspace, string(crisis.ParamStoreKeyConstantFee), `{"denom":"X","amount":"10"}`),
+	}
+	for _, pc := range invalid {
+		require.Error(t, hdlr(ctx, params.NewParameterChangeProposal("Test", "description", []params.ParamChange{pc})), pc.Value)
+	}
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), app.CrisisKeeper.GetConstantFee(ctx))
+
+	pc := params.NewParamChange(crisis.DefaultParamspace, string(crisis.ParamStoreKeyConstantFee), `{"denom":"stake","amount":"20"}`)
+	require.NoError(t, hdlr(ctx, params.NewParameterChangeProposal("Test", "description", []params.ParamChange{pc})))
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 20), app.CrisisKeeper.GetConstantFee(ctx))
+}
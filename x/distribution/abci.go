@@ -30,3 +30,21 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
 }
+
+// maxDustPayoutsPerBlock bounds the number of dust rewards records paid out by
+// a single EndBlock
+const maxDustPayoutsPerBlock = 1000
+
+// EndBlocker pays out the batched dust rewards every DustPayoutPeriod blocks.
+// A payout exceeding maxDustPayoutsPerBlock records carries on in the following
+// blocks.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	period := k.GetDustPayoutPeriod(ctx)
+	pending := k.GetDustPayoutPending(ctx)
+	if !pending && (period <= 0 || ctx.BlockHeight()%period != 0) {
+		return
+	}
+
+	done := k.PayoutDustRewards(ctx, maxDustPayoutsPerBlock)
+	k.SetDustPayoutPending(ctx, !done)
+}
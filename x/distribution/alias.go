@@ -13,30 +13,43 @@ import (
 )
 
 const (
-	DefaultParamspace                = keeper.DefaultParamspace
-	DefaultCodespace                 = types.DefaultCodespace
-	CodeInvalidInput                 = types.CodeInvalidInput
-	CodeNoDistributionInfo           = types.CodeNoDistributionInfo
-	CodeNoValidatorCommission        = types.CodeNoValidatorCommission
-	CodeSetWithdrawAddrDisabled      = types.CodeSetWithdrawAddrDisabled
-	ModuleName                       = types.ModuleName
-	StoreKey                         = types.StoreKey
-	RouterKey                        = types.RouterKey
-	QuerierRoute                     = types.QuerierRoute
-	ProposalTypeCommunityPoolSpend   = types.ProposalTypeCommunityPoolSpend
-	QueryParams                      = types.QueryParams
-	QueryValidatorOutstandingRewards = types.QueryValidatorOutstandingRewards
-	QueryValidatorCommission         = types.QueryValidatorCommission
-	QueryValidatorSlashes            = types.QueryValidatorSlashes
-	QueryDelegationRewards           = types.QueryDelegationRewards
-	QueryDelegatorTotalRewards       = types.QueryDelegatorTotalRewards
-	QueryDelegatorValidators         = types.QueryDelegatorValidators
-	QueryWithdrawAddr                = types.QueryWithdrawAddr
-	QueryCommunityPool               = types.QueryCommunityPool
-	ParamCommunityTax                = types.ParamCommunityTax
-	ParamBaseProposerReward          = types.ParamBaseProposerReward
-	ParamBonusProposerReward         = types.ParamBonusProposerReward
-	ParamWithdrawAddrEnabled         = types.ParamWithdrawAddrEnabled
+	DefaultParamspace                  = keeper.DefaultParamspace
+	DefaultCodespace                   = types.DefaultCodespace
+	DefaultDustPayoutPeriod            = types.DefaultDustPayoutPeriod
+	CodeInvalidInput                   = types.CodeInvalidInput
+	CodeNoDistributionInfo             = types.CodeNoDistributionInfo
+	CodeNoValidatorCommission          = types.CodeNoValidatorCommission
+	CodeSetWithdrawAddrDisabled        = types.CodeSetWithdrawAddrDisabled
+	ModuleName                         = types.ModuleName
+	StoreKey                           = types.StoreKey
+	RouterKey                          = types.RouterKey
+	TypeMsgSetWithdrawAddress          = types.TypeMsgSetWithdrawAddress
+	TypeMsgSetValidatorWithdrawAddress = types.TypeMsgSetValidatorWithdrawAddress
+	TypeMsgWithdrawDelegatorReward     = types.TypeMsgWithdrawDelegatorReward
+	TypeMsgWithdrawValidatorCommission = types.TypeMsgWithdrawValidatorCommission
+	QuerierRoute                       = types.QuerierRoute
+	ProposalTypeCommunityPoolSpend     = types.ProposalTypeCommunityPoolSpend
+	QueryParams                        = types.QueryParams
+	QueryValidatorOutstandingRewards   = types.QueryValidatorOutstandingRewards
+	QueryAllOutstandingRewards         = types.QueryAllOutstandingRewards
+	QueryDelegatorRewardsByDenom       = types.QueryDelegatorRewardsByDenom
+	QueryValidatorCommission           = types.QueryValidatorCommission
+	QueryValidatorSlashes              = types.QueryValidatorSlashes
+	QueryDelegationRewards             = types.QueryDelegationRewards
+	QueryDelegatorTotalRewards         = types.QueryDelegatorTotalRewards
+	QueryDelegatorValidators           = types.QueryDelegatorValidators
+	QueryDelegatorSummary              = types.QueryDelegatorSummary
+	QueryWithdrawAddr                  = types.QueryWithdrawAddr
+	QueryDelegationWithdrawAddr        = types.QueryDelegationWithdrawAddr
+	QueryValidatorWithdrawAddrs        = types.QueryValidatorWithdrawAddrs
+	QueryCommunityPool                 = types.QueryCommunityPool
+	QueryValidatorCommissionEarnings   = types.QueryValidatorCommissionEarnings
+	ParamCommunityTax                  = types.ParamCommunityTax
+	ParamBaseProposerReward            = types.ParamBaseProposerReward
+	ParamBonusProposerReward           = types.ParamBonusProposerReward
+	ParamWithdrawAddrEnabled           = types.ParamWithdrawAddrEnabled
+	ParamDustThreshold                 = types.ParamDustThreshold
+	ParamDustPayoutPeriod              = types.ParamDustPayoutPeriod
 )
 
 var (
@@ -50,13 +63,18 @@ var (
 	NewKeeper                                  = keeper.NewKeeper
 	GetValidatorOutstandingRewardsAddress      = keeper.GetValidatorOutstandingRewardsAddress
 	GetDelegatorWithdrawInfoAddress            = keeper.GetDelegatorWithdrawInfoAddress
+	GetDelegatorValidatorWithdrawAddrAddresses = keeper.GetDelegatorValidatorWithdrawAddrAddresses
 	GetDelegatorStartingInfoAddresses          = keeper.GetDelegatorStartingInfoAddresses
 	GetValidatorHistoricalRewardsAddressPeriod = keeper.GetValidatorHistoricalRewardsAddressPeriod
 	GetValidatorCurrentRewardsAddress          = keeper.GetValidatorCurrentRewardsAddress
 	GetValidatorAccumulatedCommissionAddress   = keeper.GetValidatorAccumulatedCommissionAddress
 	GetValidatorSlashEventAddressHeight        = keeper.GetValidatorSlashEventAddressHeight
+	GetDustRewardsAddress                      = keeper.GetDustRewardsAddress
+	GetValidatorCommissionEarningsAddress      = keeper.GetValidatorCommissionEarningsAddress
 	GetValidatorOutstandingRewardsKey          = keeper.GetValidatorOutstandingRewardsKey
 	GetDelegatorWithdrawAddrKey                = keeper.GetDelegatorWithdrawAddrKey
+	GetDelegatorValidatorWithdrawAddrsPrefix   = keeper.GetDelegatorValidatorWithdrawAddrsPrefix
+	GetDelegatorValidatorWithdrawAddrKey       = keeper.GetDelegatorValidatorWithdrawAddrKey
 	GetDelegatorStartingInfoKey                = keeper.GetDelegatorStartingInfoKey
 	GetValidatorHistoricalRewardsPrefix        = keeper.GetValidatorHistoricalRewardsPrefix
 	GetValidatorHistoricalRewardsKey           = keeper.GetValidatorHistoricalRewardsKey
@@ -65,6 +83,8 @@ var (
 	GetValidatorSlashEventPrefix               = keeper.GetValidatorSlashEventPrefix
 	GetValidatorSlashEventKeyPrefix            = keeper.GetValidatorSlashEventKeyPrefix
 	GetValidatorSlashEventKey                  = keeper.GetValidatorSlashEventKey
+	GetDustRewardsKey                          = keeper.GetDustRewardsKey
+	GetValidatorCommissionEarningsKey          = keeper.GetValidatorCommissionEarningsKey
 	ParamKeyTable                              = keeper.ParamKeyTable
 	HandleCommunityPoolSpendProposal           = keeper.HandleCommunityPoolSpendProposal
 	NewQuerier                                 = keeper.NewQuerier
@@ -88,21 +108,29 @@ var (
 	DefaultGenesisState                        = types.DefaultGenesisState
 	ValidateGenesis                            = types.ValidateGenesis
 	NewMsgSetWithdrawAddress                   = types.NewMsgSetWithdrawAddress
+	NewMsgSetValidatorWithdrawAddress          = types.NewMsgSetValidatorWithdrawAddress
 	NewMsgWithdrawDelegatorReward              = types.NewMsgWithdrawDelegatorReward
 	NewMsgWithdrawValidatorCommission          = types.NewMsgWithdrawValidatorCommission
 	NewCommunityPoolSpendProposal              = types.NewCommunityPoolSpendProposal
 	NewQueryValidatorOutstandingRewardsParams  = types.NewQueryValidatorOutstandingRewardsParams
+	NewQueryAllOutstandingRewardsParams        = types.NewQueryAllOutstandingRewardsParams
+	NewValidatorRewardsSummary                 = types.NewValidatorRewardsSummary
+	NewDenomRewards                            = types.NewDenomRewards
 	NewQueryValidatorCommissionParams          = types.NewQueryValidatorCommissionParams
 	NewQueryValidatorSlashesParams             = types.NewQueryValidatorSlashesParams
 	NewQueryDelegationRewardsParams            = types.NewQueryDelegationRewardsParams
 	NewQueryDelegatorParams                    = types.NewQueryDelegatorParams
 	NewQueryDelegatorWithdrawAddrParams        = types.NewQueryDelegatorWithdrawAddrParams
+	NewQueryDelegationWithdrawAddrParams       = types.NewQueryDelegationWithdrawAddrParams
 	NewQueryDelegatorTotalRewardsResponse      = types.NewQueryDelegatorTotalRewardsResponse
+	NewQueryDelegatorSummaryResponse           = types.NewQueryDelegatorSummaryResponse
 	NewDelegationDelegatorReward               = types.NewDelegationDelegatorReward
+	NewValidatorWithdrawAddress                = types.NewValidatorWithdrawAddress
 	NewValidatorHistoricalRewards              = types.NewValidatorHistoricalRewards
 	NewValidatorCurrentRewards                 = types.NewValidatorCurrentRewards
 	InitialValidatorAccumulatedCommission      = types.InitialValidatorAccumulatedCommission
 	NewValidatorSlashEvent                     = types.NewValidatorSlashEvent
+	NewValidatorCommissionEarnings             = types.NewValidatorCommissionEarnings
 
 	// variable aliases
 	FeePoolKey                           = keeper.FeePoolKey
@@ -114,10 +142,16 @@ var (
 	ValidatorCurrentRewardsPrefix        = keeper.ValidatorCurrentRewardsPrefix
 	ValidatorAccumulatedCommissionPrefix = keeper.ValidatorAccumulatedCommissionPrefix
 	ValidatorSlashEventPrefix            = keeper.ValidatorSlashEventPrefix
+	DustRewardsPrefix                    = keeper.DustRewardsPrefix
+	ValidatorCommissionEarningsPrefix    = keeper.ValidatorCommissionEarningsPrefix
+	DelegatorValidatorWithdrawAddrPrefix = keeper.DelegatorValidatorWithdrawAddrPrefix
+	DustPayoutPendingKey                 = keeper.DustPayoutPendingKey
 	ParamStoreKeyCommunityTax            = keeper.ParamStoreKeyCommunityTax
 	ParamStoreKeyBaseProposerReward      = keeper.ParamStoreKeyBaseProposerReward
 	ParamStoreKeyBonusProposerReward     = keeper.ParamStoreKeyBonusProposerReward
 	ParamStoreKeyWithdrawAddrEnabled     = keeper.ParamStoreKeyWithdrawAddrEnabled
+	ParamStoreKeyDustThreshold           = keeper.ParamStoreKeyDustThreshold
+	ParamStoreKeyDustPayoutPeriod        = keeper.ParamStoreKeyDustPayoutPeriod
 	TestAddrs                            = keeper.TestAddrs
 	ModuleCdc                            = types.ModuleCdc
 	EventTypeSetWithdrawAddress          = types.EventTypeSetWithdrawAddress
@@ -126,6 +160,7 @@ var (
 	EventTypeWithdrawRewards             = types.EventTypeWithdrawRewards
 	EventTypeWithdrawCommission          = types.EventTypeWithdrawCommission
 	EventTypeProposerReward              = types.EventTypeProposerReward
+	EventTypeDustPayout                  = types.EventTypeDustPayout
 	AttributeKeyWithdrawAddress          = types.AttributeKeyWithdrawAddress
 	AttributeKeyValidator                = types.AttributeKeyValidator
 	AttributeValueCategory               = types.AttributeValueCategory
@@ -139,29 +174,41 @@ type (
 	CodeType                               = types.CodeType
 	FeePool                                = types.FeePool
 	DelegatorWithdrawInfo                  = types.DelegatorWithdrawInfo
+	DelegatorValidatorWithdrawInfo         = types.DelegatorValidatorWithdrawInfo
 	ValidatorOutstandingRewardsRecord      = types.ValidatorOutstandingRewardsRecord
 	ValidatorAccumulatedCommissionRecord   = types.ValidatorAccumulatedCommissionRecord
 	ValidatorHistoricalRewardsRecord       = types.ValidatorHistoricalRewardsRecord
 	ValidatorCurrentRewardsRecord          = types.ValidatorCurrentRewardsRecord
 	DelegatorStartingInfoRecord            = types.DelegatorStartingInfoRecord
 	ValidatorSlashEventRecord              = types.ValidatorSlashEventRecord
+	DustRewardsRecord                      = types.DustRewardsRecord
+	ValidatorCommissionEarningsRecord      = types.ValidatorCommissionEarningsRecord
 	GenesisState                           = types.GenesisState
 	MsgSetWithdrawAddress                  = types.MsgSetWithdrawAddress
+	MsgSetValidatorWithdrawAddress         = types.MsgSetValidatorWithdrawAddress
 	MsgWithdrawDelegatorReward             = types.MsgWithdrawDelegatorReward
 	MsgWithdrawValidatorCommission         = types.MsgWithdrawValidatorCommission
 	CommunityPoolSpendProposal             = types.CommunityPoolSpendProposal
 	QueryValidatorOutstandingRewardsParams = types.QueryValidatorOutstandingRewardsParams
+	QueryAllOutstandingRewardsParams       = types.QueryAllOutstandingRewardsParams
+	ValidatorRewardsSummary                = types.ValidatorRewardsSummary
+	DenomRewards                           = types.DenomRewards
+	DenomValidatorReward                   = types.DenomValidatorReward
 	QueryValidatorCommissionParams         = types.QueryValidatorCommissionParams
 	QueryValidatorSlashesParams            = types.QueryValidatorSlashesParams
 	QueryDelegationRewardsParams           = types.QueryDelegationRewardsParams
 	QueryDelegatorParams                   = types.QueryDelegatorParams
 	QueryDelegatorWithdrawAddrParams       = types.QueryDelegatorWithdrawAddrParams
+	QueryDelegationWithdrawAddrParams      = types.QueryDelegationWithdrawAddrParams
 	QueryDelegatorTotalRewardsResponse     = types.QueryDelegatorTotalRewardsResponse
+	QueryDelegatorSummaryResponse          = types.QueryDelegatorSummaryResponse
 	DelegationDelegatorReward              = types.DelegationDelegatorReward
+	ValidatorWithdrawAddress               = types.ValidatorWithdrawAddress
 	ValidatorHistoricalRewards             = types.ValidatorHistoricalRewards
 	ValidatorCurrentRewards                = types.ValidatorCurrentRewards
 	ValidatorAccumulatedCommission         = types.ValidatorAccumulatedCommission
 	ValidatorSlashEvent                    = types.ValidatorSlashEvent
 	ValidatorSlashEvents                   = types.ValidatorSlashEvents
 	ValidatorOutstandingRewards            = types.ValidatorOutstandingRewards
+	ValidatorCommissionEarnings            = types.ValidatorCommissionEarnings
 )
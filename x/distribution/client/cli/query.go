@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -30,8 +31,11 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryParams(queryRoute, cdc),
 		GetCmdQueryValidatorOutstandingRewards(queryRoute, cdc),
 		GetCmdQueryValidatorCommission(queryRoute, cdc),
+		GetCmdQueryValidatorCommissionEarnings(queryRoute, cdc),
 		GetCmdQueryValidatorSlashes(queryRoute, cdc),
 		GetCmdQueryDelegatorRewards(queryRoute, cdc),
+		GetCmdQueryDelegatorSummary(queryRoute, cdc),
+		GetCmdQueryValidatorWithdrawAddrs(queryRoute, cdc),
 		GetCmdQueryCommunityPool(queryRoute, cdc),
 	)...)
 
@@ -46,7 +50,7 @@ func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		Short: "Query distribution params",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			params, err := common.QueryParams(cliCtx, queryRoute)
+			params, _, err := common.QueryParams(cliCtx, queryRoute)
 			if err != nil {
 				return err
 			}
@@ -126,7 +130,7 @@ $ %s query distr commission cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 				return err
 			}
 
-			res, err := common.QueryValidatorCommission(cliCtx, queryRoute, validatorAddr)
+			res, _, err := common.QueryValidatorCommission(cliCtx, queryRoute, validatorAddr)
 			if err != nil {
 				return err
 			}
@@ -138,6 +142,43 @@ $ %s query distr commission cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	}
 }
 
+// GetCmdQueryValidatorCommissionEarnings implements the query validator
+// commission earnings command.
+func GetCmdQueryValidatorCommissionEarnings(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "commission-earnings [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the lifetime commission earned and withdrawn by a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total commission a validator has earned since it was created,
+along with the total amount of commission it has withdrawn.
+
+Example:
+$ %s query distr commission-earnings cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, _, err := common.QueryValidatorCommissionEarnings(cliCtx, queryRoute, validatorAddr)
+			if err != nil {
+				return err
+			}
+
+			var earnings types.ValidatorCommissionEarnings
+			cdc.MustUnmarshalJSON(res, &earnings)
+			return cliCtx.PrintOutput(earnings)
+		},
+	}
+}
+
 // GetCmdQueryValidatorSlashes implements the query validator slashes command.
 func GetCmdQueryValidatorSlashes(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -191,26 +232,43 @@ $ %s query distr slashes cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 0
 
 // GetCmdQueryDelegatorRewards implements the query delegator rewards command.
 func GetCmdQueryDelegatorRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "rewards [delegator-addr] [<validator-addr>]",
 		Args:  cobra.RangeArgs(1, 2),
 		Short: "Query all distribution delegator rewards or rewards from a particular validator",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query all rewards earned by a delegator, optionally restrict to rewards from a single validator.
+The total rewards can be broken down by denomination with --by-denom.
 
 Example:
 $ %s query distr rewards cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+$ %s query distr rewards cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --by-denom
 $ %s query distr rewards cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 `,
-				version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
+			if viper.GetBool(flagByDenom) {
+				if len(args) == 2 {
+					return fmt.Errorf("--%s can't be used with a validator address", flagByDenom)
+				}
+
+				resp, _, err := common.QueryDelegatorRewardsByDenom(cliCtx, queryRoute, args[0])
+				if err != nil {
+					return err
+				}
+
+				var result []types.DenomRewards
+				cdc.MustUnmarshalJSON(resp, &result)
+				return cliCtx.PrintOutput(result)
+			}
+
 			if len(args) == 2 {
 				// query for rewards from a particular delegation
-				resp, err := common.QueryDelegationRewards(cliCtx, queryRoute, args[0], args[1])
+				resp, _, err := common.QueryDelegationRewards(cliCtx, queryRoute, args[0], args[1])
 				if err != nil {
 					return err
 				}
@@ -221,7 +279,7 @@ $ %s query distr rewards cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosmosval
 			}
 
 			// query for delegator total rewards
-			resp, err := common.QueryDelegatorTotalRewards(cliCtx, queryRoute, args[0])
+			resp, _, err := common.QueryDelegatorTotalRewards(cliCtx, queryRoute, args[0])
 			if err != nil {
 				return err
 			}
@@ -231,6 +289,78 @@ $ %s query distr rewards cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosmosval
 			return cliCtx.PrintOutput(result)
 		},
 	}
+
+	cmd.Flags().Bool(flagByDenom, false, "break the total rewards down by denomination")
+	return cmd
+}
+
+// GetCmdQueryDelegatorSummary implements the query delegator summary command.
+func GetCmdQueryDelegatorSummary(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegator-summary [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a delegator's delegations, unbondings, redelegations and pending rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all delegations, unbonding delegations and redelegations of a delegator
+together with the rewards pending on each of their delegations.
+
+Example:
+$ %s query distr delegator-summary cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			resp, _, err := common.QueryDelegatorSummary(cliCtx, queryRoute, args[0])
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryDelegatorSummaryResponse
+			cdc.MustUnmarshalJSON(resp, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQueryValidatorWithdrawAddrs implements the query of the withdraw
+// addresses a delegator set per validator.
+func GetCmdQueryValidatorWithdrawAddrs(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validator-withdraw-addrs [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the withdraw addresses a delegator set for the rewards of single validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the withdraw addresses a delegator set for the rewards of its delegations
+to single validators. The rewards of the other delegations are withdrawn to the
+withdraw address of the delegator.
+
+Example:
+$ %s query distr validator-withdraw-addrs cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, _, err := common.QueryValidatorWithdrawAddrs(cliCtx, queryRoute, delegatorAddr)
+			if err != nil {
+				return err
+			}
+
+			var result []types.ValidatorWithdrawAddress
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
 }
 
 // GetCmdQueryCommunityPool returns the command for fetching community pool info
@@ -26,6 +26,7 @@ var (
 	flagIsValidator       = "is-validator"
 	flagCommission        = "commission"
 	flagMaxMessagesPerTx  = "max-msgs"
+	flagByDenom           = "by-denom"
 )
 
 const (
@@ -45,6 +46,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	distTxCmd.AddCommand(client.PostCommands(
 		GetCmdWithdrawRewards(cdc),
 		GetCmdSetWithdrawAddr(cdc),
+		GetCmdSetValidatorWithdrawAddr(cdc),
 		GetCmdWithdrawAllRewards(cdc, storeKey),
 	)...)
 
@@ -195,6 +197,49 @@ $ %s tx set-withdraw-addr cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from m
 	}
 }
 
+// command to replace a delegator's withdrawal address for a single validator
+func GetCmdSetValidatorWithdrawAddr(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-validator-withdraw-addr [validator-addr] [withdraw-addr]",
+		Short: "change the withdraw address for the rewards of the delegation to a single validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the withdraw address for the rewards of the delegation of a delegator
+address to a single validator, overriding the default withdraw address of the
+delegator. Omitting the withdraw address removes the override.
+
+Example:
+$ %s tx distr set-validator-withdraw-addr cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+$ %s tx distr set-validator-withdraw-addr cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var withdrawAddr sdk.AccAddress
+			if len(args) > 1 {
+				withdrawAddr, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetValidatorWithdrawAddress(delAddr, valAddr, withdrawAddr)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -8,88 +8,156 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
-// QueryParams actually queries distribution params.
-func QueryParams(cliCtx context.CLIContext, queryRoute string) (PrettyParams, error) {
+// QueryParams actually queries distribution params, returning the height at
+// which they were queried.
+func QueryParams(cliCtx context.CLIContext, queryRoute string) (PrettyParams, int64, error) {
 	route := fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamCommunityTax)
 
-	retCommunityTax, _, err := cliCtx.QueryWithData(route, []byte{})
+	retCommunityTax, height, err := cliCtx.QueryWithData(route, []byte{})
 	if err != nil {
-		return PrettyParams{}, err
+		return PrettyParams{}, 0, err
 	}
 
+	// query the rest of the params at the same height
+	cliCtx = cliCtx.WithHeight(height)
+
 	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamBaseProposerReward)
 	retBaseProposerReward, _, err := cliCtx.QueryWithData(route, []byte{})
 	if err != nil {
-		return PrettyParams{}, err
+		return PrettyParams{}, 0, err
 	}
 
 	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamBonusProposerReward)
 	retBonusProposerReward, _, err := cliCtx.QueryWithData(route, []byte{})
 	if err != nil {
-		return PrettyParams{}, err
+		return PrettyParams{}, 0, err
 	}
 
 	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamWithdrawAddrEnabled)
 	retWithdrawAddrEnabled, _, err := cliCtx.QueryWithData(route, []byte{})
 	if err != nil {
-		return PrettyParams{}, err
+		return PrettyParams{}, 0, err
+	}
+
+	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamDustThreshold)
+	retDustThreshold, _, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, 0, err
+	}
+
+	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamDustPayoutPeriod)
+	retDustPayoutPeriod, _, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, 0, err
 	}
 
 	return NewPrettyParams(
 		retCommunityTax, retBaseProposerReward, retBonusProposerReward, retWithdrawAddrEnabled,
-	), nil
+		retDustThreshold, retDustPayoutPeriod,
+	), height, nil
 }
 
 // QueryDelegatorTotalRewards queries delegator total rewards.
-func QueryDelegatorTotalRewards(cliCtx context.CLIContext, queryRoute, delAddr string) ([]byte, error) {
+func QueryDelegatorTotalRewards(cliCtx context.CLIContext, queryRoute, delAddr string) ([]byte, int64, error) {
 	delegatorAddr, err := sdk.AccAddressFromBech32(delAddr)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	res, _, err := cliCtx.QueryWithData(
+	return cliCtx.QueryWithData(
 		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorTotalRewards),
 		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
 	)
-	return res, err
+}
+
+// QueryDelegatorRewardsByDenom queries delegator total rewards broken down by
+// denomination.
+func QueryDelegatorRewardsByDenom(cliCtx context.CLIContext, queryRoute, delAddr string) ([]byte, int64, error) {
+	delegatorAddr, err := sdk.AccAddressFromBech32(delAddr)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorRewardsByDenom),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	)
+}
+
+// QueryDelegatorSummary queries a delegator's delegations, unbonding delegations,
+// redelegations and pending rewards in a single request.
+func QueryDelegatorSummary(cliCtx context.CLIContext, queryRoute, delAddr string) ([]byte, int64, error) {
+	delegatorAddr, err := sdk.AccAddressFromBech32(delAddr)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorSummary),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	)
 }
 
 // QueryDelegationRewards queries a delegation rewards.
-func QueryDelegationRewards(cliCtx context.CLIContext, queryRoute, delAddr, valAddr string) ([]byte, error) {
+func QueryDelegationRewards(cliCtx context.CLIContext, queryRoute, delAddr, valAddr string) ([]byte, int64, error) {
 	delegatorAddr, err := sdk.AccAddressFromBech32(delAddr)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	validatorAddr, err := sdk.ValAddressFromBech32(valAddr)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	res, _, err := cliCtx.QueryWithData(
+	return cliCtx.QueryWithData(
 		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegationRewards),
 		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegationRewardsParams(delegatorAddr, validatorAddr)),
 	)
-	return res, err
 }
 
 // QueryDelegatorValidators returns delegator's list of validators
 // it submitted delegations to.
-func QueryDelegatorValidators(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) ([]byte, error) {
-	res, _, err := cliCtx.QueryWithData(
+func QueryDelegatorValidators(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) ([]byte, int64, error) {
+	return cliCtx.QueryWithData(
 		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorValidators),
 		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
 	)
-	return res, err
 }
 
 // QueryValidatorCommission returns a validator's commission.
-func QueryValidatorCommission(cliCtx context.CLIContext, queryRoute string, validatorAddr sdk.ValAddress) ([]byte, error) {
-	res, _, err := cliCtx.QueryWithData(
+func QueryValidatorCommission(cliCtx context.CLIContext, queryRoute string, validatorAddr sdk.ValAddress) ([]byte, int64, error) {
+	return cliCtx.QueryWithData(
 		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorCommission),
 		cliCtx.Codec.MustMarshalJSON(types.NewQueryValidatorCommissionParams(validatorAddr)),
 	)
-	return res, err
+}
+
+// QueryValidatorCommissionEarnings returns the lifetime commission earned and
+// withdrawn by a validator.
+func QueryValidatorCommissionEarnings(cliCtx context.CLIContext, queryRoute string, validatorAddr sdk.ValAddress) ([]byte, int64, error) {
+	return cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorCommissionEarnings),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryValidatorCommissionParams(validatorAddr)),
+	)
+}
+
+// QueryValidatorWithdrawAddrs returns the withdraw addresses a delegator set
+// for the rewards of its delegations to single validators.
+func QueryValidatorWithdrawAddrs(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) ([]byte, int64, error) {
+	return cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorWithdrawAddrs),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorWithdrawAddrParams(delegatorAddr)),
+	)
+}
+
+// QueryDelegationWithdrawAddr returns the address the rewards of a delegation
+// are withdrawn to.
+func QueryDelegationWithdrawAddr(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) ([]byte, int64, error) {
+	return cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegationWithdrawAddr),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegationWithdrawAddrParams(delegatorAddr, validatorAddr)),
+	)
 }
 
 // WithdrawAllDelegatorRewards builds a multi-message slice to be used
@@ -97,7 +165,7 @@ func QueryValidatorCommission(cliCtx context.CLIContext, queryRoute string, vali
 func WithdrawAllDelegatorRewards(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) ([]sdk.Msg, error) {
 	// retrieve the comprehensive list of all validators which the
 	// delegator had submitted delegations to
-	bz, err := QueryDelegatorValidators(cliCtx, queryRoute, delegatorAddr)
+	bz, _, err := QueryDelegatorValidators(cliCtx, queryRoute, delegatorAddr)
 	if err != nil {
 		return nil, err
 	}
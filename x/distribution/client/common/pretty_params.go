@@ -11,15 +11,19 @@ type PrettyParams struct {
 	BaseProposerReward  json.RawMessage `json:"base_proposer_reward"`
 	BonusProposerReward json.RawMessage `json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled json.RawMessage `json:"withdraw_addr_enabled"`
+	DustThreshold       json.RawMessage `json:"dust_threshold"`
+	DustPayoutPeriod    json.RawMessage `json:"dust_payout_period"`
 }
 
 // Construct a new PrettyParams
-func NewPrettyParams(communityTax json.RawMessage, baseProposerReward json.RawMessage, bonusProposerReward json.RawMessage, withdrawAddrEnabled json.RawMessage) PrettyParams {
+func NewPrettyParams(communityTax json.RawMessage, baseProposerReward json.RawMessage, bonusProposerReward json.RawMessage, withdrawAddrEnabled json.RawMessage, dustThreshold json.RawMessage, dustPayoutPeriod json.RawMessage) PrettyParams {
 	return PrettyParams{
 		CommunityTax:        communityTax,
 		BaseProposerReward:  baseProposerReward,
 		BonusProposerReward: bonusProposerReward,
 		WithdrawAddrEnabled: withdrawAddrEnabled,
+		DustThreshold:       dustThreshold,
+		DustPayoutPeriod:    dustPayoutPeriod,
 	}
 }
 
@@ -28,7 +32,10 @@ func (pp PrettyParams) String() string {
   Community Tax:          %s
   Base Proposer Reward:   %s
   Bonus Proposer Reward:  %s
-  Withdraw Addr Enabled:  %s`, pp.CommunityTax,
-		pp.BaseProposerReward, pp.BonusProposerReward, pp.WithdrawAddrEnabled)
+  Withdraw Addr Enabled:  %s
+  Dust Threshold:         %s
+  Dust Payout Period:     %s`, pp.CommunityTax,
+		pp.BaseProposerReward, pp.BonusProposerReward, pp.WithdrawAddrEnabled,
+		pp.DustThreshold, pp.DustPayoutPeriod)
 
 }
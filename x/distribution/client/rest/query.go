@@ -27,12 +27,36 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		delegationRewardsHandlerFn(cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Get the total rewards balance from all delegations broken down by denomination
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/rewards_by_denom",
+		delegatorRewardsByDenomHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the delegations, unbondings, redelegations and pending rewards of a delegator
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/summary",
+		delegatorSummaryHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 	// Get the rewards withdrawal address
 	r.HandleFunc(
 		"/distribution/delegators/{delegatorAddr}/withdraw_address",
 		delegatorWithdrawalAddrHandlerFn(cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Get the withdraw addresses set per validator
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/validator_withdraw_addresses",
+		validatorWithdrawalAddrsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the rewards withdrawal address of a delegation
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/withdraw_address/{validatorAddr}",
+		delegationWithdrawalAddrHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 	// Validator distribution information
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}",
@@ -51,6 +75,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		outstandingRewardsHandlerFn(cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Lifetime commission earned and withdrawn by a single validator
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/commission_earnings",
+		commissionEarningsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 	// Get the current distribution parameter values
 	r.HandleFunc(
 		"/distribution/parameters",
@@ -74,11 +104,31 @@ func delegatorRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) htt
 		}
 
 		// query for rewards from a particular delegator
-		res, ok := checkResponseQueryDelegatorTotalRewards(w, cliCtx, queryRoute, mux.Vars(r)["delegatorAddr"])
+		res, height, ok := checkResponseQueryDelegatorTotalRewards(w, cliCtx, queryRoute, mux.Vars(r)["delegatorAddr"])
+		if !ok {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the total rewards from all delegations broken down by denomination
+func delegatorRewardsByDenomHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
+		res, height, err := common.QueryDelegatorRewardsByDenom(cliCtx, queryRoute, mux.Vars(r)["delegatorAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
@@ -92,11 +142,31 @@ func delegationRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) ht
 		}
 
 		// query for rewards from a particular delegation
-		res, ok := checkResponseQueryDelegationRewards(w, cliCtx, queryRoute, mux.Vars(r)["delegatorAddr"], mux.Vars(r)["validatorAddr"])
+		res, height, ok := checkResponseQueryDelegationRewards(w, cliCtx, queryRoute, mux.Vars(r)["delegatorAddr"], mux.Vars(r)["validatorAddr"])
 		if !ok {
 			return
 		}
 
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query a delegator's staking positions and pending rewards
+func delegatorSummaryHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := common.QueryDelegatorSummary(cliCtx, queryRoute, mux.Vars(r)["delegatorAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
@@ -109,7 +179,7 @@ func delegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext, queryRoute stri
 			return
 		}
 
-		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
@@ -126,6 +196,59 @@ func delegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext, queryRoute stri
 	}
 }
 
+// HTTP request handler to query the withdraw addresses a delegator set per validator
+func validatorWithdrawalAddrsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := common.QueryValidatorWithdrawAddrs(cliCtx, queryRoute, delegatorAddr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the rewards withdrawal address of a delegation
+func delegationWithdrawalAddrHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		validatorAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := common.QueryDelegationWithdrawAddr(cliCtx, queryRoute, delegatorAddr, validatorAddr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // ValidatorDistInfo defines the properties of
 // validator distribution information response.
 type ValidatorDistInfo struct {
@@ -153,24 +276,27 @@ func validatorInfoHandlerFn(cliCtx context.CLIContext, queryRoute string) http.H
 			return
 		}
 
-		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
 		// query commission
-		commissionRes, err := common.QueryValidatorCommission(cliCtx, queryRoute, validatorAddr)
+		commissionRes, height, err := common.QueryValidatorCommission(cliCtx, queryRoute, validatorAddr)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
+		// query the self bond rewards at the same height
+		cliCtx = cliCtx.WithHeight(height)
+
 		var valCom types.ValidatorAccumulatedCommission
 		cliCtx.Codec.MustUnmarshalJSON(commissionRes, &valCom)
 
 		// self bond rewards
 		delAddr := sdk.AccAddress(validatorAddr)
-		rewardsRes, ok := checkResponseQueryDelegationRewards(w, cliCtx, queryRoute, delAddr.String(), valAddr)
+		rewardsRes, _, ok := checkResponseQueryDelegationRewards(w, cliCtx, queryRoute, delAddr.String(), valAddr)
 		if !ok {
 			return
 		}
@@ -193,17 +319,18 @@ func validatorRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) htt
 			return
 		}
 
-		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
 		delAddr := sdk.AccAddress(validatorAddr).String()
-		res, ok := checkResponseQueryDelegationRewards(w, cliCtx, queryRoute, delAddr, valAddr)
+		res, height, ok := checkResponseQueryDelegationRewards(w, cliCtx, queryRoute, delAddr, valAddr)
 		if !ok {
 			return
 		}
 
+		cliCtx = cliCtx.WithHeight(height)
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
@@ -216,12 +343,13 @@ func paramsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerF
 			return
 		}
 
-		params, err := common.QueryParams(cliCtx, queryRoute)
+		params, height, err := common.QueryParams(cliCtx, queryRoute)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
+		cliCtx = cliCtx.WithHeight(height)
 		rest.PostProcessResponse(w, cliCtx, params)
 	}
 }
@@ -258,7 +386,7 @@ func outstandingRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) h
 			return
 		}
 
-		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
@@ -275,28 +403,52 @@ func outstandingRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) h
 	}
 }
 
+// HTTP request handler to query the lifetime commission earnings of a validator
+func commissionEarningsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := common.QueryValidatorCommissionEarnings(cliCtx, queryRoute, validatorAddr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func checkResponseQueryDelegatorTotalRewards(
 	w http.ResponseWriter, cliCtx context.CLIContext, queryRoute, delAddr string,
-) (res []byte, ok bool) {
+) (res []byte, height int64, ok bool) {
 
-	res, err := common.QueryDelegatorTotalRewards(cliCtx, queryRoute, delAddr)
+	res, height, err := common.QueryDelegatorTotalRewards(cliCtx, queryRoute, delAddr)
 	if err != nil {
 		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return nil, false
+		return nil, 0, false
 	}
 
-	return res, true
+	return res, height, true
 }
 
 func checkResponseQueryDelegationRewards(
 	w http.ResponseWriter, cliCtx context.CLIContext, queryRoute, delAddr, valAddr string,
-) (res []byte, ok bool) {
+) (res []byte, height int64, ok bool) {
 
-	res, err := common.QueryDelegationRewards(cliCtx, queryRoute, delAddr, valAddr)
+	res, height, err := common.QueryDelegationRewards(cliCtx, queryRoute, delAddr, valAddr)
 	if err != nil {
 		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return nil, false
+		return nil, 0, false
 	}
 
-	return res, true
+	return res, height, true
 }
@@ -17,9 +17,24 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	keeper.SetBonusProposerReward(ctx, data.BonusProposerReward)
 	keeper.SetWithdrawAddrEnabled(ctx, data.WithdrawAddrEnabled)
 
+	// a genesis predating the dust parameters uses the default ones
+	dustThreshold := data.DustThreshold
+	if dustThreshold.IsNil() {
+		dustThreshold = sdk.ZeroDec()
+	}
+	dustPayoutPeriod := data.DustPayoutPeriod
+	if dustPayoutPeriod == 0 {
+		dustPayoutPeriod = types.DefaultDustPayoutPeriod
+	}
+	keeper.SetDustThreshold(ctx, dustThreshold)
+	keeper.SetDustPayoutPeriod(ctx, dustPayoutPeriod)
+
 	for _, dwi := range data.DelegatorWithdrawInfos {
 		keeper.SetDelegatorWithdrawAddr(ctx, dwi.DelegatorAddress, dwi.WithdrawAddress)
 	}
+	for _, vwi := range data.DelegatorValidatorWithdrawInfos {
+		keeper.SetDelegatorValidatorWithdrawAddr(ctx, vwi.DelegatorAddress, vwi.ValidatorAddress, vwi.WithdrawAddress)
+	}
 	keeper.SetPreviousProposerConsAddr(ctx, data.PreviousProposer)
 	for _, rew := range data.OutstandingRewards {
 		keeper.SetValidatorOutstandingRewards(ctx, rew.ValidatorAddress, rew.OutstandingRewards)
@@ -40,6 +55,13 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	for _, evt := range data.ValidatorSlashEvents {
 		keeper.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Period, evt.Event)
 	}
+	for _, dust := range data.DustRewards {
+		keeper.SetDustRewards(ctx, dust.WithdrawAddress, dust.Rewards)
+		moduleHoldings = moduleHoldings.Add(dust.Rewards)
+	}
+	for _, rec := range data.ValidatorCommissionEarnings {
+		keeper.SetValidatorCommissionEarnings(ctx, rec.ValidatorAddress, rec.Earnings)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -65,6 +87,8 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	baseProposerRewards := keeper.GetBaseProposerReward(ctx)
 	bonusProposerRewards := keeper.GetBonusProposerReward(ctx)
 	withdrawAddrEnabled := keeper.GetWithdrawAddrEnabled(ctx)
+	dustThreshold := keeper.GetDustThreshold(ctx)
+	dustPayoutPeriod := keeper.GetDustPayoutPeriod(ctx)
 	dwi := make([]types.DelegatorWithdrawInfo, 0)
 	keeper.IterateDelegatorWithdrawAddrs(ctx, func(del sdk.AccAddress, addr sdk.AccAddress) (stop bool) {
 		dwi = append(dwi, types.DelegatorWithdrawInfo{
@@ -73,6 +97,17 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		})
 		return false
 	})
+	vwi := make([]types.DelegatorValidatorWithdrawInfo, 0)
+	keeper.IterateDelegatorValidatorWithdrawAddrs(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress, addr sdk.AccAddress) (stop bool) {
+			vwi = append(vwi, types.DelegatorValidatorWithdrawInfo{
+				DelegatorAddress: del,
+				ValidatorAddress: val,
+				WithdrawAddress:  addr,
+			})
+			return false
+		},
+	)
 	pp := keeper.GetPreviousProposerConsAddr(ctx)
 	outstanding := make([]types.ValidatorOutstandingRewardsRecord, 0)
 	keeper.IterateValidatorOutstandingRewards(ctx,
@@ -138,6 +173,26 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 			return false
 		},
 	)
+	dust := make([]types.DustRewardsRecord, 0)
+	keeper.IterateDustRewards(ctx,
+		func(addr sdk.AccAddress, rewards sdk.DecCoins) (stop bool) {
+			dust = append(dust, types.DustRewardsRecord{
+				WithdrawAddress: addr,
+				Rewards:         rewards,
+			})
+			return false
+		},
+	)
+	earnings := make([]types.ValidatorCommissionEarningsRecord, 0)
+	keeper.IterateValidatorCommissionEarnings(ctx,
+		func(val sdk.ValAddress, e types.ValidatorCommissionEarnings) (stop bool) {
+			earnings = append(earnings, types.ValidatorCommissionEarningsRecord{
+				ValidatorAddress: val,
+				Earnings:         e,
+			})
+			return false
+		},
+	)
 	return types.NewGenesisState(feePool, communityTax, baseProposerRewards, bonusProposerRewards, withdrawAddrEnabled,
-		dwi, pp, outstanding, acc, his, cur, dels, slashes)
+		dustThreshold, dustPayoutPeriod, dwi, vwi, pp, outstanding, acc, his, cur, dels, slashes, dust, earnings)
 }
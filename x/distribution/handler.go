@@ -17,6 +17,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgSetWithdrawAddress:
 			return handleMsgModifyWithdrawAddress(ctx, msg, k)
 
+		case types.MsgSetValidatorWithdrawAddress:
+			return handleMsgSetValidatorWithdrawAddress(ctx, msg, k)
+
 		case types.MsgWithdrawDelegatorReward:
 			return handleMsgWithdrawDelegatorReward(ctx, msg, k)
 
@@ -49,6 +52,23 @@ func handleMsgModifyWithdrawAddress(ctx sdk.Context, msg types.MsgSetWithdrawAdd
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleMsgSetValidatorWithdrawAddress(ctx sdk.Context, msg types.MsgSetValidatorWithdrawAddress, k keeper.Keeper) sdk.Result {
+	err := k.SetValidatorWithdrawAddr(ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.WithdrawAddress)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 func handleMsgWithdrawDelegatorReward(ctx sdk.Context, msg types.MsgWithdrawDelegatorReward, k keeper.Keeper) sdk.Result {
 	_, err := k.WithdrawDelegationRewards(ctx, msg.DelegatorAddress, msg.ValidatorAddress)
 	if err != nil {
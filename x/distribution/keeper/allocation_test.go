@@ -35,6 +35,9 @@ func TestAllocateTokensToValidatorWithCommission(t *testing.T) {
 
 	// check current rewards
 	require.Equal(t, expected, k.GetValidatorCurrentRewards(ctx, val.GetOperator()).Rewards)
+
+	// check lifetime commission earned
+	require.Equal(t, expected, k.GetValidatorCommissionEarnings(ctx, val.GetOperator()).Earned)
 }
 
 func TestAllocateTokensToManyValidators(t *testing.T) {
@@ -136,7 +136,10 @@ func (k Keeper) calculateDelegationRewards(ctx sdk.Context, val exported.Validat
 	return rewards
 }
 
-func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.ValidatorI, del exported.DelegationI) (sdk.Coins, sdk.Error) {
+// withdraw the rewards of a delegation. If deferDust is set and the rewards are
+// below the dust threshold, they are added to the dust rewards of the withdraw
+// address and paid out in a later EndBlock instead.
+func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.ValidatorI, del exported.DelegationI, deferDust bool) (sdk.Coins, sdk.Error) {
 	// check existence of delegator starting info
 	if !k.HasDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr()) {
 		return nil, types.ErrNoDelegationDistInfo(k.codespace)
@@ -157,24 +160,41 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.Validato
 			val.GetOperator(), del.GetDelegatorAddr(), rewardsRaw, rewards))
 	}
 
-	// truncate coins, return remainder to community pool
-	coins, remainder := rewards.TruncateDecimal()
+	withdrawAddr := k.GetDelegationWithdrawAddr(ctx, del.GetDelegatorAddr(), del.GetValidatorAddr())
 
-	// add coins to user account
-	if !coins.IsZero() {
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
-		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
-		if err != nil {
-			return nil, err
+	var coins sdk.Coins
+	switch {
+	case rewards.IsZero():
+		// nothing was earned, so neither the rewards nor the dust records change
+
+	case deferDust && k.isDust(ctx, rewards):
+		// batch the rewards, they are paid out in EndBlock
+		k.SetDustRewards(ctx, withdrawAddr, k.GetDustRewards(ctx, withdrawAddr).Add(rewards))
+
+	default:
+		// truncate coins, return remainder to community pool
+		var remainder sdk.DecCoins
+		coins, remainder = rewards.TruncateDecimal()
+
+		// add coins to user account
+		if !coins.IsZero() {
+			err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if !remainder.IsZero() {
+			feePool := k.GetFeePool(ctx)
+			feePool.CommunityPool = feePool.CommunityPool.Add(remainder)
+			k.SetFeePool(ctx, feePool)
 		}
 	}
 
-	// update the outstanding rewards and the community pool only if the
-	// transaction was successful
-	k.SetValidatorOutstandingRewards(ctx, del.GetValidatorAddr(), outstanding.Sub(rewards))
-	feePool := k.GetFeePool(ctx)
-	feePool.CommunityPool = feePool.CommunityPool.Add(remainder)
-	k.SetFeePool(ctx, feePool)
+	// update the outstanding rewards only if the transaction was successful
+	if !rewards.IsZero() {
+		k.SetValidatorOutstandingRewards(ctx, del.GetValidatorAddr(), outstanding.Sub(rewards))
+	}
 
 	// decrement reference count of starting period
 	startingInfo := k.GetDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
@@ -333,6 +333,90 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	)
 }
 
+func TestWithdrawDelegationRewardsToValidatorWithdrawAddr(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	ctx, ak, k, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens)))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// the self-delegation rewards go to the withdraw address set for the
+	// validator, the commission to the delegator withdraw address
+	withdrawAddr := sdk.AccAddress([]byte("validatorwithdrawadd"))
+	k.SetWithdrawAddrEnabled(ctx, true)
+	require.Nil(t, k.SetValidatorWithdrawAddr(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1, withdrawAddr))
+
+	initial := sdk.TokensFromConsensusPower(10)
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	_, err := k.WithdrawDelegationRewards(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1)
+	require.Nil(t, err)
+	_, err = k.WithdrawValidatorCommission(ctx, valOpAddr1)
+	require.Nil(t, err)
+
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))},
+		ak.GetAccount(ctx, withdrawAddr).GetCoins(),
+	)
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens.Sub(valTokens).Add(initial.QuoRaw(2)))},
+		ak.GetAccount(ctx, sdk.AccAddress(valOpAddr1)).GetCoins(),
+	)
+}
+
+func TestRemoveDelegationDeletesValidatorWithdrawAddr(t *testing.T) {
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// create validator with 50% commission
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// delegate and set a withdraw address for the validator
+	delTokens := sdk.TokensFromConsensusPower(10)
+	msg2 := staking.NewMsgDelegate(delAddr1, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	require.True(t, sh(ctx, msg2).IsOK())
+
+	withdrawAddr := sdk.AccAddress([]byte("validatorwithdrawadd"))
+	k.SetWithdrawAddrEnabled(ctx, true)
+	require.Nil(t, k.SetValidatorWithdrawAddr(ctx, delAddr1, valOpAddr1, withdrawAddr))
+
+	// the withdraw address is removed along with the delegation
+	msg3 := staking.NewMsgUndelegate(delAddr1, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	require.True(t, sh(ctx, msg3).IsOK())
+
+	_, found := k.GetDelegatorValidatorWithdrawAddr(ctx, delAddr1, valOpAddr1)
+	require.False(t, found)
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
 	sh := staking.NewHandler(sk)
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// isDust returns true if the dust threshold is enabled and every one of the
+// rewards is strictly below it
+func (k Keeper) isDust(ctx sdk.Context, rewards sdk.DecCoins) bool {
+	threshold := k.GetDustThreshold(ctx)
+	if !threshold.IsPositive() || rewards.IsZero() {
+		return false
+	}
+
+	for _, reward := range rewards {
+		if reward.Amount.GTE(threshold) {
+			return false
+		}
+	}
+	return true
+}
+
+// PayoutDustRewards sends at most maxPayouts of the batched dust rewards to
+// their withdraw addresses, returning the truncated remainders to the community
+// pool, and reports whether every record has been paid out. The rewards which
+// cannot be sent, e.g. to a blacklisted address, are logged and returned to the
+// community pool as well so that the remaining records get paid.
+func (k Keeper) PayoutDustRewards(ctx sdk.Context, maxPayouts int) (done bool) {
+	var (
+		addrs     []sdk.AccAddress
+		remainder sdk.DecCoins
+	)
+
+	done = true
+	k.IterateDustRewards(ctx, func(addr sdk.AccAddress, rewards sdk.DecCoins) (stop bool) {
+		if len(addrs) == maxPayouts {
+			done = false
+			return true
+		}
+		addrs = append(addrs, addr)
+
+		coins, change := rewards.TruncateDecimal()
+		remainder = remainder.Add(change)
+
+		if !coins.IsZero() {
+			err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+			if err != nil {
+				k.Logger(ctx).Error("failed to pay out dust rewards", "address", addr.String(), "amount", coins.String(), "err", err)
+				remainder = remainder.Add(sdk.NewDecCoins(coins))
+				return false
+			}
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDustPayout,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+				sdk.NewAttribute(types.AttributeKeyWithdrawAddress, addr.String()),
+			),
+		)
+		return false
+	})
+
+	if len(addrs) == 0 {
+		return done
+	}
+
+	// delete the records outside of the iteration
+	for _, addr := range addrs {
+		k.DeleteDustRewards(ctx, addr)
+	}
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(remainder)
+	k.SetFeePool(ctx, feePool)
+
+	return done
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// setupDustTest creates a bonded validator with 50% commission and allocates
+// the given rewards to it, funding the distribution module account accordingly
+func setupDustTest(t *testing.T, rewards int64) (sdk.Context, auth.AccountKeeper, Keeper, sdk.Handler) {
+	ctx, ak, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, rewards)))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	val := sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, rewards)})
+
+	return ctx, ak, k, sh
+}
+
+func TestDustRewardsBatched(t *testing.T) {
+	ctx, ak, k, sh := setupDustTest(t, 11)
+	k.SetDustThreshold(ctx, sdk.NewDec(10))
+
+	addr := sdk.AccAddress(valOpAddr1)
+	balance := ak.GetAccount(ctx, addr).GetCoins()
+
+	// modifying the delegation withdraws 5.5 tokens, below the threshold
+	msg := staking.NewMsgDelegate(addr, valOpAddr1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	require.True(t, sh(ctx, msg).IsOK())
+
+	expDust := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(55, 1))}
+	require.Equal(t, expDust, k.GetDustRewards(ctx, addr))
+	require.Equal(t, balance.Sub(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))), ak.GetAccount(ctx, addr).GetCoins())
+	require.True(t, k.GetFeePoolCommunityCoins(ctx).IsZero())
+
+	_, broken := ModuleAccountInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// the payout sends the truncated rewards and returns the change to the community pool
+	require.True(t, k.PayoutDustRewards(ctx, 10))
+
+	require.Nil(t, k.GetDustRewards(ctx, addr))
+	require.Equal(t, balance.Sub(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))), ak.GetAccount(ctx, addr).GetCoins())
+	require.Equal(t,
+		sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(5, 1))},
+		k.GetFeePoolCommunityCoins(ctx),
+	)
+
+	_, broken = ModuleAccountInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestDustRewardsAboveThreshold(t *testing.T) {
+	ctx, ak, k, sh := setupDustTest(t, 11)
+	k.SetDustThreshold(ctx, sdk.NewDec(5))
+
+	addr := sdk.AccAddress(valOpAddr1)
+	balance := ak.GetAccount(ctx, addr).GetCoins()
+
+	// 5.5 tokens are withdrawn, above the threshold, and paid out right away
+	msg := staking.NewMsgDelegate(addr, valOpAddr1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	require.True(t, sh(ctx, msg).IsOK())
+
+	require.Nil(t, k.GetDustRewards(ctx, addr))
+	require.Equal(t, balance.Sub(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))), ak.GetAccount(ctx, addr).GetCoins())
+}
+
+func TestDustRewardsExplicitWithdrawal(t *testing.T) {
+	ctx, ak, k, _ := setupDustTest(t, 11)
+	k.SetDustThreshold(ctx, sdk.NewDec(10))
+
+	addr := sdk.AccAddress(valOpAddr1)
+	balance := ak.GetAccount(ctx, addr).GetCoins()
+
+	// explicit withdrawals are never batched
+	rewards, err := k.WithdrawDelegationRewards(ctx, addr, valOpAddr1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)), rewards)
+
+	require.Nil(t, k.GetDustRewards(ctx, addr))
+	require.Equal(t, balance.Add(rewards), ak.GetAccount(ctx, addr).GetCoins())
+}
+
+func TestDustRewardsBoundedPayout(t *testing.T) {
+	ctx, ak, k, _ := setupDustTest(t, 11)
+
+	k.SetDustRewards(ctx, delAddr1, sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 2)})
+	k.SetDustRewards(ctx, delAddr2, sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 3)})
+	balance1 := ak.GetAccount(ctx, delAddr1).GetCoins()
+	balance2 := ak.GetAccount(ctx, delAddr2).GetCoins()
+
+	// a single record is paid out per call
+	require.False(t, k.PayoutDustRewards(ctx, 1))
+	paid := 0
+	if k.GetDustRewards(ctx, delAddr1) == nil {
+		paid++
+	}
+	if k.GetDustRewards(ctx, delAddr2) == nil {
+		paid++
+	}
+	require.Equal(t, 1, paid)
+
+	require.True(t, k.PayoutDustRewards(ctx, 1))
+	require.Nil(t, k.GetDustRewards(ctx, delAddr1))
+	require.Nil(t, k.GetDustRewards(ctx, delAddr2))
+	require.Equal(t, balance1.Add(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2))), ak.GetAccount(ctx, delAddr1).GetCoins())
+	require.Equal(t, balance2.Add(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3))), ak.GetAccount(ctx, delAddr2).GetCoins())
+
+	// nothing is left to pay out
+	require.True(t, k.PayoutDustRewards(ctx, 1))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -51,6 +53,8 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 			if err != nil {
 				panic(err)
 			}
+
+			h.k.addCommissionWithdrawn(ctx, valAddr, coins)
 		}
 	}
 
@@ -81,11 +85,12 @@ func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress,
 	h.k.incrementValidatorPeriod(ctx, val)
 }
 
-// withdraw delegation rewards (which also increments period)
+// withdraw delegation rewards (which also increments period), batching dust
+// rewards for a later payout
 func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	val := h.k.stakingKeeper.Validator(ctx, valAddr)
 	del := h.k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
-	if _, err := h.k.withdrawDelegationRewards(ctx, val, del); err != nil {
+	if _, err := h.k.withdrawDelegationRewards(ctx, val, del, true); err != nil {
 		panic(err)
 	}
 }
@@ -95,6 +100,12 @@ func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress,
 	h.k.initializeDelegation(ctx, valAddr, delAddr)
 }
 
+// cleanup the withdraw address of the delegator for the removed delegation,
+// whose rewards have already been withdrawn
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	h.k.DeleteDelegatorValidatorWithdrawAddr(ctx, delAddr, valAddr)
+}
+
 // record the slash event
 func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
 	h.k.updateValidatorSlashFraction(ctx, valAddr, fraction)
@@ -104,4 +115,5 @@ func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, f
 func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                         {}
 func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)         {}
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {}
-func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)       {}
+func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, _ sdk.Int, _ time.Time) {
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -89,15 +90,12 @@ func CanWithdrawInvariant(k Keeper) sdk.Invariant {
 				}
 			}
 
+			// every denomination of the remaining rewards must be withdrawable
 			remaining = k.GetValidatorOutstandingRewards(ctx, val.GetOperator())
-			if len(remaining) > 0 && remaining[0].Amount.IsNegative() {
-				return true
-			}
-
-			return false
+			return remaining.IsAnyNegative()
 		})
 
-		broken := len(remaining) > 0 && remaining[0].Amount.IsNegative()
+		broken := remaining.IsAnyNegative()
 		return sdk.FormatInvariant(types.ModuleName, "can withdraw",
 			fmt.Sprintf("remaining coins: %v\n", remaining)), broken
 	}
@@ -134,7 +132,9 @@ func ReferenceCountInvariant(k Keeper) sdk.Invariant {
 }
 
 // ModuleAccountInvariant checks that the coins held by the distr ModuleAccount
-// is consistent with the sum of validator outstanding rewards
+// is consistent with the sum of validator outstanding rewards, the community
+// pool and the dust rewards pending payout, reporting each mismatching
+// denomination separately
 func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 
@@ -144,15 +144,46 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 			return false
 		})
 
+		k.IterateDustRewards(ctx, func(_ sdk.AccAddress, rewards sdk.DecCoins) (stop bool) {
+			expectedCoins = expectedCoins.Add(rewards)
+			return false
+		})
+
 		communityPool := k.GetFeePoolCommunityCoins(ctx)
 		expectedInt, _ := expectedCoins.Add(communityPool).TruncateDecimal()
 
 		macc := k.GetDistributionAccount(ctx)
+		maccCoins := macc.GetCoins()
 
-		broken := !macc.GetCoins().IsEqual(expectedInt)
+		var msg string
+		for _, denom := range coinsDenoms(expectedInt, maccCoins) {
+			expected, actual := expectedInt.AmountOf(denom), maccCoins.AmountOf(denom)
+			if !expected.Equal(actual) {
+				msg += fmt.Sprintf("\t%s: expected %s, distribution ModuleAccount holds %s\n", denom, expected, actual)
+			}
+		}
+
+		broken := msg != ""
 		return sdk.FormatInvariant(types.ModuleName, "ModuleAccount coins",
 			fmt.Sprintf("\texpected ModuleAccount coins:     %s\n"+
-				"\tdistribution ModuleAccount coins: %s\n",
-				expectedInt, macc.GetCoins())), broken
+				"\tdistribution ModuleAccount coins: %s\n%s",
+				expectedInt, maccCoins, msg)), broken
+	}
+}
+
+// coinsDenoms returns the sorted denominations held by any of the given coins
+func coinsDenoms(coinsList ...sdk.Coins) []string {
+	seen := make(map[string]bool)
+	var denoms []string
+	for _, coins := range coinsList {
+		for _, coin := range coins {
+			if !seen[coin.Denom] {
+				seen[coin.Denom] = true
+				denoms = append(denoms, coin.Denom)
+			}
+		}
 	}
+
+	sort.Strings(denoms)
+	return denoms
 }
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestModuleAccountInvariantMultiDenom(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	outstanding := sdk.DecCoins{
+		sdk.NewInt64DecCoin("mytoken", 3),
+		sdk.NewInt64DecCoin("stake", 5),
+	}
+	keeper.SetValidatorOutstandingRewards(ctx, valOpAddr1, outstanding)
+
+	distrAcc := keeper.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("mytoken", 3), sdk.NewInt64Coin("stake", 5)))
+	keeper.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	_, broken := ModuleAccountInvariant(keeper)(ctx)
+	require.False(t, broken)
+
+	// each mismatching denom is reported on its own
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("mytoken", 2), sdk.NewInt64Coin("photon", 1), sdk.NewInt64Coin("stake", 5)))
+	keeper.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	msg, broken := ModuleAccountInvariant(keeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "mytoken: expected 3, distribution ModuleAccount holds 2")
+	require.Contains(t, msg, "photon: expected 0, distribution ModuleAccount holds 1")
+	require.NotContains(t, msg, "stake: expected")
+}
@@ -74,6 +74,37 @@ func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, w
 	return nil
 }
 
+// SetValidatorWithdrawAddr sets a new address that will receive the rewards of
+// the delegation of a delegator to a validator upon withdrawal, overriding the
+// withdraw address of the delegator for that validator. An empty withdraw
+// address removes the override, the rewards falling back to the withdraw
+// address of the delegator.
+func (k Keeper) SetValidatorWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) sdk.Error {
+	if k.blacklistedAddrs[withdrawAddr.String()] {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is blacklisted from receiving external funds", withdrawAddr))
+	}
+
+	if !k.GetWithdrawAddrEnabled(ctx) {
+		return types.ErrSetWithdrawAddrDisabled(k.codespace)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, validatorAddr.String()),
+		),
+	)
+
+	if withdrawAddr.Empty() {
+		k.DeleteDelegatorValidatorWithdrawAddr(ctx, delegatorAddr, validatorAddr)
+		return nil
+	}
+
+	k.SetDelegatorValidatorWithdrawAddr(ctx, delegatorAddr, validatorAddr, withdrawAddr)
+	return nil
+}
+
 // withdraw rewards from a delegation
 func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, sdk.Error) {
 	val := k.stakingKeeper.Validator(ctx, valAddr)
@@ -87,7 +118,7 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	}
 
 	// withdraw rewards
-	rewards, err := k.withdrawDelegationRewards(ctx, val, del)
+	rewards, err := k.withdrawDelegationRewards(ctx, val, del, false)
 	if err != nil {
 		return nil, err
 	}
@@ -127,6 +158,8 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 		if err != nil {
 			return nil, err
 		}
+
+		k.addCommissionWithdrawn(ctx, valAddr, commission)
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -25,6 +25,39 @@ func TestSetWithdrawAddr(t *testing.T) {
 	require.Error(t, keeper.SetWithdrawAddr(ctx, delAddr1, distrAcc.GetAddress()))
 }
 
+func TestSetValidatorWithdrawAddr(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	keeper.SetWithdrawAddrEnabled(ctx, false)
+	require.NotNil(t, keeper.SetValidatorWithdrawAddr(ctx, delAddr1, valOpAddr1, delAddr2))
+
+	keeper.SetWithdrawAddrEnabled(ctx, true)
+	require.Nil(t, keeper.SetWithdrawAddr(ctx, delAddr1, delAddr3))
+	require.Nil(t, keeper.SetValidatorWithdrawAddr(ctx, delAddr1, valOpAddr1, delAddr2))
+
+	// the other delegations fall back to the delegator withdraw address
+	require.Equal(t, delAddr2, keeper.GetDelegationWithdrawAddr(ctx, delAddr1, valOpAddr1))
+	require.Equal(t, delAddr3, keeper.GetDelegationWithdrawAddr(ctx, delAddr1, valOpAddr2))
+	require.Equal(t, delAddr2, keeper.GetDelegationWithdrawAddr(ctx, delAddr2, valOpAddr1))
+
+	var vals []sdk.ValAddress
+	keeper.IterateDelegatorValidatorWithdrawAddrsByDelegator(ctx, delAddr1, func(val sdk.ValAddress, addr sdk.AccAddress) bool {
+		require.Equal(t, delAddr2, addr)
+		vals = append(vals, val)
+		return false
+	})
+	require.Equal(t, []sdk.ValAddress{valOpAddr1}, vals)
+
+	// an empty withdraw address removes the override
+	require.Nil(t, keeper.SetValidatorWithdrawAddr(ctx, delAddr1, valOpAddr1, nil))
+	require.Equal(t, delAddr3, keeper.GetDelegationWithdrawAddr(ctx, delAddr1, valOpAddr1))
+	_, found := keeper.GetDelegatorValidatorWithdrawAddr(ctx, delAddr1, valOpAddr1)
+	require.False(t, found)
+
+	keeper.blacklistedAddrs[distrAcc.GetAddress().String()] = true
+	require.Error(t, keeper.SetValidatorWithdrawAddr(ctx, delAddr1, valOpAddr1, distrAcc.GetAddress()))
+}
+
 func TestWithdrawValidatorCommission(t *testing.T) {
 	ctx, ak, keeper, _, _ := CreateTestInputDefault(t, false, 1000)
 
@@ -70,6 +103,12 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 		sdk.NewDecCoinFromDec("stake", sdk.NewDec(1).Quo(sdk.NewDec(2))),
 	}, remainder)
 
+	// check lifetime commission withdrawn
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewCoin("mytoken", sdk.NewInt(1)),
+		sdk.NewCoin("stake", sdk.NewInt(1)),
+	), keeper.GetValidatorCommissionEarnings(ctx, valOpAddr3).Withdrawn)
+
 	require.True(t, true)
 }
 
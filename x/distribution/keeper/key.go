@@ -32,6 +32,14 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddr_Bytes>: sdk.DecCoins
+//
+// - 0x0A<valAddr_Bytes>: ValidatorCommissionEarnings
+//
+// - 0x0B<accAddr_Bytes><valAddr_Bytes>: sdk.AccAddress
+//
+// - 0x0C: bool
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -43,11 +51,17 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	DustRewardsPrefix                    = []byte{0x09} // key for the dust rewards pending payout
+	ValidatorCommissionEarningsPrefix    = []byte{0x0A} // key for the lifetime validator commission
+	DelegatorValidatorWithdrawAddrPrefix = []byte{0x0B} // key for delegator withdraw address per validator
+	DustPayoutPendingKey                 = []byte{0x0C} // key for a dust rewards payout spanning several blocks
 
 	ParamStoreKeyCommunityTax        = []byte("communitytax")
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyDustThreshold       = []byte("dustthreshold")
+	ParamStoreKeyDustPayoutPeriod    = []byte("dustpayoutperiod")
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -129,6 +143,39 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// gets the address from a withdraw address' dust rewards key
+func GetDustRewardsAddress(key []byte) (addr sdk.AccAddress) {
+	b := key[1:]
+	if len(b) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(b)
+}
+
+// gets the address from a validator's lifetime commission key
+func GetValidatorCommissionEarningsAddress(key []byte) (valAddr sdk.ValAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.ValAddress(addr)
+}
+
+// gets the addresses from a delegator's withdraw address per validator key
+func GetDelegatorValidatorWithdrawAddrAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	addr := key[1 : 1+sdk.AddrLen]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	delAddr = sdk.AccAddress(addr)
+	addr = key[1+sdk.AddrLen:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	valAddr = sdk.ValAddress(addr)
+	return
+}
+
 // gets the outstanding rewards key for a validator
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, valAddr.Bytes()...)
@@ -139,6 +186,26 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawAddrPrefix, delAddr.Bytes()...)
 }
 
+// gets the prefix key for a delegator's withdraw addrs per validator
+func GetDelegatorValidatorWithdrawAddrsPrefix(delAddr sdk.AccAddress) []byte {
+	return append(DelegatorValidatorWithdrawAddrPrefix, delAddr.Bytes()...)
+}
+
+// gets the key for a delegator's withdraw addr for the rewards of a validator
+func GetDelegatorValidatorWithdrawAddrKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetDelegatorValidatorWithdrawAddrsPrefix(delAddr), valAddr.Bytes()...)
+}
+
+// gets the key for the dust rewards pending payout to a withdraw address
+func GetDustRewardsKey(addr sdk.AccAddress) []byte {
+	return append(DustRewardsPrefix, addr.Bytes()...)
+}
+
+// gets the key for the lifetime commission of a validator
+func GetValidatorCommissionEarningsKey(v sdk.ValAddress) []byte {
+	return append(ValidatorCommissionEarningsPrefix, v.Bytes()...)
+}
+
 // gets the key for a delegator's starting info
 func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, v.Bytes()...), d.Bytes()...)
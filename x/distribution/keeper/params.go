@@ -1,18 +1,78 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // type declaration for parameters
 func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable(
-		ParamStoreKeyCommunityTax, sdk.Dec{},
-		ParamStoreKeyBaseProposerReward, sdk.Dec{},
-		ParamStoreKeyBonusProposerReward, sdk.Dec{},
-		ParamStoreKeyWithdrawAddrEnabled, false,
-	)
+	return params.NewKeyTable().
+		RegisterTypeWithValidator(ParamStoreKeyCommunityTax, sdk.Dec{}, validateCommunityTax).
+		RegisterTypeWithValidator(ParamStoreKeyBaseProposerReward, sdk.Dec{}, validateBaseProposerReward).
+		RegisterTypeWithValidator(ParamStoreKeyBonusProposerReward, sdk.Dec{}, validateBonusProposerReward).
+		RegisterTypeWithValidator(ParamStoreKeyWithdrawAddrEnabled, false, validateWithdrawAddrEnabled).
+		RegisterTypeWithValidator(ParamStoreKeyDustThreshold, sdk.Dec{}, validateDustThreshold).
+		RegisterTypeWithValidator(ParamStoreKeyDustPayoutPeriod, int64(0), validateDustPayoutPeriod)
+}
+
+// validateFraction checks that a distribution parameter is a decimal between 0
+// and 1.
+func validateFraction(name string, i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("distribution parameter %s must be between 0 and 1, is %s", name, v)
+	}
+	return nil
+}
+
+func validateCommunityTax(i interface{}) error {
+	return validateFraction("CommunityTax", i)
+}
+
+// NOTE: the sum of the base and bonus proposer rewards can't be checked here,
+// as each parameter is validated on its own.
+func validateBaseProposerReward(i interface{}) error {
+	return validateFraction("BaseProposerReward", i)
+}
+
+func validateBonusProposerReward(i interface{}) error {
+	return validateFraction("BonusProposerReward", i)
+}
+
+func validateWithdrawAddrEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDustThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("distribution parameter DustThreshold must not be negative, is %s", v)
+	}
+	return nil
+}
+
+func validateDustPayoutPeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("distribution parameter DustPayoutPeriod must be positive, is %d", v)
+	}
+	return nil
 }
 
 // returns the current CommunityTax rate from the global param store
@@ -66,3 +126,30 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) bool {
 func (k Keeper) SetWithdrawAddrEnabled(ctx sdk.Context, enabled bool) {
 	k.paramSpace.Set(ctx, ParamStoreKeyWithdrawAddrEnabled, &enabled)
 }
+
+// returns the current DustThreshold, below which the rewards withdrawn when a
+// delegation is modified are batched. It is read with GetIfExists so that
+// chains whose param store predates the parameter don't batch dust rewards.
+func (k Keeper) GetDustThreshold(ctx sdk.Context) sdk.Dec {
+	threshold := sdk.ZeroDec()
+	k.paramSpace.GetIfExists(ctx, ParamStoreKeyDustThreshold, &threshold)
+	return threshold
+}
+
+// nolint: errcheck
+func (k Keeper) SetDustThreshold(ctx sdk.Context, threshold sdk.Dec) {
+	k.paramSpace.Set(ctx, ParamStoreKeyDustThreshold, &threshold)
+}
+
+// returns the current DustPayoutPeriod, in blocks. It is read with GetIfExists
+// so that chains whose param store predates the parameter use the default one.
+func (k Keeper) GetDustPayoutPeriod(ctx sdk.Context) int64 {
+	period := types.DefaultDustPayoutPeriod
+	k.paramSpace.GetIfExists(ctx, ParamStoreKeyDustPayoutPeriod, &period)
+	return period
+}
+
+// nolint: errcheck
+func (k Keeper) SetDustPayoutPeriod(ctx sdk.Context, period int64) {
+	k.paramSpace.Set(ctx, ParamStoreKeyDustPayoutPeriod, &period)
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func testParamChangeProposal(changes ...params.ParamChange) params.ParameterChangeProposal {
+	return params.NewParameterChangeProposal("Test", "description", changes)
+}
+
+func TestParamChangeProposalValidation(t *testing.T) {
+	ctx, _, _, keeper, _, pk, _ := CreateTestInputAdvanced(t, false, 1000, sdk.NewDecWithPrec(2, 2))
+	hdlr := params.NewParamChangeProposalHandler(pk)
+
+	invalid := []params.ParamChange{
+		params.NewParamChange(DefaultParamspace, string(ParamStoreKeyCommunityTax), `"1.500000000000000000"`),
+		params.NewParamChange(DefaultParamspace, string(ParamStoreKeyCommunityTax), `"-0.100000000000000000"`),
+		params.NewParamChange(DefaultParamspace, string(ParamStoreKeyBaseProposerReward), `"1.100000000000000000"`),
+		params.NewParamChange(DefaultParamspace, string(ParamStoreKeyBonusProposerReward), `"-0.010000000000000000"`),
+		params.NewParamChange(DefaultParamspace, string(ParamStoreKeyDustThreshold), `"-1.000000000000000000"`),
+		params.NewParamChange(DefaultParamspace, string(ParamStoreKeyDustPayoutPeriod), `"0"`),
+	}
+	for _, pc := range invalid {
+		require.Error(t, hdlr(ctx, testParamChangeProposal(pc)), pc.Key)
+	}
+
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), keeper.GetCommunityTax(ctx))
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), keeper.GetBaseProposerReward(ctx))
+	require.Equal(t, sdk.NewDecWithPrec(4, 2), keeper.GetBonusProposerReward(ctx))
+	require.Equal(t, sdk.ZeroDec(), keeper.GetDustThreshold(ctx))
+	require.Equal(t, int64(100), keeper.GetDustPayoutPeriod(ctx))
+
+	pc := params.NewParamChange(DefaultParamspace, string(ParamStoreKeyCommunityTax), `"0.050000000000000000"`)
+	require.NoError(t, hdlr(ctx, testParamChangeProposal(pc)))
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), keeper.GetCommunityTax(ctx))
+}
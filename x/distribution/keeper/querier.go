@@ -6,9 +6,11 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 )
 
@@ -24,6 +26,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryValidatorCommission:
 			return queryValidatorCommission(ctx, path[1:], req, k)
 
+		case types.QueryValidatorCommissionEarnings:
+			return queryValidatorCommissionEarnings(ctx, path[1:], req, k)
+
 		case types.QueryValidatorSlashes:
 			return queryValidatorSlashes(ctx, path[1:], req, k)
 
@@ -36,12 +41,27 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryDelegatorValidators:
 			return queryDelegatorValidators(ctx, path[1:], req, k)
 
+		case types.QueryDelegatorSummary:
+			return queryDelegatorSummary(ctx, path[1:], req, k)
+
 		case types.QueryWithdrawAddr:
 			return queryDelegatorWithdrawAddress(ctx, path[1:], req, k)
 
+		case types.QueryDelegationWithdrawAddr:
+			return queryDelegationWithdrawAddress(ctx, path[1:], req, k)
+
+		case types.QueryValidatorWithdrawAddrs:
+			return queryValidatorWithdrawAddresses(ctx, path[1:], req, k)
+
 		case types.QueryCommunityPool:
 			return queryCommunityPool(ctx, path[1:], req, k)
 
+		case types.QueryAllOutstandingRewards:
+			return queryAllOutstandingRewards(ctx, path[1:], req, k)
+
+		case types.QueryDelegatorRewardsByDenom:
+			return queryDelegatorRewardsByDenom(ctx, path[1:], req, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown distr query endpoint")
 		}
@@ -74,6 +94,18 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 		}
 		return bz, nil
+	case types.ParamDustThreshold:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetDustThreshold(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
+	case types.ParamDustPayoutPeriod:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetDustPayoutPeriod(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
 	default:
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("%s is not a valid query request path", req.Path))
 	}
@@ -96,6 +128,42 @@ func queryValidatorOutstandingRewards(ctx sdk.Context, path []string, req abci.R
 	return bz, nil
 }
 
+func queryAllOutstandingRewards(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAllOutstandingRewardsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	summaries := []types.ValidatorRewardsSummary{}
+	k.IterateValidatorOutstandingRewards(ctx, func(val sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool) {
+		summaries = append(summaries, types.NewValidatorRewardsSummary(val, rewards, nil))
+		return false
+	})
+
+	start, end := client.Paginate(len(summaries), params.Page, params.Limit, int(k.stakingKeeper.MaxValidators(ctx)))
+	if start < 0 || end < 0 {
+		summaries = []types.ValidatorRewardsSummary{}
+	} else {
+		summaries = summaries[start:end]
+	}
+
+	// only fetch the commission of the validators in the requested page
+	for i, summary := range summaries {
+		commission := k.GetValidatorAccumulatedCommission(ctx, summary.ValidatorAddress)
+		if commission == nil {
+			commission = sdk.DecCoins{}
+		}
+		summaries[i].Commission = commission
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, summaries)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func queryValidatorCommission(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	var params types.QueryValidatorCommissionParams
 	err := k.cdc.UnmarshalJSON(req.Data, &params)
@@ -113,6 +181,20 @@ func queryValidatorCommission(ctx sdk.Context, path []string, req abci.RequestQu
 	return bz, nil
 }
 
+func queryValidatorCommissionEarnings(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorCommissionParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	earnings := k.GetValidatorCommissionEarnings(ctx, params.ValidatorAddress)
+	bz, err := codec.MarshalJSONIndent(k.cdc, earnings)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func queryValidatorSlashes(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	var params types.QueryValidatorSlashesParams
 	err := k.cdc.UnmarshalJSON(req.Data, &params)
@@ -179,11 +261,43 @@ func queryDelegatorTotalRewards(ctx sdk.Context, _ []string, req abci.RequestQue
 	// cache-wrap context as to not persist state changes during querying
 	ctx, _ = ctx.CacheContext()
 
+	totalRewards := delegatorTotalRewards(ctx, k, params.DelegatorAddress)
+	bz, err := json.Marshal(totalRewards)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryDelegatorRewardsByDenom(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	// cache-wrap context as to not persist state changes during querying
+	ctx, _ = ctx.CacheContext()
+
+	totalRewards := delegatorTotalRewards(ctx, k, params.DelegatorAddress)
+	bz, err := codec.MarshalJSONIndent(k.cdc, types.NewDenomRewards(totalRewards.Rewards))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// delegatorTotalRewards returns the rewards of each delegation of a delegator
+// along with their total. The context is expected to be cache-wrapped, as the
+// periods of the validators are incremented.
+func delegatorTotalRewards(ctx sdk.Context, k Keeper, delAddr sdk.AccAddress) types.QueryDelegatorTotalRewardsResponse {
 	total := sdk.DecCoins{}
 	var delRewards []types.DelegationDelegatorReward
 
 	k.stakingKeeper.IterateDelegations(
-		ctx, params.DelegatorAddress,
+		ctx, delAddr,
 		func(_ int64, del exported.DelegationI) (stop bool) {
 			valAddr := del.GetValidatorAddr()
 			val := k.stakingKeeper.Validator(ctx, valAddr)
@@ -196,13 +310,7 @@ func queryDelegatorTotalRewards(ctx sdk.Context, _ []string, req abci.RequestQue
 		},
 	)
 
-	totalRewards := types.NewQueryDelegatorTotalRewardsResponse(delRewards, total)
-	bz, err := json.Marshal(totalRewards)
-	if err != nil {
-		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
-	}
-
-	return bz, nil
+	return types.NewQueryDelegatorTotalRewardsResponse(delRewards, total)
 }
 
 func queryDelegatorValidators(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
@@ -232,6 +340,77 @@ func queryDelegatorValidators(ctx sdk.Context, _ []string, req abci.RequestQuery
 	return bz, nil
 }
 
+func queryDelegatorSummary(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	// cache-wrap context as to not persist state changes during querying
+	ctx, _ = ctx.CacheContext()
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	total := sdk.DecCoins{}
+	delRewards := []types.DelegationDelegatorReward{}
+
+	delegations := k.stakingKeeper.GetAllDelegatorDelegations(ctx, params.DelegatorAddress)
+	delResps := make(staking.DelegationResponses, len(delegations))
+	for i, del := range delegations {
+		val := k.stakingKeeper.Validator(ctx, del.ValidatorAddress)
+		if val == nil {
+			return nil, sdk.ErrInternal(fmt.Sprintf("validator %s does not exist", del.ValidatorAddress))
+		}
+
+		delResps[i] = staking.NewDelegationResp(
+			del.DelegatorAddress, del.ValidatorAddress, del.Shares,
+			sdk.NewCoin(bondDenom, val.TokensFromShares(del.Shares).TruncateInt()),
+		)
+
+		endingPeriod := k.incrementValidatorPeriod(ctx, val)
+		delReward := k.calculateDelegationRewards(ctx, val, del, endingPeriod)
+
+		delRewards = append(delRewards, types.NewDelegationDelegatorReward(del.ValidatorAddress, delReward))
+		total = total.Add(delReward)
+	}
+
+	redels := k.stakingKeeper.GetAllRedelegations(ctx, params.DelegatorAddress, nil, nil)
+	redelResps := make(staking.RedelegationResponses, len(redels))
+	for i, redel := range redels {
+		val := k.stakingKeeper.Validator(ctx, redel.ValidatorDstAddress)
+		if val == nil {
+			return nil, sdk.ErrInternal(fmt.Sprintf("validator %s does not exist", redel.ValidatorDstAddress))
+		}
+
+		entries := make([]staking.RedelegationEntryResponse, len(redel.Entries))
+		for j, entry := range redel.Entries {
+			entries[j] = staking.NewRedelegationEntryResponse(
+				entry.CreationHeight, entry.CompletionTime, entry.SharesDst,
+				entry.InitialBalance, val.TokensFromShares(entry.SharesDst).TruncateInt(),
+			)
+		}
+
+		redelResps[i] = staking.NewRedelegationResponse(
+			redel.DelegatorAddress, redel.ValidatorSrcAddress, redel.ValidatorDstAddress, entries,
+		)
+	}
+
+	summary := types.NewQueryDelegatorSummaryResponse(
+		delResps,
+		k.stakingKeeper.GetAllUnbondingDelegations(ctx, params.DelegatorAddress),
+		redelResps,
+		delRewards,
+		total,
+	)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, summary)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 func queryDelegatorWithdrawAddress(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	var params types.QueryDelegatorWithdrawAddrParams
 	err := k.cdc.UnmarshalJSON(req.Data, &params)
@@ -251,6 +430,46 @@ func queryDelegatorWithdrawAddress(ctx sdk.Context, _ []string, req abci.Request
 	return bz, nil
 }
 
+func queryDelegationWithdrawAddress(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegationWithdrawAddrParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	withdrawAddr := k.GetDelegationWithdrawAddr(ctx, params.DelegatorAddress, params.ValidatorAddress)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, withdrawAddr)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryValidatorWithdrawAddresses(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorWithdrawAddrParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	withdrawAddrs := make([]types.ValidatorWithdrawAddress, 0)
+	k.IterateDelegatorValidatorWithdrawAddrsByDelegator(ctx, params.DelegatorAddress,
+		func(val sdk.ValAddress, addr sdk.AccAddress) (stop bool) {
+			withdrawAddrs = append(withdrawAddrs, types.NewValidatorWithdrawAddress(val, addr))
+			return false
+		},
+	)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, withdrawAddrs)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 func queryCommunityPool(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	pool := k.GetFeePoolCommunityCoins(ctx)
 	if pool == nil {
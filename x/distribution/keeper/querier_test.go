@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
@@ -58,6 +59,29 @@ func getQueriedParams(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier s
 	return communityTax, baseProposerReward, bonusProposerReward, withdrawAddrEnabled
 }
 
+func getQueriedDustParams(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier) (dustThreshold sdk.Dec, dustPayoutPeriod int64) {
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryParams, types.ParamDustThreshold}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err := querier(ctx, []string{types.QueryParams, types.ParamDustThreshold}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &dustThreshold))
+
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryParams, types.ParamDustPayoutPeriod}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err = querier(ctx, []string{types.QueryParams, types.ParamDustPayoutPeriod}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &dustPayoutPeriod))
+
+	return dustThreshold, dustPayoutPeriod
+}
+
 func getQueriedValidatorOutstandingRewards(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, validatorAddr sdk.ValAddress) (outstandingRewards sdk.DecCoins) {
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryValidatorOutstandingRewards}, "/"),
@@ -71,6 +95,19 @@ func getQueriedValidatorOutstandingRewards(t *testing.T, ctx sdk.Context, cdc *c
 	return
 }
 
+func getQueriedAllOutstandingRewards(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, page, limit int) (summaries []types.ValidatorRewardsSummary) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryAllOutstandingRewards}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryAllOutstandingRewardsParams(page, limit)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryAllOutstandingRewards}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &summaries))
+
+	return
+}
+
 func getQueriedValidatorCommission(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, validatorAddr sdk.ValAddress) (validatorCommission sdk.DecCoins) {
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryValidatorCommission}, "/"),
@@ -84,6 +121,19 @@ func getQueriedValidatorCommission(t *testing.T, ctx sdk.Context, cdc *codec.Cod
 	return
 }
 
+func getQueriedValidatorCommissionEarnings(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, validatorAddr sdk.ValAddress) (earnings types.ValidatorCommissionEarnings) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryValidatorCommissionEarnings}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryValidatorCommissionParams(validatorAddr)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryValidatorCommissionEarnings}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &earnings))
+
+	return
+}
+
 func getQueriedValidatorSlashes(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, validatorAddr sdk.ValAddress, startHeight uint64, endHeight uint64) (slashes []types.ValidatorSlashEvent) {
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryValidatorSlashes}, "/"),
@@ -123,6 +173,32 @@ func getQueriedDelegatorTotalRewards(t *testing.T, ctx sdk.Context, cdc *codec.C
 	return
 }
 
+func getQueriedDelegatorRewardsByDenom(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, delegatorAddr sdk.AccAddress) (rewards []types.DenomRewards) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryDelegatorRewardsByDenom}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryDelegatorRewardsByDenom}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &rewards))
+
+	return
+}
+
+func getQueriedDelegatorSummary(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, delegatorAddr sdk.AccAddress) (response types.QueryDelegatorSummaryResponse) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryDelegatorSummary}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryDelegatorSummary}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &response))
+
+	return
+}
+
 func getQueriedCommunityPool(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier) (ptr []byte) {
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryCommunityPool}, ""),
@@ -158,6 +234,14 @@ func TestQueries(t *testing.T) {
 	require.Equal(t, bonusProposerReward, retBonusProposerReward)
 	require.Equal(t, withdrawAddrEnabled, retWithdrawAddrEnabled)
 
+	dustThreshold := sdk.NewDec(7)
+	dustPayoutPeriod := int64(25)
+	keeper.SetDustThreshold(ctx, dustThreshold)
+	keeper.SetDustPayoutPeriod(ctx, dustPayoutPeriod)
+	retDustThreshold, retDustPayoutPeriod := getQueriedDustParams(t, ctx, cdc, querier)
+	require.Equal(t, dustThreshold, retDustThreshold)
+	require.Equal(t, dustPayoutPeriod, retDustPayoutPeriod)
+
 	// test outstanding rewards query
 	outstandingRewards := sdk.DecCoins{{Denom: "mytoken", Amount: sdk.NewDec(3)}, {Denom: "myothertoken", Amount: sdk.NewDecWithPrec(3, 7)}}
 	keeper.SetValidatorOutstandingRewards(ctx, valOpAddr1, outstandingRewards)
@@ -170,6 +254,30 @@ func TestQueries(t *testing.T) {
 	retCommission := getQueriedValidatorCommission(t, ctx, cdc, querier, valOpAddr1)
 	require.Equal(t, commission, retCommission)
 
+	// test validator commission earnings query
+	earnings := types.NewValidatorCommissionEarnings(commission, sdk.NewCoins(sdk.NewInt64Coin("token1", 3)))
+	keeper.SetValidatorCommissionEarnings(ctx, valOpAddr1, earnings)
+	retEarnings := getQueriedValidatorCommissionEarnings(t, ctx, cdc, querier, valOpAddr1)
+	require.Equal(t, earnings, retEarnings)
+
+	// test all outstanding rewards query
+	outstandingRewards2 := sdk.DecCoins{{Denom: "mytoken", Amount: sdk.NewDec(7)}}
+	keeper.SetValidatorOutstandingRewards(ctx, valOpAddr2, outstandingRewards2)
+	// validators are sorted by address and empty commissions decode to nil
+	expSummaries := []types.ValidatorRewardsSummary{
+		types.NewValidatorRewardsSummary(valOpAddr1, outstandingRewards, commission),
+		types.NewValidatorRewardsSummary(valOpAddr2, outstandingRewards2, nil),
+	}
+	if bytes.Compare(valOpAddr2, valOpAddr1) < 0 {
+		expSummaries[0], expSummaries[1] = expSummaries[1], expSummaries[0]
+	}
+	summaries := getQueriedAllOutstandingRewards(t, ctx, cdc, querier, 1, 0)
+	require.Equal(t, expSummaries, summaries)
+	summaries = getQueriedAllOutstandingRewards(t, ctx, cdc, querier, 2, 1)
+	require.Equal(t, expSummaries[1:], summaries)
+	summaries = getQueriedAllOutstandingRewards(t, ctx, cdc, querier, 3, 1)
+	require.Empty(t, summaries)
+
 	// test delegator's total rewards query
 	delRewards := getQueriedDelegatorTotalRewards(t, ctx, cdc, querier, sdk.AccAddress(valOpAddr1))
 	require.Equal(t, types.QueryDelegatorTotalRewardsResponse{}, delRewards)
@@ -211,7 +319,74 @@ func TestQueries(t *testing.T) {
 		[]types.DelegationDelegatorReward{expectedDelReward}, expectedDelReward.Reward)
 	require.Equal(t, wantDelRewards, delRewards)
 
+	// test delegator summary query
+	summary := getQueriedDelegatorSummary(t, ctx, cdc, querier, sdk.AccAddress(valOpAddr1))
+	require.Len(t, summary.Delegations, 1)
+	require.Equal(t, valOpAddr1, summary.Delegations[0].ValidatorAddress)
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), summary.Delegations[0].Balance)
+	require.Empty(t, summary.UnbondingDelegations)
+	require.Empty(t, summary.Redelegations)
+	require.Equal(t, wantDelRewards.Rewards, summary.Rewards)
+	require.Equal(t, wantDelRewards.Total, summary.Total)
+
 	// currently community pool hold nothing so we should return null
 	communityPool := getQueriedCommunityPool(t, ctx, cdc, querier)
 	require.Nil(t, communityPool)
 }
+
+func TestQueryDelegatorRewardsByDenom(t *testing.T) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	ctx, _, keeper, sk, _ := CreateTestInputDefault(t, false, 100)
+	querier := NewQuerier(keeper)
+	sh := staking.NewHandler(sk)
+	delAddr := sdk.AccAddress(valOpAddr1)
+
+	// no delegations
+	require.Empty(t, getQueriedDelegatorRewardsByDenom(t, ctx, cdc, querier, delAddr))
+
+	// create two validators with 50% commission, and delegate to the second
+	// one as much as its self-delegation
+	comm := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	bond := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1, bond, staking.Description{}, comm, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	msg = staking.NewMsgCreateValidator(valOpAddr2, valConsPk2, bond, staking.Description{}, comm, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	require.True(t, sh(ctx, staking.NewMsgDelegate(delAddr, valOpAddr2, bond)).IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// allocate fees paid in several denoms
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	keeper.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), sdk.DecCoins{
+		sdk.NewInt64DecCoin("photon", 20), sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 10),
+	})
+	keeper.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr2), sdk.DecCoins{
+		sdk.NewInt64DecCoin("photon", 10),
+	})
+
+	// the rewards of a denom follow the order of the validator addresses, which
+	// are randomly generated
+	rewards := getQueriedDelegatorRewardsByDenom(t, ctx, cdc, querier, delAddr)
+	require.Len(t, rewards, 2)
+	require.Equal(t, "photon", rewards[0].Denom)
+	require.Equal(t, sdk.NewDecWithPrec(125, 1), rewards[0].Total)
+	require.ElementsMatch(t, []types.DenomValidatorReward{
+		{ValidatorAddress: valOpAddr1, Amount: sdk.NewDec(10)},
+		{ValidatorAddress: valOpAddr2, Amount: sdk.NewDecWithPrec(25, 1)},
+	}, rewards[0].Rewards)
+	require.Equal(t, types.DenomRewards{
+		Denom: sdk.DefaultBondDenom,
+		Total: sdk.NewDec(5),
+		Rewards: []types.DenomValidatorReward{
+			{ValidatorAddress: valOpAddr1, Amount: sdk.NewDec(5)},
+		},
+	}, rewards[1])
+
+	// the rewards add up to the delegator's total rewards
+	total := getQueriedDelegatorTotalRewards(t, ctx, cdc, querier, delAddr).Total
+	for _, denomRewards := range rewards {
+		require.Equal(t, total.AmountOf(denomRewards.Denom), denomRewards.Total)
+	}
+}
@@ -41,6 +41,65 @@ func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context, handler func(del
 	}
 }
 
+// get the delegator withdraw address for the rewards of a validator, if any
+func (k Keeper) GetDelegatorValidatorWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (withdrawAddr sdk.AccAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetDelegatorValidatorWithdrawAddrKey(delAddr, valAddr))
+	if b == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(b), true
+}
+
+// set the delegator withdraw address for the rewards of a validator
+func (k Keeper) SetDelegatorValidatorWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetDelegatorValidatorWithdrawAddrKey(delAddr, valAddr), withdrawAddr.Bytes())
+}
+
+// delete the delegator withdraw address for the rewards of a validator
+func (k Keeper) DeleteDelegatorValidatorWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetDelegatorValidatorWithdrawAddrKey(delAddr, valAddr))
+}
+
+// iterate over the delegator withdraw addrs per validator
+func (k Keeper) IterateDelegatorValidatorWithdrawAddrs(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress, addr sdk.AccAddress) (stop bool)) {
+	k.iterateDelegatorValidatorWithdrawAddrs(ctx, DelegatorValidatorWithdrawAddrPrefix, handler)
+}
+
+// iterate over the withdraw addrs per validator of a delegator
+func (k Keeper) IterateDelegatorValidatorWithdrawAddrsByDelegator(ctx sdk.Context, delAddr sdk.AccAddress, handler func(val sdk.ValAddress, addr sdk.AccAddress) (stop bool)) {
+	k.iterateDelegatorValidatorWithdrawAddrs(ctx, GetDelegatorValidatorWithdrawAddrsPrefix(delAddr),
+		func(_ sdk.AccAddress, val sdk.ValAddress, addr sdk.AccAddress) (stop bool) {
+			return handler(val, addr)
+		},
+	)
+}
+
+func (k Keeper) iterateDelegatorValidatorWithdrawAddrs(ctx sdk.Context, prefix []byte, handler func(del sdk.AccAddress, val sdk.ValAddress, addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		addr := sdk.AccAddress(iter.Value())
+		del, val := GetDelegatorValidatorWithdrawAddrAddresses(iter.Key())
+		if handler(del, val, addr) {
+			break
+		}
+	}
+}
+
+// get the address the rewards of a delegation are withdrawn to: the delegator
+// withdraw address for the validator if set, and the delegator withdraw
+// address otherwise
+func (k Keeper) GetDelegationWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.AccAddress {
+	if withdrawAddr, found := k.GetDelegatorValidatorWithdrawAddr(ctx, delAddr, valAddr); found {
+		return withdrawAddr
+	}
+	return k.GetDelegatorWithdrawAddr(ctx, delAddr)
+}
+
 // get the global fee pool distribution info
 func (k Keeper) GetFeePool(ctx sdk.Context) (feePool types.FeePool) {
 	store := ctx.KVStore(k.storeKey)
@@ -377,3 +436,108 @@ func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// get the dust rewards pending payout to a withdraw address
+func (k Keeper) GetDustRewards(ctx sdk.Context, addr sdk.AccAddress) (rewards sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetDustRewardsKey(addr))
+	if b == nil {
+		return nil
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &rewards)
+	return
+}
+
+// set the dust rewards pending payout to a withdraw address
+func (k Keeper) SetDustRewards(ctx sdk.Context, addr sdk.AccAddress, rewards sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(rewards)
+	store.Set(GetDustRewardsKey(addr), b)
+}
+
+// delete the dust rewards pending payout to a withdraw address
+func (k Keeper) DeleteDustRewards(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetDustRewardsKey(addr))
+}
+
+// iterate over the dust rewards pending payout
+func (k Keeper) IterateDustRewards(ctx sdk.Context, handler func(addr sdk.AccAddress, rewards sdk.DecCoins) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, DustRewardsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards sdk.DecCoins
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &rewards)
+		addr := GetDustRewardsAddress(iter.Key())
+		if handler(addr, rewards) {
+			break
+		}
+	}
+}
+
+// returns true if a dust rewards payout is in progress
+func (k Keeper) GetDustPayoutPending(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(DustPayoutPendingKey)
+}
+
+// set whether a dust rewards payout is in progress
+func (k Keeper) SetDustPayoutPending(ctx sdk.Context, pending bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !pending {
+		store.Delete(DustPayoutPendingKey)
+		return
+	}
+	store.Set(DustPayoutPendingKey, []byte{0x01})
+}
+
+// get the lifetime commission of a validator
+func (k Keeper) GetValidatorCommissionEarnings(ctx sdk.Context, val sdk.ValAddress) (earnings types.ValidatorCommissionEarnings) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetValidatorCommissionEarningsKey(val))
+	if b == nil {
+		return types.ValidatorCommissionEarnings{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &earnings)
+	return
+}
+
+// set the lifetime commission of a validator
+func (k Keeper) SetValidatorCommissionEarnings(ctx sdk.Context, val sdk.ValAddress, earnings types.ValidatorCommissionEarnings) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(earnings)
+	store.Set(GetValidatorCommissionEarningsKey(val), b)
+}
+
+// iterate over the lifetime commission of the validators
+func (k Keeper) IterateValidatorCommissionEarnings(ctx sdk.Context, handler func(val sdk.ValAddress, earnings types.ValidatorCommissionEarnings) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, ValidatorCommissionEarningsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var earnings types.ValidatorCommissionEarnings
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &earnings)
+		addr := GetValidatorCommissionEarningsAddress(iter.Key())
+		if handler(addr, earnings) {
+			break
+		}
+	}
+}
+
+// add commission allocated to a validator to its lifetime commission
+func (k Keeper) addCommissionEarned(ctx sdk.Context, val sdk.ValAddress, commission sdk.DecCoins) {
+	if commission.IsZero() {
+		return
+	}
+	earnings := k.GetValidatorCommissionEarnings(ctx, val)
+	earnings.Earned = earnings.Earned.Add(commission)
+	k.SetValidatorCommissionEarnings(ctx, val, earnings)
+}
+
+// add commission withdrawn by a validator to its lifetime commission
+func (k Keeper) addCommissionWithdrawn(ctx sdk.Context, val sdk.ValAddress, commission sdk.Coins) {
+	earnings := k.GetValidatorCommissionEarnings(ctx, val)
+	earnings.Withdrawn = earnings.Withdrawn.Add(commission)
+	k.SetValidatorCommissionEarnings(ctx, val, earnings)
+}
@@ -64,6 +64,21 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &eventB)
 		return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+	case bytes.Equal(kvA.Key[:1], keeper.DustRewardsPrefix):
+		var rewardsA, rewardsB sdk.DecCoins
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &rewardsA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &rewardsB)
+		return fmt.Sprintf("%v\n%v", rewardsA, rewardsB)
+
+	case bytes.Equal(kvA.Key[:1], keeper.ValidatorCommissionEarningsPrefix):
+		var earningsA, earningsB types.ValidatorCommissionEarnings
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &earningsA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &earningsB)
+		return fmt.Sprintf("%v\n%v", earningsA, earningsB)
+
+	case bytes.Equal(kvA.Key[:1], keeper.DelegatorValidatorWithdrawAddrPrefix):
+		return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
 	default:
 		panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 	}
@@ -42,6 +42,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, sdk.OneDec())
+	earnings := types.NewValidatorCommissionEarnings(decCoins, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
 
 	kvPairs := cmn.KVPairs{
 		cmn.KVPair{Key: keeper.FeePoolKey, Value: cdc.MustMarshalBinaryLengthPrefixed(feePool)},
@@ -53,6 +54,9 @@ func TestDecodeDistributionStore(t *testing.T) {
 		cmn.KVPair{Key: keeper.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(currentRewards)},
 		cmn.KVPair{Key: keeper.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(commission)},
 		cmn.KVPair{Key: keeper.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshalBinaryLengthPrefixed(slashEvent)},
+		cmn.KVPair{Key: keeper.GetDustRewardsKey(delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(decCoins)},
+		cmn.KVPair{Key: keeper.GetValidatorCommissionEarningsKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(earnings)},
+		cmn.KVPair{Key: keeper.GetDelegatorValidatorWithdrawAddrKey(delAddr1, valAddr1), Value: delAddr1.Bytes()},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -69,6 +73,9 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"DustRewards", fmt.Sprintf("%v\n%v", decCoins, decCoins)},
+		{"ValidatorCommissionEarnings", fmt.Sprintf("%v\n%v", earnings, earnings)},
+		{"DelegatorValidatorWithdrawAddr", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
 // Simulation parameter constants
@@ -19,21 +20,45 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+	DustThreshold       = "dust_threshold"
+	DustPayoutPeriod    = "dust_payout_period"
 )
 
+// ParamChangeMaxReward is the upper bound of the community tax and proposer
+// reward values set by param change proposals. It is reserved when generating
+// the genesis parameters so that any combination of changes keeps the sum of
+// the three parameters below one, as required by the fee allocation.
+var ParamChangeMaxReward = sdk.NewDecWithPrec(5, 2)
+
+// genesisRewardBudget is the share of the fee allocation the genesis community
+// tax and proposer rewards can add up to.
+var genesisRewardBudget = sdk.OneDec().Sub(ParamChangeMaxReward.MulInt64(3))
+
 // GenCommunityTax randomized CommunityTax
 func GenCommunityTax(r *rand.Rand) sdk.Dec {
-	return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2))
+	return randomDecUpTo(r, genesisRewardBudget)
 }
 
-// GenBaseProposerReward randomized BaseProposerReward
-func GenBaseProposerReward(r *rand.Rand) sdk.Dec {
-	return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2))
+// GenBaseProposerReward randomized BaseProposerReward given the community tax
+func GenBaseProposerReward(r *rand.Rand, communityTax sdk.Dec) sdk.Dec {
+	return randomDecUpTo(r, genesisRewardBudget.Sub(communityTax))
 }
 
-// GenBonusProposerReward randomized BonusProposerReward
-func GenBonusProposerReward(r *rand.Rand) sdk.Dec {
-	return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2))
+// GenBonusProposerReward randomized BonusProposerReward given the community
+// tax and the base proposer reward
+func GenBonusProposerReward(r *rand.Rand, communityTax, baseProposerReward sdk.Dec) sdk.Dec {
+	return randomDecUpTo(r, genesisRewardBudget.Sub(communityTax).Sub(baseProposerReward))
+}
+
+// randomDecUpTo returns a random decimal with a two digits precision between
+// zero and max, both included. Zero is returned if max isn't positive.
+func randomDecUpTo(r *rand.Rand, max sdk.Dec) sdk.Dec {
+	if !max.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	hundredths := max.MulInt64(100).TruncateInt64()
+	return sdk.NewDecWithPrec(r.Int63n(hundredths+1), 2)
 }
 
 // GenWithdrawEnabled returns a randomized WithdrawEnabled parameter.
@@ -41,6 +66,19 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenDustThreshold returns a randomized DustThreshold parameter.
+func GenDustThreshold(r *rand.Rand) sdk.Dec {
+	if r.Intn(2) == 0 {
+		return sdk.ZeroDec() // 50% chance of dust batching being disabled
+	}
+	return sdk.NewDec(int64(simulation.RandIntBetween(r, 1, 1000)))
+}
+
+// GenDustPayoutPeriod returns a randomized DustPayoutPeriod parameter.
+func GenDustPayoutPeriod(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 1, 50))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -52,13 +90,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var baseProposerReward sdk.Dec
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, BaseProposerReward, &baseProposerReward, simState.Rand,
-		func(r *rand.Rand) { baseProposerReward = GenBaseProposerReward(r) },
+		func(r *rand.Rand) { baseProposerReward = GenBaseProposerReward(r, communityTax) },
 	)
 
 	var bonusProposerReward sdk.Dec
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, BonusProposerReward, &bonusProposerReward, simState.Rand,
-		func(r *rand.Rand) { bonusProposerReward = GenBonusProposerReward(r) },
+		func(r *rand.Rand) { bonusProposerReward = GenBonusProposerReward(r, communityTax, baseProposerReward) },
 	)
 
 	var withdrawEnabled bool
@@ -67,12 +105,26 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var dustThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DustThreshold, &dustThreshold, simState.Rand,
+		func(r *rand.Rand) { dustThreshold = GenDustThreshold(r) },
+	)
+
+	var dustPayoutPeriod int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DustPayoutPeriod, &dustPayoutPeriod, simState.Rand,
+		func(r *rand.Rand) { dustPayoutPeriod = GenDustPayoutPeriod(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool:             types.InitialFeePool(),
 		CommunityTax:        communityTax,
 		BaseProposerReward:  baseProposerReward,
 		BonusProposerReward: bonusProposerReward,
 		WithdrawAddrEnabled: withdrawEnabled,
+		DustThreshold:       dustThreshold,
+		DustPayoutPeriod:    dustPayoutPeriod,
 	}
 
 	fmt.Printf("Selected randomly generated distribution parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, distrGenesis))
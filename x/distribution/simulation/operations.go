@@ -6,34 +6,91 @@ import (
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
-	govsim "github.com/cosmos/cosmos-sdk/x/gov/simulation"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// SimulateMsgSetWithdrawAddress generates a MsgSetWithdrawAddress with random values.
+// Simulation operation weights constants
+const (
+	OpWeightMsgSetWithdrawAddress          = "op_weight_msg_set_withdraw_address"
+	OpWeightMsgSetValidatorWithdrawAddress = "op_weight_msg_set_validator_withdraw_address"
+	OpWeightMsgWithdrawDelegationReward    = "op_weight_msg_withdraw_delegation_reward"
+	OpWeightMsgWithdrawValidatorCommission = "op_weight_msg_withdraw_validator_commission"
+	OpWeightSubmitCommunitySpendProposal   = "op_weight_submit_community_spend_proposal"
+
+	DefaultWeightCommunitySpendProposal = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, ak types.AccountKeeper,
+	k keeper.Keeper, sk stakingkeeper.Keeper) simulation.WeightedOperations {
+
+	var weightMsgSetWithdrawAddress int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetWithdrawAddress, &weightMsgSetWithdrawAddress, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetWithdrawAddress = 50
+		},
+	)
+
+	var weightMsgSetValidatorWithdrawAddress int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetValidatorWithdrawAddress, &weightMsgSetValidatorWithdrawAddress, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetValidatorWithdrawAddress = 50
+		},
+	)
+
+	var weightMsgWithdrawDelegationReward int
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawDelegationReward, &weightMsgWithdrawDelegationReward, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawDelegationReward = 50
+		},
+	)
+
+	var weightMsgWithdrawValidatorCommission int
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawValidatorCommission, &weightMsgWithdrawValidatorCommission, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawValidatorCommission = 50
+		},
+	)
+
+	return simulation.WeightedOperations{
+		{Weight: weightMsgSetWithdrawAddress, Op: SimulateMsgSetWithdrawAddress(ak, k, sk)},
+		{Weight: weightMsgSetValidatorWithdrawAddress, Op: SimulateMsgSetValidatorWithdrawAddress(ak, k, sk)},
+		{Weight: weightMsgWithdrawDelegationReward, Op: SimulateMsgWithdrawDelegatorReward(ak, k, sk)},
+		{Weight: weightMsgWithdrawValidatorCommission, Op: SimulateMsgWithdrawValidatorCommission(ak, k, sk)},
+	}
+}
+
+// SimulateMsgSetWithdrawAddress generates a MsgSetWithdrawAddress with random
+// values for a delegator.
 // nolint: funlen
-func SimulateMsgSetWithdrawAddress(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+func SimulateMsgSetWithdrawAddress(ak types.AccountKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simulation.Operation {
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
 	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
 
 		if !k.GetWithdrawAddrEnabled(ctx) {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSetWithdrawAddress, "withdraw address changes are not enabled"), nil, nil
+		}
+
+		simAccount, _, ok := randomDelegation(r, ctx, sk, accs)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSetWithdrawAddress, "no delegation"), nil, nil
 		}
 
-		simAccount, _ := simulation.RandomAcc(r, accs)
 		simToAccount, _ := simulation.RandomAcc(r, accs)
 		account := ak.GetAccount(ctx, simAccount.Address)
 
 		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSetWithdrawAddress, ""), nil, err
 		}
 
 		msg := types.NewMsgSetWithdrawAddress(simAccount.Address, simToAccount.Address)
@@ -49,38 +106,92 @@ func SimulateMsgSetWithdrawAddress(ak types.AccountKeeper, k keeper.Keeper) simu
 
 		res := app.Deliver(tx)
 		if !res.IsOK() {
-			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSetWithdrawAddress, ""), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgSetValidatorWithdrawAddress generates a MsgSetValidatorWithdrawAddress
+// with random values for an existing delegation.
+// nolint: funlen
+func SimulateMsgSetValidatorWithdrawAddress(ak types.AccountKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		if !k.GetWithdrawAddrEnabled(ctx) {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSetValidatorWithdrawAddress, "withdraw address changes are not enabled"), nil, nil
+		}
+
+		simAccount, delegation, ok := randomDelegation(r, ctx, sk, accs)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSetValidatorWithdrawAddress, "no delegation"), nil, nil
+		}
+
+		simToAccount, _ := simulation.RandomAcc(r, accs)
+		account := ak.GetAccount(ctx, simAccount.Address)
+
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSetValidatorWithdrawAddress, ""), nil, err
+		}
+
+		msg := types.NewMsgSetValidatorWithdrawAddress(simAccount.Address, delegation.GetValidatorAddr(), simToAccount.Address)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSetValidatorWithdrawAddress, ""), nil, errors.New(res.Log)
 		}
 
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
 
-// SimulateMsgWithdrawDelegatorReward generates a MsgWithdrawDelegatorReward with random values.
+// SimulateMsgWithdrawDelegatorReward generates a MsgWithdrawDelegatorReward for
+// a random existing delegation and checks that its rewards are paid to the
+// withdraw address of the delegation.
 // nolint: funlen
 func SimulateMsgWithdrawDelegatorReward(ak types.AccountKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
 	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
 
-		simAccount, _ := simulation.RandomAcc(r, accs)
-		delegations := sk.GetAllDelegatorDelegations(ctx, simAccount.Address)
-		if len(delegations) == 0 {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		simAccount, delegation, ok := randomDelegation(r, ctx, sk, accs)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawDelegatorReward, "no delegation"), nil, nil
 		}
 
-		delegation := delegations[r.Intn(len(delegations))]
-
 		validator := sk.Validator(ctx, delegation.GetValidatorAddr())
 		if validator == nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("validator %s not found", delegation.GetValidatorAddr())
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawDelegatorReward, ""), nil, fmt.Errorf("validator %s not found", delegation.GetValidatorAddr())
 		}
 
 		account := ak.GetAccount(ctx, simAccount.Address)
 		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawDelegatorReward, ""), nil, err
 		}
 
+		// compute the rewards to be withdrawn on a cached state
+		cacheCtx, _ := ctx.CacheContext()
+		rewards, err := k.WithdrawDelegationRewards(cacheCtx, simAccount.Address, validator.GetOperator())
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawDelegatorReward, ""), nil, err
+		}
+
+		withdrawAddr := k.GetDelegationWithdrawAddr(ctx, simAccount.Address, validator.GetOperator())
+		expBalance := expectedBalance(ctx, ak, withdrawAddr, rewards, simAccount.Address, fees)
+
 		msg := types.NewMsgWithdrawDelegatorReward(simAccount.Address, validator.GetOperator())
 
 		tx := helpers.GenTx(
@@ -94,14 +205,21 @@ func SimulateMsgWithdrawDelegatorReward(ak types.AccountKeeper, k keeper.Keeper,
 
 		res := app.Deliver(tx)
 		if !res.IsOK() {
-			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawDelegatorReward, ""), nil, errors.New(res.Log)
+		}
+
+		if err := checkBalance(ctx, ak, withdrawAddr, expBalance); err != nil {
+			return simulation.NewOperationMsg(msg, true, ""), nil,
+				fmt.Errorf("invalid delegation rewards of %s withdrawn: %v", rewards, err)
 		}
 
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
 
-// SimulateMsgWithdrawValidatorCommission generates a MsgWithdrawValidatorCommission with random values.
+// SimulateMsgWithdrawValidatorCommission generates a MsgWithdrawValidatorCommission
+// for a random validator and checks that its commission is paid to the
+// withdraw address of the operator.
 // nolint: funlen
 func SimulateMsgWithdrawValidatorCommission(ak types.AccountKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simulation.Operation {
 	return func(
@@ -110,25 +228,30 @@ func SimulateMsgWithdrawValidatorCommission(ak types.AccountKeeper, k keeper.Kee
 
 		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
 		if !ok {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawValidatorCommission, "no validator"), nil, nil
 		}
 
 		commission := k.GetValidatorAccumulatedCommission(ctx, validator.GetOperator())
 		if commission.IsZero() {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawValidatorCommission, "validator commission is zero"), nil, nil
 		}
 
 		simAccount, found := simulation.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
 		if !found {
-			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("validator %s not found", validator.GetOperator())
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawValidatorCommission, ""), nil, fmt.Errorf("validator %s not found", validator.GetOperator())
 		}
 
 		account := ak.GetAccount(ctx, simAccount.Address)
 		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawValidatorCommission, ""), nil, err
 		}
 
+		// the whole commission is paid out but its decimal remainder
+		withdrawn, _ := commission.TruncateDecimal()
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, simAccount.Address)
+		expBalance := expectedBalance(ctx, ak, withdrawAddr, withdrawn, simAccount.Address, fees)
+
 		msg := types.NewMsgWithdrawValidatorCommission(validator.GetOperator())
 
 		tx := helpers.GenTx(
@@ -142,16 +265,73 @@ func SimulateMsgWithdrawValidatorCommission(ak types.AccountKeeper, k keeper.Kee
 
 		res := app.Deliver(tx)
 		if !res.IsOK() {
-			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawValidatorCommission, ""), nil, errors.New(res.Log)
+		}
+
+		if err := checkBalance(ctx, ak, withdrawAddr, expBalance); err != nil {
+			return simulation.NewOperationMsg(msg, true, ""), nil,
+				fmt.Errorf("invalid validator commission of %s withdrawn: %v", withdrawn, err)
 		}
 
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
 
+// randomDelegation returns a random existing delegation along with the
+// simulation account of its delegator
+func randomDelegation(r *rand.Rand, ctx sdk.Context, sk stakingkeeper.Keeper,
+	accs []simulation.Account) (simulation.Account, stakingtypes.Delegation, bool) {
+
+	delegations := sk.GetAllDelegations(ctx)
+	if len(delegations) == 0 {
+		return simulation.Account{}, stakingtypes.Delegation{}, false
+	}
+
+	delegation := delegations[r.Intn(len(delegations))]
+	simAccount, found := simulation.FindAccount(accs, delegation.DelegatorAddress)
+	if !found {
+		return simulation.Account{}, stakingtypes.Delegation{}, false
+	}
+
+	return simAccount, delegation, true
+}
+
+// expectedBalance returns the balance of the withdraw address once the
+// withdrawn coins are paid to it, net of the fees if it's also the signer of
+// the withdrawal
+func expectedBalance(ctx sdk.Context, ak types.AccountKeeper, withdrawAddr sdk.AccAddress,
+	withdrawn sdk.Coins, signer sdk.AccAddress, fees sdk.Coins) sdk.Coins {
+
+	var balance sdk.Coins
+	if account := ak.GetAccount(ctx, withdrawAddr); account != nil {
+		balance = account.GetCoins()
+	}
+
+	balance = balance.Add(withdrawn)
+	if withdrawAddr.Equals(signer) {
+		balance = balance.Sub(fees)
+	}
+
+	return balance
+}
+
+// checkBalance checks the balance of the withdraw address after a withdrawal
+func checkBalance(ctx sdk.Context, ak types.AccountKeeper, withdrawAddr sdk.AccAddress, expBalance sdk.Coins) error {
+	var balance sdk.Coins
+	if account := ak.GetAccount(ctx, withdrawAddr); account != nil {
+		balance = account.GetCoins()
+	}
+
+	if diff, hasNeg := balance.SafeSub(expBalance); hasNeg || !diff.IsZero() {
+		return fmt.Errorf("withdraw address %s has a balance of %s, expected %s", withdrawAddr, balance, expBalance)
+	}
+
+	return nil
+}
+
 // SimulateCommunityPoolSpendProposalContent generates random community-pool-spend proposal content
 // nolint: funlen
-func SimulateCommunityPoolSpendProposalContent(k keeper.Keeper) govsim.ContentSimulator {
+func SimulateCommunityPoolSpendProposalContent(k keeper.Keeper) simulation.ContentSimulatorFn {
 	return func(r *rand.Rand, ctx sdk.Context, accs []simulation.Account) govtypes.Content {
 		simAccount, _ := simulation.RandomAcc(r, accs)
 
@@ -161,7 +341,7 @@ func SimulateCommunityPoolSpendProposalContent(k keeper.Keeper) govsim.ContentSi
 		}
 
 		denomIndex := r.Intn(len(balance))
-		amount, err := simulation.RandPositiveInt(r, balance[denomIndex].Amount.TruncateInt())
+		amount, err := simulation.RandPositiveInt(r, ctx, balance[denomIndex].Amount.TruncateInt())
 		if err != nil {
 			return nil
 		}
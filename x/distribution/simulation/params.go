@@ -14,25 +14,33 @@ const (
 	keyCommunityTax        = "communitytax"
 	keyBaseProposerReward  = "baseproposerreward"
 	keyBonusProposerReward = "bonusproposerreward"
+	keyDustThreshold       = "dustthreshold"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
-// on the simulation
+// on the simulation. The community tax and proposer rewards are bounded by
+// ParamChangeMaxReward as proposals are generated regardless of the current
+// value of the other parameters.
 func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 	return []simulation.ParamChange{
 		simulation.NewSimParamChange(types.ModuleName, keyCommunityTax, "",
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenCommunityTax(r))
+				return fmt.Sprintf("\"%s\"", randomDecUpTo(r, ParamChangeMaxReward))
 			},
 		),
 		simulation.NewSimParamChange(types.ModuleName, keyBaseProposerReward, "",
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenBaseProposerReward(r))
+				return fmt.Sprintf("\"%s\"", randomDecUpTo(r, ParamChangeMaxReward))
 			},
 		),
 		simulation.NewSimParamChange(types.ModuleName, keyBonusProposerReward, "",
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenBonusProposerReward(r))
+				return fmt.Sprintf("\"%s\"", randomDecUpTo(r, ParamChangeMaxReward))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyDustThreshold, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenDustThreshold(r))
 			},
 		),
 	}
@@ -6,6 +6,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultDustPayoutPeriod is the default number of blocks between two payouts
+// of the batched dust rewards
+const DefaultDustPayoutPeriod int64 = 100
+
 // the address for where distributions rewards are withdrawn to by default
 // this struct is only used at genesis to feed in default withdraw addresses
 type DelegatorWithdrawInfo struct {
@@ -13,6 +17,14 @@ type DelegatorWithdrawInfo struct {
 	WithdrawAddress  sdk.AccAddress `json:"withdraw_address" yaml:"withdraw_address"`
 }
 
+// the address for where the distribution rewards of a delegation to a single
+// validator are withdrawn to, used for import / export via genesis json
+type DelegatorValidatorWithdrawInfo struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	WithdrawAddress  sdk.AccAddress `json:"withdraw_address" yaml:"withdraw_address"`
+}
+
 // used for import/export via genesis json
 type ValidatorOutstandingRewardsRecord struct {
 	ValidatorAddress   sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
@@ -53,6 +65,18 @@ type ValidatorSlashEventRecord struct {
 	Event            ValidatorSlashEvent `json:"validator_slash_event" yaml:"validator_slash_event"`
 }
 
+// used for import / export via genesis json
+type DustRewardsRecord struct {
+	WithdrawAddress sdk.AccAddress `json:"withdraw_address" yaml:"withdraw_address"`
+	Rewards         sdk.DecCoins   `json:"rewards" yaml:"rewards"`
+}
+
+// used for import / export via genesis json
+type ValidatorCommissionEarningsRecord struct {
+	ValidatorAddress sdk.ValAddress              `json:"validator_address" yaml:"validator_address"`
+	Earnings         ValidatorCommissionEarnings `json:"earnings" yaml:"earnings"`
+}
+
 // GenesisState - all distribution state that must be provided at genesis
 type GenesisState struct {
 	FeePool                         FeePool                                `json:"fee_pool" yaml:"fee_pool"`
@@ -60,7 +84,10 @@ type GenesisState struct {
 	BaseProposerReward              sdk.Dec                                `json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward             sdk.Dec                                `json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled             bool                                   `json:"withdraw_addr_enabled" yaml:"withdraw_addr_enabled"`
+	DustThreshold                   sdk.Dec                                `json:"dust_threshold" yaml:"dust_threshold"`
+	DustPayoutPeriod                int64                                  `json:"dust_payout_period" yaml:"dust_payout_period"`
 	DelegatorWithdrawInfos          []DelegatorWithdrawInfo                `json:"delegator_withdraw_infos" yaml:"delegator_withdraw_infos"`
+	DelegatorValidatorWithdrawInfos []DelegatorValidatorWithdrawInfo       `json:"delegator_validator_withdraw_infos" yaml:"delegator_validator_withdraw_infos"`
 	PreviousProposer                sdk.ConsAddress                        `json:"previous_proposer" yaml:"previous_proposer"`
 	OutstandingRewards              []ValidatorOutstandingRewardsRecord    `json:"outstanding_rewards" yaml:"outstanding_rewards"`
 	ValidatorAccumulatedCommissions []ValidatorAccumulatedCommissionRecord `json:"validator_accumulated_commissions" yaml:"validator_accumulated_commissions"`
@@ -68,13 +95,16 @@ type GenesisState struct {
 	ValidatorCurrentRewards         []ValidatorCurrentRewardsRecord        `json:"validator_current_rewards" yaml:"validator_current_rewards"`
 	DelegatorStartingInfos          []DelegatorStartingInfoRecord          `json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	ValidatorSlashEvents            []ValidatorSlashEventRecord            `json:"validator_slash_events" yaml:"validator_slash_events"`
+	DustRewards                     []DustRewardsRecord                    `json:"dust_rewards" yaml:"dust_rewards"`
+	ValidatorCommissionEarnings     []ValidatorCommissionEarningsRecord    `json:"validator_commission_earnings" yaml:"validator_commission_earnings"`
 }
 
 func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusProposerReward sdk.Dec,
-	withdrawAddrEnabled bool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
-	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
-	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord,
-	slashes []ValidatorSlashEventRecord) GenesisState {
+	withdrawAddrEnabled bool, dustThreshold sdk.Dec, dustPayoutPeriod int64, dwis []DelegatorWithdrawInfo,
+	vwis []DelegatorValidatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord, acc []ValidatorAccumulatedCommissionRecord,
+	historical []ValidatorHistoricalRewardsRecord, cur []ValidatorCurrentRewardsRecord,
+	dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord, dust []DustRewardsRecord,
+	earnings []ValidatorCommissionEarningsRecord) GenesisState {
 
 	return GenesisState{
 		FeePool:                         feePool,
@@ -82,7 +112,10 @@ func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusPro
 		BaseProposerReward:              baseProposerReward,
 		BonusProposerReward:             bonusProposerReward,
 		WithdrawAddrEnabled:             withdrawAddrEnabled,
+		DustThreshold:                   dustThreshold,
+		DustPayoutPeriod:                dustPayoutPeriod,
 		DelegatorWithdrawInfos:          dwis,
+		DelegatorValidatorWithdrawInfos: vwis,
 		PreviousProposer:                pp,
 		OutstandingRewards:              r,
 		ValidatorAccumulatedCommissions: acc,
@@ -90,6 +123,8 @@ func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusPro
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		DustRewards:                     dust,
+		ValidatorCommissionEarnings:     earnings,
 	}
 }
 
@@ -101,7 +136,10 @@ func DefaultGenesisState() GenesisState {
 		BaseProposerReward:              sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward:             sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled:             true,
+		DustThreshold:                   sdk.ZeroDec(), // disabled
+		DustPayoutPeriod:                DefaultDustPayoutPeriod,
 		DelegatorWithdrawInfos:          []DelegatorWithdrawInfo{},
+		DelegatorValidatorWithdrawInfos: []DelegatorValidatorWithdrawInfo{},
 		PreviousProposer:                nil,
 		OutstandingRewards:              []ValidatorOutstandingRewardsRecord{},
 		ValidatorAccumulatedCommissions: []ValidatorAccumulatedCommissionRecord{},
@@ -109,6 +147,8 @@ func DefaultGenesisState() GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		DustRewards:                     []DustRewardsRecord{},
+		ValidatorCommissionEarnings:     []ValidatorCommissionEarningsRecord{},
 	}
 }
 
@@ -132,5 +172,14 @@ func ValidateGenesis(data GenesisState) error {
 			"BonusProposerReward cannot add to be greater than one, "+
 			"adds to %s", data.BaseProposerReward.Add(data.BonusProposerReward).String())
 	}
+	// a genesis predating the dust parameters uses the default ones
+	if !data.DustThreshold.IsNil() && data.DustThreshold.IsNegative() {
+		return fmt.Errorf("distribution parameter DustThreshold should be non-negative, is %s",
+			data.DustThreshold.String())
+	}
+	if data.DustPayoutPeriod < 0 {
+		return fmt.Errorf("distribution parameter DustPayoutPeriod should be positive, is %d",
+			data.DustPayoutPeriod)
+	}
 	return data.FeePool.ValidateGenesis()
 }
@@ -72,3 +72,28 @@ func TestMsgWithdrawValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetValidatorWithdrawAddress
+func TestMsgSetValidatorWithdrawAddress(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		withdrawAddr  sdk.AccAddress
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, delAddr2, true},
+		{delAddr1, valAddr1, emptyDelAddr, true},
+		{emptyDelAddr, valAddr1, delAddr2, false},
+		{delAddr1, emptyValAddr, delAddr2, false},
+		{emptyDelAddr, emptyValAddr, emptyDelAddr, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSetValidatorWithdrawAddress(tc.delegatorAddr, tc.validatorAddr, tc.withdrawAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
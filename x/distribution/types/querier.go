@@ -11,13 +11,21 @@ const (
 	QueryDelegationRewards           = "delegation_rewards"
 	QueryDelegatorTotalRewards       = "delegator_total_rewards"
 	QueryDelegatorValidators         = "delegator_validators"
+	QueryDelegatorSummary            = "delegator_summary"
 	QueryWithdrawAddr                = "withdraw_addr"
+	QueryDelegationWithdrawAddr      = "delegation_withdraw_addr"
+	QueryValidatorWithdrawAddrs      = "validator_withdraw_addrs"
 	QueryCommunityPool               = "community_pool"
+	QueryAllOutstandingRewards       = "all_outstanding_rewards"
+	QueryDelegatorRewardsByDenom     = "delegator_rewards_by_denom"
+	QueryValidatorCommissionEarnings = "validator_commission_earnings"
 
 	ParamCommunityTax        = "community_tax"
 	ParamBaseProposerReward  = "base_proposer_reward"
 	ParamBonusProposerReward = "bonus_proposer_reward"
 	ParamWithdrawAddrEnabled = "withdraw_addr_enabled"
+	ParamDustThreshold       = "dust_threshold"
+	ParamDustPayoutPeriod    = "dust_payout_period"
 )
 
 // params for query 'custom/distr/validator_outstanding_rewards'
@@ -32,6 +40,20 @@ func NewQueryValidatorOutstandingRewardsParams(validatorAddr sdk.ValAddress) Que
 	}
 }
 
+// params for query 'custom/distr/all_outstanding_rewards'
+type QueryAllOutstandingRewardsParams struct {
+	Page  int `json:"page" yaml:"page"`
+	Limit int `json:"limit" yaml:"limit"`
+}
+
+// creates a new instance of QueryAllOutstandingRewardsParams
+func NewQueryAllOutstandingRewardsParams(page, limit int) QueryAllOutstandingRewardsParams {
+	return QueryAllOutstandingRewardsParams{
+		Page:  page,
+		Limit: limit,
+	}
+}
+
 // params for query 'custom/distr/validator_commission'
 type QueryValidatorCommissionParams struct {
 	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
@@ -74,7 +96,8 @@ func NewQueryDelegationRewardsParams(delegatorAddr sdk.AccAddress, validatorAddr
 	}
 }
 
-// params for query 'custom/distr/delegator_total_rewards' and 'custom/distr/delegator_validators'
+// params for query 'custom/distr/delegator_total_rewards', 'custom/distr/delegator_validators'
+// and 'custom/distr/delegator_summary'
 type QueryDelegatorParams struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
 }
@@ -86,7 +109,7 @@ func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams
 	}
 }
 
-// params for query 'custom/distr/withdraw_addr'
+// params for query 'custom/distr/withdraw_addr' and 'custom/distr/validator_withdraw_addrs'
 type QueryDelegatorWithdrawAddrParams struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
 }
@@ -95,3 +118,17 @@ type QueryDelegatorWithdrawAddrParams struct {
 func NewQueryDelegatorWithdrawAddrParams(delegatorAddr sdk.AccAddress) QueryDelegatorWithdrawAddrParams {
 	return QueryDelegatorWithdrawAddrParams{DelegatorAddress: delegatorAddr}
 }
+
+// params for query 'custom/distr/delegation_withdraw_addr'
+type QueryDelegationWithdrawAddrParams struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+// NewQueryDelegationWithdrawAddrParams creates a new instance of QueryDelegationWithdrawAddrParams.
+func NewQueryDelegationWithdrawAddrParams(delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) QueryDelegationWithdrawAddrParams {
+	return QueryDelegationWithdrawAddrParams{
+		DelegatorAddress: delegatorAddr,
+		ValidatorAddress: validatorAddr,
+	}
+}
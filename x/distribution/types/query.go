@@ -2,9 +2,11 @@ package types
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 // QueryDelegatorTotalRewardsResponse defines the properties of
@@ -44,3 +46,158 @@ func NewDelegationDelegatorReward(valAddr sdk.ValAddress,
 	reward sdk.DecCoins) DelegationDelegatorReward {
 	return DelegationDelegatorReward{ValidatorAddress: valAddr, Reward: reward}
 }
+
+// DenomRewards defines the rewards of a delegator in a single denomination,
+// broken down by the validators they are earned from, as returned by the
+// QueryDelegatorRewardsByDenom query.
+type DenomRewards struct {
+	Denom   string                 `json:"denom" yaml:"denom"`
+	Total   sdk.Dec                `json:"total" yaml:"total"`
+	Rewards []DenomValidatorReward `json:"rewards" yaml:"rewards"`
+}
+
+// DenomValidatorReward defines the rewards in a single denomination of a
+// delegator's delegation.
+type DenomValidatorReward struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Amount           sdk.Dec        `json:"amount" yaml:"amount"`
+}
+
+// NewDenomRewards groups the rewards of a delegator's delegations by
+// denomination. The denominations are sorted, and only the delegations with
+// rewards in a denomination are listed under it.
+func NewDenomRewards(rewards []DelegationDelegatorReward) []DenomRewards {
+	byDenom := make(map[string]*DenomRewards)
+	for _, reward := range rewards {
+		for _, coin := range reward.Reward {
+			if coin.IsZero() {
+				continue
+			}
+
+			denomRewards, ok := byDenom[coin.Denom]
+			if !ok {
+				denomRewards = &DenomRewards{Denom: coin.Denom, Total: sdk.ZeroDec()}
+				byDenom[coin.Denom] = denomRewards
+			}
+
+			denomRewards.Total = denomRewards.Total.Add(coin.Amount)
+			denomRewards.Rewards = append(denomRewards.Rewards, DenomValidatorReward{
+				ValidatorAddress: reward.ValidatorAddress,
+				Amount:           coin.Amount,
+			})
+		}
+	}
+
+	denoms := make([]string, 0, len(byDenom))
+	for denom := range byDenom {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	res := make([]DenomRewards, len(denoms))
+	for i, denom := range denoms {
+		res[i] = *byDenom[denom]
+	}
+
+	return res
+}
+
+func (dr DenomRewards) String() string {
+	out := fmt.Sprintf("Denom Rewards:\n  Denom: %s\n  Total: %s\n  Rewards:", dr.Denom, dr.Total)
+	for _, reward := range dr.Rewards {
+		out += fmt.Sprintf(`
+	ValidatorAddress: %s
+	Amount: %s`, reward.ValidatorAddress, reward.Amount)
+	}
+	return strings.TrimSpace(out)
+}
+
+// ValidatorRewardsSummary defines the outstanding rewards and the accumulated
+// commission of a validator, as returned by the QueryAllOutstandingRewards
+// query.
+type ValidatorRewardsSummary struct {
+	ValidatorAddress   sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	OutstandingRewards sdk.DecCoins   `json:"outstanding_rewards" yaml:"outstanding_rewards"`
+	Commission         sdk.DecCoins   `json:"commission" yaml:"commission"`
+}
+
+// NewValidatorRewardsSummary constructs a ValidatorRewardsSummary.
+func NewValidatorRewardsSummary(valAddr sdk.ValAddress,
+	outstandingRewards, commission sdk.DecCoins) ValidatorRewardsSummary {
+	return ValidatorRewardsSummary{
+		ValidatorAddress:   valAddr,
+		OutstandingRewards: outstandingRewards,
+		Commission:         commission,
+	}
+}
+
+func (vrs ValidatorRewardsSummary) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Validator Rewards:
+  ValidatorAddress:   %s
+  OutstandingRewards: %s
+  Commission:         %s`, vrs.ValidatorAddress, vrs.OutstandingRewards, vrs.Commission))
+}
+
+// QueryDelegatorSummaryResponse defines the properties of the
+// QueryDelegatorSummary query's response. It bundles a delegator's staking
+// positions together with their pending distribution rewards.
+type QueryDelegatorSummaryResponse struct {
+	Delegations          staking.DelegationResponses   `json:"delegations" yaml:"delegations"`
+	UnbondingDelegations staking.UnbondingDelegations  `json:"unbonding_delegations" yaml:"unbonding_delegations"`
+	Redelegations        staking.RedelegationResponses `json:"redelegations" yaml:"redelegations"`
+	Rewards              []DelegationDelegatorReward   `json:"rewards" yaml:"rewards"`
+	Total                sdk.DecCoins                  `json:"total" yaml:"total"`
+}
+
+// NewQueryDelegatorSummaryResponse constructs a QueryDelegatorSummaryResponse
+func NewQueryDelegatorSummaryResponse(
+	delegations staking.DelegationResponses, unbondings staking.UnbondingDelegations,
+	redelegations staking.RedelegationResponses, rewards []DelegationDelegatorReward, total sdk.DecCoins,
+) QueryDelegatorSummaryResponse {
+
+	return QueryDelegatorSummaryResponse{
+		Delegations:          delegations,
+		UnbondingDelegations: unbondings,
+		Redelegations:        redelegations,
+		Rewards:              rewards,
+		Total:                total,
+	}
+}
+
+func (res QueryDelegatorSummaryResponse) String() string {
+	out := "Delegator Summary:\n"
+	out += "  Delegations:"
+	for _, del := range res.Delegations {
+		out += fmt.Sprintf(`
+	ValidatorAddress: %s
+	Shares: %s
+	Balance: %s`, del.ValidatorAddress, del.Shares, del.Balance)
+	}
+	out += fmt.Sprintf("\n  Unbonding Delegations: %d", len(res.UnbondingDelegations))
+	out += fmt.Sprintf("\n  Redelegations: %d", len(res.Redelegations))
+	out += "\n  Rewards:"
+	for _, reward := range res.Rewards {
+		out += fmt.Sprintf(`
+	ValidatorAddress: %s
+	Reward: %s`, reward.ValidatorAddress, reward.Reward)
+	}
+	out += fmt.Sprintf("\n  Total: %s\n", res.Total)
+	return strings.TrimSpace(out)
+}
+
+// ValidatorWithdrawAddress defines the withdraw address a delegator set for
+// the rewards of its delegation to a validator.
+type ValidatorWithdrawAddress struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	WithdrawAddress  sdk.AccAddress `json:"withdraw_address" yaml:"withdraw_address"`
+}
+
+// NewValidatorWithdrawAddress constructs a ValidatorWithdrawAddress
+func NewValidatorWithdrawAddress(valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) ValidatorWithdrawAddress {
+	return ValidatorWithdrawAddress{ValidatorAddress: valAddr, WithdrawAddress: withdrawAddr}
+}
+
+func (vwa ValidatorWithdrawAddress) String() string {
+	return fmt.Sprintf(`ValidatorAddress: %s
+WithdrawAddress: %s`, vwa.ValidatorAddress, vwa.WithdrawAddress)
+}
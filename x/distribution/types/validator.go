@@ -92,6 +92,26 @@ func (vs ValidatorSlashEvents) String() string {
 	return strings.TrimSpace(out)
 }
 
+// lifetime commission of a validator, updated whenever commission is
+// allocated to or withdrawn by the validator
+type ValidatorCommissionEarnings struct {
+	Earned    sdk.DecCoins `json:"earned" yaml:"earned"`       // cumulative commission allocated
+	Withdrawn sdk.Coins    `json:"withdrawn" yaml:"withdrawn"` // cumulative commission withdrawn
+}
+
+// create a new ValidatorCommissionEarnings
+func NewValidatorCommissionEarnings(earned sdk.DecCoins, withdrawn sdk.Coins) ValidatorCommissionEarnings {
+	return ValidatorCommissionEarnings{
+		Earned:    earned,
+		Withdrawn: withdrawn,
+	}
+}
+
+func (ce ValidatorCommissionEarnings) String() string {
+	return fmt.Sprintf(`Earned:    %s
+Withdrawn: %s`, ce.Earned, ce.Withdrawn)
+}
+
 // outstanding (un-withdrawn) rewards for a validator
 // inexpensive to track, allows simple sanity checks
 type ValidatorOutstandingRewards = sdk.DecCoins
@@ -23,21 +23,25 @@ const (
 	EventTypeSubmitEvidence     = types.EventTypeSubmitEvidence
 	AttributeValueCategory      = types.AttributeValueCategory
 	AttributeKeyEvidenceHash    = types.AttributeKeyEvidenceHash
+	RouteEquivocation           = types.RouteEquivocation
+	TypeEquivocation            = types.TypeEquivocation
 )
 
 var (
 	NewKeeper  = keeper.NewKeeper
 	NewQuerier = keeper.NewQuerier
 
-	NewMsgSubmitEvidence      = types.NewMsgSubmitEvidence
-	NewRouter                 = types.NewRouter
-	NewQueryEvidenceParams    = types.NewQueryEvidenceParams
-	NewQueryAllEvidenceParams = types.NewQueryAllEvidenceParams
-	RegisterCodec             = types.RegisterCodec
-	RegisterEvidenceTypeCodec = types.RegisterEvidenceTypeCodec
-	ModuleCdc                 = types.ModuleCdc
-	NewGenesisState           = types.NewGenesisState
-	DefaultGenesisState       = types.DefaultGenesisState
+	NewMsgSubmitEvidence         = types.NewMsgSubmitEvidence
+	NewRouter                    = types.NewRouter
+	NewQueryEvidenceParams       = types.NewQueryEvidenceParams
+	NewQueryAllEvidenceParams    = types.NewQueryAllEvidenceParams
+	RegisterCodec                = types.RegisterCodec
+	RegisterEvidenceTypeCodec    = types.RegisterEvidenceTypeCodec
+	ModuleCdc                    = types.ModuleCdc
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	ConvertDuplicateVoteEvidence = types.ConvertDuplicateVoteEvidence
+	DoubleSignJailEndTime        = types.DoubleSignJailEndTime
 )
 
 type (
@@ -47,4 +51,5 @@ type (
 	MsgSubmitEvidence = types.MsgSubmitEvidence
 	Handler           = types.Handler
 	Router            = types.Router
+	Equivocation      = types.Equivocation
 )
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
@@ -38,7 +39,6 @@ $ %s query %s --page=2 --limit=50
 			),
 		),
 		Args:                       cobra.MaximumNArgs(1),
-		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
 		RunE:                       QueryEvidenceCmd(cdc),
 	}
@@ -46,7 +46,7 @@ $ %s query %s --page=2 --limit=50
 	cmd.Flags().Int(flagPage, 1, "pagination page of evidence to to query for")
 	cmd.Flags().Int(flagLimit, 100, "pagination limit of evidence to query for")
 
-	return cmd
+	return flags.GetCommands(cmd)[0]
 }
 
 // QueryEvidenceCmd returns the command handler for evidence querying. Evidence
@@ -59,8 +59,8 @@ func QueryEvidenceCmd(cdc *codec.Codec) func(*cobra.Command, []string) error {
 
 		cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-		if hash := args[0]; hash != "" {
-			return queryEvidence(cdc, cliCtx, hash)
+		if len(args) > 0 && args[0] != "" {
+			return queryEvidence(cdc, cliCtx, args[0])
 		}
 
 		return queryAllEvidence(cdc, cliCtx)
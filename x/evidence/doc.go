@@ -24,6 +24,7 @@ A full setup of the evidence module may look something as follows:
 	evidenceParamspace := app.ParamsKeeper.Subspace(evidence.DefaultParamspace)
 	evidenceKeeper := evidence.NewKeeper(
 	  app.cdc, keys[evidence.StoreKey], evidenceParamspace, evidence.DefaultCodespace,
+	  app.StakingKeeper, app.SlashingKeeper,
 	)
 
 
@@ -36,7 +37,7 @@ A full setup of the evidence module may look something as follows:
 
 	app.mm = module.NewManager(
 	  // ...
-	  evidence.NewAppModule(evidenceKeeper),
+	  evidence.NewAppModule(evidenceKeeper, app.StakingKeeper),
 	)
 
 	// Remaining application bootstrapping...
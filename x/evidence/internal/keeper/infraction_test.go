@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// createValidator creates and bonds a validator with the given consensus power,
+// returning its operator address and consensus public key.
+func (suite *KeeperTestSuite) createValidator(ctx sdk.Context, power int64) (sdk.ValAddress, crypto.PubKey) {
+	pk := ed25519.GenPrivKey().PubKey()
+	operatorAddr := sdk.ValAddress(pk.Address())
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+	amt := sdk.TokensFromConsensusPower(power)
+
+	err := testutil.FundAccount(suite.app, ctx, sdk.AccAddress(operatorAddr), sdk.NewCoins(sdk.NewCoin(bondDenom, amt)))
+	suite.NoError(err)
+
+	msg := staking.NewMsgCreateValidator(
+		operatorAddr, pk, sdk.NewCoin(bondDenom, amt), staking.Description{},
+		staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+
+	res := staking.NewHandler(suite.app.StakingKeeper)(ctx, msg)
+	suite.True(res.IsOK(), res.Log)
+
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+	suite.Equal(amt, suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetBondedTokens())
+
+	// handle a signature to set signing info
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, pk.Address(), power, true)
+
+	return operatorAddr, pk
+}
+
+func (suite *KeeperTestSuite) TestHandleDoubleSign() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	power := int64(100)
+	operatorAddr, val := suite.createValidator(ctx, power)
+	consAddr := sdk.ConsAddress(val.Address())
+
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+
+	// double sign less than max age
+	evidence := types.Equivocation{
+		Height:           0,
+		Time:             time.Unix(0, 0).UTC(),
+		Power:            power,
+		ConsensusAddress: consAddr,
+	}
+	suite.keeper.HandleDoubleSign(ctx, evidence)
+
+	// should be jailed and tombstoned
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+
+	// tokens should be decreased
+	newTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	suite.True(newTokens.LT(oldTokens))
+
+	// the evidence and the infraction should be recorded
+	res, ok := suite.keeper.GetEvidence(ctx, evidence.Hash())
+	suite.True(ok)
+	suite.Equal(evidence, res)
+	suite.Len(suite.app.SlashingKeeper.GetInfractionRecords(ctx, consAddr), 1)
+
+	// submit duplicate evidence
+	suite.keeper.HandleDoubleSign(ctx, evidence)
+
+	// tokens should be the same (capped slash)
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens().Equal(newTokens))
+	suite.Len(suite.app.SlashingKeeper.GetInfractionRecords(ctx, consAddr), 1)
+
+	// jump to past the unbonding period
+	ctx = ctx.WithBlockTime(time.Unix(1, 0).Add(suite.app.StakingKeeper.UnbondingTime(ctx)))
+
+	// require we cannot unjail
+	suite.Error(suite.app.SlashingKeeper.Unjail(ctx, operatorAddr))
+
+	// require we be able to unbond now
+	del, _ := suite.app.StakingKeeper.GetDelegation(ctx, sdk.AccAddress(operatorAddr), operatorAddr)
+	validator, _ := suite.app.StakingKeeper.GetValidator(ctx, operatorAddr)
+	totalBond := validator.TokensFromShares(del.GetShares()).TruncateInt()
+	msgUnbond := staking.NewMsgUndelegate(
+		sdk.AccAddress(operatorAddr), operatorAddr, sdk.NewCoin(suite.app.StakingKeeper.BondDenom(ctx), totalBond),
+	)
+	res2 := staking.NewHandler(suite.app.StakingKeeper)(ctx, msgUnbond)
+	suite.True(res2.IsOK(), res2.Log)
+}
+
+func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	power := int64(100)
+	operatorAddr, val := suite.createValidator(ctx, power)
+
+	evidence := types.Equivocation{
+		Height:           0,
+		Time:             time.Unix(0, 0).UTC(),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(val.Address()),
+	}
+
+	ctx = ctx.WithBlockTime(time.Unix(1, 0).Add(suite.app.SlashingKeeper.MaxEvidenceAge(ctx)))
+	suite.keeper.HandleDoubleSign(ctx, evidence)
+
+	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
+
+	_, ok := suite.keeper.GetEvidence(ctx, evidence.Hash())
+	suite.False(ok)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerDuplicateVote() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	power := int64(100)
+	operatorAddr, val := suite.createValidator(ctx, power)
+
+	tmEvidence := abci.Evidence{
+		Type:      tmtypes.ABCIEvidenceTypeDuplicateVote,
+		Validator: abci.Validator{Address: val.Address(), Power: power},
+		Height:    1,
+		Time:      time.Unix(0, 0),
+	}
+
+	evidence.BeginBlocker(ctx, abci.RequestBeginBlock{ByzantineValidators: []abci.Evidence{tmEvidence}}, suite.keeper)
+
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
+
+	_, ok := suite.keeper.GetEvidence(ctx, types.ConvertDuplicateVoteEvidence(tmEvidence).Hash())
+	suite.True(ok)
+}
@@ -0,0 +1,35 @@
+// noalias
+// DONTCOVER
+package types
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+type (
+	// StakingKeeper defines the staking module interface contract needed by the
+	// evidence module.
+	StakingKeeper interface {
+		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingexported.ValidatorI
+	}
+
+	// SlashingKeeper defines the slashing module interface contract needed by the
+	// evidence module.
+	SlashingKeeper interface {
+		GetPubkey(sdk.Context, crypto.Address) (crypto.PubKey, error)
+		IsTombstoned(sdk.Context, sdk.ConsAddress) bool
+		HasValidatorSigningInfo(sdk.Context, sdk.ConsAddress) bool
+		Tombstone(sdk.Context, sdk.ConsAddress)
+		Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64) sdk.Int
+		SlashFractionDoubleSign(sdk.Context) sdk.Dec
+		MaxEvidenceAge(sdk.Context) time.Duration
+		Jail(sdk.Context, sdk.ConsAddress)
+		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
+		RecordInfraction(sdk.Context, sdk.ConsAddress, int64, time.Time, int64, sdk.Dec, sdk.Int)
+	}
+)
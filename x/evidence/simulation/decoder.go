@@ -0,0 +1,26 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding evidence type
+func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.KeyPrefixEvidence):
+		var evidenceA, evidenceB exported.Evidence
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &evidenceA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &evidenceB)
+		return fmt.Sprintf("%v\n%v", evidenceA, evidenceB)
+
+	default:
+		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+	}
+}
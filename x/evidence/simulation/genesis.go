@@ -0,0 +1,15 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+// RandomizedGenState generates a random GenesisState for evidence. Evidence is
+// only ever submitted after genesis, so the genesis state is always empty.
+func RandomizedGenState(simState *module.SimulationState) {
+	evidenceGenesis := types.DefaultGenesisState()
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(evidenceGenesis)
+}
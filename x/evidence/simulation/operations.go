@@ -0,0 +1,73 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightDoubleSign = "op_weight_double_sign"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, k keeper.Keeper,
+	sk stakingkeeper.Keeper) simulation.WeightedOperations {
+
+	var weightDoubleSign int
+	appParams.GetOrGenerate(cdc, OpWeightDoubleSign, &weightDoubleSign, nil,
+		func(_ *rand.Rand) {
+			weightDoubleSign = 5
+		},
+	)
+
+	return simulation.WeightedOperations{
+		{Weight: weightDoubleSign, Op: SimulateDoubleSign(k, sk)},
+	}
+}
+
+// SimulateDoubleSign injects equivocation evidence for a random bonded
+// validator, which gets it slashed, jailed and tombstoned
+func SimulateDoubleSign(k keeper.Keeper, sk stakingkeeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok || !validator.IsBonded() {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeEquivocation, "no bonded validator"), nil, nil
+		}
+
+		evidence := types.Equivocation{
+			Height:           ctx.BlockHeight(),
+			Time:             ctx.BlockHeader().Time,
+			Power:            validator.GetConsensusPower(),
+			ConsensusAddress: sdk.ConsAddress(validator.GetConsPubKey().Address()),
+		}
+
+		k.HandleDoubleSign(ctx, evidence)
+
+		// the evidence is only persisted once handled, evidence against an
+		// already tombstoned validator is ignored
+		if _, found := k.GetEvidence(ctx, evidence.Hash()); !found {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeEquivocation, "validator already tombstoned"), nil, nil
+		}
+
+		validator, _ = sk.GetValidator(ctx, validator.GetOperator())
+		if !validator.IsJailed() {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeEquivocation, ""), nil,
+				errors.New("validator should have been jailed after double signing")
+		}
+
+		return simulation.NewOperationMsgBasic(types.ModuleName, types.TypeEquivocation, "", true, nil), nil, nil
+	}
+}
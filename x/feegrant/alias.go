@@ -0,0 +1,73 @@
+package feegrant
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+// nolint
+
+const (
+	ModuleName                = types.ModuleName
+	StoreKey                  = types.StoreKey
+	RouterKey                 = types.RouterKey
+	QuerierRoute              = types.QuerierRoute
+	QueryFeeAllowance         = types.QueryFeeAllowance
+	QueryFeeAllowances        = types.QueryFeeAllowances
+	DefaultCodespace          = types.DefaultCodespace
+	CodeFeeLimitExceeded      = types.CodeFeeLimitExceeded
+	CodeFeeLimitExpired       = types.CodeFeeLimitExpired
+	CodeInvalidDuration       = types.CodeInvalidDuration
+	CodeNoAllowance           = types.CodeNoAllowance
+	TypeMsgGrantFeeAllowance  = types.TypeMsgGrantFeeAllowance
+	TypeMsgRevokeFeeAllowance = types.TypeMsgRevokeFeeAllowance
+	EventTypeUseFeeGrant      = types.EventTypeUseFeeGrant
+	EventTypeRevokeFeeGrant   = types.EventTypeRevokeFeeGrant
+	EventTypeSetFeeGrant      = types.EventTypeSetFeeGrant
+	AttributeValueCategory    = types.AttributeValueCategory
+	AttributeKeyGranter       = types.AttributeKeyGranter
+	AttributeKeyGrantee       = types.AttributeKeyGrantee
+)
+
+var (
+	NewKeeper  = keeper.NewKeeper
+	NewQuerier = keeper.NewQuerier
+
+	NewBasicFeeAllowance        = types.NewBasicFeeAllowance
+	NewPeriodicFeeAllowance     = types.NewPeriodicFeeAllowance
+	ExpiresAtTime               = types.ExpiresAtTime
+	ExpiresAtHeight             = types.ExpiresAtHeight
+	ClockDuration               = types.ClockDuration
+	BlockDuration               = types.BlockDuration
+	NewFeeAllowanceGrant        = types.NewFeeAllowanceGrant
+	NewMsgGrantFeeAllowance     = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance    = types.NewMsgRevokeFeeAllowance
+	NewQueryFeeAllowanceParams  = types.NewQueryFeeAllowanceParams
+	NewQueryFeeAllowancesParams = types.NewQueryFeeAllowancesParams
+	NewGenesisState             = types.NewGenesisState
+	DefaultGenesisState         = types.DefaultGenesisState
+	RegisterCodec               = types.RegisterCodec
+	ModuleCdc                   = types.ModuleCdc
+	ErrFeeLimitExceeded         = types.ErrFeeLimitExceeded
+	ErrFeeLimitExpired          = types.ErrFeeLimitExpired
+	ErrInvalidDuration          = types.ErrInvalidDuration
+	ErrNoAllowance              = types.ErrNoAllowance
+	FeeAllowanceKey             = types.FeeAllowanceKey
+	FeeAllowancePrefixByGrantee = types.FeeAllowancePrefixByGrantee
+	FeeAllowanceKeyPrefix       = types.FeeAllowanceKeyPrefix
+)
+
+type (
+	Keeper = keeper.Keeper
+
+	BasicFeeAllowance        = types.BasicFeeAllowance
+	PeriodicFeeAllowance     = types.PeriodicFeeAllowance
+	ExpiresAt                = types.ExpiresAt
+	Duration                 = types.Duration
+	FeeAllowanceGrant        = types.FeeAllowanceGrant
+	MsgGrantFeeAllowance     = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance    = types.MsgRevokeFeeAllowance
+	QueryFeeAllowanceParams  = types.QueryFeeAllowanceParams
+	QueryFeeAllowancesParams = types.QueryFeeAllowancesParams
+	GenesisState             = types.GenesisState
+)
@@ -0,0 +1,71 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/keeper"
+)
+
+// DeductGrantedFeeDecorator deducts fees from the fee payer, or from the fee
+// granter if the tx names one and the fee payer has a fee allowance from it.
+// If the account paying the fees does not have the funds, or the allowance does
+// not cover the fees, an error is returned.
+// Call next AnteHandler if fees successfully deducted
+// CONTRACT: Tx must implement GrantedFeeTx interface to use DeductGrantedFeeDecorator
+type DeductGrantedFeeDecorator struct {
+	ak           authkeeper.AccountKeeper
+	k            keeper.Keeper
+	supplyKeeper authtypes.SupplyKeeper
+}
+
+func NewDeductGrantedFeeDecorator(ak authkeeper.AccountKeeper, sk authtypes.SupplyKeeper, k keeper.Keeper) DeductGrantedFeeDecorator {
+	return DeductGrantedFeeDecorator{
+		ak:           ak,
+		k:            k,
+		supplyKeeper: sk,
+	}
+}
+
+func (d DeductGrantedFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(authante.GrantedFeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a GrantedFeeTx")
+	}
+
+	if addr := d.supplyKeeper.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", authtypes.FeeCollectorName))
+	}
+
+	fee := feeTx.GetFee()
+	feePayer := feeTx.FeePayer()
+
+	// use the granter's allowance if the fee payer isn't paying the fees itself
+	if granter := feeTx.FeeGranter(); !granter.Empty() && !granter.Equals(feePayer) {
+		err := d.k.UseGrantedFees(ctx, granter, feePayer, fee)
+		if err != nil {
+			return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feePayer, granter)
+		}
+
+		feePayer = granter
+	}
+
+	feePayerAcc := d.ak.GetAccount(ctx, feePayer)
+	if feePayerAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", feePayer)
+	}
+
+	// deduct the fees
+	if !fee.IsZero() {
+		err = authante.DeductFees(d.supplyKeeper, ctx, feePayerAcc, fee)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
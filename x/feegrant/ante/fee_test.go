@@ -0,0 +1,218 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/ante"
+)
+
+func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(isCheckTx)
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{Height: 1})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+
+	return app, ctx
+}
+
+func TestDeductGrantedFees(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	dgfd := ante.NewDeductGrantedFeeDecorator(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper)
+	antehandler := sdk.ChainAnteDecorators(dgfd)
+
+	// keys and addresses
+	priv1, _, addr1 := authtypes.KeyTestPubAddr()
+	priv2, _, addr2 := authtypes.KeyTestPubAddr()
+	priv3, _, addr3 := authtypes.KeyTestPubAddr()
+	priv4, _, addr4 := authtypes.KeyTestPubAddr()
+
+	// set up the accounts: addr1 is funded, addr2 has nothing, addr3 is funded
+	// but too little to pay the fees, addr4 doesn't exist
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 99999))))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr2))
+	acc3 := app.AccountKeeper.NewAccountWithAddress(ctx, addr3)
+	require.NoError(t, acc3.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 10))))
+	app.AccountKeeper.SetAccount(ctx, acc3)
+
+	// grant a few allowances:
+	// addr1 -> addr2 (500atom), addr3 -> addr2 (unlimited), addr2 -> addr4 (unlimited)
+	app.FeeGrantKeeper.GrantFeeAllowance(ctx, feegrant.NewFeeAllowanceGrant(
+		addr1, addr2, feegrant.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 500)), feegrant.ExpiresAt{}),
+	))
+	app.FeeGrantKeeper.GrantFeeAllowance(ctx, feegrant.NewFeeAllowanceGrant(
+		addr3, addr2, feegrant.NewBasicFeeAllowance(nil, feegrant.ExpiresAt{}),
+	))
+	app.FeeGrantKeeper.GrantFeeAllowance(ctx, feegrant.NewFeeAllowanceGrant(
+		addr2, addr4, feegrant.NewBasicFeeAllowance(nil, feegrant.ExpiresAt{}),
+	))
+
+	cases := map[string]struct {
+		signerKey  crypto.PrivKey
+		signer     sdk.AccAddress
+		feeAccount sdk.AccAddress
+		fee        int64
+		valid      bool
+	}{
+		"paying with own funds": {
+			signerKey: priv1,
+			signer:    addr1,
+			fee:       50,
+			valid:     true,
+		},
+		"paying with no account": {
+			signerKey: priv4,
+			signer:    addr4,
+			fee:       1,
+			valid:     false,
+		},
+		"paying zero with no funds": {
+			signerKey: priv2,
+			signer:    addr2,
+			fee:       0,
+			valid:     true,
+		},
+		"paying with no funds": {
+			signerKey: priv2,
+			signer:    addr2,
+			fee:       50,
+			valid:     false,
+		},
+		"valid fee grant": {
+			signerKey:  priv2,
+			signer:     addr2,
+			feeAccount: addr1,
+			fee:        50,
+			valid:      true,
+		},
+		"no fee grant": {
+			signerKey:  priv3,
+			signer:     addr3,
+			feeAccount: addr1,
+			fee:        2,
+			valid:      false,
+		},
+		"allowance smaller than requested fee": {
+			signerKey:  priv2,
+			signer:     addr2,
+			feeAccount: addr1,
+			fee:        501,
+			valid:      false,
+		},
+		"granter cannot cover allowed fee grant": {
+			signerKey:  priv2,
+			signer:     addr2,
+			feeAccount: addr3,
+			fee:        50,
+			valid:      false,
+		},
+		"granter without funds": {
+			signerKey:  priv4,
+			signer:     addr4,
+			feeAccount: addr2,
+			fee:        50,
+			valid:      false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+
+			fee := authtypes.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("atom", tc.fee)))
+			fee.FeeAccount = tc.feeAccount
+			msgs := []sdk.Msg{authtypes.NewTestMsg(tc.signer)}
+			tx := authtypes.NewTestTx(cacheCtx, msgs, []crypto.PrivKey{tc.signerKey}, []uint64{0}, []uint64{0}, fee)
+
+			_, err := antehandler(cacheCtx, tx, false)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			payer := tc.signer
+			if !tc.feeAccount.Empty() {
+				payer = tc.feeAccount
+			}
+
+			// the fees are taken from the account paying them
+			before := app.AccountKeeper.GetAccount(ctx, payer).GetCoins()
+			after := app.AccountKeeper.GetAccount(cacheCtx, payer).GetCoins()
+			require.Equal(t, tc.fee, before.Sub(after).AmountOf("atom").Int64())
+		})
+	}
+
+	// the allowance used by the valid fee grant is reduced accordingly
+	cacheCtx, _ := ctx.CacheContext()
+	fee := authtypes.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("atom", 200)))
+	fee.FeeAccount = addr1
+	tx := authtypes.NewTestTx(cacheCtx, []sdk.Msg{authtypes.NewTestMsg(addr2)}, []crypto.PrivKey{priv2}, []uint64{0}, []uint64{0}, fee)
+
+	_, err := antehandler(cacheCtx, tx, false)
+	require.NoError(t, err)
+
+	allowance := app.FeeGrantKeeper.GetFeeAllowance(cacheCtx, addr1, addr2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 300)), allowance.(*feegrant.BasicFeeAllowance).SpendLimit)
+}
+
+func TestAnteHandlerGrantedFees(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	antehandler := ante.NewAnteHandler(
+		app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper, auth.DefaultSigVerificationGasConsumer,
+	)
+
+	_, _, granter := authtypes.KeyTestPubAddr()
+	granteeKey, _, grantee := authtypes.KeyTestPubAddr()
+
+	granterAcc := app.AccountKeeper.NewAccountWithAddress(ctx, granter)
+	require.NoError(t, granterAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 99999))))
+	app.AccountKeeper.SetAccount(ctx, granterAcc)
+
+	// the grantee account doesn't exist before being granted an allowance
+	require.Nil(t, app.AccountKeeper.GetAccount(ctx, grantee))
+	app.FeeGrantKeeper.GrantFeeAllowance(ctx, feegrant.NewFeeAllowanceGrant(
+		granter, grantee, feegrant.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 500)), feegrant.ExpiresAt{}),
+	))
+
+	granteeAcc := app.AccountKeeper.GetAccount(ctx, grantee)
+	require.NotNil(t, granteeAcc)
+	require.True(t, granteeAcc.GetCoins().IsZero())
+
+	fee := authtypes.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	msgs := []sdk.Msg{authtypes.NewTestMsg(grantee)}
+
+	// the grantee can't pay the fees itself
+	tx := authtypes.NewTestTx(ctx, msgs, []crypto.PrivKey{granteeKey}, []uint64{granteeAcc.GetAccountNumber()}, []uint64{0}, fee)
+	_, err := antehandler(ctx, tx, false)
+	require.Error(t, err)
+
+	// the granter pays the fees once named as the fee account
+	fee.FeeAccount = granter
+	tx = authtypes.NewTestTx(ctx, msgs, []crypto.PrivKey{granteeKey}, []uint64{granteeAcc.GetAccountNumber()}, []uint64{0}, fee)
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 99999-150)), app.AccountKeeper.GetAccount(ctx, granter).GetCoins())
+	require.Equal(t, uint64(1), app.AccountKeeper.GetAccount(ctx, grantee).GetSequence())
+
+	// the fee account is part of the sign bytes, so it can't be swapped
+	_, _, other := authtypes.KeyTestPubAddr()
+	tx = authtypes.NewTestTx(ctx, msgs, []crypto.PrivKey{granteeKey}, []uint64{granteeAcc.GetAccountNumber()}, []uint64{1}, fee)
+	stdTx := tx.(authtypes.StdTx)
+	stdTx.Fee.FeeAccount = other
+	_, err = antehandler(ctx, stdTx, false)
+	require.Error(t, err)
+}
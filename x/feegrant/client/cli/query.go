@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+// GetQueryCmd returns the query commands for the feegrant module.
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	feegrantQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feegrant module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantQueryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryFeeAllowance(queryRoute, cdc),
+		GetCmdQueryFeeAllowances(queryRoute, cdc),
+	)...)
+
+	return feegrantQueryCmd
+}
+
+// GetCmdQueryFeeAllowance returns the command to query the fee allowance from
+// a granter to a grantee.
+func GetCmdQueryFeeAllowance(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grant [granter] [grantee]",
+		Short: "Query the fee allowance from a granter to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fee allowance from a granter to a grantee.
+
+Example:
+$ %s query %s grant cosmos1h9z... cosmos1skjw...
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryFeeAllowanceParams(granter, grantee))
+			if err != nil {
+				return fmt.Errorf("failed to marshal query params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFeeAllowance)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grant types.FeeAllowanceGrant
+			if err := cdc.UnmarshalJSON(res, &grant); err != nil {
+				return fmt.Errorf("failed to unmarshal fee allowance: %w", err)
+			}
+
+			return cliCtx.PrintOutput(grant)
+		},
+	}
+}
+
+// GetCmdQueryFeeAllowances returns the command to query all fee allowances
+// granted to a grantee.
+func GetCmdQueryFeeAllowances(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants [grantee]",
+		Short: "Query all fee allowances granted to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all fee allowances granted to a grantee.
+
+Example:
+$ %s query %s grants cosmos1skjw...
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryFeeAllowancesParams(grantee))
+			if err != nil {
+				return fmt.Errorf("failed to marshal query params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFeeAllowances)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grants []types.FeeAllowanceGrant
+			if err := cdc.UnmarshalJSON(res, &grants); err != nil {
+				return fmt.Errorf("failed to unmarshal fee allowances: %w", err)
+			}
+
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+}
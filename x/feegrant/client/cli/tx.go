@@ -0,0 +1,189 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+const (
+	flagExpiration       = "expiration"
+	flagExpirationHeight = "expiration-height"
+	flagPeriod           = "period"
+	flagPeriodBlocks     = "period-blocks"
+	flagPeriodLimit      = "period-limit"
+)
+
+// GetTxCmd returns the transaction commands for the feegrant module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	feegrantTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Fee grant transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantTxCmd.AddCommand(client.PostCommands(
+		GetCmdGrantFeeAllowance(cdc),
+		GetCmdRevokeFeeAllowance(cdc),
+	)...)
+
+	return feegrantTxCmd
+}
+
+// GetCmdGrantFeeAllowance returns the command to grant a fee allowance to a
+// grantee.
+func GetCmdGrantFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [spend-limit]",
+		Short: "Grant a fee allowance to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an address a fee allowance paid from the --from account. An empty
+spend limit ("") places no cap on the fees paid. The allowance may expire at a
+block time or height, and may cap the fees paid per period.
+
+Example:
+$ %s tx %s grant cosmos1skjw... 1000stake --from=mykey
+$ %s tx %s grant cosmos1skjw... 1000stake --expiration=2021-01-01T00:00:00Z --period=24h --period-limit=10stake --from=mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			allowance, err := allowanceFromFlags(spendLimit)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantFeeAllowance(cliCtx.GetFromAddress(), grantee, allowance)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagExpiration, "", "RFC3339 block time at which the allowance expires")
+	cmd.Flags().Int64(flagExpirationHeight, 0, "Block height at which the allowance expires")
+	cmd.Flags().String(flagPeriod, "", "Clock duration of a period (e.g. 24h) for a periodic allowance")
+	cmd.Flags().Int64(flagPeriodBlocks, 0, "Number of blocks in a period for a periodic allowance")
+	cmd.Flags().String(flagPeriodLimit, "", "Fees that can be paid per period for a periodic allowance")
+
+	return cmd
+}
+
+// GetCmdRevokeFeeAllowance returns the command to revoke a fee allowance from
+// a grantee.
+func GetCmdRevokeFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee]",
+		Short: "Revoke a fee allowance granted to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the fee allowance granted to an address by the --from account.
+
+Example:
+$ %s tx %s revoke cosmos1skjw... --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(cliCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// allowanceFromFlags builds a basic allowance, or a periodic one if a period
+// is given, from the grant command flags.
+func allowanceFromFlags(spendLimit sdk.Coins) (exported.FeeAllowance, error) {
+	var expiration types.ExpiresAt
+
+	if exp := viper.GetString(flagExpiration); exp != "" {
+		t, err := time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiration time: %w", err)
+		}
+
+		expiration = types.ExpiresAtTime(t)
+	}
+	if height := viper.GetInt64(flagExpirationHeight); height != 0 {
+		if !expiration.IsZero() {
+			return nil, fmt.Errorf("only one of --%s and --%s may be set", flagExpiration, flagExpirationHeight)
+		}
+
+		expiration = types.ExpiresAtHeight(height)
+	}
+
+	basic := types.NewBasicFeeAllowance(spendLimit, expiration)
+
+	var period types.Duration
+	if p := viper.GetString(flagPeriod); p != "" {
+		d, err := time.ParseDuration(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid period: %w", err)
+		}
+
+		period = types.ClockDuration(d)
+	}
+	if blocks := viper.GetInt64(flagPeriodBlocks); blocks != 0 {
+		if period.Clock != 0 {
+			return nil, fmt.Errorf("only one of --%s and --%s may be set", flagPeriod, flagPeriodBlocks)
+		}
+
+		period = types.BlockDuration(blocks)
+	}
+
+	if period.Clock == 0 && period.Block == 0 {
+		return basic, nil
+	}
+
+	periodLimit, err := sdk.ParseCoins(viper.GetString(flagPeriodLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewPeriodicFeeAllowance(*basic, period, periodLimit), nil
+}
@@ -0,0 +1,38 @@
+/*
+Package feegrant provides functionality for one account (the granter) to pay the
+transaction fees of another account (the grantee).
+
+A granter gives a grantee a fee allowance with MsgGrantFeeAllowance and may take
+it back at any time with MsgRevokeFeeAllowance. Granting an allowance creates the
+grantee's account if it does not exist yet, so that a brand new account with a
+zero balance can start sending transactions right away.
+
+Allowances
+
+Two allowance types are supported:
+
+	- BasicFeeAllowance lets the grantee spend up to SpendLimit in fees. An
+	empty SpendLimit places no cap on the fees paid.
+	- PeriodicFeeAllowance additionally caps the fees spent per Period, after
+	which PeriodCanSpend is topped up again to PeriodSpendLimit.
+
+Either allowance expires once its Expiration, a block time or block height, is
+reached. Used up and expired allowances are removed from state.
+
+Paying Fees
+
+A transaction selects the granter through the fee account of its StdFee, set
+with the --fee-account flag on the CLI. The DeductGrantedFeeDecorator from the
+feegrant ante package replaces the auth module's DeductFeeDecorator: when a
+fee account is set it charges the fee against the granter's allowance for the
+fee payer and deducts the fee from the granter's account, otherwise it deducts
+the fee from the fee payer as usual. Applications wire it in with:
+
+	app.SetAnteHandler(
+		feegrantante.NewAnteHandler(
+			app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper,
+			auth.DefaultSigVerificationGasConsumer,
+		),
+	)
+*/
+package feegrant
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryFeeAllowance() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	cdc := suite.app.Codec()
+
+	allowance := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 555)), types.ExpiresAt{})
+	grant := types.NewFeeAllowanceGrant(suite.addr, suite.addr2, allowance)
+	suite.keeper.GrantFeeAllowance(ctx, grant)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", types.QuerierRoute, types.QueryFeeAllowance}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryFeeAllowanceParams(suite.addr, suite.addr2)),
+	}
+
+	bz, err := suite.querier(ctx, []string{types.QueryFeeAllowance}, query)
+	suite.Nil(err)
+	suite.NotNil(bz)
+
+	var res types.FeeAllowanceGrant
+	suite.Nil(cdc.UnmarshalJSON(bz, &res))
+	suite.Equal(grant, res)
+
+	// no allowance the other way around
+	query.Data = cdc.MustMarshalJSON(types.NewQueryFeeAllowanceParams(suite.addr2, suite.addr))
+	bz, err = suite.querier(ctx, []string{types.QueryFeeAllowance}, query)
+	suite.NotNil(err)
+	suite.Nil(bz)
+}
+
+func (suite *KeeperTestSuite) TestQueryFeeAllowances() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	cdc := suite.app.Codec()
+
+	allowance := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 555)), types.ExpiresAt{})
+	suite.keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr, suite.addr3, allowance))
+	suite.keeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr2, suite.addr3, allowance))
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", types.QuerierRoute, types.QueryFeeAllowances}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryFeeAllowancesParams(suite.addr3)),
+	}
+
+	bz, err := suite.querier(ctx, []string{types.QueryFeeAllowances}, query)
+	suite.Nil(err)
+	suite.NotNil(bz)
+
+	var res []types.FeeAllowanceGrant
+	suite.Nil(cdc.UnmarshalJSON(bz, &res))
+	suite.Len(res, 2)
+
+	// nothing granted to the granters
+	query.Data = cdc.MustMarshalJSON(types.NewQueryFeeAllowancesParams(suite.addr))
+	bz, err = suite.querier(ctx, []string{types.QueryFeeAllowances}, query)
+	suite.Nil(err)
+	suite.Nil(cdc.UnmarshalJSON(bz, &res))
+	suite.Len(res, 0)
+}
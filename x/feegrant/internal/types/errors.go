@@ -0,0 +1,51 @@
+// DONTCOVER
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Error codes specific to the feegrant module
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeFeeLimitExceeded sdk.CodeType = 1
+	CodeFeeLimitExpired  sdk.CodeType = 2
+	CodeInvalidDuration  sdk.CodeType = 3
+	CodeNoAllowance      sdk.CodeType = 4
+)
+
+// ErrFeeLimitExceeded returns a typed ABCI error for a fee that exceeds the
+// remaining fee allowance.
+func ErrFeeLimitExceeded(codespace sdk.CodespaceType) error {
+	return sdkerrors.New(string(codespace), uint32(CodeFeeLimitExceeded), "fee limit exceeded")
+}
+
+// ErrFeeLimitExpired returns a typed ABCI error for a fee allowance that has
+// expired.
+func ErrFeeLimitExpired(codespace sdk.CodespaceType) error {
+	return sdkerrors.New(string(codespace), uint32(CodeFeeLimitExpired), "fee limit expired")
+}
+
+// ErrInvalidDuration returns a typed ABCI error for an invalid expiration or
+// period.
+func ErrInvalidDuration(codespace sdk.CodespaceType, msg string) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeInvalidDuration),
+		fmt.Sprintf("invalid duration: %s", msg),
+	)
+}
+
+// ErrNoAllowance returns a typed ABCI error for a granter and grantee pair
+// without a fee allowance.
+func ErrNoAllowance(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeNoAllowance),
+		fmt.Sprintf("no fee allowance from %s to %s", granter, grantee),
+	)
+}
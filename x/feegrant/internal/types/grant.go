@@ -0,0 +1,64 @@
+package types
+
+import (
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+// FeeAllowanceGrant is stored in the KVStore to record a grant with full context
+type FeeAllowanceGrant struct {
+	Granter   sdk.AccAddress        `json:"granter" yaml:"granter"`
+	Grantee   sdk.AccAddress        `json:"grantee" yaml:"grantee"`
+	Allowance exported.FeeAllowance `json:"allowance" yaml:"allowance"`
+}
+
+// NewFeeAllowanceGrant creates a new FeeAllowanceGrant.
+func NewFeeAllowanceGrant(granter, grantee sdk.AccAddress, feeAllowance exported.FeeAllowance) FeeAllowanceGrant {
+	return FeeAllowanceGrant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: feeAllowance,
+	}
+}
+
+// ValidateBasic performs basic validation on
+// FeeAllowanceGrant
+func (a FeeAllowanceGrant) ValidateBasic() error {
+	if a.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if a.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if a.Grantee.Equals(a.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+	}
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "missing fee allowance")
+	}
+
+	return a.Allowance.ValidateBasic()
+}
+
+// String implements the fmt.Stringer interface
+func (a FeeAllowanceGrant) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
+
+// PrepareForExport will make all needed changes to the allowance to prepare to be
+// re-imported at height 0, and return a copy of this grant.
+func (a FeeAllowanceGrant) PrepareForExport(dumpTime time.Time, dumpHeight int64) FeeAllowanceGrant {
+	if exportable, ok := a.Allowance.(interface {
+		PrepareForExport(time.Time, int64) exported.FeeAllowance
+	}); ok {
+		a.Allowance = exportable.PrepareForExport(dumpTime, dumpHeight)
+	}
+
+	return a
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgGrantFeeAllowance(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter_____________"))
+	grantee := sdk.AccAddress([]byte("grantee_____________"))
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+
+	testCases := []struct {
+		msg       MsgGrantFeeAllowance
+		expectErr bool
+	}{
+		{NewMsgGrantFeeAllowance(granter, grantee, NewBasicFeeAllowance(atom, ExpiresAtHeight(100))), false},
+		{NewMsgGrantFeeAllowance(granter, grantee, NewBasicFeeAllowance(nil, ExpiresAt{})), false},
+		{NewMsgGrantFeeAllowance(nil, grantee, NewBasicFeeAllowance(atom, ExpiresAt{})), true},
+		{NewMsgGrantFeeAllowance(granter, nil, NewBasicFeeAllowance(atom, ExpiresAt{})), true},
+		{NewMsgGrantFeeAllowance(granter, granter, NewBasicFeeAllowance(atom, ExpiresAt{})), true},
+		{NewMsgGrantFeeAllowance(granter, grantee, nil), true},
+		{NewMsgGrantFeeAllowance(granter, grantee, NewPeriodicFeeAllowance(BasicFeeAllowance{}, Duration{}, atom)), true},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, RouterKey, tc.msg.Route(), "unexpected result for tc #%d", i)
+		require.Equal(t, TypeMsgGrantFeeAllowance, tc.msg.Type(), "unexpected result for tc #%d", i)
+		require.Equal(t, tc.expectErr, tc.msg.ValidateBasic() != nil, "unexpected result for tc #%d", i)
+
+		if !tc.expectErr {
+			require.Equal(t, []sdk.AccAddress{granter}, tc.msg.GetSigners(), "unexpected result for tc #%d", i)
+			require.NotEmpty(t, tc.msg.GetSignBytes(), "unexpected result for tc #%d", i)
+		}
+	}
+}
+
+func TestMsgRevokeFeeAllowance(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter_____________"))
+	grantee := sdk.AccAddress([]byte("grantee_____________"))
+
+	testCases := []struct {
+		msg       MsgRevokeFeeAllowance
+		expectErr bool
+	}{
+		{NewMsgRevokeFeeAllowance(granter, grantee), false},
+		{NewMsgRevokeFeeAllowance(nil, grantee), true},
+		{NewMsgRevokeFeeAllowance(granter, nil), true},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, RouterKey, tc.msg.Route(), "unexpected result for tc #%d", i)
+		require.Equal(t, TypeMsgRevokeFeeAllowance, tc.msg.Type(), "unexpected result for tc #%d", i)
+		require.Equal(t, tc.expectErr, tc.msg.ValidateBasic() != nil, "unexpected result for tc #%d", i)
+
+		if !tc.expectErr {
+			require.Equal(t, []sdk.AccAddress{granter}, tc.msg.GetSigners(), "unexpected result for tc #%d", i)
+			require.NotEmpty(t, tc.msg.GetSignBytes(), "unexpected result for tc #%d", i)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+var _ exported.FeeAllowance = (*PeriodicFeeAllowance)(nil)
+
+// PeriodicFeeAllowance extends FeeAllowance to allow for both a maximum cap,
+// as well as a limit per time period.
+type PeriodicFeeAllowance struct {
+	Basic BasicFeeAllowance `json:"basic" yaml:"basic"`
+
+	// Period is the duration of one period
+	Period Duration `json:"period" yaml:"period"`
+	// PeriodSpendLimit is the maximum amount of tokens to be spent in this period
+	PeriodSpendLimit sdk.Coins `json:"period_spend_limit" yaml:"period_spend_limit"`
+
+	// PeriodCanSpend is how much is available until PeriodReset
+	PeriodCanSpend sdk.Coins `json:"period_can_spend" yaml:"period_can_spend"`
+
+	// PeriodReset is when the PeriodCanSpend is updated
+	PeriodReset ExpiresAt `json:"period_reset" yaml:"period_reset"`
+}
+
+// NewPeriodicFeeAllowance returns a new PeriodicFeeAllowance whose first
+// period starts with the next block.
+func NewPeriodicFeeAllowance(basic BasicFeeAllowance, period Duration, periodSpendLimit sdk.Coins) *PeriodicFeeAllowance {
+	return &PeriodicFeeAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}
+}
+
+// Accept can use fee payment requested as well as timestamp/height of the current block
+// to determine whether or not to process this. This is checked in
+// Keeper.UseGrantedFees and the return values should match how it is handled there.
+//
+// If it returns an error, the fee payment is rejected, otherwise it is accepted.
+// The FeeAllowance implementation is expected to update it's internal state
+// and will be saved again after an acceptance.
+//
+// If remove is true (regardless of the error), the FeeAllowance will be deleted from storage
+// (eg. when it is used up). (See call to RevokeFeeAllowance in Keeper.UseGrantedFees)
+func (a *PeriodicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64) (bool, error) {
+	if a.Basic.Expiration.IsExpired(blockTime, blockHeight) {
+		return true, ErrFeeLimitExpired(DefaultCodespace)
+	}
+
+	a.TryResetPeriod(blockTime, blockHeight)
+
+	// deduct from both the current period and the max amount
+	var isNeg bool
+	a.PeriodCanSpend, isNeg = a.PeriodCanSpend.SafeSub(fee)
+	if isNeg {
+		return false, ErrFeeLimitExceeded(DefaultCodespace)
+	}
+
+	if a.Basic.SpendLimit.Empty() {
+		return false, nil
+	}
+
+	a.Basic.SpendLimit, isNeg = a.Basic.SpendLimit.SafeSub(fee)
+	if isNeg {
+		return false, ErrFeeLimitExceeded(DefaultCodespace)
+	}
+
+	return a.Basic.SpendLimit.IsZero(), nil
+}
+
+// TryResetPeriod will check if the PeriodReset has been hit. If not, it is a no-op.
+// If we hit the reset period, it will top up the PeriodCanSpend amount to
+// min(PeriodicSpendLimit, a.Basic.SpendLimit) so it is never more than the maximum allowed.
+// It will also update the PeriodReset. If we are within one Period, it will update from the
+// last PeriodReset (eg. if you always do one tx per day, it will always reset the same time)
+// If we are more then one period out (eg. no activity in a week), reset is one Period from the execution of this method
+func (a *PeriodicFeeAllowance) TryResetPeriod(blockTime time.Time, blockHeight int64) {
+	if !a.PeriodReset.IsZero() && !a.PeriodReset.IsExpired(blockTime, blockHeight) {
+		return
+	}
+
+	// set CanSpend to the lesser of PeriodSpendLimit and the TotalLimit
+	if _, isNeg := a.Basic.SpendLimit.SafeSub(a.PeriodSpendLimit); isNeg && !a.Basic.SpendLimit.Empty() {
+		a.PeriodCanSpend = a.Basic.SpendLimit
+	} else {
+		a.PeriodCanSpend = a.PeriodSpendLimit
+	}
+
+	// a zero PeriodReset starts the first period at the current block
+	if a.PeriodReset.IsZero() {
+		if a.Period.Clock > 0 {
+			a.PeriodReset = ExpiresAtTime(blockTime)
+		} else {
+			a.PeriodReset = ExpiresAtHeight(blockHeight)
+		}
+	}
+
+	// If we are within the period, step from expiration (eg. if you always do one tx per day, it will always reset the same time)
+	// If we are more then one period out (eg. no activity in a week), reset is one period from this time
+	a.PeriodReset = a.PeriodReset.MustStep(a.Period)
+	if a.PeriodReset.IsExpired(blockTime, blockHeight) {
+		a.PeriodReset = a.PeriodReset.FastForward(blockTime, blockHeight).MustStep(a.Period)
+	}
+}
+
+// PrepareForExport will adjust the expiration based on export time. In particular,
+// it will subtract the dumpHeight from any height-based expiration to ensure that
+// the elapsed number of blocks this allowance is valid for is fixed.
+// (For PeriodReset and Basic.Expiration)
+func (a *PeriodicFeeAllowance) PrepareForExport(dumpTime time.Time, dumpHeight int64) exported.FeeAllowance {
+	return &PeriodicFeeAllowance{
+		Basic: BasicFeeAllowance{
+			SpendLimit: a.Basic.SpendLimit,
+			Expiration: a.Basic.Expiration.PrepareForExport(dumpTime, dumpHeight),
+		},
+		PeriodSpendLimit: a.PeriodSpendLimit,
+		PeriodCanSpend:   a.PeriodCanSpend,
+		Period:           a.Period,
+		PeriodReset:      a.PeriodReset.PrepareForExport(dumpTime, dumpHeight),
+	}
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a PeriodicFeeAllowance) ValidateBasic() error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !a.PeriodSpendLimit.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend amount is invalid: %s", a.PeriodSpendLimit)
+	}
+	if !a.PeriodSpendLimit.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit must be positive")
+	}
+	if !a.PeriodCanSpend.IsValid() && !a.PeriodCanSpend.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "can spend amount is invalid: %s", a.PeriodCanSpend)
+	}
+	// We allow 0 for CanSpend
+	if a.PeriodCanSpend.IsAnyNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "can spend must not be negative")
+	}
+
+	// check for mismatched denominations
+	if !a.Basic.SpendLimit.Empty() && !a.PeriodSpendLimit.DenomsSubsetOf(a.Basic.SpendLimit) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "period spend limit has denominations not in the spend limit")
+	}
+
+	if err := a.Period.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := a.PeriodReset.ValidateBasic(); err != nil {
+		return err
+	}
+	if !a.PeriodReset.IsZero() && !a.PeriodReset.IsCompatible(a.Period) {
+		return ErrInvalidDuration(DefaultCodespace, "period reset and period have different units")
+	}
+	return nil
+}
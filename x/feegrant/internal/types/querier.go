@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier routes for the feegrant module
+const (
+	QueryFeeAllowance  = "fee_allowance"
+	QueryFeeAllowances = "fee_allowances"
+)
+
+// QueryFeeAllowanceParams defines the parameters necessary for querying the
+// fee allowance from a granter to a grantee.
+type QueryFeeAllowanceParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+func NewQueryFeeAllowanceParams(granter, grantee sdk.AccAddress) QueryFeeAllowanceParams {
+	return QueryFeeAllowanceParams{Granter: granter, Grantee: grantee}
+}
+
+// QueryFeeAllowancesParams defines the parameters necessary for querying all
+// fee allowances granted to a grantee.
+type QueryFeeAllowancesParams struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+func NewQueryFeeAllowancesParams(grantee sdk.AccAddress) QueryFeeAllowancesParams {
+	return QueryFeeAllowancesParams{Grantee: grantee}
+}
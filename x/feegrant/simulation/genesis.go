@@ -0,0 +1,88 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation parameter constants
+const (
+	FeeAllowances = "fee_allowances"
+)
+
+// GenFeeAllowances returns random fee allowances between the simulation
+// accounts.
+func GenFeeAllowances(r *rand.Rand, accs []simulation.Account, denom string, genesisTime time.Time) []types.FeeAllowanceGrant {
+	grants := []types.FeeAllowanceGrant{}
+	if len(accs) < 2 {
+		return grants
+	}
+
+	seen := make(map[string]bool)
+	for i := 0; i < r.Intn(len(accs)); i++ {
+		granter, _ := simulation.RandomAcc(r, accs)
+		grantee, _ := simulation.RandomAcc(r, accs)
+
+		key := string(types.FeeAllowanceKey(granter.Address, grantee.Address))
+		if granter.Address.Equals(grantee.Address) || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		grants = append(grants, types.NewFeeAllowanceGrant(
+			granter.Address, grantee.Address, RandomAllowance(r, denom, genesisTime),
+		))
+	}
+
+	return grants
+}
+
+// RandomAllowance returns a random basic or periodic fee allowance in the given
+// denomination that may expire at a random time after the given time.
+func RandomAllowance(r *rand.Rand, denom string, now time.Time) exported.FeeAllowance {
+	var spendLimit sdk.Coins
+	if r.Intn(4) != 0 {
+		spendLimit = sdk.NewCoins(sdk.NewInt64Coin(denom, int64(simulation.RandIntBetween(r, 1, 1000000))))
+	}
+
+	var expiration types.ExpiresAt
+	if r.Intn(2) == 0 {
+		expiration = types.ExpiresAtTime(now.Add(time.Duration(simulation.RandIntBetween(r, 1, 60*60*24*30)) * time.Second))
+	}
+
+	basic := types.NewBasicFeeAllowance(spendLimit, expiration)
+	if r.Intn(2) == 0 {
+		return basic
+	}
+
+	period := types.ClockDuration(time.Duration(simulation.RandIntBetween(r, 1, 60*60*24)) * time.Second)
+	periodLimit := sdk.NewCoins(sdk.NewInt64Coin(denom, int64(simulation.RandIntBetween(r, 1, 100000))))
+
+	return types.NewPeriodicFeeAllowance(*basic, period, periodLimit)
+}
+
+// RandomizedGenState generates a random GenesisState for feegrant
+func RandomizedGenState(simState *module.SimulationState) {
+	var grants []types.FeeAllowanceGrant
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeAllowances, &grants, simState.Rand,
+		func(r *rand.Rand) {
+			grants = GenFeeAllowances(r, simState.Accounts, simState.BondDenom, simState.GenTimestamp)
+		},
+	)
+
+	feegrantGenesis := types.NewGenesisState(grants)
+
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, codec.MustMarshalJSONIndent(simState.Cdc, feegrantGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feegrantGenesis)
+}
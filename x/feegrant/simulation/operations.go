@@ -0,0 +1,183 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgGrantFeeAllowance  = "op_weight_msg_grant_fee_allowance"
+	OpWeightMsgRevokeFeeAllowance = "op_weight_msg_revoke_fee_allowance"
+)
+
+// WeightedOperations returns all the operations from the module with their
+// respective weights. Granters without spendable coins grant allowances in the
+// bond denom of the simulation.
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, ak types.AccountKeeper,
+	k keeper.Keeper, bondDenom string) simulation.WeightedOperations {
+
+	var weightMsgGrantFeeAllowance int
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrantFeeAllowance, &weightMsgGrantFeeAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrantFeeAllowance = 50
+		},
+	)
+
+	var weightMsgRevokeFeeAllowance int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeFeeAllowance, &weightMsgRevokeFeeAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeFeeAllowance = 20
+		},
+	)
+
+	return simulation.WeightedOperations{
+		{Weight: weightMsgGrantFeeAllowance, Op: SimulateMsgGrantFeeAllowance(ak, k, bondDenom)},
+		{Weight: weightMsgRevokeFeeAllowance, Op: SimulateMsgRevokeFeeAllowance(ak, k)},
+	}
+}
+
+// SimulateMsgGrantFeeAllowance generates a MsgGrantFeeAllowance from a random
+// account to another one, in one of the denoms the granter can spend or in the
+// given bond denom if it can't spend any. When the granter has itself been
+// granted a fee allowance that covers the fees, the fees are paid through that
+// allowance.
+func SimulateMsgGrantFeeAllowance(ak types.AccountKeeper, k keeper.Keeper, bondDenom string) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		granter, _ := simulation.RandomAcc(r, accs)
+		grantee, _ := simulation.RandomAcc(r, accs)
+		if granter.Address.Equals(grantee.Address) {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgGrantFeeAllowance, "granter and grantee are the same"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+		denom := bondDenom
+		if coins := account.SpendableCoins(ctx.BlockTime()); !coins.Empty() {
+			denom = coins[r.Intn(len(coins))].Denom
+		}
+
+		msg := types.NewMsgGrantFeeAllowance(granter.Address, grantee.Address, RandomAllowance(r, denom, ctx.BlockTime()))
+
+		feeAccount, fees, err := randomGrantedFees(r, ctx, ak, k, granter.Address)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName, msg.Type(), ""), nil, err
+		}
+
+		tx := helpers.GenTxWithFeeAccount(
+			[]sdk.Msg{msg},
+			fees,
+			feeAccount,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName, msg.Type(), ""), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRevokeFeeAllowance generates a MsgRevokeFeeAllowance for a random
+// existing fee allowance granted by a simulation account.
+func SimulateMsgRevokeFeeAllowance(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		var grants []types.FeeAllowanceGrant
+		k.IterateAllFeeAllowances(ctx, func(grant types.FeeAllowanceGrant) bool {
+			grants = append(grants, grant)
+			return false
+		})
+
+		if len(grants) == 0 {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgRevokeFeeAllowance, "no fee allowances"), nil, nil
+		}
+
+		grant := grants[r.Intn(len(grants))]
+
+		granter, found := simulation.FindAccount(accs, grant.Granter)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgRevokeFeeAllowance, "granter is not a simulation account"), nil, nil
+		}
+
+		msg := types.NewMsgRevokeFeeAllowance(grant.Granter, grant.Grantee)
+
+		account := ak.GetAccount(ctx, granter.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName, msg.Type(), ""), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName, msg.Type(), ""), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// randomGrantedFees returns random fees for a tx signed by the fee payer. Half
+// of the time the fees are paid by an account that granted the fee payer an
+// allowance covering them, which is returned as the fee account.
+func randomGrantedFees(
+	r *rand.Rand, ctx sdk.Context, ak types.AccountKeeper, k keeper.Keeper, feePayer sdk.AccAddress,
+) (sdk.AccAddress, sdk.Coins, error) {
+
+	if r.Intn(2) == 0 {
+		var grants []types.FeeAllowanceGrant
+		k.IterateAllGranteeFeeAllowances(ctx, feePayer, func(grant types.FeeAllowanceGrant) bool {
+			grants = append(grants, grant)
+			return false
+		})
+
+		if len(grants) > 0 {
+			grant := grants[r.Intn(len(grants))]
+			granterAcc := ak.GetAccount(ctx, grant.Granter)
+
+			fees, err := simulation.RandomFees(r, ctx, granterAcc.SpendableCoins(ctx.BlockTime()))
+			if err != nil {
+				return nil, nil, err
+			}
+
+			// only use the allowance if it accepts the fees, the grant read from
+			// the store is a copy so accepting here doesn't alter the state
+			if _, err := grant.Allowance.Accept(fees, ctx.BlockTime(), ctx.BlockHeight()); err == nil {
+				return grant.Granter, fees, nil
+			}
+		}
+	}
+
+	account := ak.GetAccount(ctx, feePayer)
+	fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+
+	return nil, fees, err
+}
@@ -142,7 +142,8 @@ func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager, sm
 				return errors.Wrap(err, "failed to read from tx builder keybase")
 			}
 
-			if info.GetType() == kbkeys.TypeOffline || info.GetType() == kbkeys.TypeMulti {
+			if info.GetType() == kbkeys.TypeOffline || info.GetType() == kbkeys.TypeMulti ||
+				info.GetType() == kbkeys.TypeWatch {
 				fmt.Println("Offline key passed in. Use `tx sign` command to sign:")
 				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
 			}
@@ -2,28 +2,46 @@ package gov
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+// BeginBlocker halts the chain once it reaches the height scheduled by a
+// passed HaltChainProposal. The block at that height is committed before the
+// nodes stop, and the halt height is cleared, so that the chain resumes when
+// they restart.
+func BeginBlocker(ctx sdk.Context, keeper Keeper) {
+	height, found := keeper.GetHaltHeight(ctx)
+	if !found || ctx.BlockHeight() < height {
+		return
+	}
+
+	keeper.HaltChain(ctx)
+}
+
 // EndBlocker called every block, process inflation, update validator set.
 func EndBlocker(ctx sdk.Context, keeper Keeper) {
 	logger := keeper.Logger(ctx)
 
 	// delete inactive proposal from store and its deposits
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal Proposal) bool {
+		burned, refunded := keeper.SettleDeposits(ctx, proposal, true)
 		keeper.DeleteProposal(ctx, proposal.ProposalID)
-		keeper.DeleteDeposits(ctx, proposal.ProposalID)
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeInactiveProposal,
 				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
 				sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalDropped),
+				sdk.NewAttribute(types.AttributeKeyBurnedDeposits, burned.String()),
+				sdk.NewAttribute(types.AttributeKeyRefundedDeposits, refunded.String()),
 			),
 		)
 
+		keeper.AfterProposalFailedMinDeposit(ctx, proposal.ProposalID)
+
 		logger.Info(
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalID,
@@ -41,27 +59,17 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
-		if burnDeposits {
-			keeper.DeleteDeposits(ctx, proposal.ProposalID)
-		} else {
-			keeper.RefundDeposits(ctx, proposal.ProposalID)
-		}
+		burned, refunded := keeper.SettleDeposits(ctx, proposal, burnDeposits)
 
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
-			cacheCtx, writeCache := ctx.CacheContext()
-
 			// The proposal handler may execute state mutating logic depending
 			// on the proposal content. If the handler fails, no state mutation
 			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.Content)
+			err := executeProposal(ctx, keeper, proposal)
 			if err == nil {
 				proposal.Status = StatusPassed
 				tagValue = types.AttributeValueProposalPassed
 				logMsg = "passed"
-
-				// write state to the underlying multi-store
-				writeCache()
 			} else {
 				proposal.Status = StatusFailed
 				tagValue = types.AttributeValueProposalFailed
@@ -78,6 +86,17 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		keeper.SetProposal(ctx, proposal)
 		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
 
+		// prune the votes right away unless they must be retained for a while;
+		// proposals without any vote or settled deposit have nothing to prune
+		// and are not queued
+		if retention := keeper.GetVotingParams(ctx).RetentionPeriod; retention > 0 {
+			if len(keeper.GetVotes(ctx, proposal.ProposalID)) > 0 || len(keeper.GetSettledDeposits(ctx, proposal.ProposalID)) > 0 {
+				keeper.InsertPruneQueue(ctx, proposal.ProposalID, proposal.VotingEndTime.Add(retention))
+			}
+		} else {
+			keeper.PruneVotes(ctx, proposal.ProposalID)
+		}
+
 		logger.Info(
 			fmt.Sprintf(
 				"proposal %d (%s) tallied; result: %s",
@@ -90,8 +109,66 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 				types.EventTypeActiveProposal,
 				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
 				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+				sdk.NewAttribute(types.AttributeKeyBurnedDeposits, burned.String()),
+				sdk.NewAttribute(types.AttributeKeyRefundedDeposits, refunded.String()),
+			),
+		)
+
+		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalID)
+		return false
+	})
+
+	// prune the votes and settled deposits of concluded proposals whose
+	// retention period has ended
+	keeper.IteratePruneQueue(ctx, ctx.BlockHeader().Time, func(proposalID uint64, pruneTime time.Time) bool {
+		pruned := keeper.PruneVotes(ctx, proposalID)
+		prunedDeposits := keeper.PruneDeposits(ctx, proposalID)
+		keeper.RemoveFromPruneQueue(ctx, proposalID, pruneTime)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePruneVotes,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+				sdk.NewAttribute(types.AttributeKeyPrunedVotes, fmt.Sprintf("%d", pruned)),
+				sdk.NewAttribute(types.AttributeKeyPrunedDeposits, fmt.Sprintf("%d", prunedDeposits)),
 			),
 		)
 		return false
 	})
 }
+
+// executeProposal runs the handler of a passed proposal on a cache context
+// whose gas is capped by the execution gas limit, and writes its state changes
+// if it succeeds. A handler running out of gas or panicking fails like a
+// handler returning an error, so that it can't halt the chain.
+func executeProposal(ctx sdk.Context, keeper Keeper, proposal Proposal) (err sdk.Error) {
+	handler := keeper.Router().GetRoute(proposal.ProposalRoute())
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	gasLimit := keeper.GetTallyParams(ctx).ExecutionGasLimit
+	if gasLimit > 0 {
+		cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = sdk.ErrOutOfGas(fmt.Sprintf(
+					"out of gas in location: %v; gasLimit: %d", rType.Descriptor, gasLimit,
+				))
+
+			default:
+				err = sdk.ErrInternal(fmt.Sprintf("proposal handler panicked: %v", r))
+			}
+		}
+	}()
+
+	if err := handler(cacheCtx, proposal.Content); err != nil {
+		return err
+	}
+
+	// write state to the underlying multi-store
+	writeCache()
+	return nil
+}
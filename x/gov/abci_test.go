@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	keep "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -283,6 +284,88 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.True(t, macc.GetCoins().IsEqual(initialModuleAccCoins))
 }
 
+type mockArchiveSink struct {
+	votes    Votes
+	deposits Deposits
+}
+
+func (s *mockArchiveSink) ArchiveVote(_ sdk.Context, vote Vote) {
+	s.votes = append(s.votes, vote)
+}
+
+func (s *mockArchiveSink) ArchiveDeposit(_ sdk.Context, deposit Deposit) {
+	s.deposits = append(s.deposits, deposit)
+}
+
+func TestEndBlockerPruneVotes(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil, ProposalHandler)
+	SortAddresses(input.addrs)
+
+	sink := &mockArchiveSink{}
+	input.keeper.SetArchiveSink(sink)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	retention := time.Hour
+	votingParams := input.keeper.GetVotingParams(ctx)
+	votingParams.RetentionPeriod = retention
+	input.keeper.SetVotingParams(ctx, votingParams)
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	proposal, err := input.keeper.SubmitProposal(ctx, keep.TestProposal)
+	require.NoError(t, err)
+
+	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
+	res := handler(ctx, NewMsgDeposit(input.addrs[0], proposal.ProposalID, proposalCoins))
+	require.True(t, res.IsOK())
+
+	require.NoError(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes))
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	// the refunded deposits and the votes are kept until the retention period ends
+	require.Empty(t, sink.deposits)
+	require.Empty(t, sink.votes)
+	require.Empty(t, input.keeper.GetDeposits(ctx, proposal.ProposalID))
+	require.Len(t, input.keeper.GetSettledDeposits(ctx, proposal.ProposalID), 1)
+	require.Len(t, input.keeper.GetVotes(ctx, proposal.ProposalID), 1)
+
+	pruneQueue := input.keeper.PruneQueueIterator(ctx, proposal.VotingEndTime.Add(retention))
+	require.True(t, pruneQueue.Valid())
+	pruneQueue.Close()
+
+	newHeader.Time = proposal.VotingEndTime.Add(retention)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	require.Len(t, sink.votes, 1)
+	require.Equal(t, input.addrs[0], sink.votes[0].Voter)
+	require.Empty(t, input.keeper.GetVotes(ctx, proposal.ProposalID))
+	require.Len(t, sink.deposits, 1)
+	require.Equal(t, input.addrs[0], sink.deposits[0].Depositor)
+	require.Empty(t, input.keeper.GetSettledDeposits(ctx, proposal.ProposalID))
+
+	pruneQueue = input.keeper.PruneQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, pruneQueue.Valid())
+	pruneQueue.Close()
+}
+
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// hijack the router to one that will fail in a proposal's handler
 	input := getMockApp(t, 1, GenesisState{}, nil, badProposalHandler)
@@ -325,3 +408,282 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	EndBlocker(ctx, input.keeper)
 }
+
+func TestEndBlockerProposalHandlerSandboxed(t *testing.T) {
+	testCases := []struct {
+		name    string
+		execute func(ctx sdk.Context)
+	}{
+		{"panic", func(_ sdk.Context) { panic("unexpected failure") }},
+		{"out of gas", func(ctx sdk.Context) { ctx.GasMeter().ConsumeGas(DefaultExecutionGasLimit+1, "test") }},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				input   testInput
+				execute bool
+			)
+
+			// once executed, the handler changes the state before failing
+			input = getMockApp(t, 1, GenesisState{}, nil, func(ctx sdk.Context, _ Content) sdk.Error {
+				if !execute {
+					return nil
+				}
+
+				depositParams := input.keeper.GetDepositParams(ctx)
+				depositParams.MaxDepositPeriod++
+				input.keeper.SetDepositParams(ctx, depositParams)
+
+				tc.execute(ctx)
+				return nil
+			})
+			SortAddresses(input.addrs)
+
+			handler := NewHandler(input.keeper)
+			stakingHandler := staking.NewHandler(input.sk)
+
+			header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+			input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+			ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+			valAddr := sdk.ValAddress(input.addrs[0])
+
+			createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			staking.EndBlocker(ctx, input.sk)
+
+			depositParams := input.keeper.GetDepositParams(ctx)
+
+			proposal, err := input.keeper.SubmitProposal(ctx, keep.TestProposal)
+			require.NoError(t, err)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+			res := handler(ctx, NewMsgDeposit(input.addrs[0], proposal.ProposalID, proposalCoins))
+			require.True(t, res.IsOK())
+
+			err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes)
+			require.NoError(t, err)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(depositParams.MaxDepositPeriod).Add(input.keeper.GetVotingParams(ctx).VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			execute = true
+			require.NotPanics(t, func() { EndBlocker(ctx, input.keeper) })
+
+			proposal, ok := input.keeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+			require.Equal(t, StatusFailed, proposal.Status)
+
+			// the state changes of the handler are discarded
+			require.Equal(t, depositParams, input.keeper.GetDepositParams(ctx))
+		})
+	}
+}
+
+func TestHaltChainProposal(t *testing.T) {
+	// the proposal handler uses the keeper created along with its router
+	var keeper Keeper
+	input := getMockApp(t, 1, GenesisState{}, nil, NewProposalHandler(&keeper))
+	keeper = input.keeper
+	SortAddresses(input.addrs)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{Height: 10})
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	require.Error(t, NewHaltChainProposal("Test", "description", 0).ValidateBasic())
+
+	proposal, err := input.keeper.SubmitProposal(ctx, NewHaltChainProposal("Test", "description", 100))
+	require.NoError(t, err)
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+	res := handler(ctx, NewMsgDeposit(input.addrs[0], proposal.ProposalID, proposalCoins))
+	require.True(t, res.IsOK())
+
+	err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes)
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(input.keeper.GetDepositParams(ctx).MaxDepositPeriod).Add(input.keeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+
+	height, found := input.keeper.GetHaltHeight(ctx)
+	require.True(t, found)
+	require.Equal(t, int64(100), height)
+
+	halts := 0
+	keeper.SetHaltHandler(func() { halts++ })
+
+	BeginBlocker(ctx.WithBlockHeight(99), keeper)
+	require.Equal(t, 0, halts)
+
+	BeginBlocker(ctx.WithBlockHeight(100), keeper)
+	require.Equal(t, 1, halts)
+
+	// the halt height is cleared, so that the chain resumes once restarted
+	_, found = keeper.GetHaltHeight(ctx)
+	require.False(t, found)
+	BeginBlocker(ctx.WithBlockHeight(101), keeper)
+	require.Equal(t, 1, halts)
+}
+
+func TestHaltChainProposalNotExported(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil, ProposalHandler)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{Height: 10})
+
+	require.NoError(t, input.keeper.ScheduleHalt(ctx, 100))
+
+	// a chain restarted from the exported state doesn't halt again at a height
+	// it may already have passed
+	input = getMockApp(t, 1, ExportGenesis(ctx, input.keeper), nil, ProposalHandler)
+	header = abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx = input.mApp.BaseApp.NewContext(false, header)
+	_, found := input.keeper.GetHaltHeight(ctx)
+	require.False(t, found)
+}
+
+func TestHaltChainProposalPastHeight(t *testing.T) {
+	var keeper Keeper
+	input := getMockApp(t, 1, GenesisState{}, nil, NewProposalHandler(&keeper))
+	keeper = input.keeper
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{Height: 10})
+
+	proposalHandler := NewProposalHandler(&keeper)
+	require.NoError(t, proposalHandler(ctx, NewTextProposal("Test", "description")))
+	require.Error(t, proposalHandler(ctx, NewHaltChainProposal("Test", "description", 10)))
+
+	_, found := input.keeper.GetHaltHeight(ctx)
+	require.False(t, found)
+}
+
+type mockGovHooks struct {
+	submitted, deposited, voted, failedMinDeposit, votingPeriodEnded []uint64
+}
+
+func (h *mockGovHooks) AfterProposalSubmission(_ sdk.Context, proposalID uint64) {
+	h.submitted = append(h.submitted, proposalID)
+}
+
+func (h *mockGovHooks) AfterProposalDeposit(_ sdk.Context, proposalID uint64, _ sdk.AccAddress) {
+	h.deposited = append(h.deposited, proposalID)
+}
+
+func (h *mockGovHooks) AfterProposalVote(_ sdk.Context, proposalID uint64, _ sdk.AccAddress) {
+	h.voted = append(h.voted, proposalID)
+}
+
+func (h *mockGovHooks) AfterProposalFailedMinDeposit(_ sdk.Context, proposalID uint64) {
+	h.failedMinDeposit = append(h.failedMinDeposit, proposalID)
+}
+
+func (h *mockGovHooks) AfterProposalVotingPeriodEnded(_ sdk.Context, proposalID uint64) {
+	h.votingPeriodEnded = append(h.votingPeriodEnded, proposalID)
+}
+
+// halfBurnDepositPolicy burns half of the deposits the default policy burns
+type halfBurnDepositPolicy struct{}
+
+func (halfBurnDepositPolicy) DepositBurnRate(_ sdk.Context, _ Proposal, burn bool) sdk.Dec {
+	if burn {
+		return sdk.NewDecWithPrec(5, 1)
+	}
+	return sdk.ZeroDec()
+}
+
+func TestEndBlockerGovHooksAndDepositPolicy(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil, ProposalHandler)
+
+	hooks := &mockGovHooks{}
+	input.keeper.SetHooks(NewMultiGovHooks(hooks))
+	require.Panics(t, func() { input.keeper.SetHooks(hooks) })
+	input.keeper.SetDepositPolicy(halfBurnDepositPolicy{})
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	// the first proposal doesn't meet the minimum deposit, the second one is vetoed
+	dropped, err := input.keeper.SubmitProposal(ctx, keep.TestProposal)
+	require.NoError(t, err)
+	vetoed, err := input.keeper.SubmitProposal(ctx, keep.TestProposal)
+	require.NoError(t, err)
+
+	droppedCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	res := handler(ctx, NewMsgDeposit(input.addrs[0], dropped.ProposalID, droppedCoins))
+	require.True(t, res.IsOK())
+
+	vetoedCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+	res = handler(ctx, NewMsgDeposit(input.addrs[0], vetoed.ProposalID, vetoedCoins))
+	require.True(t, res.IsOK())
+	require.NoError(t, input.keeper.AddVote(ctx, vetoed.ProposalID, input.addrs[0], OptionNoWithVeto))
+
+	require.Equal(t, []uint64{dropped.ProposalID, vetoed.ProposalID}, hooks.submitted)
+	require.Equal(t, []uint64{dropped.ProposalID, vetoed.ProposalID}, hooks.deposited)
+	require.Equal(t, []uint64{vetoed.ProposalID}, hooks.voted)
+
+	depositorCoins := input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[0]).GetCoins()
+
+	vetoed, ok := input.keeper.GetProposal(ctx, vetoed.ProposalID)
+	require.True(t, ok)
+	endTime := dropped.DepositEndTime
+	if vetoed.VotingEndTime.After(endTime) {
+		endTime = vetoed.VotingEndTime
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = endTime
+	ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, input.keeper)
+
+	require.Equal(t, []uint64{dropped.ProposalID}, hooks.failedMinDeposit)
+	require.Equal(t, []uint64{vetoed.ProposalID}, hooks.votingPeriodEnded)
+
+	// half of the deposits are burned, the other half refunded
+	refunds := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5).AddRaw(5)))
+	require.Equal(t, depositorCoins.Add(refunds), input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[0]).GetCoins())
+	require.True(t, input.keeper.GetGovernanceAccount(ctx).GetCoins().IsZero())
+
+	burned := make(map[string]string)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeInactiveProposal && event.Type != types.EventTypeActiveProposal {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyBurnedDeposits {
+				burned[event.Type] = string(attr.Value)
+			}
+		}
+	}
+	require.Equal(t, "5"+sdk.DefaultBondDenom, burned[types.EventTypeInactiveProposal])
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5)).String(), burned[types.EventTypeActiveProposal])
+}
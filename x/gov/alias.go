@@ -13,6 +13,9 @@ import (
 const (
 	MaxDescriptionLength         = types.MaxDescriptionLength
 	MaxTitleLength               = types.MaxTitleLength
+	MaxSummaryLength             = types.MaxSummaryLength
+	MaxForumLinkLength           = types.MaxForumLinkLength
+	MaxIPFSHashLength            = types.MaxIPFSHashLength
 	DefaultCodespace             = types.DefaultCodespace
 	CodeUnknownProposal          = types.CodeUnknownProposal
 	CodeInactiveProposal         = types.CodeInactiveProposal
@@ -25,7 +28,10 @@ const (
 	CodeInvalidGenesis           = types.CodeInvalidGenesis
 	CodeInvalidProposalStatus    = types.CodeInvalidProposalStatus
 	CodeProposalHandlerNotExists = types.CodeProposalHandlerNotExists
+	CodeInvalidProposer          = types.CodeInvalidProposer
+	CodeInvalidHaltHeight        = types.CodeInvalidHaltHeight
 	DefaultPeriod                = types.DefaultPeriod
+	DefaultExecutionGasLimit     = types.DefaultExecutionGasLimit
 	ModuleName                   = types.ModuleName
 	StoreKey                     = types.StoreKey
 	RouterKey                    = types.RouterKey
@@ -33,6 +39,8 @@ const (
 	DefaultParamspace            = types.DefaultParamspace
 	TypeMsgDeposit               = types.TypeMsgDeposit
 	TypeMsgVote                  = types.TypeMsgVote
+	TypeMsgVoteWeighted          = types.TypeMsgVoteWeighted
+	TypeMsgCancelProposal        = types.TypeMsgCancelProposal
 	TypeMsgSubmitProposal        = types.TypeMsgSubmitProposal
 	StatusNil                    = types.StatusNil
 	StatusDepositPeriod          = types.StatusDepositPeriod
@@ -41,6 +49,7 @@ const (
 	StatusRejected               = types.StatusRejected
 	StatusFailed                 = types.StatusFailed
 	ProposalTypeText             = types.ProposalTypeText
+	ProposalTypeHaltChain        = types.ProposalTypeHaltChain
 	QueryParams                  = types.QueryParams
 	QueryProposals               = types.QueryProposals
 	QueryProposal                = types.QueryProposal
@@ -48,7 +57,13 @@ const (
 	QueryDeposit                 = types.QueryDeposit
 	QueryVotes                   = types.QueryVotes
 	QueryVote                    = types.QueryVote
+	QueryVoterVotes              = types.QueryVoterVotes
+	QueryMetadata                = types.QueryMetadata
 	QueryTally                   = types.QueryTally
+	QueryRecentProposals         = types.QueryRecentProposals
+	QueryHaltHeight              = types.QueryHaltHeight
+	MaxRecentProposalsLimit      = types.MaxRecentProposalsLimit
+	MaxVoterVotesLimit           = types.MaxVoterVotesLimit
 	ParamDeposit                 = types.ParamDeposit
 	ParamVoting                  = types.ParamVoting
 	ParamTallying                = types.ParamTallying
@@ -69,15 +84,22 @@ var (
 	RegisterCodec                 = types.RegisterCodec
 	RegisterProposalTypeCodec     = types.RegisterProposalTypeCodec
 	ValidateAbstract              = types.ValidateAbstract
+	NewProposalMetadata           = types.NewProposalMetadata
+	GetContentMetadata            = types.GetContentMetadata
+	ValidateMetadata              = types.ValidateMetadata
 	NewDeposit                    = types.NewDeposit
+	NewMultiGovHooks              = types.NewMultiGovHooks
 	ErrUnknownProposal            = types.ErrUnknownProposal
 	ErrInactiveProposal           = types.ErrInactiveProposal
 	ErrAlreadyActiveProposal      = types.ErrAlreadyActiveProposal
 	ErrInvalidProposalContent     = types.ErrInvalidProposalContent
 	ErrInvalidProposalType        = types.ErrInvalidProposalType
 	ErrInvalidVote                = types.ErrInvalidVote
+	ErrInvalidWeightedVote        = types.ErrInvalidWeightedVote
 	ErrInvalidGenesis             = types.ErrInvalidGenesis
 	ErrNoProposalHandlerExists    = types.ErrNoProposalHandlerExists
+	ErrInvalidProposer            = types.ErrInvalidProposer
+	ErrInvalidHaltHeight          = types.ErrInvalidHaltHeight
 	NewGenesisState               = types.NewGenesisState
 	DefaultGenesisState           = types.DefaultGenesisState
 	ValidateGenesis               = types.ValidateGenesis
@@ -88,18 +110,28 @@ var (
 	ActiveProposalQueueKey        = types.ActiveProposalQueueKey
 	InactiveProposalByTimeKey     = types.InactiveProposalByTimeKey
 	InactiveProposalQueueKey      = types.InactiveProposalQueueKey
+	PruneQueueByTimeKey           = types.PruneQueueByTimeKey
+	PruneQueueKey                 = types.PruneQueueKey
 	DepositsKey                   = types.DepositsKey
 	DepositKey                    = types.DepositKey
+	SettledDepositsKey            = types.SettledDepositsKey
+	SettledDepositKey             = types.SettledDepositKey
 	VotesKey                      = types.VotesKey
 	VoteKey                       = types.VoteKey
 	SplitProposalKey              = types.SplitProposalKey
 	SplitActiveProposalQueueKey   = types.SplitActiveProposalQueueKey
 	SplitInactiveProposalQueueKey = types.SplitInactiveProposalQueueKey
+	SplitPruneQueueKey            = types.SplitPruneQueueKey
 	SplitKeyDeposit               = types.SplitKeyDeposit
 	SplitKeyVote                  = types.SplitKeyVote
+	VoterVotesKey                 = types.VoterVotesKey
+	VoterVoteKey                  = types.VoterVoteKey
+	SplitKeyVoterVote             = types.SplitKeyVoterVote
 	NewMsgSubmitProposal          = types.NewMsgSubmitProposal
 	NewMsgDeposit                 = types.NewMsgDeposit
 	NewMsgVote                    = types.NewMsgVote
+	NewMsgVoteWeighted            = types.NewMsgVoteWeighted
+	NewMsgCancelProposal          = types.NewMsgCancelProposal
 	ParamKeyTable                 = types.ParamKeyTable
 	NewDepositParams              = types.NewDepositParams
 	NewTallyParams                = types.NewTallyParams
@@ -110,6 +142,8 @@ var (
 	ProposalStatusFromString      = types.ProposalStatusFromString
 	ValidProposalStatus           = types.ValidProposalStatus
 	NewTextProposal               = types.NewTextProposal
+	NewTextProposalWithMetadata   = types.NewTextProposalWithMetadata
+	NewHaltChainProposal          = types.NewHaltChainProposal
 	RegisterProposalType          = types.RegisterProposalType
 	ContentFromProposalType       = types.ContentFromProposalType
 	IsValidProposalType           = types.IsValidProposalType
@@ -118,11 +152,18 @@ var (
 	NewQueryDepositParams         = types.NewQueryDepositParams
 	NewQueryVoteParams            = types.NewQueryVoteParams
 	NewQueryProposalsParams       = types.NewQueryProposalsParams
+	NewQueryRecentProposalsParams = types.NewQueryRecentProposalsParams
 	NewValidatorGovInfo           = types.NewValidatorGovInfo
 	NewTallyResult                = types.NewTallyResult
 	NewTallyResultFromMap         = types.NewTallyResultFromMap
 	EmptyTallyResult              = types.EmptyTallyResult
 	NewVote                       = types.NewVote
+	NewWeightedVote               = types.NewWeightedVote
+	NewWeightedVoteOption         = types.NewWeightedVoteOption
+	NewNonSplitVoteOption         = types.NewNonSplitVoteOption
+	WeightedVoteOptionsFromString = types.WeightedVoteOptionsFromString
+	NewVoteRecord                 = types.NewVoteRecord
+	NewQueryVoterVotesParams      = types.NewQueryVoterVotesParams
 	VoteOptionFromString          = types.VoteOptionFromString
 	ValidVoteOption               = types.ValidVoteOption
 
@@ -132,39 +173,59 @@ var (
 	ActiveProposalQueuePrefix   = types.ActiveProposalQueuePrefix
 	InactiveProposalQueuePrefix = types.InactiveProposalQueuePrefix
 	ProposalIDKey               = types.ProposalIDKey
+	PruneQueuePrefix            = types.PruneQueuePrefix
+	HaltHeightKey               = types.HaltHeightKey
 	DepositsKeyPrefix           = types.DepositsKeyPrefix
+	SettledDepositsKeyPrefix    = types.SettledDepositsKeyPrefix
 	VotesKeyPrefix              = types.VotesKeyPrefix
+	VoterVotesKeyPrefix         = types.VoterVotesKeyPrefix
 	ParamStoreKeyDepositParams  = types.ParamStoreKeyDepositParams
 	ParamStoreKeyVotingParams   = types.ParamStoreKeyVotingParams
 	ParamStoreKeyTallyParams    = types.ParamStoreKeyTallyParams
 )
 
 type (
-	Keeper               = keeper.Keeper
-	Content              = types.Content
-	Handler              = types.Handler
-	Deposit              = types.Deposit
-	Deposits             = types.Deposits
-	GenesisState         = types.GenesisState
-	MsgSubmitProposal    = types.MsgSubmitProposal
-	MsgDeposit           = types.MsgDeposit
-	MsgVote              = types.MsgVote
-	DepositParams        = types.DepositParams
-	TallyParams          = types.TallyParams
-	VotingParams         = types.VotingParams
-	Params               = types.Params
-	Proposal             = types.Proposal
-	Proposals            = types.Proposals
-	ProposalQueue        = types.ProposalQueue
-	ProposalStatus       = types.ProposalStatus
-	TextProposal         = types.TextProposal
-	QueryProposalParams  = types.QueryProposalParams
-	QueryDepositParams   = types.QueryDepositParams
-	QueryVoteParams      = types.QueryVoteParams
-	QueryProposalsParams = types.QueryProposalsParams
-	ValidatorGovInfo     = types.ValidatorGovInfo
-	TallyResult          = types.TallyResult
-	Vote                 = types.Vote
-	Votes                = types.Votes
-	VoteOption           = types.VoteOption
+	Keeper                     = keeper.Keeper
+	ArchiveSink                = types.ArchiveSink
+	GovHooks                   = types.GovHooks
+	MultiGovHooks              = types.MultiGovHooks
+	DepositPolicy              = types.DepositPolicy
+	DefaultDepositPolicy       = types.DefaultDepositPolicy
+	Content                    = types.Content
+	ContentWithMetadata        = types.ContentWithMetadata
+	ProposalMetadata           = types.ProposalMetadata
+	Handler                    = types.Handler
+	Deposit                    = types.Deposit
+	Deposits                   = types.Deposits
+	GenesisState               = types.GenesisState
+	MsgSubmitProposal          = types.MsgSubmitProposal
+	MsgDeposit                 = types.MsgDeposit
+	MsgVote                    = types.MsgVote
+	MsgVoteWeighted            = types.MsgVoteWeighted
+	MsgCancelProposal          = types.MsgCancelProposal
+	DepositParams              = types.DepositParams
+	TallyParams                = types.TallyParams
+	VotingParams               = types.VotingParams
+	Params                     = types.Params
+	Proposal                   = types.Proposal
+	Proposals                  = types.Proposals
+	ProposalQueue              = types.ProposalQueue
+	ProposalStatus             = types.ProposalStatus
+	TextProposal               = types.TextProposal
+	HaltChainProposal          = types.HaltChainProposal
+	QueryProposalParams        = types.QueryProposalParams
+	QueryDepositParams         = types.QueryDepositParams
+	QueryVoteParams            = types.QueryVoteParams
+	QueryProposalsParams       = types.QueryProposalsParams
+	QueryRecentProposalsParams = types.QueryRecentProposalsParams
+	ValidatorGovInfo           = types.ValidatorGovInfo
+	TallyResult                = types.TallyResult
+	Vote                       = types.Vote
+	Votes                      = types.Votes
+	VoteRecord                 = types.VoteRecord
+	VoteRecords                = types.VoteRecords
+	QueryVoterVotesParams      = types.QueryVoterVotesParams
+	VoteOption                 = types.VoteOption
+	WeightedVoteOption         = types.WeightedVoteOption
+	WeightedVoteOptions        = types.WeightedVoteOptions
 )
@@ -19,6 +19,9 @@ func parseSubmitProposalFlags() (*proposal, error) {
 		proposal.Description = viper.GetString(FlagDescription)
 		proposal.Type = govutils.NormalizeProposalType(viper.GetString(flagProposalType))
 		proposal.Deposit = viper.GetString(FlagDeposit)
+		proposal.Summary = viper.GetString(flagSummary)
+		proposal.ForumLink = viper.GetString(flagForumLink)
+		proposal.IPFSHash = viper.GetString(flagIPFSHash)
 		return proposal, nil
 	}
 
@@ -16,7 +16,10 @@ func TestParseSubmitProposalFlags(t *testing.T) {
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "1000test"
+  "deposit": "1000test",
+  "summary": "A short summary",
+  "forum_link": "https://forum.cosmos.network/t/1",
+  "ipfs_hash": "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
 }
 `)
 
@@ -42,6 +45,9 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, "My awesome proposal", proposal1.Description)
 	require.Equal(t, "Text", proposal1.Type)
 	require.Equal(t, "1000test", proposal1.Deposit)
+	require.Equal(t, "A short summary", proposal1.Summary)
+	require.Equal(t, "https://forum.cosmos.network/t/1", proposal1.ForumLink)
+	require.Equal(t, "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", proposal1.IPFSHash)
 
 	// flags that can't be used with --proposal
 	for _, incompatibleFlag := range ProposalFlags {
@@ -57,12 +63,18 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	viper.Set(FlagDescription, proposal1.Description)
 	viper.Set(flagProposalType, proposal1.Type)
 	viper.Set(FlagDeposit, proposal1.Deposit)
+	viper.Set(flagSummary, proposal1.Summary)
+	viper.Set(flagForumLink, proposal1.ForumLink)
+	viper.Set(flagIPFSHash, proposal1.IPFSHash)
 	proposal2, err := parseSubmitProposalFlags()
 	require.Nil(t, err, "unexpected error")
 	require.Equal(t, proposal1.Title, proposal2.Title)
 	require.Equal(t, proposal1.Description, proposal2.Description)
 	require.Equal(t, proposal1.Type, proposal2.Type)
 	require.Equal(t, proposal1.Deposit, proposal2.Deposit)
+	require.Equal(t, proposal1.Summary, proposal2.Summary)
+	require.Equal(t, proposal1.ForumLink, proposal2.ForumLink)
+	require.Equal(t, proposal1.IPFSHash, proposal2.IPFSHash)
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
@@ -33,12 +33,15 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryProposals(queryRoute, cdc),
 		GetCmdQueryVote(queryRoute, cdc),
 		GetCmdQueryVotes(queryRoute, cdc),
+		GetCmdQueryVoterVotes(queryRoute, cdc),
 		GetCmdQueryParam(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
 		GetCmdQueryProposer(queryRoute, cdc),
 		GetCmdQueryDeposit(queryRoute, cdc),
 		GetCmdQueryDeposits(queryRoute, cdc),
-		GetCmdQueryTally(queryRoute, cdc))...)
+		GetCmdQueryTally(queryRoute, cdc),
+		GetCmdQueryMetadata(queryRoute, cdc),
+		GetCmdQueryHaltHeight(queryRoute, cdc))...)
 
 	return govQueryCmd
 }
@@ -297,6 +300,56 @@ $ %s query gov votes 1
 	}
 }
 
+// GetCmdQueryVoterVotes implements the query voter votes command.
+func GetCmdQueryVoterVotes(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voter-votes [voter-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the voting history of a voter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the proposals a voter voted on, along with the option and height of its
+latest vote on each of them, from the most recent proposal to the oldest. The
+history is kept once the votes of a proposal are pruned. To query the next
+page, pass the ID of the last proposal returned to --start-after.
+
+Example:
+$ %s query gov voter-votes cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --limit=10
+$ %s query gov voter-votes cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --limit=10 --start-after=42
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			voterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryVoterVotesParams(voterAddr, viper.GetUint64(flagStartAfter), viper.GetInt(flagNumLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVoterVotes), bz)
+			if err != nil {
+				return err
+			}
+
+			var records types.VoteRecords
+			cdc.MustUnmarshalJSON(res, &records)
+			return cliCtx.PrintOutput(records)
+		},
+	}
+
+	cmd.Flags().Uint64(flagStartAfter, 0, "ID of the last proposal of the previous page, the most recent proposal is returned first if unset")
+	cmd.Flags().Int(flagNumLimit, types.MaxVoterVotesLimit, "pagination limit of votes to query for")
+
+	return cmd
+}
+
 // Command to Get a specific Deposit Information
 // GetCmdQueryDeposit implements the query proposal deposit command.
 func GetCmdQueryDeposit(queryRoute string, cdc *codec.Codec) *cobra.Command {
@@ -469,6 +522,50 @@ $ %s query gov tally 1
 	}
 }
 
+// GetCmdQueryMetadata implements the command to query for the structured
+// metadata of a proposal.
+func GetCmdQueryMetadata(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "metadata [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the metadata of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the title, summary, forum link and IPFS hash of a
+proposal. You can find the proposal-id by running "%s query gov proposals".
+
+Example:
+$ %s query gov metadata 1
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			params := types.NewQueryProposalParams(proposalID)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryMetadata), bz)
+			if err != nil {
+				return err
+			}
+
+			var metadata types.ProposalMetadata
+			cdc.MustUnmarshalJSON(res, &metadata)
+			return cliCtx.PrintOutput(metadata)
+		},
+	}
+}
+
 // GetCmdQueryProposal implements the query proposal command.
 func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -594,3 +691,34 @@ $ %s query gov proposer 1
 }
 
 // DONTCOVER
+
+// GetCmdQueryHaltHeight implements the query halt height command.
+func GetCmdQueryHaltHeight(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "halt-height",
+		Args:  cobra.NoArgs,
+		Short: "Query the height of the chain halt scheduled by governance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the block height at which a passed halt chain proposal halts
+the chain. It returns zero if no halt is scheduled.
+
+Example:
+$ %s query gov halt-height
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryHaltHeight), nil)
+			if err != nil {
+				return err
+			}
+
+			var height int64
+			cdc.MustUnmarshalJSON(res, &height)
+			return cliCtx.PrintOutput(height)
+		},
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -29,7 +30,11 @@ const (
 	flagStatus       = "status"
 	flagNumLimit     = "limit"
 	flagPage         = "page"
+	flagStartAfter   = "start-after"
 	FlagProposal     = "proposal"
+	flagSummary      = "summary"
+	flagForumLink    = "forum-link"
+	flagIPFSHash     = "ipfs-hash"
 )
 
 type proposal struct {
@@ -37,6 +42,9 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Summary     string
+	ForumLink   string `json:"forum_link"`
+	IPFSHash    string `json:"ipfs_hash"`
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -47,6 +55,9 @@ var ProposalFlags = []string{
 	FlagDescription,
 	flagProposalType,
 	FlagDeposit,
+	flagSummary,
+	flagForumLink,
+	flagIPFSHash,
 }
 
 // GetTxCmd returns the transaction commands for this module
@@ -64,6 +75,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	}
 
 	cmdSubmitProp := GetCmdSubmitProposal(cdc)
+	cmdSubmitProp.AddCommand(client.PostCommands(GetCmdSubmitHaltChainProposal(cdc))[0])
 	for _, pcmd := range pcmds {
 		cmdSubmitProp.AddCommand(client.PostCommands(pcmd)[0])
 	}
@@ -71,6 +83,8 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	govTxCmd.AddCommand(client.PostCommands(
 		GetCmdDeposit(cdc),
 		GetCmdVote(cdc),
+		GetCmdWeightedVote(cdc),
+		GetCmdCancelProposal(cdc),
 		cmdSubmitProp,
 	)...)
 
@@ -101,6 +115,9 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+Text proposals can also be given a summary, a forum link and an IPFS hash with the
+"summary", "forum_link" and "ipfs_hash" JSON fields, or the matching flags.
 `,
 				version.ClientName, version.ClientName,
 			),
@@ -120,6 +137,11 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 			}
 
 			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
+			if proposal.Type == types.ProposalTypeText {
+				content = types.NewTextProposalWithMetadata(
+					proposal.Title, proposal.Description, proposal.Summary, proposal.ForumLink, proposal.IPFSHash,
+				)
+			}
 
 			msg := types.NewMsgSubmitProposal(content, amount, cliCtx.GetFromAddress())
 			if err := msg.ValidateBasic(); err != nil {
@@ -134,6 +156,9 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(FlagDescription, "", "description of proposal")
 	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
 	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(flagSummary, "", "summary of a text proposal (optional)")
+	cmd.Flags().String(flagForumLink, "", "forum link of a text proposal (optional)")
+	cmd.Flags().String(flagIPFSHash, "", "IPFS hash of a text proposal (optional)")
 	cmd.Flags().String(FlagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
 
 	return cmd
@@ -233,4 +258,141 @@ $ %s tx gov vote 1 yes --from mykey
 	}
 }
 
+// GetCmdWeightedVote implements creating a new weighted vote command.
+func GetCmdWeightedVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal splitting the voting power, options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal splitting the voting power
+across several options. The weights must be positive and add up to one. You can
+find the proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.7,abstain=0.3 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Find out which options and weights the user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // DONTCOVER
+
+// GetCmdCancelProposal implements the command to cancel a proposal.
+func GetCmdCancelProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal during its deposit or voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal still in its deposit or voting period. Only the
+proposer of the proposal may cancel it. A fraction of the deposits, set by the
+cancel burn rate governance parameter, is burned and the rest is refunded.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgCancelProposal(cliCtx.GetFromAddress(), proposalID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubmitHaltChainProposal implements submitting a halt chain proposal
+// transaction command.
+func GetCmdSubmitHaltChainProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halt-chain [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to halt the chain at a given height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to halt the chain at the given block height along with
+an initial deposit. Once the proposal passes, every node stops when the chain
+reaches that height, which must be after the height the proposal passes at.
+
+Example:
+$ %s tx gov submit-proposal halt-chain 1000000 --title="Emergency stop" --description="Stop the chain" --deposit="10test" --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("height %s not a valid int, please input a valid height", args[0])
+			}
+
+			amount, err := sdk.ParseCoins(viper.GetString(FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewHaltChainProposal(viper.GetString(FlagTitle), viper.GetString(FlagDescription), height)
+
+			msg := types.NewMsgSubmitProposal(content, amount, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagTitle, "", "title of proposal")
+	cmd.Flags().String(FlagDescription, "", "description of proposal")
+	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
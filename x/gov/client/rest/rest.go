@@ -19,6 +19,7 @@ const (
 	RestVoter          = "voter"
 	RestProposalStatus = "status"
 	RestNumLimit       = "limit"
+	RestStartAfter     = "start_after"
 )
 
 // ProposalRESTHandler defines a REST handler implemented in another module. The
@@ -40,6 +41,9 @@ type PostProposalReq struct {
 	Title          string         `json:"title" yaml:"title"`                     // Title of the proposal
 	Description    string         `json:"description" yaml:"description"`         // Description of the proposal
 	ProposalType   string         `json:"proposal_type" yaml:"proposal_type"`     // Type of proposal. Initial set {PlainTextProposal }
+	Summary        string         `json:"summary" yaml:"summary"`                 // Summary of a text proposal (optional)
+	ForumLink      string         `json:"forum_link" yaml:"forum_link"`           // Forum link of a text proposal (optional)
+	IPFSHash       string         `json:"ipfs_hash" yaml:"ipfs_hash"`             // IPFS hash of a text proposal (optional)
 	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               // Address of the proposer
 	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"` // Coins to add to the proposal's deposit
 }
@@ -57,3 +61,16 @@ type VoteReq struct {
 	Voter   sdk.AccAddress `json:"voter" yaml:"voter"`   // address of the voter
 	Option  string         `json:"option" yaml:"option"` // option from OptionSet chosen by the voter
 }
+
+// WeightedVoteReq defines the properties of a weighted vote request's body.
+type WeightedVoteReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Voter   sdk.AccAddress `json:"voter" yaml:"voter"`     // address of the voter
+	Options string         `json:"options" yaml:"options"` // options and their weights chosen by the voter, e.g. "yes=0.7,abstain=0.3"
+}
+
+// CancelProposalReq defines the properties of a cancel proposal request's body.
+type CancelProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"` // address of the proposer
+}
@@ -1,6 +1,10 @@
 package utils
 
-import "github.com/cosmos/cosmos-sdk/x/gov/types"
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,6 +26,21 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
+// NormalizeWeightedVoteOptions - normalize user specified weighted vote options,
+// e.g. "yes=0.7,abstain=0.3" or "yes:0.7,abstain:0.3"
+func NormalizeWeightedVoteOptions(options string) string {
+	pairs := strings.Split(options, ",")
+	for i, pair := range pairs {
+		fields := strings.FieldsFunc(pair, func(r rune) bool { return r == '=' || r == ':' })
+		if len(fields) != 2 {
+			continue
+		}
+		fields[0] = NormalizeVoteOption(strings.TrimSpace(fields[0]))
+		pairs[i] = strings.Join(fields, ":")
+	}
+	return strings.Join(pairs, ",")
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
@@ -10,6 +10,11 @@ import (
 // InitGenesis - store genesis parameters
 func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, data GenesisState) {
 
+	// a genesis predating the cancel burn rate uses the default one
+	if data.DepositParams.CancelBurnRate.IsNil() {
+		data.DepositParams.CancelBurnRate = types.DefaultCancelBurnRate
+	}
+
 	k.SetProposalID(ctx, data.StartingProposalID)
 	k.SetDepositParams(ctx, data.DepositParams)
 	k.SetVotingParams(ctx, data.VotingParams)
@@ -27,8 +32,29 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, dat
 		totalDeposits = totalDeposits.Add(deposit.Amount)
 	}
 
+	// proposals still holding votes or settled deposits are the ones whose
+	// votes and deposits have not been pruned yet; settled deposits are no
+	// longer held by the module account
+	votedProposals := make(map[uint64]bool)
 	for _, vote := range data.Votes {
 		k.SetVote(ctx, vote)
+		votedProposals[vote.ProposalID] = true
+	}
+
+	for _, deposit := range data.SettledDeposits {
+		k.SetSettledDeposit(ctx, deposit)
+		votedProposals[deposit.ProposalID] = true
+	}
+
+	for _, record := range data.VoteRecords {
+		k.SetVoteRecord(ctx, record)
+	}
+
+	// record the votes of a genesis predating the voting history
+	for _, vote := range data.Votes {
+		if _, found := k.GetVoteRecord(ctx, vote.Voter, vote.ProposalID); !found {
+			k.SetVoteRecord(ctx, types.NewVoteRecord(vote, ctx.BlockHeight()))
+		}
 	}
 
 	for _, proposal := range data.Proposals {
@@ -37,6 +63,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, dat
 			k.InsertInactiveProposalQueue(ctx, proposal.ProposalID, proposal.DepositEndTime)
 		case StatusVotingPeriod:
 			k.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+		case StatusPassed, StatusRejected, StatusFailed:
+			if data.VotingParams.RetentionPeriod > 0 && votedProposals[proposal.ProposalID] {
+				k.InsertPruneQueue(ctx, proposal.ProposalID, proposal.VotingEndTime.Add(data.VotingParams.RetentionPeriod))
+			}
 		}
 		k.SetProposal(ctx, proposal)
 	}
@@ -76,5 +106,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
 		TallyParams:        tallyParams,
+		VoteRecords:        k.GetAllVoteRecords(ctx),
+		SettledDeposits:    k.GetAllSettledDeposits(ctx),
 	}
 }
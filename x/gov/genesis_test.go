@@ -3,7 +3,9 @@ package gov
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	keep "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -115,3 +117,59 @@ func TestEqualProposals(t *testing.T) {
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
+
+func TestImportExportVoteRecords(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
+	SortAddresses(input.addrs)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(5)
+
+	proposal, err := input.keeper.SubmitProposal(ctx, keep.TestProposal)
+	require.NoError(t, err)
+	err, votingStarted := input.keeper.AddDeposit(ctx, proposal.ProposalID, input.addrs[0], input.keeper.GetDepositParams(ctx).MinDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+	require.NoError(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[1], OptionYes))
+
+	genAccs := input.mApp.AccountKeeper.GetAllAccounts(ctx)
+	genState := ExportGenesis(ctx, input.keeper)
+	require.Equal(t, VoteRecords{NewVoteRecord(NewVote(proposal.ProposalID, input.addrs[1], OptionYes), 5)}, genState.VoteRecords)
+
+	// the vote records are imported as is
+	input2 := getMockApp(t, 2, genState, genAccs, ProposalHandler)
+	input2.mApp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: input2.mApp.LastBlockHeight() + 1}})
+	ctx2 := input2.mApp.BaseApp.NewContext(false, abci.Header{})
+	require.Equal(t, genState.VoteRecords, input2.keeper.GetAllVoteRecords(ctx2))
+
+	// the votes of a genesis without vote records are recorded at genesis
+	genState.VoteRecords = nil
+	input3 := getMockApp(t, 2, genState, genAccs, ProposalHandler)
+	input3.mApp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: input3.mApp.LastBlockHeight() + 1}})
+	ctx3 := input3.mApp.BaseApp.NewContext(false, abci.Header{})
+	require.Equal(t, VoteRecords{NewVoteRecord(NewVote(proposal.ProposalID, input.addrs[1], OptionYes), 0)}, input3.keeper.GetAllVoteRecords(ctx3))
+}
+
+func TestGenesisWithoutCancelBurnRate(t *testing.T) {
+	// a genesis predating the cancel burn rate has no cancel_burn_rate field
+	genState := DefaultGenesisState()
+	genState.DepositParams.CancelBurnRate = sdk.Dec{}
+	bz := ModuleCdc.MustMarshalJSON(genState)
+	require.NotContains(t, string(bz), "cancel_burn_rate")
+
+	require.NoError(t, AppModuleBasic{}.ValidateGenesis(bz))
+
+	var data GenesisState
+	ModuleCdc.MustUnmarshalJSON(bz, &data)
+	require.False(t, data.DepositParams.Equal(types.DefaultDepositParams()))
+
+	input := getMockApp(t, 1, data, nil, ProposalHandler)
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, header)
+
+	require.Equal(t, types.DefaultCancelBurnRate, input.keeper.GetDepositParams(ctx).CancelBurnRate)
+	require.True(t, input.keeper.GetDepositParams(ctx).Equal(types.DefaultDepositParams()))
+}
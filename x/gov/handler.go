@@ -22,6 +22,12 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 
+		case MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
+
+		case MsgCancelProposal:
+			return handleMsgCancelProposal(ctx, keeper, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -35,6 +41,10 @@ func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitPropos
 		return err.Result()
 	}
 
+	// record the proposer so that it may later cancel the proposal
+	proposal.Proposer = msg.Proposer
+	keeper.SetProposal(ctx, proposal)
+
 	err, votingStarted := keeper.AddDeposit(ctx, proposal.ProposalID, msg.Proposer, msg.InitialDeposit)
 	if err != nil {
 		return err.Result()
@@ -105,3 +115,74 @@ func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) sdk.Result {
 	return sdk.Result{Events: ctx.EventManager().Events()}
 
 }
+
+func handleMsgVoteWeighted(ctx sdk.Context, keeper Keeper, msg MsgVoteWeighted) sdk.Result {
+	err := keeper.AddWeightedVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgCancelProposal(ctx sdk.Context, keeper Keeper, msg MsgCancelProposal) sdk.Result {
+	err := keeper.CancelProposal(ctx, msg.ProposalID, msg.Proposer)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// NewProposalHandler creates a governance Handler for the proposals of the gov
+// module itself. Unlike ProposalHandler, it also handles HaltChainProposal,
+// which schedules a chain halt through the given keeper.
+//
+// The keeper is passed by reference since it is created with a router already
+// holding this handler.
+func NewProposalHandler(k *Keeper) Handler {
+	return func(ctx sdk.Context, content Content) sdk.Error {
+		switch c := content.(type) {
+		case TextProposal:
+			return nil
+
+		case HaltChainProposal:
+			return handleHaltChainProposal(ctx, *k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized gov proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleHaltChainProposal(ctx sdk.Context, k Keeper, p HaltChainProposal) sdk.Error {
+	if err := k.ScheduleHalt(ctx, p.Height); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHaltChain,
+			sdk.NewAttribute(types.AttributeKeyHaltHeight, fmt.Sprintf("%d", p.Height)),
+		),
+	)
+
+	return nil
+}
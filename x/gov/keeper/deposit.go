@@ -49,6 +49,10 @@ func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
 
 	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		if keeper.archiveSink != nil {
+			keeper.archiveSink.ArchiveDeposit(ctx, deposit)
+		}
+
 		err := keeper.supplyKeeper.BurnCoins(ctx, types.ModuleName, deposit.Amount)
 		if err != nil {
 			panic(err)
@@ -139,6 +143,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	)
 
 	keeper.SetDeposit(ctx, deposit)
+	keeper.AfterProposalDeposit(ctx, proposalID, depositorAddr)
 	return nil, activatedVotingPeriod
 }
 
@@ -147,6 +152,10 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
 
 	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		if keeper.archiveSink != nil {
+			keeper.archiveSink.ArchiveDeposit(ctx, deposit)
+		}
+
 		err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, deposit.Amount)
 		if err != nil {
 			panic(err)
@@ -156,3 +165,126 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 		return false
 	})
 }
+
+// PenalizeDeposits burns the given fraction of every deposit on a specific
+// proposal, refunds the remainder to the depositors and deletes the deposits.
+// It returns the total amounts burned and refunded.
+func (keeper Keeper) PenalizeDeposits(ctx sdk.Context, proposalID uint64, burnRate sdk.Dec) (burned, refunded sdk.Coins) {
+	return keeper.penalizeDeposits(ctx, proposalID, burnRate, false)
+}
+
+// penalizeDeposits burns the given fraction of every deposit on a specific
+// proposal and refunds the remainder to the depositors. The deposits are
+// deleted, or moved to the settled deposits until they are pruned if retain
+// is set.
+func (keeper Keeper) penalizeDeposits(ctx sdk.Context, proposalID uint64, burnRate sdk.Dec, retain bool) (burned, refunded sdk.Coins) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		if retain {
+			keeper.SetSettledDeposit(ctx, deposit)
+		} else if keeper.archiveSink != nil {
+			keeper.archiveSink.ArchiveDeposit(ctx, deposit)
+		}
+
+		var burn sdk.Coins
+		for _, coin := range deposit.Amount {
+			burn = burn.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, burnRate.MulInt(coin.Amount).TruncateInt())))
+		}
+		refund := deposit.Amount.Sub(burn)
+
+		if !burn.IsZero() {
+			err := keeper.supplyKeeper.BurnCoins(ctx, types.ModuleName, burn)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		if !refund.IsZero() {
+			err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, refund)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		burned = burned.Add(burn)
+		refunded = refunded.Add(refund)
+
+		store.Delete(types.DepositKey(proposalID, deposit.Depositor))
+		return false
+	})
+
+	return burned, refunded
+}
+
+// SettleDeposits burns the fraction of the deposits on a proposal leaving the
+// deposit or voting period decided by the deposit policy, refunds the
+// remainder to the depositors and deletes the deposits. burn tells the policy
+// whether the proposal failed to meet the minimum deposit or its tally calls
+// for burning the deposits. It returns the total amounts burned and refunded.
+//
+// The deposits of a tallied proposal are kept as settled deposits, alongside
+// its votes, for the retention period of the voting params.
+func (keeper Keeper) SettleDeposits(ctx sdk.Context, proposal types.Proposal, burn bool) (burned, refunded sdk.Coins) {
+	burnRate := keeper.depositPolicy.DepositBurnRate(ctx, proposal, burn)
+	if burnRate.IsNil() || burnRate.IsNegative() || burnRate.GT(sdk.OneDec()) {
+		panic(fmt.Sprintf("invalid deposit burn rate for proposal %d: %s", proposal.ProposalID, burnRate))
+	}
+
+	retain := proposal.Status == types.StatusVotingPeriod && keeper.GetVotingParams(ctx).RetentionPeriod > 0
+	return keeper.penalizeDeposits(ctx, proposal.ProposalID, burnRate, retain)
+}
+
+// SetSettledDeposit sets a settled Deposit to the gov store
+func (keeper Keeper) SetSettledDeposit(ctx sdk.Context, deposit types.Deposit) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(deposit)
+	store.Set(types.SettledDepositKey(deposit.ProposalID, deposit.Depositor), bz)
+}
+
+// GetSettledDeposits returns all the settled deposits from a proposal
+func (keeper Keeper) GetSettledDeposits(ctx sdk.Context, proposalID uint64) (deposits types.Deposits) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SettledDepositsKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+	return
+}
+
+// GetAllSettledDeposits returns all the settled deposits from the store
+func (keeper Keeper) GetAllSettledDeposits(ctx sdk.Context) (deposits types.Deposits) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SettledDepositsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+	return
+}
+
+// PruneDeposits deletes all the settled deposits on a specific proposal,
+// passing each of them to the archive sink, if any, beforehand. It returns the
+// number of deposits pruned.
+func (keeper Keeper) PruneDeposits(ctx sdk.Context, proposalID uint64) (pruned int) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	// collect the deposits first as deleting while iterating is not supported
+	deposits := keeper.GetSettledDeposits(ctx, proposalID)
+	for _, deposit := range deposits {
+		if keeper.archiveSink != nil {
+			keeper.archiveSink.ArchiveDeposit(ctx, deposit)
+		}
+
+		store.Delete(types.SettledDepositKey(proposalID, deposit.Depositor))
+	}
+
+	return len(deposits)
+}
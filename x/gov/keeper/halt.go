@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// GetHaltHeight returns the block height at which governance scheduled the
+// chain to halt, if any.
+func (keeper Keeper) GetHaltHeight(ctx sdk.Context) (height int64, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.HaltHeightKey)
+	if bz == nil {
+		return 0, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &height)
+	return height, true
+}
+
+// SetHaltHeight sets the block height at which the chain halts
+func (keeper Keeper) SetHaltHeight(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.HaltHeightKey, keeper.cdc.MustMarshalBinaryLengthPrefixed(height))
+}
+
+// DeleteHaltHeight clears the scheduled chain halt
+func (keeper Keeper) DeleteHaltHeight(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.HaltHeightKey)
+}
+
+// HaltChain clears the scheduled chain halt and calls the halt handler, if any,
+// which stops the node. As the halt height is cleared in the block that halts
+// the chain, the chain resumes from the next block once the nodes restart.
+func (keeper Keeper) HaltChain(ctx sdk.Context) {
+	height, _ := keeper.GetHaltHeight(ctx)
+	keeper.DeleteHaltHeight(ctx)
+	keeper.Logger(ctx).Info("chain halted by governance", "height", height)
+
+	if keeper.haltHandler != nil {
+		keeper.haltHandler()
+	}
+}
+
+// ScheduleHalt schedules the chain to halt at the given height, which must be
+// after the current block height. It replaces any previously scheduled halt.
+func (keeper Keeper) ScheduleHalt(ctx sdk.Context, height int64) sdk.Error {
+	if height <= ctx.BlockHeight() {
+		return types.ErrInvalidHaltHeight(keeper.codespace, height)
+	}
+
+	keeper.SetHaltHeight(ctx, height)
+	keeper.Logger(ctx).Info("chain halt scheduled", "height", height)
+	return nil
+}
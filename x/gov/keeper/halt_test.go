@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleHalt(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
+	ctx = ctx.WithBlockHeight(10)
+
+	_, found := keeper.GetHaltHeight(ctx)
+	require.False(t, found)
+
+	require.Error(t, keeper.ScheduleHalt(ctx, 5))
+	require.Error(t, keeper.ScheduleHalt(ctx, 10))
+	_, found = keeper.GetHaltHeight(ctx)
+	require.False(t, found)
+
+	require.NoError(t, keeper.ScheduleHalt(ctx, 20))
+	height, found := keeper.GetHaltHeight(ctx)
+	require.True(t, found)
+	require.Equal(t, int64(20), height)
+
+	// a later proposal replaces the scheduled halt
+	require.NoError(t, keeper.ScheduleHalt(ctx, 15))
+	height, _ = keeper.GetHaltHeight(ctx)
+	require.Equal(t, int64(15), height)
+}
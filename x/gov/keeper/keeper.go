@@ -34,6 +34,18 @@ type Keeper struct {
 
 	// Proposal router
 	router types.Router
+
+	// Optional sink receiving votes and deposits before they are deleted
+	archiveSink types.ArchiveSink
+
+	// Optional hooks called on the proposal lifecycle events
+	hooks types.GovHooks
+
+	// Policy deciding whether the deposits of the ended proposals are burned
+	depositPolicy types.DepositPolicy
+
+	// Optional function stopping the node at the halt height
+	haltHandler func()
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -59,13 +71,14 @@ func NewKeeper(
 	rtr.Seal()
 
 	return Keeper{
-		storeKey:     key,
-		paramSpace:   paramSpace,
-		supplyKeeper: supplyKeeper,
-		sk:           sk,
-		cdc:          cdc,
-		codespace:    codespace,
-		router:       rtr,
+		storeKey:      key,
+		paramSpace:    paramSpace,
+		supplyKeeper:  supplyKeeper,
+		sk:            sk,
+		cdc:           cdc,
+		codespace:     codespace,
+		router:        rtr,
+		depositPolicy: types.DefaultDepositPolicy{},
 	}
 }
 
@@ -74,6 +87,41 @@ func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// SetArchiveSink sets the sink receiving votes and deposits before they are
+// deleted from state.
+func (keeper *Keeper) SetArchiveSink(sink types.ArchiveSink) *Keeper {
+	if keeper.archiveSink != nil {
+		panic("cannot set governance archive sink twice")
+	}
+	keeper.archiveSink = sink
+	return keeper
+}
+
+// SetHooks sets the hooks called on the proposal lifecycle events.
+func (keeper *Keeper) SetHooks(gh types.GovHooks) *Keeper {
+	if keeper.hooks != nil {
+		panic("cannot set governance hooks twice")
+	}
+	keeper.hooks = gh
+	return keeper
+}
+
+// SetDepositPolicy replaces the DefaultDepositPolicy deciding whether the
+// deposits of the proposals leaving the deposit or voting period are burned
+// or refunded.
+func (keeper *Keeper) SetDepositPolicy(policy types.DepositPolicy) *Keeper {
+	keeper.depositPolicy = policy
+	return keeper
+}
+
+// SetHaltHandler sets the function called in the block at which governance
+// halts the chain, e.g. BaseApp.HaltAfterCommit to gracefully stop the node
+// once the block is committed.
+func (keeper *Keeper) SetHaltHandler(handler func()) *Keeper {
+	keeper.haltHandler = handler
+	return keeper
+}
+
 // Router returns the gov Keeper's Router
 func (keeper Keeper) Router() types.Router {
 	return keeper.router
@@ -112,6 +160,19 @@ func (keeper Keeper) RemoveFromInactiveProposalQueue(ctx sdk.Context, proposalID
 	store.Delete(types.InactiveProposalQueueKey(proposalID, endTime))
 }
 
+// InsertPruneQueue inserts a ProposalID into the vote prune queue at pruneTime
+func (keeper Keeper) InsertPruneQueue(ctx sdk.Context, proposalID uint64, pruneTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.PruneQueueKey(proposalID, pruneTime), bz)
+}
+
+// RemoveFromPruneQueue removes a proposalID from the vote prune queue
+func (keeper Keeper) RemoveFromPruneQueue(ctx sdk.Context, proposalID uint64, pruneTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.PruneQueueKey(proposalID, pruneTime))
+}
+
 // Iterators
 
 // IterateActiveProposalsQueue iterates over the proposals in the active proposal queue
@@ -152,6 +213,19 @@ func (keeper Keeper) IterateInactiveProposalsQueue(ctx sdk.Context, endTime time
 	}
 }
 
+// IteratePruneQueue iterates over the proposals in the vote prune queue whose
+// votes are due to be pruned by pruneTime and performs a callback function
+func (keeper Keeper) IteratePruneQueue(ctx sdk.Context, pruneTime time.Time, cb func(proposalID uint64, pruneTime time.Time) (stop bool)) {
+	iterator := keeper.PruneQueueIterator(ctx, pruneTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.SplitPruneQueueKey(iterator.Key())) {
+			break
+		}
+	}
+}
+
 // ActiveProposalQueueIterator returns an sdk.Iterator for all the proposals in the Active Queue that expire by endTime
 func (keeper Keeper) ActiveProposalQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(keeper.storeKey)
@@ -163,3 +237,9 @@ func (keeper Keeper) InactiveProposalQueueIterator(ctx sdk.Context, endTime time
 	store := ctx.KVStore(keeper.storeKey)
 	return store.Iterator(types.InactiveProposalQueuePrefix, sdk.PrefixEndBytes(types.InactiveProposalByTimeKey(endTime)))
 }
+
+// PruneQueueIterator returns an sdk.Iterator for all the proposals in the vote prune queue due by pruneTime
+func (keeper Keeper) PruneQueueIterator(ctx sdk.Context, pruneTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(types.PruneQueuePrefix, sdk.PrefixEndBytes(types.PruneQueueByTimeKey(pruneTime)))
+}
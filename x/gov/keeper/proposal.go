@@ -44,6 +44,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 		),
 	)
 
+	keeper.AfterProposalSubmission(ctx, proposalID)
 	return proposal, nil
 }
 
@@ -102,6 +103,27 @@ func (keeper Keeper) GetProposals(ctx sdk.Context) (proposals types.Proposals) {
 	return
 }
 
+// GetRecentProposals returns at most limit proposals from the most recent one
+// to the oldest, starting right after the proposal with the startAfter ID. A
+// zero startAfter starts from the most recent proposal.
+func (keeper Keeper) GetRecentProposals(ctx sdk.Context, startAfter uint64, limit int) types.Proposals {
+	var cursor []byte
+	if startAfter != 0 {
+		cursor = types.ProposalKey(startAfter)
+	}
+
+	proposals := types.Proposals{}
+	store := ctx.KVStore(keeper.storeKey)
+	sdk.KVStoreIterateDescending(store, types.ProposalsKeyPrefix, cursor, limit, func(_, value []byte) bool {
+		var proposal types.Proposal
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(value, &proposal)
+		proposals = append(proposals, proposal)
+		return false
+	})
+
+	return proposals
+}
+
 // GetProposalsFiltered retrieves proposals filtered by a given set of params which
 // include pagination parameters along with voter and depositor addresses and a
 // proposal status. The voter address will filter proposals by whether or not
@@ -175,3 +197,42 @@ func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal types.Propos
 	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.ProposalID, proposal.DepositEndTime)
 	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
 }
+
+// CancelProposal cancels a proposal still in its deposit or voting period on
+// behalf of its proposer. The cancel burn rate fraction of the deposits is
+// burned and the remainder refunded, while the votes cast so far are pruned
+// along with the proposal itself.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) sdk.Error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return types.ErrUnknownProposal(keeper.codespace, proposalID)
+	}
+
+	if proposal.Status != types.StatusDepositPeriod && proposal.Status != types.StatusVotingPeriod {
+		return types.ErrInactiveProposal(keeper.codespace, proposalID)
+	}
+
+	if proposal.Proposer.Empty() || !proposal.Proposer.Equals(proposer) {
+		return types.ErrInvalidProposer(keeper.codespace, proposalID, proposer)
+	}
+
+	burnRate := keeper.GetDepositParams(ctx).CancelBurnRate
+	if burnRate.IsNil() {
+		burnRate = sdk.ZeroDec()
+	}
+
+	burned, refunded := keeper.PenalizeDeposits(ctx, proposalID, burnRate)
+	keeper.PruneVotes(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyBurnedDeposits, burned.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundedDeposits, refunded.String()),
+		),
+	)
+
+	return nil
+}
@@ -174,3 +174,50 @@ func TestGetProposalsFiltered(t *testing.T) {
 		}
 	}
 }
+
+func TestCancelProposal(t *testing.T) {
+	ctx, ak, keeper, _, _ := createTestInput(t, false, 100)
+
+	proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposal.Proposer = TestAddrs[0]
+	keeper.SetProposal(ctx, proposal)
+	proposalID := proposal.ProposalID
+
+	fiveStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5)))
+	addr0Initial := ak.GetAccount(ctx, TestAddrs[0]).GetCoins()
+	addr1Initial := ak.GetAccount(ctx, TestAddrs[1]).GetCoins()
+
+	err, _ = keeper.AddDeposit(ctx, proposalID, TestAddrs[0], fiveStake)
+	require.NoError(t, err)
+	err, votingStarted := keeper.AddDeposit(ctx, proposalID, TestAddrs[1], fiveStake)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[1], types.OptionYes))
+
+	// only the proposer may cancel the proposal
+	err = keeper.CancelProposal(ctx, proposalID, TestAddrs[1])
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidProposer, err.Code())
+
+	err = keeper.CancelProposal(ctx, proposalID+1, TestAddrs[0])
+	require.Error(t, err)
+	require.Equal(t, types.CodeUnknownProposal, err.Code())
+
+	require.NoError(t, keeper.CancelProposal(ctx, proposalID, TestAddrs[0]))
+
+	_, ok := keeper.GetProposal(ctx, proposalID)
+	require.False(t, ok)
+	require.Empty(t, keeper.GetDeposits(ctx, proposalID))
+	require.Empty(t, keeper.GetVotes(ctx, proposalID))
+
+	activeIterator := keeper.ActiveProposalQueueIterator(ctx, proposal.DepositEndTime.Add(types.DefaultPeriod))
+	require.False(t, activeIterator.Valid())
+	activeIterator.Close()
+
+	// half of each deposit is burned with the default cancel burn rate
+	halfStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5).QuoRaw(2)))
+	require.Equal(t, addr0Initial.Sub(halfStake), ak.GetAccount(ctx, TestAddrs[0]).GetCoins())
+	require.Equal(t, addr1Initial.Sub(halfStake), ak.GetAccount(ctx, TestAddrs[1]).GetCoins())
+	require.True(t, keeper.GetGovernanceAccount(ctx).GetCoins().IsZero())
+}
@@ -23,6 +23,9 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		case types.QueryProposal:
 			return queryProposal(ctx, path[1:], req, keeper)
 
+		case types.QueryRecentProposals:
+			return queryRecentProposals(ctx, path[1:], req, keeper)
+
 		case types.QueryDeposits:
 			return queryDeposits(ctx, path[1:], req, keeper)
 
@@ -38,6 +41,15 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		case types.QueryTally:
 			return queryTally(ctx, path[1:], req, keeper)
 
+		case types.QueryHaltHeight:
+			return queryHaltHeight(ctx, keeper)
+
+		case types.QueryVoterVotes:
+			return queryVoterVotes(ctx, path[1:], req, keeper)
+
+		case types.QueryMetadata:
+			return queryMetadata(ctx, path[1:], req, keeper)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -89,6 +101,26 @@ func queryProposal(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 	return bz, nil
 }
 
+// nolint: unparam
+func queryMetadata(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	proposal, ok := keeper.GetProposal(ctx, params.ProposalID)
+	if !ok {
+		return nil, types.ErrUnknownProposal(types.DefaultCodespace, params.ProposalID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, types.GetContentMetadata(proposal.Content))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 // nolint: unparam
 func queryDeposit(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QueryDepositParams
@@ -129,7 +161,12 @@ func queryDeposits(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
 	}
 
+	// the deposits of a concluded proposal are kept as settled deposits until
+	// they are pruned
 	deposits := keeper.GetDeposits(ctx, params.ProposalID)
+	if deposits == nil {
+		deposits = keeper.GetSettledDeposits(ctx, params.ProposalID)
+	}
 	if deposits == nil {
 		deposits = types.Deposits{}
 	}
@@ -141,6 +178,19 @@ func queryDeposits(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 	return bz, nil
 }
 
+// queryHaltHeight returns the height of the chain halt scheduled by governance,
+// or zero if there is none.
+func queryHaltHeight(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	height, _ := keeper.GetHaltHeight(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, height)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 // nolint: unparam
 func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QueryProposalParams
@@ -216,3 +266,53 @@ func queryProposals(ctx sdk.Context, _ []string, req abci.RequestQuery, keeper K
 
 	return bz, nil
 }
+
+func queryRecentProposals(ctx sdk.Context, _ []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryRecentProposalsParams
+
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	limit := params.Limit
+	if limit <= 0 || limit > types.MaxRecentProposalsLimit {
+		limit = types.MaxRecentProposalsLimit
+	}
+
+	proposals := keeper.GetRecentProposals(ctx, params.StartAfter, limit)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, proposals)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryVoterVotes(ctx sdk.Context, _ []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryVoterVotesParams
+
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	if params.Voter.Empty() {
+		return nil, sdk.ErrInvalidAddress("voter address cannot be empty")
+	}
+
+	limit := params.Limit
+	if limit <= 0 || limit > types.MaxVoterVotesLimit {
+		limit = types.MaxVoterVotesLimit
+	}
+
+	records := keeper.GetVoterVoteRecords(ctx, params.Voter, params.StartAfter, limit)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -280,3 +280,155 @@ func TestQueries(t *testing.T) {
 	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, TestAddrs[0], TestAddrs[0], types.StatusNil, 1, 0)
 	require.Equal(t, proposal2.ProposalID, proposals[0].ProposalID)
 }
+
+func getQueriedRecentProposals(
+	t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, startAfter uint64, limit int,
+) []types.Proposal {
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryRecentProposals}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryRecentProposalsParams(startAfter, limit)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryRecentProposals}, query)
+	require.NoError(t, err)
+	require.NotNil(t, bz)
+
+	var proposals types.Proposals
+	require.NoError(t, cdc.UnmarshalJSON(bz, &proposals))
+
+	return proposals
+}
+
+func TestQueryRecentProposals(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 1000)
+	querier := NewQuerier(keeper)
+
+	require.Empty(t, getQueriedRecentProposals(t, ctx, keeper.cdc, querier, 0, 2))
+
+	for i := 0; i < 5; i++ {
+		_, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+	}
+
+	proposalIDs := func(proposals []types.Proposal) (ids []uint64) {
+		for _, proposal := range proposals {
+			ids = append(ids, proposal.ProposalID)
+		}
+		return ids
+	}
+
+	proposals := getQueriedRecentProposals(t, ctx, keeper.cdc, querier, 0, 2)
+	require.Equal(t, []uint64{5, 4}, proposalIDs(proposals))
+
+	proposals = getQueriedRecentProposals(t, ctx, keeper.cdc, querier, 4, 2)
+	require.Equal(t, []uint64{3, 2}, proposalIDs(proposals))
+
+	// a non positive limit falls back to the maximum limit
+	proposals = getQueriedRecentProposals(t, ctx, keeper.cdc, querier, 2, 0)
+	require.Equal(t, []uint64{1}, proposalIDs(proposals))
+
+	require.Empty(t, getQueriedRecentProposals(t, ctx, keeper.cdc, querier, 1, 2))
+}
+
+func getQueriedVoterVotes(
+	t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, voter sdk.AccAddress, startAfter uint64, limit int,
+) types.VoteRecords {
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryVoterVotes}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryVoterVotesParams(voter, startAfter, limit)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryVoterVotes}, query)
+	require.NoError(t, err)
+	require.NotNil(t, bz)
+
+	var records types.VoteRecords
+	require.NoError(t, cdc.UnmarshalJSON(bz, &records))
+
+	return records
+}
+
+func TestQueryVoterVotes(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 1000)
+	querier := NewQuerier(keeper)
+
+	require.Empty(t, getQueriedVoterVotes(t, ctx, keeper.cdc, querier, TestAddrs[0], 0, 2))
+
+	for i := 0; i < 4; i++ {
+		proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		proposal.Status = types.StatusVotingPeriod
+		keeper.SetProposal(ctx, proposal)
+	}
+
+	// TestAddrs[0] votes on proposals 1, 2 and 4 and changes its vote on 2
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, keeper.AddVote(ctx, 1, TestAddrs[0], types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, 2, TestAddrs[0], types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, 3, TestAddrs[1], types.OptionYes))
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, keeper.AddVote(ctx, 2, TestAddrs[0], types.OptionAbstain))
+	require.NoError(t, keeper.AddVote(ctx, 4, TestAddrs[0], types.OptionNoWithVeto))
+
+	// the history outlives the pruning of the votes
+	require.Equal(t, 1, keeper.PruneVotes(ctx, 1))
+
+	records := getQueriedVoterVotes(t, ctx, keeper.cdc, querier, TestAddrs[0], 0, 2)
+	require.Equal(t, types.VoteRecords{
+		{ProposalID: 4, Voter: TestAddrs[0], Option: types.OptionNoWithVeto, Height: 20},
+		{ProposalID: 2, Voter: TestAddrs[0], Option: types.OptionAbstain, Height: 20},
+	}, records)
+
+	// a non positive limit falls back to the maximum limit
+	records = getQueriedVoterVotes(t, ctx, keeper.cdc, querier, TestAddrs[0], 2, 0)
+	require.Equal(t, types.VoteRecords{
+		{ProposalID: 1, Voter: TestAddrs[0], Option: types.OptionYes, Height: 10},
+	}, records)
+
+	require.Empty(t, getQueriedVoterVotes(t, ctx, keeper.cdc, querier, TestAddrs[0], 1, 2))
+	require.Len(t, getQueriedVoterVotes(t, ctx, keeper.cdc, querier, TestAddrs[1], 0, 0), 1)
+}
+
+func TestQueryMetadata(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 1000)
+	querier := NewQuerier(keeper)
+
+	getQueriedMetadata := func(proposalID uint64) (types.ProposalMetadata, sdk.Error) {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryMetadata}, "/"),
+			Data: keeper.cdc.MustMarshalJSON(types.NewQueryProposalParams(proposalID)),
+		}
+
+		var metadata types.ProposalMetadata
+		bz, err := querier(ctx, []string{types.QueryMetadata}, query)
+		if err == nil {
+			require.NoError(t, keeper.cdc.UnmarshalJSON(bz, &metadata))
+		}
+		return metadata, err
+	}
+
+	tp := types.NewTextProposalWithMetadata(
+		"Test", "description", "summary", "https://forum.cosmos.network/t/1", "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+	)
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+
+	metadata, err := getQueriedMetadata(proposal.ProposalID)
+	require.NoError(t, err)
+	require.Equal(t, types.NewProposalMetadata(
+		"Test", "summary", "https://forum.cosmos.network/t/1", "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+	), metadata)
+
+	// a proposal without metadata only has a title
+	proposal, err = keeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+
+	metadata, err = getQueriedMetadata(proposal.ProposalID)
+	require.NoError(t, err)
+	require.Equal(t, types.ProposalMetadata{Title: TestProposal.GetTitle()}, metadata)
+
+	_, err = getQueriedMetadata(proposal.ProposalID + 1)
+	require.Error(t, err)
+}
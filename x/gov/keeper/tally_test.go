@@ -46,9 +46,10 @@ func TestTallyNoQuorum(t *testing.T) {
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, _ := keeper.Tally(ctx, proposal)
+	passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 	require.False(t, passes)
 	require.True(t, burnDeposits)
+	require.False(t, keeper.QuorumReached(ctx, tallyResults))
 }
 
 func TestTallyOnlyValidatorsAllYes(t *testing.T) {
@@ -73,6 +74,7 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 	require.True(t, passes)
 	require.False(t, burnDeposits)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
+	require.True(t, keeper.QuorumReached(ctx, tallyResults))
 }
 
 func TestTallyOnlyValidators51No(t *testing.T) {
@@ -434,3 +436,50 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyWeightedVotes(t *testing.T) {
+	ctx, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{10, 10, 10})
+
+	delTokens := sdk.TokensFromConsensusPower(10)
+	val1, found := sk.GetValidator(ctx, valOpAddr1)
+	require.True(t, found)
+
+	_, err := sk.Delegate(ctx, TestAddrs[0], delTokens, sdk.Unbonded, val1, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, sk)
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	// the validator splits its own power, the delegator overrides its share
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, valAccAddr1, types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(5, 1)),
+	}))
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(7, 1)),
+		types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(3, 1)),
+	}))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionNo))
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+
+	expectedYes := sdk.TokensFromConsensusPower(12)
+	expectedAbstain := sdk.TokensFromConsensusPower(3)
+	expectedNo := sdk.TokensFromConsensusPower(15)
+	expectedNoWithVeto := sdk.TokensFromConsensusPower(0)
+	expectedTallyResult := types.NewTallyResult(expectedYes, expectedAbstain, expectedNo, expectedNoWithVeto)
+
+	require.True(t, tallyResults.Equals(expectedTallyResult))
+}
@@ -9,6 +9,35 @@ import (
 
 // AddVote adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption) sdk.Error {
+	if err := keeper.checkVotingPeriod(ctx, proposalID); err != nil {
+		return err
+	}
+
+	if !types.ValidVoteOption(option) {
+		return types.ErrInvalidVote(keeper.codespace, option.String())
+	}
+
+	keeper.addVote(ctx, types.NewVote(proposalID, voterAddr, option))
+	return nil
+}
+
+// AddWeightedVote adds a vote on a specific proposal splitting the voting
+// power of the voter across the given options
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions) sdk.Error {
+	if err := keeper.checkVotingPeriod(ctx, proposalID); err != nil {
+		return err
+	}
+
+	if err := options.Validate(); err != nil {
+		return types.ErrInvalidWeightedVote(keeper.codespace, options, err.Error())
+	}
+
+	keeper.addVote(ctx, types.NewWeightedVote(proposalID, voterAddr, options))
+	return nil
+}
+
+// checkVotingPeriod returns an error unless the proposal is in its voting period
+func (keeper Keeper) checkVotingPeriod(ctx sdk.Context, proposalID uint64) sdk.Error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return types.ErrUnknownProposal(keeper.codespace, proposalID)
@@ -16,23 +45,23 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 	if proposal.Status != types.StatusVotingPeriod {
 		return types.ErrInactiveProposal(keeper.codespace, proposalID)
 	}
+	return nil
+}
 
-	if !types.ValidVoteOption(option) {
-		return types.ErrInvalidVote(keeper.codespace, option.String())
-	}
-
-	vote := types.NewVote(proposalID, voterAddr, option)
+func (keeper Keeper) addVote(ctx sdk.Context, vote types.Vote) {
+	proposalID, voterAddr := vote.ProposalID, vote.Voter
 	keeper.SetVote(ctx, vote)
+	keeper.SetVoteRecord(ctx, types.NewVoteRecord(vote, ctx.BlockHeight()))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, vote.OptionString()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
 
-	return nil
+	keeper.AfterProposalVote(ctx, proposalID, voterAddr)
 }
 
 // GetAllVotes returns all the votes from the store
@@ -104,6 +133,87 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	}
 }
 
+// GetVoteRecord gets the record of the latest vote of a voter on a specific proposal
+func (keeper Keeper) GetVoteRecord(ctx sdk.Context, voterAddr sdk.AccAddress, proposalID uint64) (record types.VoteRecord, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VoterVoteKey(voterAddr, proposalID))
+	if bz == nil {
+		return record, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &record)
+	return record, true
+}
+
+// SetVoteRecord sets a VoteRecord to the gov store
+func (keeper Keeper) SetVoteRecord(ctx sdk.Context, record types.VoteRecord) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(types.VoterVoteKey(record.Voter, record.ProposalID), bz)
+}
+
+// GetVoterVoteRecords returns at most limit vote records of a voter, from the
+// most recent proposal to the oldest, starting right after the proposal with
+// the startAfter ID. A zero startAfter starts from the most recent proposal.
+func (keeper Keeper) GetVoterVoteRecords(ctx sdk.Context, voterAddr sdk.AccAddress, startAfter uint64, limit int) types.VoteRecords {
+	var cursor []byte
+	if startAfter != 0 {
+		cursor = types.VoterVoteKey(voterAddr, startAfter)
+	}
+
+	records := types.VoteRecords{}
+	store := ctx.KVStore(keeper.storeKey)
+	sdk.KVStoreIterateDescending(store, types.VoterVotesKey(voterAddr), cursor, limit, func(_, value []byte) bool {
+		var record types.VoteRecord
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(value, &record)
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+// IterateAllVoteRecords iterates over the all the stored vote records and performs a callback function
+func (keeper Keeper) IterateAllVoteRecords(ctx sdk.Context, cb func(record types.VoteRecord) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoterVotesKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.VoteRecord
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllVoteRecords returns all the vote records from the store
+func (keeper Keeper) GetAllVoteRecords(ctx sdk.Context) (records types.VoteRecords) {
+	keeper.IterateAllVoteRecords(ctx, func(record types.VoteRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return
+}
+
+// PruneVotes deletes all the votes on a specific proposal, passing each of them
+// to the archive sink, if any, beforehand. It returns the number of votes pruned.
+func (keeper Keeper) PruneVotes(ctx sdk.Context, proposalID uint64) (pruned int) {
+	// collect the votes first as deleting while iterating is not supported
+	votes := keeper.GetVotes(ctx, proposalID)
+	for _, vote := range votes {
+		if keeper.archiveSink != nil {
+			keeper.archiveSink.ArchiveVote(ctx, vote)
+		}
+
+		keeper.deleteVote(ctx, proposalID, vote.Voter)
+	}
+
+	return len(votes)
+}
+
 // deleteVote deletes a vote from a given proposalID and voter from the store
 func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -62,3 +63,40 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, proposalID, votes[1].ProposalID)
 	require.Equal(t, types.OptionNoWithVeto, votes[1].Option)
 }
+
+func TestWeightedVotes(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+
+	require.Error(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], options), "proposal not on voting period")
+
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	invalidOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+	}
+	require.Error(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], invalidOptions), "weights not adding up to one")
+
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], options))
+	vote, found := keeper.GetVote(ctx, proposalID, TestAddrs[0])
+	require.True(t, found)
+	require.Equal(t, types.OptionEmpty, vote.Option)
+	require.True(t, options.Equal(vote.WeightedOptions()))
+
+	// a regular vote replaces the weighted one
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.OptionAbstain))
+	vote, found = keeper.GetVote(ctx, proposalID, TestAddrs[0])
+	require.True(t, found)
+	require.Equal(t, types.OptionAbstain, vote.Option)
+	require.Empty(t, vote.Options)
+}
@@ -0,0 +1,66 @@
+package gov_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func TestParamChangeProposalValidation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	hdlr := params.NewParamChangeProposalHandler(app.ParamsKeeper)
+	cdc := app.Codec()
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+
+	paramChange := func(key []byte, value interface{}) params.ParamChange {
+		return params.NewParamChange(gov.DefaultParamspace, string(key), string(cdc.MustMarshalJSON(value)))
+	}
+
+	badMinDeposit := depositParams
+	badMinDeposit.MinDeposit = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(-1)}}
+	badDepositPeriod := depositParams
+	badDepositPeriod.MaxDepositPeriod = -1
+	badCancelBurnRate := depositParams
+	badCancelBurnRate.CancelBurnRate = sdk.NewDecWithPrec(15, 1)
+	badVotingPeriod := votingParams
+	badVotingPeriod.VotingPeriod = -1
+	badQuorum := tallyParams
+	badQuorum.Quorum = sdk.NewDec(2)
+	badThreshold := tallyParams
+	badThreshold.Threshold = sdk.NewDecWithPrec(-5, 1)
+	badVeto := tallyParams
+	badVeto.Veto = sdk.NewDecWithPrec(11, 1)
+
+	invalid := []params.ParamChange{
+		paramChange(gov.ParamStoreKeyDepositParams, badMinDeposit),
+		paramChange(gov.ParamStoreKeyDepositParams, badDepositPeriod),
+		paramChange(gov.ParamStoreKeyDepositParams, badCancelBurnRate),
+		paramChange(gov.ParamStoreKeyVotingParams, badVotingPeriod),
+		paramChange(gov.ParamStoreKeyTallyParams, badQuorum),
+		paramChange(gov.ParamStoreKeyTallyParams, badThreshold),
+		paramChange(gov.ParamStoreKeyTallyParams, badVeto),
+	}
+	for _, pc := range invalid {
+		require.Error(t, hdlr(ctx, params.NewParameterChangeProposal("Test", "description", []params.ParamChange{pc})), pc.Value)
+	}
+
+	require.Equal(t, depositParams, app.GovKeeper.GetDepositParams(ctx))
+	require.Equal(t, votingParams, app.GovKeeper.GetVotingParams(ctx))
+	require.Equal(t, tallyParams, app.GovKeeper.GetTallyParams(ctx))
+
+	newTallyParams := tallyParams
+	newTallyParams.Quorum = sdk.NewDecWithPrec(4, 1)
+	pc := paramChange(gov.ParamStoreKeyTallyParams, newTallyParams)
+	require.NoError(t, hdlr(ctx, params.NewParameterChangeProposal("Test", "description", []params.ParamChange{pc})))
+	require.Equal(t, newTallyParams, app.GovKeeper.GetTallyParams(ctx))
+}
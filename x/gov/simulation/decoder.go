@@ -22,12 +22,20 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
 
 	case bytes.Equal(kvA.Key[:1], types.ActiveProposalQueuePrefix),
 		bytes.Equal(kvA.Key[:1], types.InactiveProposalQueuePrefix),
+		bytes.Equal(kvA.Key[:1], types.PruneQueuePrefix),
 		bytes.Equal(kvA.Key[:1], types.ProposalIDKey):
 		proposalIDA := binary.LittleEndian.Uint64(kvA.Value)
 		proposalIDB := binary.LittleEndian.Uint64(kvB.Value)
 		return fmt.Sprintf("proposalIDA: %d\nProposalIDB: %d", proposalIDA, proposalIDB)
 
-	case bytes.Equal(kvA.Key[:1], types.DepositsKeyPrefix):
+	case bytes.Equal(kvA.Key[:1], types.HaltHeightKey):
+		var heightA, heightB int64
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &heightA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &heightB)
+		return fmt.Sprintf("HaltHeightA: %d\nHaltHeightB: %d", heightA, heightB)
+
+	case bytes.Equal(kvA.Key[:1], types.DepositsKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.SettledDepositsKeyPrefix):
 		var depositA, depositB types.Deposit
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &depositA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &depositB)
@@ -39,6 +47,12 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &voteB)
 		return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+	case bytes.Equal(kvA.Key[:1], types.VoterVotesKeyPrefix):
+		var recordA, recordB types.VoteRecord
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &recordA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &recordB)
+		return fmt.Sprintf("%v\n%v", recordA, recordB)
+
 	default:
 		panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
 	}
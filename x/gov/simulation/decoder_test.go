@@ -40,12 +40,15 @@ func TestDecodeStore(t *testing.T) {
 	binary.LittleEndian.PutUint64(proposalIDBz, 1)
 	deposit := types.NewDeposit(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
 	vote := types.NewVote(1, delAddr1, types.OptionYes)
+	record := types.NewVoteRecord(vote, 10)
 
 	kvPairs := cmn.KVPairs{
 		cmn.KVPair{Key: types.ProposalKey(1), Value: cdc.MustMarshalBinaryLengthPrefixed(proposal)},
 		cmn.KVPair{Key: types.InactiveProposalQueueKey(1, endTime), Value: proposalIDBz},
 		cmn.KVPair{Key: types.DepositKey(1, delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(deposit)},
 		cmn.KVPair{Key: types.VoteKey(1, delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(vote)},
+		cmn.KVPair{Key: types.VoterVoteKey(delAddr1, 1), Value: cdc.MustMarshalBinaryLengthPrefixed(record)},
+		cmn.KVPair{Key: types.HaltHeightKey, Value: cdc.MustMarshalBinaryLengthPrefixed(int64(100))},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -57,6 +60,8 @@ func TestDecodeStore(t *testing.T) {
 		{"proposal IDs", "proposalIDA: 1\nProposalIDB: 1"},
 		{"deposits", fmt.Sprintf("%v\n%v", deposit, deposit)},
 		{"votes", fmt.Sprintf("%v\n%v", vote, vote)},
+		{"vote records", fmt.Sprintf("%v\n%v", record, record)},
+		{"halt height", "HaltHeightA: 100\nHaltHeightB: 100"},
 		{"other", ""},
 	}
 
@@ -19,10 +19,13 @@ import (
 const (
 	DepositParamsMinDeposit    = "deposit_params_min_deposit"
 	DepositParamsDepositPeriod = "deposit_params_deposit_period"
+	DepositParamsCancelBurn    = "deposit_params_cancel_burn_rate"
 	VotingParamsVotingPeriod   = "voting_params_voting_period"
+	VotingParamsRetention      = "voting_params_retention_period"
 	TallyParamsQuorum          = "tally_params_quorum"
 	TallyParamsThreshold       = "tally_params_threshold"
 	TallyParamsVeto            = "tally_params_veto"
+	TallyParamsExecutionGas    = "tally_params_execution_gas_limit"
 )
 
 // GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
@@ -31,8 +34,13 @@ func GenDepositParamsDepositPeriod(r *rand.Rand) time.Duration {
 }
 
 // GenDepositParamsMinDeposit randomized DepositParamsMinDeposit
-func GenDepositParamsMinDeposit(r *rand.Rand) sdk.Coins {
-	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
+func GenDepositParamsMinDeposit(r *rand.Rand, bondDenom string) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
+}
+
+// GenDepositParamsCancelBurnRate randomized DepositParamsCancelBurnRate
+func GenDepositParamsCancelBurnRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 100)), 2)
 }
 
 // GenVotingParamsVotingPeriod randomized VotingParamsVotingPeriod
@@ -40,6 +48,11 @@ func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
 }
 
+// GenVotingParamsRetentionPeriod randomized VotingParamsRetentionPeriod
+func GenVotingParamsRetentionPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 2*60*60*24*2)) * time.Second
+}
+
 // GenTallyParamsQuorum randomized TallyParamsQuorum
 func GenTallyParamsQuorum(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 334, 500)), 3)
@@ -55,6 +68,11 @@ func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
 }
 
+// GenTallyParamsExecutionGasLimit randomized TallyParamsExecutionGasLimit
+func GenTallyParamsExecutionGasLimit(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1e4, 1e7))
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -62,7 +80,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var minDeposit sdk.Coins
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, DepositParamsMinDeposit, &minDeposit, simState.Rand,
-		func(r *rand.Rand) { minDeposit = GenDepositParamsMinDeposit(r) },
+		func(r *rand.Rand) { minDeposit = GenDepositParamsMinDeposit(r, simState.BondDenom) },
 	)
 
 	var depositPeriod time.Duration
@@ -71,12 +89,24 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { depositPeriod = GenDepositParamsDepositPeriod(r) },
 	)
 
+	var cancelBurnRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsCancelBurn, &cancelBurnRate, simState.Rand,
+		func(r *rand.Rand) { cancelBurnRate = GenDepositParamsCancelBurnRate(r) },
+	)
+
 	var votingPeriod time.Duration
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, VotingParamsVotingPeriod, &votingPeriod, simState.Rand,
 		func(r *rand.Rand) { votingPeriod = GenVotingParamsVotingPeriod(r) },
 	)
 
+	var retentionPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsRetention, &retentionPeriod, simState.Rand,
+		func(r *rand.Rand) { retentionPeriod = GenVotingParamsRetentionPeriod(r) },
+	)
+
 	var quorum sdk.Dec
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, TallyParamsQuorum, &quorum, simState.Rand,
@@ -95,11 +125,17 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var executionGasLimit uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsExecutionGas, &executionGasLimit, simState.Rand,
+		func(r *rand.Rand) { executionGasLimit = GenTallyParamsExecutionGasLimit(r) },
+	)
+
 	govGenesis := types.NewGenesisState(
 		startingProposalID,
-		types.NewDepositParams(minDeposit, depositPeriod),
-		types.NewVotingParams(votingPeriod),
-		types.NewTallyParams(quorum, threshold, veto),
+		types.NewDepositParams(minDeposit, depositPeriod, cancelBurnRate),
+		types.NewVotingParams(votingPeriod, retentionPeriod),
+		types.NewTallyParams(quorum, threshold, veto, executionGasLimit),
 	)
 
 	fmt.Printf("Selected randomly generated governance parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, govGenesis))
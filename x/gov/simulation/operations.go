@@ -2,11 +2,14 @@ package simulation
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
@@ -16,16 +19,79 @@ import (
 
 var initialProposalID = uint64(100000000000000)
 
-// ContentSimulator defines a function type alias for generating random proposal
-// content.
-type ContentSimulator func(r *rand.Rand, ctx sdk.Context, accs []simulation.Account) types.Content
+// maxQueuedDeposits is the maximum number of deposits scheduled on a proposal
+// submitted without reaching the minimum deposit
+const maxQueuedDeposits = 5
 
-// SimulateSubmitProposal simulates creating a msg Submit Proposal
-// voting on the proposal, and subsequently slashing the proposal. It is implemented using
-// future operations.
+// opProposalTally is the name of the operation verifying the tally of a
+// proposal
+const opProposalTally = "tally"
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgDeposit         = "op_weight_msg_deposit"
+	OpWeightMsgVote            = "op_weight_msg_vote"
+	OpWeightMsgVoteWeighted    = "op_weight_msg_weighted_vote"
+	OpWeightSubmitTextProposal = "op_weight_submit_text_proposal"
+
+	DefaultWeightTextProposal = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, ak types.AccountKeeper,
+	k keeper.Keeper, wContents []simulation.WeightedProposalContent) simulation.WeightedOperations {
+
+	var weightMsgDeposit int
+	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeposit = 100
+		},
+	)
+
+	var weightMsgVote int
+	appParams.GetOrGenerate(cdc, OpWeightMsgVote, &weightMsgVote, nil,
+		func(_ *rand.Rand) {
+			weightMsgVote = 100
+		},
+	)
+
+	var weightMsgVoteWeighted int
+	appParams.GetOrGenerate(cdc, OpWeightMsgVoteWeighted, &weightMsgVoteWeighted, nil,
+		func(_ *rand.Rand) {
+			weightMsgVoteWeighted = 50
+		},
+	)
+
+	// generate the weighted operations for the proposal contents
+	var wProposalOps simulation.WeightedOperations
+
+	for _, wContent := range wContents {
+		wContent := wContent // pin variable
+		var weight int
+		appParams.GetOrGenerate(cdc, wContent.AppParamsKey, &weight, nil,
+			func(_ *rand.Rand) { weight = wContent.DefaultWeight })
+
+		wProposalOps = append(wProposalOps, simulation.WeightedOperation{
+			Weight: weight,
+			Op:     SimulateSubmitProposal(ak, k, wContent.ContentSimulatorFn),
+		})
+	}
+
+	wGovOps := simulation.WeightedOperations{
+		{Weight: weightMsgDeposit, Op: SimulateMsgDeposit(ak, k)},
+		{Weight: weightMsgVote, Op: SimulateMsgVote(ak, k)},
+		{Weight: weightMsgVoteWeighted, Op: SimulateMsgVoteWeighted(ak, k)},
+	}
+
+	return append(wProposalOps, wGovOps...)
+}
+
+// SimulateSubmitProposal simulates creating a msg Submit Proposal, depositing
+// on and voting on the proposal from random accounts, and subsequently
+// verifying its tally once it ends. It is implemented using future operations.
 // nolint: funlen
 func SimulateSubmitProposal(ak types.AccountKeeper, k keeper.Keeper,
-	contentSim ContentSimulator) simulation.Operation {
+	contentSim simulation.ContentSimulatorFn) simulation.Operation {
 	// The states are:
 	// column 1: All validators vote
 	// column 2: 90% vote
@@ -54,16 +120,16 @@ func SimulateSubmitProposal(ak types.AccountKeeper, k keeper.Keeper,
 		// 1) submit proposal now
 		content := contentSim(r, ctx, accs)
 		if content == nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProposal, "no proposal content"), nil, nil
 		}
 
 		simAccount, _ := simulation.RandomAcc(r, accs)
 		deposit, skip, err := randomDeposit(r, ctx, ak, k, simAccount.Address)
 		switch {
 		case skip:
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProposal, "no coins to deposit"), nil, nil
 		case err != nil:
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProposal, ""), nil, err
 		}
 
 		msg := types.NewMsgSubmitProposal(content, deposit, simAccount.Address)
@@ -76,7 +142,7 @@ func SimulateSubmitProposal(ak types.AccountKeeper, k keeper.Keeper,
 		if !hasNeg {
 			fees, err = simulation.RandomFees(r, ctx, coins)
 			if err != nil {
-				return simulation.NoOpMsg(types.ModuleName), nil, err
+				return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProposal, ""), nil, err
 			}
 		}
 
@@ -91,38 +157,73 @@ func SimulateSubmitProposal(ak types.AccountKeeper, k keeper.Keeper,
 
 		res := app.Deliver(tx)
 		if !res.IsOK() {
-			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProposal, ""), nil, errors.New(res.Log)
 		}
 
 		opMsg := simulation.NewOperationMsg(msg, true, "")
 
-		// get the submitted proposal ID
-		proposalID, err := k.GetProposalID(ctx)
+		// get the submitted proposal, the stored proposal ID being the next one
+		nextProposalID, err := k.GetProposalID(ctx)
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProposal, ""), nil, err
 		}
 
-		// 2) Schedule operations for votes
-		// 2.1) first pick a number of people to vote.
+		proposalID := nextProposalID - 1
+		proposal, ok := k.GetProposal(ctx, proposalID)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgSubmitProposal, ""), nil, fmt.Errorf("submitted proposal %d not found", proposalID)
+		}
+
+		// the proposal ends at the latest once its deposit period and a whole
+		// voting period elapse
+		endTime := proposal.VotingEndTime
+		if proposal.Status == types.StatusDepositPeriod {
+			endTime = proposal.DepositEndTime.Add(k.GetVotingParams(ctx).VotingPeriod)
+		}
+
+		randomTime := func(from, to time.Time) time.Time {
+			return from.Add(time.Duration(r.Int63n(int64(to.Sub(from)/time.Second)+1)) * time.Second)
+		}
+
+		var fops []simulation.FutureOperation
+
+		// 2) Schedule operations for deposits from random accounts across the
+		// deposit period, until the proposal reaches the minimum deposit
+		if proposal.Status == types.StatusDepositPeriod {
+			numDeposits := r.Intn(maxQueuedDeposits + 1)
+			for i := 0; i < numDeposits; i++ {
+				depositor, _ := simulation.RandomAcc(r, accs)
+				fops = append(fops, simulation.FutureOperation{
+					BlockTime: randomTime(ctx.BlockHeader().Time, proposal.DepositEndTime),
+					Op:        operationSimulateMsgDeposit(ak, k, depositor, int64(proposalID)),
+				})
+			}
+		}
+
+		// 3) Schedule operations for votes
+		// 3.1) first pick a number of people to vote.
 		curNumVotesState = numVotesTransitionMatrix.NextState(r, curNumVotesState)
 		numVotes := int(math.Ceil(float64(len(accs)) * statePercentageArray[curNumVotesState]))
 
-		// 2.2) select who votes and when
+		// 3.2) select who votes and when
 		whoVotes := r.Perm(len(accs))
 
 		// didntVote := whoVotes[numVotes:]
 		whoVotes = whoVotes[:numVotes]
-		votingPeriod := k.GetVotingParams(ctx).VotingPeriod
 
-		fops := make([]simulation.FutureOperation, numVotes+1)
 		for i := 0; i < numVotes; i++ {
-			whenVote := ctx.BlockHeader().Time.Add(time.Duration(r.Int63n(int64(votingPeriod.Seconds()))) * time.Second)
-			fops[i] = simulation.FutureOperation{
-				BlockTime: whenVote,
+			fops = append(fops, simulation.FutureOperation{
+				BlockTime: randomTime(ctx.BlockHeader().Time, endTime),
 				Op:        operationSimulateMsgVote(ak, k, accs[whoVotes[i]], int64(proposalID)),
-			}
+			})
 		}
 
+		// 4) Schedule the verification of the tally once the proposal ends
+		fops = append(fops, simulation.FutureOperation{
+			BlockTime: endTime.Add(time.Second),
+			Op:        operationVerifyProposalTally(k, proposalID),
+		})
+
 		return opMsg, fops, nil
 	}
 }
@@ -136,24 +237,46 @@ func SimulateTextProposalContent(r *rand.Rand, _ sdk.Context, _ []simulation.Acc
 }
 
 // SimulateMsgDeposit generates a MsgDeposit with random values.
-// nolint: funlen
 func SimulateMsgDeposit(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return operationSimulateMsgDeposit(ak, k, simulation.Account{}, -1)
+}
+
+// nolint: funlen
+func operationSimulateMsgDeposit(ak types.AccountKeeper, k keeper.Keeper,
+	simAccount simulation.Account, proposalIDInt int64) simulation.Operation {
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account, chainID string,
 	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
-		simAccount, _ := simulation.RandomAcc(r, accs)
-		proposalID, ok := randomProposalID(r, k, ctx, types.StatusDepositPeriod)
-		if !ok {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		if simAccount.Equals(simulation.Account{}) {
+			simAccount, _ = simulation.RandomAcc(r, accs)
+		}
+
+		var proposalID uint64
+
+		switch {
+		case proposalIDInt < 0:
+			var ok bool
+			proposalID, ok = randomProposalID(r, k, ctx, types.StatusDepositPeriod)
+			if !ok {
+				return simulation.NoOpMsg(types.ModuleName, types.TypeMsgDeposit, "no proposal in deposit period"), nil, nil
+			}
+		default:
+			// the scheduled deposits are skipped once the proposal leaves its
+			// deposit period
+			proposalID = uint64(proposalIDInt)
+			proposal, ok := k.GetProposal(ctx, proposalID)
+			if !ok || proposal.Status != types.StatusDepositPeriod {
+				return simulation.NoOpMsg(types.ModuleName, types.TypeMsgDeposit, "proposal left its deposit period"), nil, nil
+			}
 		}
 
 		deposit, skip, err := randomDeposit(r, ctx, ak, k, simAccount.Address)
 		switch {
 		case skip:
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgDeposit, "no coins to deposit"), nil, nil
 		case err != nil:
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgDeposit, ""), nil, err
 		}
 
 		msg := types.NewMsgDeposit(simAccount.Address, proposalID, deposit)
@@ -166,7 +289,7 @@ func SimulateMsgDeposit(ak types.AccountKeeper, k keeper.Keeper) simulation.Oper
 		if !hasNeg {
 			fees, err = simulation.RandomFees(r, ctx, coins)
 			if err != nil {
-				return simulation.NoOpMsg(types.ModuleName), nil, err
+				return simulation.NoOpMsg(types.ModuleName, types.TypeMsgDeposit, ""), nil, err
 			}
 		}
 
@@ -181,7 +304,7 @@ func SimulateMsgDeposit(ak types.AccountKeeper, k keeper.Keeper) simulation.Oper
 
 		res := app.Deliver(tx)
 		if !res.IsOK() {
-			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgDeposit, ""), nil, errors.New(res.Log)
 		}
 
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
@@ -211,10 +334,16 @@ func operationSimulateMsgVote(ak types.AccountKeeper, k keeper.Keeper,
 			var ok bool
 			proposalID, ok = randomProposalID(r, k, ctx, types.StatusVotingPeriod)
 			if !ok {
-				return simulation.NoOpMsg(types.ModuleName), nil, nil
+				return simulation.NoOpMsg(types.ModuleName, types.TypeMsgVote, "no proposal in voting period"), nil, nil
 			}
 		default:
+			// the scheduled votes are skipped while the proposal isn't in its
+			// voting period
 			proposalID = uint64(proposalIDInt)
+			proposal, ok := k.GetProposal(ctx, proposalID)
+			if !ok || proposal.Status != types.StatusVotingPeriod {
+				return simulation.NoOpMsg(types.ModuleName, types.TypeMsgVote, "proposal isn't in its voting period"), nil, nil
+			}
 		}
 
 		option := randomVotingOption(r)
@@ -224,7 +353,49 @@ func operationSimulateMsgVote(ak types.AccountKeeper, k keeper.Keeper,
 		account := ak.GetAccount(ctx, simAccount.Address)
 		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgVote, ""), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		res := app.Deliver(tx)
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgVote, ""), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgVoteWeighted generates a MsgVoteWeighted with random values.
+// nolint: funlen
+func SimulateMsgVoteWeighted(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		simAccount, _ := simulation.RandomAcc(r, accs)
+
+		proposalID, ok := randomProposalID(r, k, ctx, types.StatusVotingPeriod)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgVoteWeighted, "no proposal in voting period"), nil, nil
+		}
+
+		options := randomWeightedVotingOptions(r)
+
+		msg := types.NewMsgVoteWeighted(simAccount.Address, proposalID, options)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgVoteWeighted, ""), nil, err
 		}
 
 		tx := helpers.GenTx(
@@ -238,13 +409,71 @@ func operationSimulateMsgVote(ak types.AccountKeeper, k keeper.Keeper,
 
 		res := app.Deliver(tx)
 		if !res.IsOK() {
-			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgVoteWeighted, ""), nil, errors.New(res.Log)
 		}
 
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
 
+// operationVerifyProposalTally verifies the outcome of a proposal once it ends.
+// The proposal is either deleted, if it didn't reach the minimum deposit, or
+// tallied, in which case its deposits are either refunded or burned.
+func operationVerifyProposalTally(k keeper.Keeper, proposalID uint64) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		proposal, ok := k.GetProposal(ctx, proposalID)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName, opProposalTally, "proposal deleted"), nil, nil
+		}
+
+		opMsg := simulation.NewOperationMsgBasic(types.ModuleName, tallyOutcome(ctx, k, proposal), proposal.Status.String(), true, nil)
+
+		switch proposal.Status {
+		case types.StatusDepositPeriod, types.StatusVotingPeriod:
+			// the proposal either ends on this block, before being processed by
+			// the end blocker, or its voting period got extended by a parameter
+			// change
+			return simulation.NoOpMsg(types.ModuleName, opProposalTally, "proposal hasn't ended yet"), nil, nil
+
+		case types.StatusPassed, types.StatusFailed:
+			if !proposal.FinalTallyResult.Yes.IsPositive() {
+				return opMsg, nil, fmt.Errorf("proposal %d passed without yes votes: %s", proposalID, proposal.FinalTallyResult)
+			}
+
+		case types.StatusRejected:
+
+		default:
+			return opMsg, nil, fmt.Errorf("proposal %d has an invalid status after it ended: %s", proposalID, proposal.Status)
+		}
+
+		if deposits := k.GetDeposits(ctx, proposalID); len(deposits) != 0 {
+			return opMsg, nil, fmt.Errorf("proposal %d still holds %d deposits after being tallied", proposalID, len(deposits))
+		}
+
+		return opMsg, nil, nil
+	}
+}
+
+// tallyOutcome returns the name under which the verification of the tally of
+// an ended proposal is recorded in the simulation event stats, so that they
+// report how many proposals passed, failed or got rejected. Rejected proposals
+// without any vote, or whose votes don't reach the quorum of the bonded tokens,
+// are told apart.
+func tallyOutcome(ctx sdk.Context, k keeper.Keeper, proposal types.Proposal) string {
+	if proposal.Status == types.StatusRejected {
+		switch {
+		case proposal.FinalTallyResult.Equals(types.EmptyTallyResult()):
+			return opProposalTally + "_no_votes"
+		case !k.QuorumReached(ctx, proposal.FinalTallyResult):
+			return opProposalTally + "_no_quorum"
+		}
+	}
+	return opProposalTally + "_" + strings.ToLower(proposal.Status.String())
+}
+
 // Pick a random deposit with a random denomination with a
 // deposit amount between (0, min(balance, minDepositAmount))
 // This is to simulate multiple users depositing to get the
@@ -272,7 +501,7 @@ func randomDeposit(r *rand.Rand, ctx sdk.Context,
 		maxAmt = minDeposit[denomIndex].Amount
 	}
 
-	amount, err := simulation.RandPositiveInt(r, maxAmt)
+	amount, err := simulation.RandPositiveInt(r, ctx, maxAmt)
 	if err != nil {
 		return nil, false, err
 	}
@@ -322,3 +551,23 @@ func randomVotingOption(r *rand.Rand) types.VoteOption {
 		panic("invalid vote option")
 	}
 }
+
+// Pick random distinct voting options with random weights adding up to one
+func randomWeightedVotingOptions(r *rand.Rand) types.WeightedVoteOptions {
+	all := []types.VoteOption{types.OptionYes, types.OptionAbstain, types.OptionNo, types.OptionNoWithVeto}
+	r.Shuffle(len(all), func(i, j int) { all[i], all[j] = all[j], all[i] })
+
+	// split 100 percent across the chosen options, each getting at least one
+	count := simulation.RandIntBetween(r, 1, len(all)+1)
+	remaining := 100
+
+	options := make(types.WeightedVoteOptions, count)
+	for i := 0; i < count-1; i++ {
+		weight := simulation.RandIntBetween(r, 1, remaining-(count-1-i)+1)
+		options[i] = types.NewWeightedVoteOption(all[i], sdk.NewDecWithPrec(int64(weight), 2))
+		remaining -= weight
+	}
+	options[count-1] = types.NewWeightedVoteOption(all[count-1], sdk.NewDecWithPrec(int64(remaining), 2))
+
+	return options
+}
@@ -19,6 +19,7 @@ const (
 	subkeyQuorum     = "quorum"
 	subkeyThreshold  = "threshold"
 	subkeyVeto       = "veto"
+	subkeyExecGas    = "execution_gas_limit"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -39,11 +40,12 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 			func(r *rand.Rand) string {
 				changes := []struct {
 					key   string
-					value sdk.Dec
+					value fmt.Stringer
 				}{
 					{subkeyQuorum, GenTallyParamsQuorum(r)},
 					{subkeyThreshold, GenTallyParamsThreshold(r)},
 					{subkeyVeto, GenTallyParamsVeto(r)},
+					{subkeyExecGas, sdk.NewUint(GenTallyParamsExecutionGasLimit(r))},
 				}
 
 				pc := make(map[string]string)
@@ -69,7 +69,8 @@ func getMockApp(t *testing.T, numGenAccs int, genState types.GenesisState, genAc
 	rtr := types.NewRouter().
 		AddRoute(types.RouterKey, handler)
 
-	bk := bank.NewBaseKeeper(mApp.AccountKeeper, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
+	keyBank := sdk.NewKVStoreKey(bank.StoreKey)
+	bk := bank.NewBaseKeeper(mApp.Cdc, keyBank, mApp.AccountKeeper, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
 
 	maccPerms := map[string][]string{
 		types.ModuleName:          {supply.Burner},
@@ -92,7 +93,7 @@ func getMockApp(t *testing.T, numGenAccs int, genState types.GenesisState, genAc
 	mApp.SetInitChainer(getInitChainer(mApp, keeper, sk, supplyKeeper, genAccs, genState,
 		[]supplyexported.ModuleAccountI{govAcc, notBondedPool, bondPool}))
 
-	require.NoError(t, mApp.CompleteSetup(keyStaking, keyGov, keySupply))
+	require.NoError(t, mApp.CompleteSetup(keyBank, keyStaking, keyGov, keySupply))
 
 	var (
 		addrs    []sdk.AccAddress
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ArchiveSink defines the interface that must be implemented by a sink which
+// wishes to receive governance records before they are deleted from state,
+// e.g. to let an indexer capture the full voting history of a chain.
+//
+// CONTRACT: the sink is not part of consensus and must not modify state nor
+// rely on the provided context being committed.
+type ArchiveSink interface {
+	ArchiveVote(ctx sdk.Context, vote Vote)          // called before a vote is pruned
+	ArchiveDeposit(ctx sdk.Context, deposit Deposit) // called before a deposit is deleted or refunded
+}
@@ -23,6 +23,8 @@ const (
 	CodeInvalidGenesis           sdk.CodeType = 9
 	CodeInvalidProposalStatus    sdk.CodeType = 10
 	CodeProposalHandlerNotExists sdk.CodeType = 11
+	CodeInvalidProposer          sdk.CodeType = 12
+	CodeInvalidHaltHeight        sdk.CodeType = 13
 )
 
 // ErrUnknownProposal error for unknown proposals
@@ -55,6 +57,11 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid voting option", voteOption))
 }
 
+// ErrInvalidWeightedVote error for invalid weighted vote options
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, options WeightedVoteOptions, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%s' are not valid weighted voting options: %s", options, reason))
+}
+
 // ErrInvalidGenesis error for an invalid governance GenesisState
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
@@ -64,3 +71,15 @@ func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 func ErrNoProposalHandlerExists(codespace sdk.CodespaceType, content interface{}) sdk.Error {
 	return sdk.NewError(codespace, CodeProposalHandlerNotExists, fmt.Sprintf("'%T' does not have a corresponding handler", content))
 }
+
+// ErrInvalidProposer error when an address other than the proposer tries to
+// cancel a proposal
+func ErrInvalidProposer(codespace sdk.CodespaceType, proposalID uint64, address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposer, fmt.Sprintf("%s is not the proposer of proposal %d", address, proposalID))
+}
+
+// ErrInvalidHaltHeight error when a chain halt is requested at a height that
+// is not in the future
+func ErrInvalidHaltHeight(codespace sdk.CodespaceType, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidHaltHeight, fmt.Sprintf("invalid chain halt height %d", height))
+}
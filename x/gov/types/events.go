@@ -7,6 +7,9 @@ const (
 	EventTypeProposalVote     = "proposal_vote"
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
+	EventTypePruneVotes       = "prune_votes"
+	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeHaltChain        = "halt_chain"
 
 	AttributeKeyProposalResult     = "proposal_result"
 	AttributeKeyOption             = "option"
@@ -18,4 +21,9 @@ const (
 	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
 	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
 	AttributeKeyProposalType       = "proposal_type"
+	AttributeKeyPrunedVotes        = "pruned_votes"
+	AttributeKeyPrunedDeposits     = "pruned_deposits"
+	AttributeKeyBurnedDeposits     = "burned_deposits"
+	AttributeKeyRefundedDeposits   = "refunded_deposits"
+	AttributeKeyHaltHeight         = "halt_height"
 )
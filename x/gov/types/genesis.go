@@ -16,6 +16,8 @@ type GenesisState struct {
 	DepositParams      DepositParams `json:"deposit_params" yaml:"deposit_params"`
 	VotingParams       VotingParams  `json:"voting_params" yaml:"voting_params"`
 	TallyParams        TallyParams   `json:"tally_params" yaml:"tally_params"`
+	VoteRecords        VoteRecords   `json:"vote_records,omitempty" yaml:"vote_records,omitempty"`         // voting history of the voters
+	SettledDeposits    Deposits      `json:"settled_deposits,omitempty" yaml:"settled_deposits,omitempty"` // deposits of concluded proposals kept for the retention period
 }
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -64,6 +66,34 @@ func ValidateGenesis(data GenesisState) error {
 			veto.String())
 	}
 
+	if data.VotingParams.RetentionPeriod < 0 {
+		return fmt.Errorf("governance vote retention period should not be negative, is %s",
+			data.VotingParams.RetentionPeriod)
+	}
+
+	// a genesis predating the cancel burn rate uses the default one
+	cancelBurnRate := data.DepositParams.CancelBurnRate
+	if !cancelBurnRate.IsNil() && (cancelBurnRate.IsNegative() || cancelBurnRate.GT(sdk.OneDec())) {
+		return fmt.Errorf("governance cancel burn rate should be positive and less or equal to one, is %s",
+			cancelBurnRate.String())
+	}
+
+	for _, record := range data.VoteRecords {
+		if len(record.Options) > 0 {
+			if err := record.Options.Validate(); err != nil {
+				return fmt.Errorf("governance vote record of %s on proposal %d has invalid options %s: %w",
+					record.Voter, record.ProposalID, record.Options, err)
+			}
+		} else if !ValidVoteOption(record.Option) {
+			return fmt.Errorf("governance vote record of %s on proposal %d has invalid option %s",
+				record.Voter, record.ProposalID, record.Option)
+		}
+		if record.Height < 0 {
+			return fmt.Errorf("governance vote record of %s on proposal %d has negative height %d",
+				record.Voter, record.ProposalID, record.Height)
+		}
+	}
+
 	if !data.DepositParams.MinDeposit.IsValid() {
 		return fmt.Errorf("governance deposit amount must be a valid sdk.Coins amount, is %s",
 			data.DepositParams.MinDeposit.String())
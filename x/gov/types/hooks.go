@@ -0,0 +1,78 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GovHooks event hooks for the governance proposal lifecycle. Several hooks
+// can be composed with MultiGovHooks.
+type GovHooks interface {
+	AfterProposalSubmission(ctx sdk.Context, proposalID uint64)                            // Must be called after a proposal is submitted
+	AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) // Must be called after a deposit is made
+	AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress)        // Must be called after a vote is cast
+	AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64)                      // Must be called when a proposal is dropped for not meeting the minimum deposit
+	AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64)                     // Must be called when the voting period of a proposal ends
+}
+
+// combine multiple governance hooks, all hook functions are run in array sequence
+type MultiGovHooks []GovHooks
+
+var _ GovHooks = MultiGovHooks{}
+
+func NewMultiGovHooks(hooks ...GovHooks) MultiGovHooks {
+	return hooks
+}
+
+// nolint
+func (h MultiGovHooks) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
+	for i := range h {
+		h[i].AfterProposalSubmission(ctx, proposalID)
+	}
+}
+func (h MultiGovHooks) AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterProposalDeposit(ctx, proposalID, depositorAddr)
+	}
+}
+func (h MultiGovHooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterProposalVote(ctx, proposalID, voterAddr)
+	}
+}
+func (h MultiGovHooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {
+	for i := range h {
+		h[i].AfterProposalFailedMinDeposit(ctx, proposalID)
+	}
+}
+func (h MultiGovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	for i := range h {
+		h[i].AfterProposalVotingPeriodEnded(ctx, proposalID)
+	}
+}
+
+// DepositPolicy decides which fraction of the deposits of a proposal is burned
+// once it leaves the deposit or voting period, the remainder being refunded to
+// the depositors.
+type DepositPolicy interface {
+	// DepositBurnRate is called with the proposal as it ended: in the deposit
+	// period if it didn't meet the minimum deposit, and in the voting period
+	// otherwise. burn is true if the proposal didn't meet the minimum deposit
+	// or if its tally calls for burning the deposits, as when it is vetoed or
+	// doesn't reach quorum. The returned rate must be between zero and one.
+	DepositBurnRate(ctx sdk.Context, proposal Proposal, burn bool) sdk.Dec
+}
+
+var _ DepositPolicy = DefaultDepositPolicy{}
+
+// DefaultDepositPolicy burns all the deposits of the proposals that didn't
+// meet the minimum deposit and of the proposals whose tally calls for burning
+// the deposits, and refunds the deposits of the other proposals.
+type DefaultDepositPolicy struct{}
+
+// DepositBurnRate implements the DepositPolicy interface.
+func (DefaultDepositPolicy) DepositBurnRate(_ sdk.Context, _ Proposal, burn bool) sdk.Dec {
+	if burn {
+		return sdk.OneDec()
+	}
+	return sdk.ZeroDec()
+}
@@ -36,18 +36,30 @@ const (
 //
 // - 0x03: nextProposalID
 //
+// - 0x04<pruneTime_Bytes><proposalID_Bytes>: pruneProposalID
+//
+// - 0x05: haltHeight
+//
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
+// - 0x11<proposalID_Bytes><depositorAddr_Bytes>: SettledDeposit
+//
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - 0x21<voterAddr_Bytes><proposalID_Bytes>: VoteRecord
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
 	InactiveProposalQueuePrefix = []byte{0x02}
 	ProposalIDKey               = []byte{0x03}
+	PruneQueuePrefix            = []byte{0x04}
+	HaltHeightKey               = []byte{0x05}
 
-	DepositsKeyPrefix = []byte{0x10}
+	DepositsKeyPrefix        = []byte{0x10}
+	SettledDepositsKeyPrefix = []byte{0x11}
 
-	VotesKeyPrefix = []byte{0x20}
+	VotesKeyPrefix      = []byte{0x20}
+	VoterVotesKeyPrefix = []byte{0x21}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -89,6 +101,16 @@ func InactiveProposalQueueKey(proposalID uint64, endTime time.Time) []byte {
 	return append(InactiveProposalByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
 }
 
+// PruneQueueByTimeKey gets the vote prune queue key by pruneTime
+func PruneQueueByTimeKey(pruneTime time.Time) []byte {
+	return append(PruneQueuePrefix, sdk.FormatTimeBytes(pruneTime)...)
+}
+
+// PruneQueueKey returns the key for a proposalID in the vote prune queue
+func PruneQueueKey(proposalID uint64, pruneTime time.Time) []byte {
+	return append(PruneQueueByTimeKey(pruneTime), GetProposalIDBytes(proposalID)...)
+}
+
 // DepositsKey gets the first part of the deposits key based on the proposalID
 func DepositsKey(proposalID uint64) []byte {
 	return append(DepositsKeyPrefix, GetProposalIDBytes(proposalID)...)
@@ -99,6 +121,16 @@ func DepositKey(proposalID uint64, depositorAddr sdk.AccAddress) []byte {
 	return append(DepositsKey(proposalID), depositorAddr.Bytes()...)
 }
 
+// SettledDepositsKey gets the first part of the settled deposits key based on the proposalID
+func SettledDepositsKey(proposalID uint64) []byte {
+	return append(SettledDepositsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// SettledDepositKey key of a specific settled deposit from the store
+func SettledDepositKey(proposalID uint64, depositorAddr sdk.AccAddress) []byte {
+	return append(SettledDepositsKey(proposalID), depositorAddr.Bytes()...)
+}
+
 // VotesKey gets the first part of the votes key based on the proposalID
 func VotesKey(proposalID uint64) []byte {
 	return append(VotesKeyPrefix, GetProposalIDBytes(proposalID)...)
@@ -109,6 +141,16 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), voterAddr.Bytes()...)
 }
 
+// VoterVotesKey gets the first part of the vote records key based on the voter address
+func VoterVotesKey(voterAddr sdk.AccAddress) []byte {
+	return append(VoterVotesKeyPrefix, voterAddr.Bytes()...)
+}
+
+// VoterVoteKey key of the vote record of a voter on a specific proposal
+func VoterVoteKey(voterAddr sdk.AccAddress, proposalID uint64) []byte {
+	return append(VoterVotesKey(voterAddr), GetProposalIDBytes(proposalID)...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -130,6 +172,11 @@ func SplitInactiveProposalQueueKey(key []byte) (proposalID uint64, endTime time.
 	return splitKeyWithTime(key)
 }
 
+// SplitPruneQueueKey split the vote prune queue key and returns the proposal id and pruneTime
+func SplitPruneQueueKey(key []byte) (proposalID uint64, pruneTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
 // SplitKeyDeposit split the deposits key and returns the proposal id and depositor address
 func SplitKeyDeposit(key []byte) (proposalID uint64, depositorAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
@@ -140,6 +187,17 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
 }
 
+// SplitKeyVoterVote split the vote records key and returns the voter address and proposal id
+func SplitKeyVoterVote(key []byte) (voterAddr sdk.AccAddress, proposalID uint64) {
+	if len(key[1:]) != sdk.AddrLen+8 {
+		panic(fmt.Sprintf("unexpected key length (%d ≠ %d)", len(key[1:]), sdk.AddrLen+8))
+	}
+
+	voterAddr = sdk.AccAddress(key[1 : 1+sdk.AddrLen])
+	proposalID = GetProposalIDFromBytes(key[1+sdk.AddrLen:])
+	return
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
@@ -69,3 +69,16 @@ func TestVoteKeys(t *testing.T) {
 	key = VoteKey(5, addr2)
 	require.Panics(t, func() { SplitKeyVote(key) })
 }
+
+func TestVoterVoteKeys(t *testing.T) {
+
+	key := VoterVoteKey(addr, 2)
+	voterAddr, proposalID := SplitKeyVoterVote(key)
+	require.Equal(t, addr, voterAddr)
+	require.Equal(t, int(proposalID), 2)
+
+	// invalid key
+	addr2 := sdk.AccAddress("test1")
+	key = VoterVoteKey(addr2, 5)
+	require.Panics(t, func() { SplitKeyVoterVote(key) })
+}
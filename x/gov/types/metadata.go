@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Constants pertaining to the metadata of a proposal
+const (
+	MaxSummaryLength   int = 500
+	MaxForumLinkLength int = 255
+	MaxIPFSHashLength  int = 128
+)
+
+// ProposalMetadata defines the structured metadata of a proposal, so that
+// clients don't have to parse it out of its description.
+type ProposalMetadata struct {
+	Title     string `json:"title" yaml:"title"`
+	Summary   string `json:"summary" yaml:"summary"`
+	ForumLink string `json:"forum_link" yaml:"forum_link"`
+	IPFSHash  string `json:"ipfs_hash" yaml:"ipfs_hash"`
+}
+
+// NewProposalMetadata creates a new ProposalMetadata instance
+func NewProposalMetadata(title, summary, forumLink, ipfsHash string) ProposalMetadata {
+	return ProposalMetadata{
+		Title:     title,
+		Summary:   summary,
+		ForumLink: forumLink,
+		IPFSHash:  ipfsHash,
+	}
+}
+
+// ContentWithMetadata defines a proposal Content providing structured
+// metadata.
+type ContentWithMetadata interface {
+	Content
+	GetMetadata() ProposalMetadata
+}
+
+// GetContentMetadata returns the metadata of a proposal Content. Only the
+// title is set if the Content doesn't provide structured metadata.
+func GetContentMetadata(c Content) ProposalMetadata {
+	if cm, ok := c.(ContentWithMetadata); ok {
+		return cm.GetMetadata()
+	}
+
+	return ProposalMetadata{Title: c.GetTitle()}
+}
+
+// ValidateMetadata validates the summary, forum link and IPFS hash of the
+// metadata of a proposal, which are all optional, returning an error if
+// invalid. The title is validated along with the proposal Content.
+func ValidateMetadata(codespace sdk.CodespaceType, m ProposalMetadata) sdk.Error {
+	if len(m.Summary) > MaxSummaryLength {
+		return ErrInvalidProposalContent(codespace, fmt.Sprintf("proposal summary is longer than max length of %d", MaxSummaryLength))
+	}
+
+	if m.ForumLink != "" {
+		if len(m.ForumLink) > MaxForumLinkLength {
+			return ErrInvalidProposalContent(codespace, fmt.Sprintf("proposal forum link is longer than max length of %d", MaxForumLinkLength))
+		}
+
+		u, err := url.Parse(m.ForumLink)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ErrInvalidProposalContent(codespace, fmt.Sprintf("proposal forum link %s is not a valid http(s) URL", m.ForumLink))
+		}
+	}
+
+	if m.IPFSHash != "" {
+		if len(m.IPFSHash) > MaxIPFSHashLength {
+			return ErrInvalidProposalContent(codespace, fmt.Sprintf("proposal IPFS hash is longer than max length of %d", MaxIPFSHashLength))
+		}
+
+		if !sdk.IsAlphaNumeric(m.IPFSHash) {
+			return ErrInvalidProposalContent(codespace, fmt.Sprintf("proposal IPFS hash %s is not alphanumeric", m.IPFSHash))
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (m ProposalMetadata) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Title:      %s
+Summary:    %s
+Forum Link: %s
+IPFS Hash:  %s`, m.Title, m.Summary, m.ForumLink, m.IPFSHash))
+}
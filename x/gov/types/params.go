@@ -13,12 +13,17 @@ const (
 	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
 )
 
+// DefaultExecutionGasLimit is the default gas limit of the execution of a
+// passed proposal's handler
+const DefaultExecutionGasLimit uint64 = 10000000
+
 // Default governance params
 var (
 	DefaultMinDepositTokens = sdk.TokensFromConsensusPower(10)
 	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
 	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
 	DefaultVeto             = sdk.NewDecWithPrec(334, 3)
+	DefaultCancelBurnRate   = sdk.NewDecWithPrec(5, 1)
 )
 
 // Parameter store key
@@ -30,24 +35,76 @@ var (
 
 // ParamKeyTable - Key declaration for parameters
 func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable(
-		ParamStoreKeyDepositParams, DepositParams{},
-		ParamStoreKeyVotingParams, VotingParams{},
-		ParamStoreKeyTallyParams, TallyParams{},
-	)
+	return params.NewKeyTable().
+		RegisterTypeWithValidator(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams).
+		RegisterTypeWithValidator(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams).
+		RegisterTypeWithValidator(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams)
+}
+
+// validateFraction checks that a governance parameter is a decimal between 0
+// and 1.
+func validateFraction(name string, v sdk.Dec) error {
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance parameter %s must be between 0 and 1, is %s", name, v)
+	}
+	return nil
+}
+
+func validateDepositParams(i interface{}) error {
+	v, ok := i.(DepositParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.MinDeposit.IsValid() {
+		return fmt.Errorf("governance parameter MinDeposit must be a valid sdk.Coins amount, is %s", v.MinDeposit)
+	}
+	if v.MaxDepositPeriod <= 0 {
+		return fmt.Errorf("governance parameter MaxDepositPeriod must be positive, is %s", v.MaxDepositPeriod)
+	}
+	return validateFraction("CancelBurnRate", v.CancelBurnRate)
+}
+
+func validateVotingParams(i interface{}) error {
+	v, ok := i.(VotingParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.VotingPeriod <= 0 {
+		return fmt.Errorf("governance parameter VotingPeriod must be positive, is %s", v.VotingPeriod)
+	}
+	if v.RetentionPeriod < 0 {
+		return fmt.Errorf("governance parameter RetentionPeriod must not be negative, is %s", v.RetentionPeriod)
+	}
+	return nil
+}
+
+func validateTallyParams(i interface{}) error {
+	v, ok := i.(TallyParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := validateFraction("Quorum", v.Quorum); err != nil {
+		return err
+	}
+	if err := validateFraction("Threshold", v.Threshold); err != nil {
+		return err
+	}
+	return validateFraction("Veto", v.Veto)
 }
 
 // DepositParams defines the params around deposits for governance
 type DepositParams struct {
 	MinDeposit       sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`               //  Minimum deposit for a proposal to enter voting period.
 	MaxDepositPeriod time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	CancelBurnRate   sdk.Dec       `json:"cancel_burn_rate,omitempty" yaml:"cancel_burn_rate,omitempty"`     //  Fraction of the deposits burned when a proposal is cancelled by its proposer. Initial value: 0.5
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration, cancelBurnRate sdk.Dec) DepositParams {
 	return DepositParams{
 		MinDeposit:       minDeposit,
 		MaxDepositPeriod: maxDepositPeriod,
+		CancelBurnRate:   cancelBurnRate,
 	}
 }
 
@@ -56,6 +113,7 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		DefaultCancelBurnRate,
 	)
 }
 
@@ -63,33 +121,43 @@ func DefaultDepositParams() DepositParams {
 func (dp DepositParams) String() string {
 	return fmt.Sprintf(`Deposit Params:
   Min Deposit:        %s
-  Max Deposit Period: %s`, dp.MinDeposit, dp.MaxDepositPeriod)
+  Max Deposit Period: %s
+  Cancel Burn Rate:   %s`, dp.MinDeposit, dp.MaxDepositPeriod, dp.CancelBurnRate)
 }
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
+	if dp.CancelBurnRate.IsNil() || dp2.CancelBurnRate.IsNil() {
+		if !dp.CancelBurnRate.IsNil() || !dp2.CancelBurnRate.IsNil() {
+			return false
+		}
+	} else if !dp.CancelBurnRate.Equal(dp2.CancelBurnRate) {
+		return false
+	}
 	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
 }
 
 // TallyParams defines the params around Tallying votes in governance
 type TallyParams struct {
-	Quorum    sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`       //  Minimum percentage of total stake needed to vote for a result to be considered valid
-	Threshold sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"` //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
-	Veto      sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`           //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	Quorum            sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`                           //  Minimum percentage of total stake needed to vote for a result to be considered valid
+	Threshold         sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"`                     //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
+	Veto              sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`                               //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	ExecutionGasLimit uint64  `json:"execution_gas_limit,omitempty" yaml:"execution_gas_limit,omitempty"` //  Maximum gas consumed by the handler of a passed proposal before it fails. Zero means no limit.
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, veto sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, veto sdk.Dec, executionGasLimit uint64) TallyParams {
 	return TallyParams{
-		Quorum:    quorum,
-		Threshold: threshold,
-		Veto:      veto,
+		Quorum:            quorum,
+		Threshold:         threshold,
+		Veto:              veto,
+		ExecutionGasLimit: executionGasLimit,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVeto)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVeto, DefaultExecutionGasLimit)
 }
 
 // String implements stringer insterface
@@ -97,31 +165,35 @@ func (tp TallyParams) String() string {
 	return fmt.Sprintf(`Tally Params:
   Quorum:             %s
   Threshold:          %s
-  Veto:               %s`,
-		tp.Quorum, tp.Threshold, tp.Veto)
+  Veto:               %s
+  Execution Gas Limit: %d`,
+		tp.Quorum, tp.Threshold, tp.Veto, tp.ExecutionGasLimit)
 }
 
 // VotingParams defines the params around Voting in governance
 type VotingParams struct {
-	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period.
+	VotingPeriod    time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"`       //  Length of the voting period.
+	RetentionPeriod time.Duration `json:"retention_period,omitempty" yaml:"retention_period,omitempty"` //  Length of time the votes and settled deposits are kept after the voting period ends. Zero prunes them once the proposal is tallied.
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, retentionPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:    votingPeriod,
+		RetentionPeriod: retentionPeriod,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, 0)
 }
 
 // String implements stringer interface
 func (vp VotingParams) String() string {
 	return fmt.Sprintf(`Voting Params:
-  Voting Period:      %s`, vp.VotingPeriod)
+  Voting Period:      %s
+  Retention Period:   %s`, vp.VotingPeriod, vp.RetentionPeriod)
 }
 
 // Params returns all of the governance params
@@ -27,6 +27,8 @@ type Proposal struct {
 
 	VotingStartTime time.Time `json:"voting_start_time" yaml:"voting_start_time"` // Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndTime   time.Time `json:"voting_end_time" yaml:"voting_end_time"`     // Time that the VotingPeriod for this proposal will end and votes will be tallied
+
+	Proposer sdk.AccAddress `json:"proposer,omitempty" yaml:"proposer,omitempty"` // Address of the proposer, the only one allowed to cancel the proposal
 }
 
 // NewProposal creates a new Proposal instance
@@ -201,7 +203,8 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 
 // Proposal types
 const (
-	ProposalTypeText string = "Text"
+	ProposalTypeText      string = "Text"
+	ProposalTypeHaltChain string = "HaltChain"
 )
 
 // TextProposal defines a standard text proposal whose changes need to be
@@ -209,15 +212,30 @@ const (
 type TextProposal struct {
 	Title       string `json:"title" yaml:"title"`
 	Description string `json:"description" yaml:"description"`
+	Summary     string `json:"summary,omitempty" yaml:"summary,omitempty"`
+	ForumLink   string `json:"forum_link,omitempty" yaml:"forum_link,omitempty"`
+	IPFSHash    string `json:"ipfs_hash,omitempty" yaml:"ipfs_hash,omitempty"`
 }
 
 // NewTextProposal creates a text proposal Content
 func NewTextProposal(title, description string) Content {
-	return TextProposal{title, description}
+	return TextProposal{Title: title, Description: description}
 }
 
-// Implements Content Interface
-var _ Content = TextProposal{}
+// NewTextProposalWithMetadata creates a text proposal Content with the given
+// summary, forum link and IPFS hash, which are all optional.
+func NewTextProposalWithMetadata(title, description, summary, forumLink, ipfsHash string) Content {
+	return TextProposal{
+		Title:       title,
+		Description: description,
+		Summary:     summary,
+		ForumLink:   forumLink,
+		IPFSHash:    ipfsHash,
+	}
+}
+
+// Implements ContentWithMetadata Interface
+var _ ContentWithMetadata = TextProposal{}
 
 // GetTitle returns the proposal title
 func (tp TextProposal) GetTitle() string { return tp.Title }
@@ -231,19 +249,91 @@ func (tp TextProposal) ProposalRoute() string { return RouterKey }
 // ProposalType is "Text"
 func (tp TextProposal) ProposalType() string { return ProposalTypeText }
 
-// ValidateBasic validates the content's title and description of the proposal
-func (tp TextProposal) ValidateBasic() sdk.Error { return ValidateAbstract(DefaultCodespace, tp) }
+// GetMetadata returns the structured metadata of the proposal
+func (tp TextProposal) GetMetadata() ProposalMetadata {
+	return NewProposalMetadata(tp.Title, tp.Summary, tp.ForumLink, tp.IPFSHash)
+}
+
+// ValidateBasic validates the content's title, description and metadata of the
+// proposal
+func (tp TextProposal) ValidateBasic() sdk.Error {
+	if err := ValidateAbstract(DefaultCodespace, tp); err != nil {
+		return err
+	}
+
+	return ValidateMetadata(DefaultCodespace, tp.GetMetadata())
+}
 
 // String implements Stringer interface
 func (tp TextProposal) String() string {
-	return fmt.Sprintf(`Text Proposal:
+	s := fmt.Sprintf(`Text Proposal:
   Title:       %s
   Description: %s
 `, tp.Title, tp.Description)
+
+	if tp.Summary != "" {
+		s += fmt.Sprintf("  Summary:     %s\n", tp.Summary)
+	}
+	if tp.ForumLink != "" {
+		s += fmt.Sprintf("  Forum Link:  %s\n", tp.ForumLink)
+	}
+	if tp.IPFSHash != "" {
+		s += fmt.Sprintf("  IPFS Hash:   %s\n", tp.IPFSHash)
+	}
+
+	return s
+}
+
+// HaltChainProposal defines a proposal that, once passed, halts the chain at
+// the given block height. It is an emergency stop path for token holders that
+// doesn't require a software upgrade to be scheduled.
+type HaltChainProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Height      int64  `json:"height" yaml:"height"`
+}
+
+// NewHaltChainProposal creates a halt chain proposal Content
+func NewHaltChainProposal(title, description string, height int64) Content {
+	return HaltChainProposal{title, description, height}
+}
+
+// Implements Content Interface
+var _ Content = HaltChainProposal{}
+
+// GetTitle returns the proposal title
+func (hcp HaltChainProposal) GetTitle() string { return hcp.Title }
+
+// GetDescription returns the proposal description
+func (hcp HaltChainProposal) GetDescription() string { return hcp.Description }
+
+// ProposalRoute returns the proposal router key
+func (hcp HaltChainProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType is "HaltChain"
+func (hcp HaltChainProposal) ProposalType() string { return ProposalTypeHaltChain }
+
+// ValidateBasic validates the content's title, description and halt height
+func (hcp HaltChainProposal) ValidateBasic() sdk.Error {
+	if hcp.Height <= 0 {
+		return ErrInvalidHaltHeight(DefaultCodespace, hcp.Height)
+	}
+
+	return ValidateAbstract(DefaultCodespace, hcp)
+}
+
+// String implements Stringer interface
+func (hcp HaltChainProposal) String() string {
+	return fmt.Sprintf(`Halt Chain Proposal:
+  Title:       %s
+  Description: %s
+  Height:      %d
+`, hcp.Title, hcp.Description, hcp.Height)
 }
 
 var validProposalTypes = map[string]struct{}{
-	ProposalTypeText: {},
+	ProposalTypeText:      {},
+	ProposalTypeHaltChain: {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -280,6 +370,9 @@ func IsValidProposalType(ty string) bool {
 // proposals (ie. TextProposal ). Since these are
 // merely signaling mechanisms at the moment and do not affect state, it
 // performs a no-op.
+//
+// NOTE: HaltChainProposal changes the gov state and is only handled by the
+// handler returned by gov.NewProposalHandler.
 func ProposalHandler(_ sdk.Context, c Content) sdk.Error {
 	switch c.ProposalType() {
 	case ProposalTypeText:
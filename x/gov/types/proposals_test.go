@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -22,3 +23,41 @@ func TestProposalStatus_Format(t *testing.T) {
 		require.Equal(t, tt.expectedStringOutput, got)
 	}
 }
+
+func TestTextProposalMetadata(t *testing.T) {
+	validHash := "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+
+	tests := []struct {
+		name      string
+		summary   string
+		forumLink string
+		ipfsHash  string
+		expPass   bool
+	}{
+		{"no metadata", "", "", "", true},
+		{"valid metadata", "summary", "https://forum.cosmos.network/t/1", validHash, true},
+		{"summary too long", strings.Repeat("#", MaxSummaryLength+1), "", "", false},
+		{"forum link too long", "", "https://forum.cosmos.network/" + strings.Repeat("a", MaxForumLinkLength), "", false},
+		{"forum link without scheme", "", "forum.cosmos.network/t/1", "", false},
+		{"forum link with invalid scheme", "", "ftp://forum.cosmos.network/t/1", "", false},
+		{"IPFS hash too long", "", "", strings.Repeat("a", MaxIPFSHashLength+1), false},
+		{"IPFS hash not alphanumeric", "", "", "Qm/../" + validHash, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tp := NewTextProposalWithMetadata("Test", "description", tc.summary, tc.forumLink, tc.ipfsHash)
+			require.Equal(t, NewProposalMetadata("Test", tc.summary, tc.forumLink, tc.ipfsHash), GetContentMetadata(tp))
+
+			if tc.expPass {
+				require.NoError(t, tp.ValidateBasic())
+			} else {
+				require.Error(t, tp.ValidateBasic())
+			}
+		})
+	}
+
+	hcp := NewHaltChainProposal("Halt", "description", 10)
+	require.Equal(t, ProposalMetadata{Title: "Halt"}, GetContentMetadata(hcp))
+}
@@ -17,15 +17,29 @@ const (
 	QueryVote      = "vote"
 	QueryTally     = "tally"
 
+	QueryRecentProposals = "recent_proposals"
+	QueryHaltHeight      = "halt_height"
+	QueryVoterVotes      = "voter_votes"
+	QueryMetadata        = "metadata"
+
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
 )
 
+// MaxRecentProposalsLimit is the maximum number of proposals returned by a
+// single 'custom/gov/recent_proposals' query.
+const MaxRecentProposalsLimit = 100
+
+// MaxVoterVotesLimit is the maximum number of vote records returned by a
+// single 'custom/gov/voter_votes' query.
+const MaxVoterVotesLimit = 100
+
 // QueryProposalParams Params for queries:
 // - 'custom/gov/proposal'
 // - 'custom/gov/deposits'
 // - 'custom/gov/tally'
+// - 'custom/gov/metadata'
 // - 'custom/gov/votes'
 type QueryProposalParams struct {
 	ProposalID uint64
@@ -85,3 +99,33 @@ func NewQueryProposalsParams(page, limit int, status ProposalStatus, voter, depo
 		ProposalStatus: status,
 	}
 }
+
+// QueryRecentProposalsParams Params for query 'custom/gov/recent_proposals'
+type QueryRecentProposalsParams struct {
+	StartAfter uint64 // ID of the last proposal of the previous page, zero for the first page
+	Limit      int
+}
+
+// NewQueryRecentProposalsParams creates a new instance of QueryRecentProposalsParams
+func NewQueryRecentProposalsParams(startAfter uint64, limit int) QueryRecentProposalsParams {
+	return QueryRecentProposalsParams{
+		StartAfter: startAfter,
+		Limit:      limit,
+	}
+}
+
+// QueryVoterVotesParams Params for query 'custom/gov/voter_votes'
+type QueryVoterVotesParams struct {
+	Voter      sdk.AccAddress
+	StartAfter uint64 // ID of the proposal of the last vote of the previous page, zero for the first page
+	Limit      int
+}
+
+// NewQueryVoterVotesParams creates a new instance of QueryVoterVotesParams
+func NewQueryVoterVotesParams(voter sdk.AccAddress, startAfter uint64, limit int) QueryVoterVotesParams {
+	return QueryVoterVotesParams{
+		Voter:      voter,
+		StartAfter: startAfter,
+		Limit:      limit,
+	}
+}
@@ -2,25 +2,56 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Vote
 type Vote struct {
-	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
-	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`             //  address of the voter
-	Option     VoteOption     `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"`             //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`                         //  address of the voter
+	Option     VoteOption          `json:"option" yaml:"option"`                       //  option from OptionSet chosen by the voter, empty for a weighted vote
+	Options    WeightedVoteOptions `json:"options,omitempty" yaml:"options,omitempty"` //  options the voting power of the voter is split across, for a weighted vote
 }
 
 // NewVote creates a new Vote instance
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return Vote{ProposalID: proposalID, Voter: voter, Option: option}
+}
+
+// NewWeightedVote creates a new Vote instance splitting the voting power of the
+// voter across the given options. Options giving the whole voting power to a
+// single option make a regular vote.
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	if len(options) == 1 && options[0].Weight.Equal(sdk.OneDec()) {
+		return NewVote(proposalID, voter, options[0].Option)
+	}
+	return Vote{ProposalID: proposalID, Voter: voter, Option: OptionEmpty, Options: options}
+}
+
+// WeightedOptions returns the options the voting power of the voter is split
+// across, a regular vote giving the whole voting power to its option.
+func (v Vote) WeightedOptions() WeightedVoteOptions {
+	if len(v.Options) > 0 {
+		return v.Options
+	}
+	return NewNonSplitVoteOption(v.Option)
+}
+
+// OptionString returns the option of a regular vote, or the weighted options
+// of a weighted vote.
+func (v Vote) OptionString() string {
+	if len(v.Options) > 0 {
+		return v.Options.String()
+	}
+	return v.Option.String()
 }
 
 func (v Vote) String() string {
-	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
+	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.OptionString(), v.ProposalID)
 }
 
 // Votes is a collection of Vote objects
@@ -32,7 +63,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.OptionString())
 	}
 	return out
 }
@@ -41,7 +72,8 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.Option == comp.Option &&
+		v.Options.Equal(comp.Options)
 }
 
 // Empty returns whether a vote is empty.
@@ -49,6 +81,49 @@ func (v Vote) Empty() bool {
 	return v.Equals(Vote{})
 }
 
+// VoteRecord is an entry of the voting history of a voter, recording the
+// option of its latest vote on a proposal and the height it was cast at. Unlike
+// votes, vote records are kept once the votes of a proposal are pruned.
+type VoteRecord struct {
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"`             //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`                         //  address of the voter
+	Option     VoteOption          `json:"option" yaml:"option"`                       //  option from OptionSet chosen by the voter, empty for a weighted vote
+	Height     int64               `json:"height" yaml:"height"`                       //  height of the latest vote of the voter on the proposal
+	Options    WeightedVoteOptions `json:"options,omitempty" yaml:"options,omitempty"` //  options the voting power of the voter is split across, for a weighted vote
+}
+
+// NewVoteRecord creates a new VoteRecord instance for a vote cast at the given height
+func NewVoteRecord(vote Vote, height int64) VoteRecord {
+	return VoteRecord{vote.ProposalID, vote.Voter, vote.Option, height, vote.Options}
+}
+
+// OptionString returns the option of a regular vote, or the weighted options
+// of a weighted vote.
+func (r VoteRecord) OptionString() string {
+	if len(r.Options) > 0 {
+		return r.Options.String()
+	}
+	return r.Option.String()
+}
+
+func (r VoteRecord) String() string {
+	return fmt.Sprintf("voter %s voted with option %s on proposal %d at height %d", r.Voter, r.OptionString(), r.ProposalID, r.Height)
+}
+
+// VoteRecords is a collection of VoteRecord objects
+type VoteRecords []VoteRecord
+
+func (r VoteRecords) String() string {
+	if len(r) == 0 {
+		return "[]"
+	}
+	out := fmt.Sprintf("Votes of %s:", r[0].Voter)
+	for _, rec := range r {
+		out += fmt.Sprintf("\n  %d: %s (height %d)", rec.ProposalID, rec.OptionString(), rec.Height)
+	}
+	return out
+}
+
 // VoteOption defines a vote option
 type VoteOption byte
 
@@ -93,6 +168,108 @@ func ValidVoteOption(option VoteOption) bool {
 	return false
 }
 
+// WeightedVoteOption defines the weight of the voting power of a voter given
+// to an option
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option" yaml:"option"`
+	Weight sdk.Dec    `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+func (o WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s:%s", o.Option, o.Weight)
+}
+
+// WeightedVoteOptions is a collection of WeightedVoteOption objects
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption returns the options giving the whole voting power to a
+// single option
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+// WeightedVoteOptionsFromString returns the WeightedVoteOptions from a string
+// of comma separated option:weight pairs, e.g. "Yes:0.7,Abstain:0.3". It
+// returns an error if the string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, pair := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(pair), ":")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option, expected option:weight", pair)
+		}
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid weight: %s", fields[1], err)
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
+
+// Validate checks that the options are valid and distinct, and that their
+// weights are positive and add up to one.
+func (opts WeightedVoteOptions) Validate() error {
+	if len(opts) == 0 {
+		return errors.New("no vote option")
+	}
+
+	seen := make(map[VoteOption]bool)
+	total := sdk.ZeroDec()
+	for _, opt := range opts {
+		if !ValidVoteOption(opt.Option) {
+			return fmt.Errorf("'%s' is not a valid voting option", opt.Option)
+		}
+		if seen[opt.Option] {
+			return fmt.Errorf("duplicate vote option %s", opt.Option)
+		}
+		seen[opt.Option] = true
+
+		if opt.Weight.IsNil() || !opt.Weight.IsPositive() || opt.Weight.GT(sdk.OneDec()) {
+			return fmt.Errorf("weight of vote option %s should be positive and less or equal to one, is %s", opt.Option, opt.Weight)
+		}
+		total = total.Add(opt.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("vote option weights should add up to one, add up to %s", total)
+	}
+	return nil
+}
+
+// Equal returns whether two collections of weighted vote options are equal.
+func (opts WeightedVoteOptions) Equal(comp WeightedVoteOptions) bool {
+	if len(opts) != len(comp) {
+		return false
+	}
+	for i := range opts {
+		if opts[i].Option != comp[i].Option || !opts[i].Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+func (opts WeightedVoteOptions) String() string {
+	out := make([]string, len(opts))
+	for i, opt := range opts {
+		out[i] = opt.String()
+	}
+	return strings.Join(out, ",")
+}
+
 // Marshal needed for protobuf compatibility.
 func (vo VoteOption) Marshal() ([]byte, error) {
 	return []byte{byte(vo)}, nil
@@ -117,6 +294,12 @@ func (vo *VoteOption) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	// the empty option of weighted votes marshals to an empty string
+	if s == "" {
+		*vo = OptionEmpty
+		return nil
+	}
+
 	bz2, err := VoteOptionFromString(s)
 	if err != nil {
 		return err
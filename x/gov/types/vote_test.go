@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes:0.7,Abstain:0.3")
+	require.NoError(t, err)
+	require.True(t, options.Equal(WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+		NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(3, 1)),
+	}))
+	require.NoError(t, options.Validate())
+
+	parsed, err := WeightedVoteOptionsFromString(options.String())
+	require.NoError(t, err)
+	require.True(t, options.Equal(parsed))
+
+	_, err = WeightedVoteOptionsFromString("Yes")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("Maybe:1")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("Yes:one")
+	require.Error(t, err)
+}
+
+func TestNewWeightedVote(t *testing.T) {
+	vote := NewWeightedVote(1, addr, NewNonSplitVoteOption(OptionNo))
+	require.True(t, vote.Equals(NewVote(1, addr, OptionNo)))
+	require.Equal(t, "No", vote.OptionString())
+
+	options := WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+		NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(3, 1)),
+	}
+	vote = NewWeightedVote(1, addr, options)
+	require.Equal(t, OptionEmpty, vote.Option)
+	require.True(t, options.Equal(vote.WeightedOptions()))
+	require.False(t, vote.Equals(NewVote(1, addr, OptionYes)))
+
+	var decoded Vote
+	require.NoError(t, ModuleCdc.UnmarshalJSON(ModuleCdc.MustMarshalJSON(vote), &decoded))
+	require.True(t, vote.Equals(decoded))
+}
@@ -5,16 +5,34 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
 )
 
-// BeginBlocker mints new tokens for the previous block.
-func BeginBlocker(ctx sdk.Context, k Keeper) {
+// BeginBlocker mints new tokens for the previous block, at the inflation rate
+// computed by the given InflationCalculationFn.
+func BeginBlocker(ctx sdk.Context, k Keeper, ic types.InflationCalculationFn) {
 	// fetch stored minter & params
 	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
 
+	// burn a fraction of the fees collected in the previous block before new
+	// provisions are added to the fee collector and distributed
+	if params.FeeBurnRate.IsPositive() {
+		burnedFees, err := k.BurnCollectedFees(ctx, params.FeeBurnRate)
+		if err != nil {
+			panic(err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBurnFee,
+				sdk.NewAttribute(types.AttributeKeyFeeBurnRate, params.FeeBurnRate.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, burnedFees.String()),
+			),
+		)
+	}
+
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
+	minter.Inflation = ic(ctx, minter, params, bondedRatio)
 	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
 	k.SetMinter(ctx, minter)
 
@@ -18,23 +18,25 @@ const (
 	QueryParameters       = types.QueryParameters
 	QueryInflation        = types.QueryInflation
 	QueryAnnualProvisions = types.QueryAnnualProvisions
+	QueryBlockProvision   = types.QueryBlockProvision
 )
 
 var (
 	// functions aliases
-	NewKeeper            = keeper.NewKeeper
-	NewQuerier           = keeper.NewQuerier
-	NewGenesisState      = types.NewGenesisState
-	DefaultGenesisState  = types.DefaultGenesisState
-	ValidateGenesis      = types.ValidateGenesis
-	NewMinter            = types.NewMinter
-	InitialMinter        = types.InitialMinter
-	DefaultInitialMinter = types.DefaultInitialMinter
-	ValidateMinter       = types.ValidateMinter
-	ParamKeyTable        = types.ParamKeyTable
-	NewParams            = types.NewParams
-	DefaultParams        = types.DefaultParams
-	ValidateParams       = types.ValidateParams
+	NewKeeper                     = keeper.NewKeeper
+	NewQuerier                    = keeper.NewQuerier
+	NewGenesisState               = types.NewGenesisState
+	DefaultGenesisState           = types.DefaultGenesisState
+	ValidateGenesis               = types.ValidateGenesis
+	NewMinter                     = types.NewMinter
+	InitialMinter                 = types.InitialMinter
+	DefaultInitialMinter          = types.DefaultInitialMinter
+	ValidateMinter                = types.ValidateMinter
+	DefaultInflationCalculationFn = types.DefaultInflationCalculationFn
+	ParamKeyTable                 = types.ParamKeyTable
+	NewParams                     = types.NewParams
+	DefaultParams                 = types.DefaultParams
+	ValidateParams                = types.ValidateParams
 
 	// variable aliases
 	ModuleCdc              = types.ModuleCdc
@@ -48,8 +50,9 @@ var (
 )
 
 type (
-	Keeper       = keeper.Keeper
-	GenesisState = types.GenesisState
-	Minter       = types.Minter
-	Params       = types.Params
+	Keeper                 = keeper.Keeper
+	GenesisState           = types.GenesisState
+	Minter                 = types.Minter
+	Params                 = types.Params
+	InflationCalculationFn = types.InflationCalculationFn
 )
@@ -27,6 +27,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetCmdQueryParams(cdc),
 			GetCmdQueryInflation(cdc),
 			GetCmdQueryAnnualProvisions(cdc),
+			GetCmdQueryBlockProvision(cdc),
 		)...,
 	)
 
@@ -110,3 +111,29 @@ func GetCmdQueryAnnualProvisions(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryBlockProvision implements a command to return the amount of
+// tokens minted in the current block.
+func GetCmdQueryBlockProvision(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "block-provision",
+		Short: "Query the amount of tokens minted in the current block",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBlockProvision)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var provision sdk.Coin
+			if err := cdc.UnmarshalJSON(res, &provision); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(provision)
+		},
+	}
+}
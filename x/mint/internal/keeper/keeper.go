@@ -109,3 +109,40 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) sdk.Error {
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) sdk.Error {
 	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// BurnCollectedFees burns the given fraction of the fees currently held by the
+// fee collector account, truncating the burned amount of each denomination.
+// The fees are first moved to the mint module account, which must have the
+// Burner permission. It returns the coins that were burned.
+func (k Keeper) BurnCollectedFees(ctx sdk.Context, rate sdk.Dec) (sdk.Coins, sdk.Error) {
+	if !rate.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+
+	feeCollector := k.supplyKeeper.GetModuleAccount(ctx, k.feeCollectorName)
+	if feeCollector == nil {
+		return nil, sdk.ErrUnknownAddress(fmt.Sprintf("module account %s does not exist", k.feeCollectorName))
+	}
+
+	burned := sdk.NewCoins()
+	for _, fee := range feeCollector.GetCoins() {
+		amt := fee.Amount.ToDec().Mul(rate).TruncateInt()
+		if amt.IsPositive() {
+			burned = burned.Add(sdk.NewCoins(sdk.NewCoin(fee.Denom, amt)))
+		}
+	}
+
+	if burned.Empty() {
+		return burned, nil
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, burned); err != nil {
+		return nil, err
+	}
+
+	if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+		return nil, err
+	}
+
+	return burned, nil
+}
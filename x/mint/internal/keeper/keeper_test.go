@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+func TestBurnCollectedFees(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("foo", 1000), sdk.NewInt64Coin(sdk.DefaultBondDenom, 3))
+	require.NoError(t, app.SupplyKeeper.MintCoins(ctx, types.ModuleName, fees))
+	require.NoError(t, app.SupplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auth.FeeCollectorName, fees))
+	initialSupply := app.SupplyKeeper.GetSupply(ctx).GetTotal()
+
+	// a zero rate is a no-op
+	burned, err := app.MintKeeper.BurnCollectedFees(ctx, sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, burned.Empty())
+
+	// burned amounts are truncated per denomination
+	burned, err = app.MintKeeper.BurnCollectedFees(ctx, sdk.NewDecWithPrec(25, 2))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 250)), burned)
+
+	feeCollector := app.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
+	require.Equal(t, fees.Sub(burned), feeCollector.GetCoins())
+	require.Equal(t, initialSupply.Sub(burned), app.SupplyKeeper.GetSupply(ctx).GetTotal())
+	require.True(t, app.SupplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins().Empty())
+}
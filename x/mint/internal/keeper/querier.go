@@ -23,6 +23,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryAnnualProvisions:
 			return queryAnnualProvisions(ctx, k)
 
+		case types.QueryBlockProvision:
+			return queryBlockProvision(ctx, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown minting query endpoint: %s", path[0]))
 		}
@@ -61,3 +64,15 @@ func queryAnnualProvisions(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 
 	return res, nil
 }
+
+func queryBlockProvision(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, minter.BlockProvision(params))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
@@ -30,6 +30,9 @@ func TestNewQuerier(t *testing.T) {
 	_, err = querier(ctx, []string{types.QueryAnnualProvisions}, query)
 	require.NoError(t, err)
 
+	_, err = querier(ctx, []string{types.QueryBlockProvision}, query)
+	require.NoError(t, err)
+
 	_, err = querier(ctx, []string{"foo"}, query)
 	require.Error(t, err)
 }
@@ -78,3 +81,19 @@ func TestQueryAnnualProvisions(t *testing.T) {
 
 	require.Equal(t, app.MintKeeper.GetMinter(ctx).AnnualProvisions, annualProvisions)
 }
+
+func TestQueryBlockProvision(t *testing.T) {
+	app, ctx := createTestApp(true)
+	querier := keep.NewQuerier(app.MintKeeper)
+
+	var provision sdk.Coin
+
+	res, sdkErr := querier(ctx, []string{types.QueryBlockProvision}, abci.RequestQuery{})
+	require.NoError(t, sdkErr)
+
+	err := app.Codec().UnmarshalJSON(res, &provision)
+	require.NoError(t, err)
+
+	params := app.MintKeeper.GetParams(ctx)
+	require.Equal(t, app.MintKeeper.GetMinter(ctx).BlockProvision(params), provision)
+}
@@ -2,9 +2,11 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint    = ModuleName
+	EventTypeBurnFee = "burn_fee"
 
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyFeeBurnRate      = "fee_burn_rate"
 )
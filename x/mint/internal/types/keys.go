@@ -21,4 +21,5 @@ const (
 	QueryParameters       = "parameters"
 	QueryInflation        = "inflation"
 	QueryAnnualProvisions = "annual_provisions"
+	QueryBlockProvision   = "block_provision"
 )
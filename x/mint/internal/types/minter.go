@@ -46,6 +46,17 @@ func ValidateMinter(minter Minter) error {
 	return nil
 }
 
+// InflationCalculationFn defines the function computing the inflation rate of
+// the next block from the current minter, the params and the bonded ratio.
+// Applications can supply their own to implement a custom issuance curve.
+type InflationCalculationFn func(ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec
+
+// DefaultInflationCalculationFn is the default InflationCalculationFn, which
+// adjusts the inflation rate towards the bonded ratio goal.
+func DefaultInflationCalculationFn(_ sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec {
+	return minter.NextInflationRate(params, bondedRatio)
+}
+
 // NextInflationRate returns the new inflation rate for the next hour.
 func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec) sdk.Dec {
 	// The target annual inflation rate is recalculated for each previsions cycle. The
@@ -54,6 +54,16 @@ func TestNextInflation(t *testing.T) {
 	}
 }
 
+func TestDefaultInflationCalculationFn(t *testing.T) {
+	minter := DefaultInitialMinter()
+	params := DefaultParams()
+
+	for _, bondedRatio := range []sdk.Dec{sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1), sdk.OneDec()} {
+		inflation := DefaultInflationCalculationFn(sdk.Context{}, minter, params, bondedRatio)
+		require.True(t, inflation.Equal(minter.NextInflationRate(params, bondedRatio)))
+	}
+}
+
 func TestBlockProvision(t *testing.T) {
 	minter := InitialMinter(sdk.NewDecWithPrec(1, 1))
 	params := DefaultParams()
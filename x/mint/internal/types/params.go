@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -15,6 +16,7 @@ var (
 	KeyInflationMin        = []byte("InflationMin")
 	KeyGoalBonded          = []byte("GoalBonded")
 	KeyBlocksPerYear       = []byte("BlocksPerYear")
+	KeyFeeBurnRate         = []byte("FeeBurnRate")
 )
 
 // mint parameters
@@ -25,6 +27,7 @@ type Params struct {
 	InflationMin        sdk.Dec `json:"inflation_min" yaml:"inflation_min"`                 // minimum inflation rate
 	GoalBonded          sdk.Dec `json:"goal_bonded" yaml:"goal_bonded"`                     // goal of percent bonded atoms
 	BlocksPerYear       uint64  `json:"blocks_per_year" yaml:"blocks_per_year"`             // expected blocks per year
+	FeeBurnRate         sdk.Dec `json:"fee_burn_rate" yaml:"fee_burn_rate"`                 // fraction of collected fees burned each block
 }
 
 // ParamTable for minting module.
@@ -33,7 +36,7 @@ func ParamKeyTable() params.KeyTable {
 }
 
 func NewParams(mintDenom string, inflationRateChange, inflationMax,
-	inflationMin, goalBonded sdk.Dec, blocksPerYear uint64) Params {
+	inflationMin, goalBonded sdk.Dec, blocksPerYear uint64, feeBurnRate sdk.Dec) Params {
 
 	return Params{
 		MintDenom:           mintDenom,
@@ -42,6 +45,7 @@ func NewParams(mintDenom string, inflationRateChange, inflationMax,
 		InflationMin:        inflationMin,
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
+		FeeBurnRate:         feeBurnRate,
 	}
 }
 
@@ -54,6 +58,7 @@ func DefaultParams() Params {
 		InflationMin:        sdk.NewDecWithPrec(7, 2),
 		GoalBonded:          sdk.NewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		FeeBurnRate:         sdk.ZeroDec(),
 	}
 }
 
@@ -68,6 +73,9 @@ func ValidateParams(params Params) error {
 	if params.InflationMax.LT(params.InflationMin) {
 		return fmt.Errorf("mint parameter Max inflation must be greater than or equal to min inflation")
 	}
+	if params.FeeBurnRate.IsNegative() || params.FeeBurnRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint parameter FeeBurnRate must be between 0 and 1, is %s", params.FeeBurnRate.String())
+	}
 	if params.MintDenom == "" {
 		return fmt.Errorf("mint parameter MintDenom can't be an empty string")
 	}
@@ -82,20 +90,76 @@ func (p Params) String() string {
   Inflation Min:          %s
   Goal Bonded:            %s
   Blocks Per Year:        %d
+  Fee Burn Rate:          %s
 `,
 		p.MintDenom, p.InflationRateChange, p.InflationMax,
-		p.InflationMin, p.GoalBonded, p.BlocksPerYear,
+		p.InflationMin, p.GoalBonded, p.BlocksPerYear, p.FeeBurnRate,
 	)
 }
 
 // Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		{Key: KeyMintDenom, Value: &p.MintDenom},
-		{Key: KeyInflationRateChange, Value: &p.InflationRateChange},
-		{Key: KeyInflationMax, Value: &p.InflationMax},
-		{Key: KeyInflationMin, Value: &p.InflationMin},
-		{Key: KeyGoalBonded, Value: &p.GoalBonded},
-		{Key: KeyBlocksPerYear, Value: &p.BlocksPerYear},
+		params.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
+		params.NewParamSetPair(KeyInflationRateChange, &p.InflationRateChange, validateInflationRateChange),
+		params.NewParamSetPair(KeyInflationMax, &p.InflationMax, validateInflationMax),
+		params.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
+		params.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
+		params.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		params.NewParamSetPair(KeyFeeBurnRate, &p.FeeBurnRate, validateFeeBurnRate),
+	}
+}
+
+func validateMintDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if strings.TrimSpace(v) == "" {
+		return fmt.Errorf("mint parameter MintDenom can't be an empty string")
+	}
+	return nil
+}
+
+// validateFraction checks that a mint parameter is a decimal between 0 and 1.
+func validateFraction(name string, i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint parameter %s must be between 0 and 1, is %s", name, v)
 	}
+	return nil
+}
+
+func validateInflationRateChange(i interface{}) error {
+	return validateFraction("InflationRateChange", i)
+}
+
+func validateInflationMax(i interface{}) error {
+	return validateFraction("InflationMax", i)
+}
+
+func validateInflationMin(i interface{}) error {
+	return validateFraction("InflationMin", i)
+}
+
+func validateGoalBonded(i interface{}) error {
+	return validateFraction("GoalBonded", i)
+}
+
+func validateFeeBurnRate(i interface{}) error {
+	return validateFraction("FeeBurnRate", i)
+}
+
+func validateBlocksPerYear(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("mint parameter BlocksPerYear must be positive")
+	}
+	return nil
 }
@@ -87,6 +87,16 @@ func (AppModuleSimulation) RandomizedParams(r *rand.Rand) []sim.ParamChange {
 	return simulation.ParamChanges(r)
 }
 
+// ProposalContents doesn't return any content functions for governance proposals.
+func (AppModuleSimulation) ProposalContents(_ module.SimulationState) []sim.WeightedProposalContent {
+	return nil
+}
+
+// WeightedOperations doesn't return any mint module operation.
+func (AppModuleSimulation) WeightedOperations(_ module.SimulationState) []sim.WeightedOperation {
+	return nil
+}
+
 //____________________________________________________________________________
 
 // AppModule implements an application module for the mint module.
@@ -94,15 +104,23 @@ type AppModule struct {
 	AppModuleBasic
 	AppModuleSimulation
 
-	keeper Keeper
+	keeper        Keeper
+	inflationCalc InflationCalculationFn
 }
 
-// NewAppModule creates a new AppModule object
-func NewAppModule(keeper Keeper) AppModule {
+// NewAppModule creates a new AppModule object. The inflation rate is computed
+// by the given InflationCalculationFn, or by DefaultInflationCalculationFn if
+// it is nil.
+func NewAppModule(keeper Keeper, ic InflationCalculationFn) AppModule {
+	if ic == nil {
+		ic = DefaultInflationCalculationFn
+	}
+
 	return AppModule{
 		AppModuleBasic:      AppModuleBasic{},
 		AppModuleSimulation: AppModuleSimulation{},
 		keeper:              keeper,
+		inflationCalc:       ic,
 	}
 }
 
@@ -148,7 +166,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 
 // BeginBlock returns the begin blocker for the mint module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
-	BeginBlocker(ctx, am.keeper)
+	BeginBlocker(ctx, am.keeper, am.inflationCalc)
 }
 
 // EndBlock returns the end blocker for the mint module. It returns no validator
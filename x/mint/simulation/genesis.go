@@ -20,6 +20,7 @@ const (
 	InflationMax        = "inflation_max"
 	InflationMin        = "inflation_min"
 	GoalBonded          = "goal_bonded"
+	FeeBurnRate         = "fee_burn_rate"
 )
 
 // GenInflation randomized Inflation
@@ -47,6 +48,15 @@ func GenGoalBonded(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(67, 2)
 }
 
+// GenFeeBurnRate randomized FeeBurnRate
+func GenFeeBurnRate(r *rand.Rand) sdk.Dec {
+	// keep fee burning disabled for half of the runs
+	if r.Intn(2) == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -81,9 +91,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
 	)
 
-	mintDenom := sdk.DefaultBondDenom
+	var feeBurnRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeBurnRate, &feeBurnRate, simState.Rand,
+		func(r *rand.Rand) { feeBurnRate = GenFeeBurnRate(r) },
+	)
+
+	mintDenom := simState.BondDenom
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
-	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear)
+	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear, feeBurnRate)
 
 	mintGenesis := types.NewGenesisState(types.InitialMinter(inflation), params)
 
@@ -15,6 +15,7 @@ const (
 	keyInflationMax        = "InflationMax"
 	keyInflationMin        = "InflationMin"
 	keyGoalBonded          = "GoalBonded"
+	keyFeeBurnRate         = "FeeBurnRate"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -41,5 +42,10 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenGoalBonded(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyFeeBurnRate, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenFeeBurnRate(r))
+			},
+		),
 	}
 }
@@ -47,6 +47,7 @@ var (
 
 type (
 	ParamSetPair            = subspace.ParamSetPair
+	ValueValidatorFn        = subspace.ValueValidatorFn
 	ParamSetPairs           = subspace.ParamSetPairs
 	ParamSet                = subspace.ParamSet
 	Subspace                = subspace.Subspace
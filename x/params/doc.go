@@ -84,14 +84,25 @@ be able to be passed to SetParamSet.
 	}
 
 	// Implements params.ParamSet
-	// KeyValuePairs must return the list of (ParamKey, PointerToTheField)
-	func (p *MyParams) KeyValuePairs() params.KeyValuePairs {
-		return params.KeyFieldPairs {
-			{KeyParameter1, &p.Parameter1},
-			{KeyParameter2, &p.Parameter2},
+	// ParamSetPairs must return the list of (ParamKey, PointerToTheField, ValidatorFn)
+	func (p *MyParams) ParamSetPairs() params.ParamSetPairs {
+		return params.ParamSetPairs{
+			params.NewParamSetPair(KeyParameter1, &p.Parameter1, validateParameter1),
+			params.NewParamSetPair(KeyParameter2, &p.Parameter2, nil),
 		}
 	}
 
+	func validateParameter1(i interface{}) error {
+		v, ok := i.(uint64)
+		if !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+		if v == 0 {
+			return fmt.Errorf("parameter 1 must be positive")
+		}
+		return nil
+	}
+
 	func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 		k.ps.SetParamSet(ctx, &data.params)
 	}
@@ -99,6 +110,10 @@ be able to be passed to SetParamSet.
 The method is pointer receiver because there could be a case that we read from
 the store and set the result to the struct.
 
+The validator function of a pair, if not nil, is called with the new value of
+the parameter whenever it is updated externally, e.g. by a parameter change
+proposal, so that an invalid value is rejected instead of being stored.
+
 Master Keeper Usage:
 
 Keepers that require master permission to the paramstore, such as gov, can take
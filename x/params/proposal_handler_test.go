@@ -1,6 +1,7 @@
 package params_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -43,11 +44,22 @@ type testParams struct {
 
 func (tp *testParams) ParamSetPairs() subspace.ParamSetPairs {
 	return subspace.ParamSetPairs{
-		{Key: []byte(keyMaxValidators), Value: &tp.MaxValidators},
-		{Key: []byte(keySlashingRate), Value: &tp.SlashingRate},
+		subspace.NewParamSetPair([]byte(keyMaxValidators), &tp.MaxValidators, validateMaxValidators),
+		subspace.NewParamSetPair([]byte(keySlashingRate), &tp.SlashingRate, nil),
 	}
 }
 
+func validateMaxValidators(i interface{}) error {
+	v, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max validators must be positive")
+	}
+	return nil
+}
+
 func testProposal(changes ...params.ParamChange) params.ParameterChangeProposal {
 	return params.NewParameterChangeProposal(
 		"Test",
@@ -106,6 +118,23 @@ func TestProposalHandlerFailed(t *testing.T) {
 	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
 }
 
+func TestProposalHandlerInvalidValue(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.NoError(t, hdlr(input.ctx, testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "1"))))
+
+	tp := testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "0"))
+	require.Error(t, hdlr(input.ctx, tp))
+
+	var param uint16
+	ss.Get(input.ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(1), param)
+}
+
 func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	input := newTestInput(t)
 	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
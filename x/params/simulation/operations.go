@@ -1,53 +1,136 @@
 package simulation
 
 import (
+	"fmt"
 	"math/rand"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	govsim "github.com/cosmos/cosmos-sdk/x/gov/simulation"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
-	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
+// Simulation operation weights constants
+const (
+	OpWeightSubmitParamChangeProposal = "op_weight_submit_param_change_proposal"
+	OpWeightParamChange               = "op_weight_param_change"
+
+	DefaultWeightParamChangeProposal = 20
+)
+
+// OpParamChange defines the name of the simulated parameter changes operation
+const OpParamChange = "param_change"
+
+// Keeper defines the params keeper used to apply the simulated parameter
+// changes
+type Keeper interface {
+	GetSubspace(name string) (subspace.Subspace, bool)
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, k Keeper,
+	paramChangePool []simulation.ParamChange) simulation.WeightedOperations {
+
+	var weightParamChange int
+	appParams.GetOrGenerate(cdc, OpWeightParamChange, &weightParamChange, nil,
+		func(_ *rand.Rand) {
+			weightParamChange = 5
+		},
+	)
+
+	return simulation.WeightedOperations{
+		{Weight: weightParamChange, Op: SimulateParamChange(k, paramChangePool)},
+	}
+}
+
+// SimulateParamChange applies random valid parameter changes directly through
+// the params keeper, without going through a governance proposal, so that the
+// modules get exercised under shifting parameters within a single run.
+func SimulateParamChange(k Keeper, paramChangePool []simulation.ParamChange) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		if len(paramChangePool) == 0 {
+			return simulation.NoOpMsg(types.ModuleName, OpParamChange, "no parameter to change"), nil, nil
+		}
+
+		paramChanges := randomParamChanges(r, paramChangePool)
+		keys := make([]string, len(paramChanges))
+
+		for i, c := range paramChanges {
+			ss, ok := k.GetSubspace(c.Subspace)
+			if !ok {
+				return simulation.NoOpMsg(types.ModuleName, OpParamChange, ""), nil, fmt.Errorf("unknown subspace %s", c.Subspace)
+			}
+
+			var err error
+			if len(c.Subkey) == 0 {
+				err = ss.Update(ctx, []byte(c.Key), []byte(c.Value))
+			} else {
+				err = ss.UpdateWithSubkey(ctx, []byte(c.Key), []byte(c.Subkey), []byte(c.Value))
+			}
+
+			if err != nil {
+				return simulation.NoOpMsg(types.ModuleName, OpParamChange, ""), nil,
+					fmt.Errorf("failed to set parameter %s/%s to %s: %v", c.Subspace, c.Key, c.Value, err)
+			}
+
+			keys[i] = fmt.Sprintf("%s/%s", c.Subspace, c.Key)
+		}
+
+		return simulation.NewOperationMsgBasic(types.ModuleName, OpParamChange, strings.Join(keys, ","), true, nil), nil, nil
+	}
+}
+
 // SimulateParamChangeProposalContent returns random parameter change content.
 // It will generate a ParameterChangeProposal object with anywhere between 1 and
 // the total amount of defined parameters changes, all of which have random valid values.
-func SimulateParamChangeProposalContent(paramChangePool []simulation.ParamChange) govsim.ContentSimulator {
+func SimulateParamChangeProposalContent(paramChangePool []simulation.ParamChange) simulation.ContentSimulatorFn {
 	return func(r *rand.Rand, _ sdk.Context, _ []simulation.Account) govtypes.Content {
 
-		lenParamChange := len(paramChangePool)
-		if lenParamChange == 0 {
+		if len(paramChangePool) == 0 {
 			panic("param changes array is empty")
 		}
 
-		numChanges := simulation.RandIntBetween(r, 1, lenParamChange)
-		paramChanges := make([]params.ParamChange, numChanges)
+		return types.NewParameterChangeProposal(
+			simulation.RandStringOfLength(r, 140),  // title
+			simulation.RandStringOfLength(r, 5000), // description
+			randomParamChanges(r, paramChangePool), // set of changes
+		)
+	}
+}
 
-		// map from key to empty struct; used only for look-up of the keys of the
-		// parameters that are already in the random set of changes.
-		paramChangesKeys := make(map[string]struct{})
+// randomParamChanges returns anywhere between 1 and the total amount of
+// defined distinct parameters changes, all of which have random valid values.
+func randomParamChanges(r *rand.Rand, paramChangePool []simulation.ParamChange) []types.ParamChange {
+	numChanges := simulation.RandIntBetween(r, 1, len(paramChangePool))
+	paramChanges := make([]types.ParamChange, numChanges)
 
-		for i := 0; i < numChanges; i++ {
-			spc := paramChangePool[r.Intn(len(paramChangePool))]
+	// map from key to empty struct; used only for look-up of the keys of the
+	// parameters that are already in the random set of changes.
+	paramChangesKeys := make(map[string]struct{})
 
-			// do not include duplicate parameter changes for a given subspace/key
-			_, ok := paramChangesKeys[spc.ComposedKey()]
-			for ok {
-				spc = paramChangePool[r.Intn(len(paramChangePool))]
-				_, ok = paramChangesKeys[spc.ComposedKey()]
-			}
+	for i := 0; i < numChanges; i++ {
+		spc := paramChangePool[r.Intn(len(paramChangePool))]
 
-			// add a new distinct parameter to the set of changes and register the key
-			// to avoid further duplicates
-			paramChangesKeys[spc.ComposedKey()] = struct{}{}
-			paramChanges[i] = params.NewParamChangeWithSubkey(spc.Subspace, spc.Key, spc.Subkey, spc.SimValue(r))
+		// do not include duplicate parameter changes for a given subspace/key
+		_, ok := paramChangesKeys[spc.ComposedKey()]
+		for ok {
+			spc = paramChangePool[r.Intn(len(paramChangePool))]
+			_, ok = paramChangesKeys[spc.ComposedKey()]
 		}
 
-		return params.NewParameterChangeProposal(
-			simulation.RandStringOfLength(r, 140),  // title
-			simulation.RandStringOfLength(r, 5000), // description
-			paramChanges,                           // set of changes
-		)
+		// add a new distinct parameter to the set of changes and register the key
+		// to avoid further duplicates
+		paramChangesKeys[spc.ComposedKey()] = struct{}{}
+		paramChanges[i] = types.NewParamChangeWithSubkey(spc.Subspace, spc.Key, spc.Subkey, spc.SimValue(r))
 	}
+
+	return paramChanges
 }
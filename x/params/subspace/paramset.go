@@ -1,14 +1,21 @@
 package subspace
 
-// ParamSetPair is used for associating paramsubspace key and field of param structs
+// ValueValidatorFn validates a parameter value, given by value and not by
+// pointer, returning an error if it is invalid.
+type ValueValidatorFn func(value interface{}) error
+
+// ParamSetPair is used for associating paramsubspace key and field of param
+// structs, along with an optional function validating the field's values.
 type ParamSetPair struct {
-	Key   []byte
-	Value interface{}
+	Key         []byte
+	Value       interface{}
+	ValidatorFn ValueValidatorFn
 }
 
-// NewParamSetPair creates a new ParamSetPair instance
-func NewParamSetPair(key []byte, value interface{}) ParamSetPair {
-	return ParamSetPair{key, value}
+// NewParamSetPair creates a new ParamSetPair instance. The validator function
+// may be nil if the parameter values don't need to be validated.
+func NewParamSetPair(key []byte, value interface{}, vfn ValueValidatorFn) ParamSetPair {
+	return ParamSetPair{key, value, vfn}
 }
 
 // ParamSetPairs Slice of KeyFieldPair
@@ -177,9 +177,20 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
 
 }
 
+// Validate validates a parameter value with the validator function registered
+// for its key, if any. It panics if the key isn't registered.
+func (s Subspace) Validate(key []byte, param interface{}) error {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		panic(fmt.Sprintf("parameter %s not registered", string(key)))
+	}
+
+	return attr.validate(param)
+}
+
 // Update stores raw parameter bytes. It returns error if the stored parameter
-// has a different type from the input. It also sets to the transient store to
-// record change.
+// has a different type from the input, or if the validator function registered
+// for the key rejects it. It also sets to the transient store to record change.
 func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 	attr, ok := s.table.m[string(key)]
 	if !ok {
@@ -194,6 +205,10 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 		return err
 	}
 
+	if err := attr.validate(dest); err != nil {
+		return err
+	}
+
 	s.Set(ctx, key, dest)
 
 	// TODO: Remove; seems redundant as Set already does this.
@@ -240,6 +255,10 @@ func (s Subspace) UpdateWithSubkey(ctx sdk.Context, key []byte, subkey []byte, p
 		return err
 	}
 
+	if err := attr.validate(dest); err != nil {
+		return err
+	}
+
 	s.SetWithSubkey(ctx, key, subkey, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(concatkey, []byte{})
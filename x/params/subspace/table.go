@@ -5,7 +5,8 @@ import (
 )
 
 type attribute struct {
-	ty reflect.Type
+	ty  reflect.Type
+	vfn ValueValidatorFn
 }
 
 // KeyTable subspaces appropriate type for each parameter key
@@ -43,6 +44,13 @@ func isAlphaNumeric(key []byte) bool {
 
 // Register single key-type pair
 func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
+	return t.RegisterTypeWithValidator(key, ty, nil)
+}
+
+// RegisterTypeWithValidator registers a single key-type pair along with the
+// function validating the values of the parameter when it is updated, e.g. by
+// a parameter change proposal. The function may be nil.
+func (t KeyTable) RegisterTypeWithValidator(key []byte, ty interface{}, vfn ValueValidatorFn) KeyTable {
 	if len(key) == 0 {
 		panic("cannot register empty key")
 	}
@@ -62,7 +70,8 @@ func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
 	}
 
 	t.m[keystr] = attribute{
-		ty: rty,
+		ty:  rty,
+		vfn: vfn,
 	}
 
 	return t
@@ -71,7 +80,7 @@ func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
 // Register multiple pairs from ParamSet
 func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	for _, kvp := range ps.ParamSetPairs() {
-		t = t.RegisterType(kvp.Key, kvp.Value)
+		t = t.RegisterTypeWithValidator(kvp.Key, kvp.Value, kvp.ValidatorFn)
 	}
 	return t
 }
@@ -85,3 +94,13 @@ func (t KeyTable) maxKeyLength() (res int) {
 	}
 	return
 }
+
+// validate runs the validator function of the attribute, if any, on the given
+// value, which is indirected if it is a pointer.
+func (attr attribute) validate(value interface{}) error {
+	if attr.vfn == nil {
+		return nil
+	}
+
+	return attr.vfn(reflect.Indirect(reflect.ValueOf(value)).Interface())
+}
@@ -13,8 +13,8 @@ type testparams struct {
 
 func (tp *testparams) ParamSetPairs() ParamSetPairs {
 	return ParamSetPairs{
-		{[]byte("i"), &tp.i},
-		{[]byte("b"), &tp.b},
+		NewParamSetPair([]byte("i"), &tp.i, nil),
+		NewParamSetPair([]byte("b"), &tp.b, nil),
 	}
 }
 
@@ -0,0 +1,48 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/permissions/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/permissions/internal/types
+package permissions
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/permissions/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/permissions/internal/types"
+)
+
+const (
+	ModuleName            = types.ModuleName
+	QuerierRoute          = types.QuerierRoute
+	DefaultParamspace     = types.DefaultParamspace
+	QueryParameters       = types.QueryParameters
+	DefaultCodespace      = types.DefaultCodespace
+	CodeAddressDenied     = types.CodeAddressDenied
+	CodeAddressNotAllowed = types.CodeAddressNotAllowed
+)
+
+var (
+	// functions aliases
+	NewKeeper            = keeper.NewKeeper
+	NewQuerier           = keeper.NewQuerier
+	RegisterCodec        = types.RegisterCodec
+	ErrAddressDenied     = types.ErrAddressDenied
+	ErrAddressNotAllowed = types.ErrAddressNotAllowed
+	NewGenesisState      = types.NewGenesisState
+	DefaultGenesisState  = types.DefaultGenesisState
+	NewMsgAllowlist      = types.NewMsgAllowlist
+	ParamKeyTable        = types.ParamKeyTable
+	NewParams            = types.NewParams
+	DefaultParams        = types.DefaultParams
+
+	// variable aliases
+	ModuleCdc     = types.ModuleCdc
+	KeyAllowlists = types.KeyAllowlists
+	KeyDenylist   = types.KeyDenylist
+)
+
+type (
+	Keeper       = keeper.Keeper
+	GenesisState = types.GenesisState
+	MsgAllowlist = types.MsgAllowlist
+	Params       = types.Params
+)
@@ -0,0 +1,28 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/permissions/internal/keeper"
+)
+
+// MsgPermissionDecorator rejects the txs with a msg signed by an address on the
+// denylist of the permissions module, or by an address missing from one of its
+// allowlists matching the msg.
+// Call next AnteHandler if all the msgs are permitted
+type MsgPermissionDecorator struct {
+	k keeper.Keeper
+}
+
+func NewMsgPermissionDecorator(k keeper.Keeper) MsgPermissionDecorator {
+	return MsgPermissionDecorator{k: k}
+}
+
+func (mpd MsgPermissionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		if err := mpd.k.CheckMsgPermissions(ctx, msg); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
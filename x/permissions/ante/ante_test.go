@@ -0,0 +1,63 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/permissions"
+	"github.com/cosmos/cosmos-sdk/x/permissions/ante"
+)
+
+func TestMsgPermissionDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewMsgPermissionDecorator(app.PermsKeeper))
+
+	priv1, _, addr1 := authtypes.KeyTestPubAddr()
+	priv2, _, addr2 := authtypes.KeyTestPubAddr()
+	priv3, _, addr3 := authtypes.KeyTestPubAddr()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	newTx := func(priv crypto.PrivKey, from sdk.AccAddress) sdk.Tx {
+		msgs := []sdk.Msg{bank.NewMsgSend(from, addr1, coins)}
+		return authtypes.NewTestTx(ctx, msgs, []crypto.PrivKey{priv}, []uint64{0}, []uint64{0}, authtypes.NewTestStdFee())
+	}
+
+	// only addr1 may send coins and addr3 may not submit any msg
+	app.PermsKeeper.SetParams(ctx, permissions.NewParams(
+		[]permissions.MsgAllowlist{permissions.NewMsgAllowlist(bank.RouterKey, "send", []sdk.AccAddress{addr1})},
+		[]sdk.AccAddress{addr3},
+	))
+
+	_, err := antehandler(ctx, newTx(priv1, addr1), false)
+	require.NoError(t, err)
+
+	_, err = antehandler(ctx, newTx(priv2, addr2), false)
+	require.Error(t, err)
+	require.Equal(t, permissions.CodeAddressNotAllowed, sdk.ConvertError(err).Code())
+
+	_, err = antehandler(ctx, newTx(priv3, addr3), false)
+	require.Error(t, err)
+	require.Equal(t, permissions.CodeAddressDenied, sdk.ConvertError(err).Code())
+
+	// the allowlists are managed with parameter change proposals
+	subspace, ok := app.ParamsKeeper.GetSubspace(permissions.DefaultParamspace)
+	require.True(t, ok)
+
+	value := `[{"route":"bank","msg_type":"send","addresses":["` + addr1.String() + `","` + addr2.String() + `"]}]`
+	require.NoError(t, subspace.Update(ctx, permissions.KeyAllowlists, []byte(value)))
+	_, err = antehandler(ctx, newTx(priv2, addr2), false)
+	require.NoError(t, err)
+
+	// invalid allowlists are rejected
+	value = `[{"route":"","msg_type":"send","addresses":[]}]`
+	require.Error(t, subspace.Update(ctx, permissions.KeyAllowlists, []byte(value)))
+}
@@ -0,0 +1,50 @@
+/*
+Package permissions restricts who may submit msgs on permissioned chains, such
+as consortium chains where only approved members may run a validator.
+
+The restrictions are the module's parameters, so that they are managed through
+governance with parameter change proposals:
+
+	- Allowlists restrict the msgs of a route, or only those of one type within
+	the route, to the listed addresses. A msg matching several allowlists must
+	be signed by addresses on all of them, and an allowlist without addresses
+	disables the msgs it matches.
+	- Denylist lists the addresses that may not submit any msg.
+
+For instance, the following allowlist only lets two addresses create a
+validator:
+
+	{
+	  "route": "staking",
+	  "msg_type": "create_validator",
+	  "addresses": ["cosmos1h9z...", "cosmos1skjw..."]
+	}
+
+Enforcing the Restrictions
+
+The MsgPermissionDecorator from the permissions ante package rejects the txs
+with a msg signed by an address on the denylist or missing from an allowlist
+matching the msg. Applications add it to their AnteHandler after the
+ValidateBasicDecorator of the auth module:
+
+	sdk.ChainAnteDecorators(
+		authante.NewSetUpContextDecorator(),
+		authante.NewMempoolFeeDecorator(),
+		authante.NewValidateBasicDecorator(),
+		permissionsante.NewMsgPermissionDecorator(app.PermsKeeper),
+		...
+	)
+
+The decorator only sees the msgs of the txs, while other modules route msgs
+too, e.g. the authz module executes msgs on behalf of a granter. Applications
+therefore also add the middleware returned by NewHandlerMiddleware to every
+route of their router, which checks each msg before it is handled:
+
+	app.Router().AddMiddleware(permissions.NewHandlerMiddleware(app.PermsKeeper))
+
+The genesis state of the module should be initialized before the one of the
+genutil module, so that the genesis txs are subject to the allowlists too. A
+chain adding the module without a genesis state for it restricts nothing until
+its parameters are set.
+*/
+package permissions
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/permissions/internal/types"
+)
+
+// Keeper of the permissions module, which reads the allowlists and denylist
+// from its parameters so that they are managed through governance.
+type Keeper struct {
+	paramSpace params.Subspace
+	codespace  sdk.CodespaceType
+}
+
+// NewKeeper creates a new Keeper object
+func NewKeeper(paramSpace params.Subspace, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:  codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the permissions module's parameters. The parameters that
+// are not set, e.g. on a chain that added the module without a genesis state
+// for it, default to empty lists.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetIfExists(ctx, types.KeyAllowlists, &params.Allowlists)
+	k.paramSpace.GetIfExists(ctx, types.KeyDenylist, &params.Denylist)
+	return params
+}
+
+// SetParams sets the permissions module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// CheckMsgPermissions returns an error if a signer of the msg is on the
+// denylist, or is missing from any of the allowlists matching the msg.
+func (k Keeper) CheckMsgPermissions(ctx sdk.Context, msg sdk.Msg) error {
+	params := k.GetParams(ctx)
+
+	for _, signer := range msg.GetSigners() {
+		for _, denied := range params.Denylist {
+			if denied.Equals(signer) {
+				return types.ErrAddressDenied(k.codespace, signer)
+			}
+		}
+
+		for _, allowlist := range params.Allowlists {
+			if allowlist.Matches(msg) && !allowlist.Allows(signer) {
+				return types.ErrAddressNotAllowed(k.codespace, signer, msg)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/permissions/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/permissions/internal/types"
+)
+
+var (
+	addr1 = sdk.AccAddress([]byte("addr1_______________"))
+	addr2 = sdk.AccAddress([]byte("addr2_______________"))
+	addr3 = sdk.AccAddress([]byte("addr3_______________"))
+)
+
+func createTestApp() (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	return app, ctx
+}
+
+func TestCheckMsgPermissions(t *testing.T) {
+	app, ctx := createTestApp()
+	k := app.PermsKeeper
+
+	// nothing is restricted by default
+	params := k.GetParams(ctx)
+	require.Empty(t, params.Allowlists)
+	require.Empty(t, params.Denylist)
+	require.NoError(t, k.CheckMsgPermissions(ctx, sdk.NewTestMsg(addr1, addr2, addr3)))
+
+	msg := sdk.NewTestMsg(addr1)
+	k.SetParams(ctx, types.NewParams(
+		[]types.MsgAllowlist{
+			types.NewMsgAllowlist(msg.Route(), "", []sdk.AccAddress{addr1, addr2}),
+			types.NewMsgAllowlist(msg.Route(), msg.Type(), []sdk.AccAddress{addr1}),
+			types.NewMsgAllowlist("other", "", nil),
+		},
+		[]sdk.AccAddress{addr3},
+	))
+
+	testCases := []struct {
+		name    string
+		signers []sdk.AccAddress
+		code    sdk.CodeType
+	}{
+		{"on every allowlist", []sdk.AccAddress{addr1}, sdk.CodeOK},
+		{"missing from the msg type allowlist", []sdk.AccAddress{addr2}, types.CodeAddressNotAllowed},
+		{"one signer not allowed", []sdk.AccAddress{addr1, addr2}, types.CodeAddressNotAllowed},
+		{"denied", []sdk.AccAddress{addr3}, types.CodeAddressDenied},
+	}
+
+	for _, tc := range testCases {
+		err := k.CheckMsgPermissions(ctx, sdk.NewTestMsg(tc.signers...))
+		if tc.code == sdk.CodeOK {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+			require.Equal(t, tc.code, sdk.ConvertError(err).Code(), tc.name)
+		}
+	}
+}
+
+func TestGetParamsNotSet(t *testing.T) {
+	app, ctx := createTestApp()
+
+	// a chain adding the module without a genesis state restricts nothing
+	k := keeper.NewKeeper(app.ParamsKeeper.Subspace("unset"), types.DefaultCodespace)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+	require.NoError(t, k.CheckMsgPermissions(ctx, sdk.NewTestMsg(addr1)))
+}
+
+func TestQueryParams(t *testing.T) {
+	app, ctx := createTestApp()
+	querier := keeper.NewQuerier(app.PermsKeeper)
+
+	params := types.NewParams(
+		[]types.MsgAllowlist{types.NewMsgAllowlist("staking", "create_validator", []sdk.AccAddress{addr1})},
+		[]sdk.AccAddress{addr2},
+	)
+	app.PermsKeeper.SetParams(ctx, params)
+
+	bz, err := querier(ctx, []string{types.QueryParameters}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var res types.Params
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &res))
+	require.Equal(t, params, res)
+
+	_, err = querier(ctx, []string{"other"}, abci.RequestQuery{})
+	require.Error(t, err)
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc defines the permissions module's codec.
+var ModuleCdc = codec.New()
+
+// RegisterCodec registers the types of the permissions module. The module has
+// no msgs nor interfaces, so there is nothing to register.
+func RegisterCodec(_ *codec.Codec) {}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	ModuleCdc.Seal()
+}
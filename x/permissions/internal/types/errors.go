@@ -0,0 +1,37 @@
+// DONTCOVER
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Error codes specific to the permissions module
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeAddressDenied     sdk.CodeType = 1
+	CodeAddressNotAllowed sdk.CodeType = 2
+)
+
+// ErrAddressDenied returns a typed ABCI error for a msg signed by an address on
+// the denylist.
+func ErrAddressDenied(codespace sdk.CodespaceType, addr sdk.AccAddress) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeAddressDenied),
+		fmt.Sprintf("address %s is denied", addr),
+	)
+}
+
+// ErrAddressNotAllowed returns a typed ABCI error for a msg signed by an
+// address missing from an allowlist of the msg.
+func ErrAddressNotAllowed(codespace sdk.CodespaceType, addr sdk.AccAddress, msg sdk.Msg) error {
+	return sdkerrors.New(
+		string(codespace),
+		uint32(CodeAddressNotAllowed),
+		fmt.Sprintf("address %s is not allowed to submit %s/%s msgs", addr, msg.Route(), msg.Type()),
+	)
+}
@@ -0,0 +1,18 @@
+package types
+
+const (
+	// ModuleName is the name of the permissions module
+	ModuleName = "permissions"
+
+	// QuerierRoute is the querier route for the permissions module
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace is the default parameter namespace of the permissions
+	// module
+	DefaultParamspace = ModuleName
+)
+
+// Querier routes for the permissions module
+const (
+	QueryParameters = "parameters"
+)
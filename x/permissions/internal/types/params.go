@@ -0,0 +1,163 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter store keys
+var (
+	KeyAllowlists = []byte("Allowlists")
+	KeyDenylist   = []byte("Denylist")
+)
+
+// MsgAllowlist restricts the msgs of a route, or only those of one type within
+// the route if MsgType is set, to the listed addresses. An allowlist without
+// addresses disables the msgs it matches.
+type MsgAllowlist struct {
+	Route     string           `json:"route" yaml:"route"`
+	MsgType   string           `json:"msg_type" yaml:"msg_type"`
+	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+}
+
+// NewMsgAllowlist returns an allowlist of the msgs of the given route and, if
+// not empty, type.
+func NewMsgAllowlist(route, msgType string, addrs []sdk.AccAddress) MsgAllowlist {
+	return MsgAllowlist{
+		Route:     route,
+		MsgType:   msgType,
+		Addresses: addrs,
+	}
+}
+
+// Matches returns true if the allowlist applies to the msg.
+func (a MsgAllowlist) Matches(msg sdk.Msg) bool {
+	return msg.Route() == a.Route && (a.MsgType == "" || msg.Type() == a.MsgType)
+}
+
+// Allows returns true if the address is on the allowlist.
+func (a MsgAllowlist) Allows(addr sdk.AccAddress) bool {
+	for _, allowed := range a.Addresses {
+		if allowed.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate performs a basic validation of the allowlist.
+func (a MsgAllowlist) Validate() error {
+	if strings.TrimSpace(a.Route) == "" {
+		return fmt.Errorf("allowlist route can't be an empty string")
+	}
+	if err := validateAddresses(a.Addresses); err != nil {
+		return fmt.Errorf("invalid allowlist of %s: %w", a, err)
+	}
+	return nil
+}
+
+func (a MsgAllowlist) String() string {
+	if a.MsgType == "" {
+		return a.Route
+	}
+	return fmt.Sprintf("%s/%s", a.Route, a.MsgType)
+}
+
+// Params defines the parameters of the permissions module.
+type Params struct {
+	Allowlists []MsgAllowlist   `json:"allowlists" yaml:"allowlists"` // allowlists of the restricted msgs
+	Denylist   []sdk.AccAddress `json:"denylist" yaml:"denylist"`     // addresses that may not submit any msg
+}
+
+// ParamKeyTable returns the key table of the permissions module's parameters.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(allowlists []MsgAllowlist, denylist []sdk.AccAddress) Params {
+	return Params{
+		Allowlists: allowlists,
+		Denylist:   denylist,
+	}
+}
+
+// DefaultParams returns the default parameters, which restrict no msg and no
+// address.
+func DefaultParams() Params {
+	return NewParams([]MsgAllowlist{}, []sdk.AccAddress{})
+}
+
+// Validate performs a basic validation of the parameters.
+func (p Params) Validate() error {
+	if err := validateAllowlists(p.Allowlists); err != nil {
+		return err
+	}
+	return validateDenylist(p.Denylist)
+}
+
+func (p Params) String() string {
+	var b strings.Builder
+	b.WriteString("Permissions Params:\n  Allowlists:\n")
+	for _, a := range p.Allowlists {
+		b.WriteString(fmt.Sprintf("    %s: %v\n", a, a.Addresses))
+	}
+	b.WriteString(fmt.Sprintf("  Denylist: %v\n", p.Denylist))
+	return b.String()
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyAllowlists, &p.Allowlists, validateAllowlists),
+		params.NewParamSetPair(KeyDenylist, &p.Denylist, validateDenylist),
+	}
+}
+
+func validateAllowlists(i interface{}) error {
+	v, ok := i.([]MsgAllowlist)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, a := range v {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+		if seen[a.String()] {
+			return fmt.Errorf("duplicate allowlist of %s", a)
+		}
+		seen[a.String()] = true
+	}
+	return nil
+}
+
+func validateDenylist(i interface{}) error {
+	v, ok := i.([]sdk.AccAddress)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := validateAddresses(v); err != nil {
+		return fmt.Errorf("invalid denylist: %w", err)
+	}
+	return nil
+}
+
+// validateAddresses checks that a list holds no empty nor duplicate address.
+func validateAddresses(addrs []sdk.AccAddress) error {
+	seen := make(map[string]bool)
+	for _, addr := range addrs {
+		if addr.Empty() {
+			return fmt.Errorf("empty address")
+		}
+		if seen[addr.String()] {
+			return fmt.Errorf("duplicate address %s", addr)
+		}
+		seen[addr.String()] = true
+	}
+	return nil
+}
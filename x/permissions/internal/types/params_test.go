@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	require.NoError(t, DefaultParams().Validate())
+
+	testCases := []struct {
+		name   string
+		params Params
+		valid  bool
+	}{
+		{"allowlists", NewParams([]MsgAllowlist{
+			NewMsgAllowlist("staking", "create_validator", []sdk.AccAddress{addr1, addr2}),
+			NewMsgAllowlist("staking", "", []sdk.AccAddress{addr1}),
+			NewMsgAllowlist("bank", "", nil),
+		}, []sdk.AccAddress{addr2}), true},
+		{"empty route", NewParams([]MsgAllowlist{NewMsgAllowlist(" ", "send", nil)}, nil), false},
+		{"duplicate allowlist", NewParams([]MsgAllowlist{
+			NewMsgAllowlist("bank", "send", []sdk.AccAddress{addr1}),
+			NewMsgAllowlist("bank", "send", []sdk.AccAddress{addr2}),
+		}, nil), false},
+		{"duplicate allowed address", NewParams([]MsgAllowlist{
+			NewMsgAllowlist("bank", "", []sdk.AccAddress{addr1, addr1}),
+		}, nil), false},
+		{"empty allowed address", NewParams([]MsgAllowlist{
+			NewMsgAllowlist("bank", "", []sdk.AccAddress{sdk.AccAddress{}}),
+		}, nil), false},
+		{"duplicate denied address", NewParams(nil, []sdk.AccAddress{addr1, addr1}), false},
+		{"empty denied address", NewParams(nil, []sdk.AccAddress{nil}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+
+	params := DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		require.Error(t, pair.ValidatorFn("invalid"))
+	}
+}
+
+func TestMsgAllowlistMatches(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	msg := sdk.NewTestMsg(addr)
+
+	require.True(t, NewMsgAllowlist(msg.Route(), "", nil).Matches(msg))
+	require.True(t, NewMsgAllowlist(msg.Route(), msg.Type(), nil).Matches(msg))
+	require.False(t, NewMsgAllowlist(msg.Route(), "other", nil).Matches(msg))
+	require.False(t, NewMsgAllowlist("other", "", nil).Matches(msg))
+
+	require.True(t, NewMsgAllowlist(msg.Route(), "", []sdk.AccAddress{addr}).Allows(addr))
+	require.False(t, NewMsgAllowlist(msg.Route(), "", nil).Allows(addr))
+}
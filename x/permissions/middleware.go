@@ -0,0 +1,22 @@
+package permissions
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandlerMiddleware returns a router middleware rejecting the msgs signed by
+// an address on the denylist or missing from an allowlist matching the msg.
+// Unlike the MsgPermissionDecorator, which only sees the msgs of a tx, it also
+// checks the msgs routed by other modules, such as those the authz module
+// executes on behalf of a granter. It should be added to every route.
+func NewHandlerMiddleware(k Keeper) sdk.HandlerMiddleware {
+	return func(_ string, next sdk.Handler) sdk.Handler {
+		return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			if err := k.CheckMsgPermissions(ctx, msg); err != nil {
+				return sdk.ConvertError(err).Result()
+			}
+
+			return next(ctx, msg)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package permissions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/permissions"
+)
+
+func TestHandlerMiddlewareAuthzExec(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: now})
+
+	granter := sdk.AccAddress([]byte("granter_____________"))
+	grantee := sdk.AccAddress([]byte("grantee_____________"))
+	recipient := sdk.AccAddress([]byte("recipient___________"))
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, granter)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 100))))
+	app.AccountKeeper.SetAccount(ctx, acc)
+	app.AuthzKeeper.Grant(ctx, authz.NewAuthorizationGrant(
+		granter, grantee, authz.NewGenericAuthorization("bank/send"), now.Add(time.Hour),
+	))
+
+	send := bank.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	exec := authz.NewMsgExecAuthorized(grantee, []sdk.Msg{send})
+	handler := authz.NewHandler(app.AuthzKeeper)
+
+	// the grantee executes the granter's msgs while nothing is restricted
+	res := handler(ctx, exec)
+	require.True(t, res.IsOK(), res.Log)
+
+	// a denied granter's msgs can't be executed through a grantee
+	app.PermsKeeper.SetParams(ctx, permissions.NewParams(nil, []sdk.AccAddress{granter}))
+	res = handler(ctx, exec)
+	require.Equal(t, permissions.DefaultCodespace, res.Codespace)
+	require.Equal(t, permissions.CodeAddressDenied, res.Code)
+
+	// nor can the msgs the granter isn't allowed to submit
+	app.PermsKeeper.SetParams(ctx, permissions.NewParams(
+		[]permissions.MsgAllowlist{permissions.NewMsgAllowlist(bank.RouterKey, "send", []sdk.AccAddress{grantee})}, nil,
+	))
+	res = handler(ctx, exec)
+	require.Equal(t, permissions.DefaultCodespace, res.Codespace)
+	require.Equal(t, permissions.CodeAddressNotAllowed, res.Code)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 90)), app.BankKeeper.GetCoins(ctx, granter))
+}
@@ -1,14 +1,23 @@
 package simulation
 
 import (
+	"bytes"
 	"math/rand"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxMultisigKeys is the maximum number of keys of the simulated multisig
+// accounts. The bech32 public key of an account must fit in the 90 characters
+// of BIP 173 for the account to be imported from genesis, which only leaves
+// room for a single secp256k1 key.
+const MaxMultisigKeys = 1
+
 // Account contains a privkey, pubkey, address tuple
 // eventually more useful data can be placed in here.
 // (e.g. number of coins)
@@ -46,6 +55,93 @@ func RandomAccounts(r *rand.Rand, n int) []Account {
 	return accs
 }
 
+// NewMultisigAccount returns a threshold multisig account whose keys are held by
+// the given simulation accounts. Its private key signs with the private keys of
+// threshold of them, so that it can sign transactions like any other
+// simulation account.
+func NewMultisigAccount(threshold int, signers []Account) Account {
+	pubKeys := make([]crypto.PubKey, len(signers))
+	privKeys := make([]crypto.PrivKey, len(signers))
+	for i, signer := range signers {
+		pubKeys[i] = signer.PubKey
+		privKeys[i] = signer.PrivKey
+	}
+
+	pubKey := multisig.NewPubKeyMultisigThreshold(threshold, pubKeys).(multisig.PubKeyMultisigThreshold)
+	return Account{
+		PrivKey: MultisigPrivKey{PubKeyMultisig: pubKey, Keys: privKeys},
+		PubKey:  pubKey,
+		Address: sdk.AccAddress(pubKey.Address()),
+	}
+}
+
+// RandomMultisigAccount returns a random k-of-n threshold multisig account, with
+// up to MaxMultisigKeys keys held by distinct random simulation accounts.
+func RandomMultisigAccount(r *rand.Rand, accs []Account) Account {
+	maxKeys := MaxMultisigKeys
+	if len(accs) < maxKeys {
+		maxKeys = len(accs)
+	}
+
+	numKeys := RandIntBetween(r, 1, maxKeys+1)
+	signers := make([]Account, numKeys)
+	for i, idx := range r.Perm(len(accs))[:numKeys] {
+		signers[i] = accs[idx]
+	}
+
+	return NewMultisigAccount(RandIntBetween(r, 1, numKeys+1), signers)
+}
+
+// MultisigPrivKey signs on behalf of a threshold multisig public key with the
+// private keys of its subkeys, implementing the crypto.PrivKey interface.
+type MultisigPrivKey struct {
+	PubKeyMultisig multisig.PubKeyMultisigThreshold
+	Keys           []crypto.PrivKey // private keys, in the order of the public keys
+}
+
+var _ crypto.PrivKey = MultisigPrivKey{}
+
+// Sign returns a multisignature of the message by threshold of the keys. The
+// signing keys depend on the message so that different subsets of keys sign.
+func (pk MultisigPrivKey) Sign(msg []byte) ([]byte, error) {
+	numKeys := len(pk.Keys)
+	start := int(tmhash.Sum(msg)[0]) % numKeys
+
+	sig := multisig.NewMultisig(numKeys)
+	for i := 0; i < int(pk.PubKeyMultisig.K); i++ {
+		key := pk.Keys[(start+i)%numKeys]
+		keySig, err := key.Sign(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := sig.AddSignatureFromPubKey(keySig, key.PubKey(), pk.PubKeyMultisig.PubKeys); err != nil {
+			return nil, err
+		}
+	}
+
+	return sig.Marshal(), nil
+}
+
+// PubKey returns the threshold multisig public key
+func (pk MultisigPrivKey) PubKey() crypto.PubKey {
+	return pk.PubKeyMultisig
+}
+
+// Bytes returns the concatenated bytes of the private keys
+func (pk MultisigPrivKey) Bytes() []byte {
+	var bz []byte
+	for _, key := range pk.Keys {
+		bz = append(bz, key.Bytes()...)
+	}
+	return bz
+}
+
+// Equals returns true if both private keys hold the same keys
+func (pk MultisigPrivKey) Equals(other crypto.PrivKey) bool {
+	return bytes.Equal(pk.Bytes(), other.Bytes())
+}
+
 // FindAccount iterates over all the simulation accounts to find the one that matches
 // the given address
 func FindAccount(accs []Account, address sdk.Address) (Account, bool) {
@@ -59,8 +155,11 @@ func FindAccount(accs []Account, address sdk.Address) (Account, bool) {
 }
 
 // RandomFees returns a random fee by selecting a random coin denomination and
-// amount from the account's available balance. If the user doesn't have enough
-// funds for paying fees, it returns empty coins.
+// amount from the account's available balance. The amount follows the fee
+// pressure regime of the context (see WithFeePressure), and is below or above
+// the fee required by the minimum gas prices of the context, if any, for a tx
+// of DefaultGenTxGas gas. If the user doesn't have enough funds for paying
+// fees, it returns empty coins.
 func RandomFees(r *rand.Rand, ctx sdk.Context, spendableCoins sdk.Coins) (sdk.Coins, error) {
 	if spendableCoins.Empty() {
 		return nil, nil
@@ -73,10 +172,16 @@ func RandomFees(r *rand.Rand, ctx sdk.Context, spendableCoins sdk.Coins) (sdk.Co
 		return nil, nil
 	}
 
-	amt, err := RandPositiveInt(r, randCoin.Amount)
+	min, max := feeAmountRange(GetFeePressure(ctx), randCoin.Amount)
+	if price := ctx.MinGasPrices().AmountOf(randCoin.Denom); price.IsPositive() {
+		required := requiredFees(sdk.DecCoins{sdk.NewDecCoinFromDec(randCoin.Denom, price)}, DefaultGenTxGas)
+		min, max = minGasPriceFeeRange(r, min, max, required.AmountOf(randCoin.Denom), randCoin.Amount)
+	}
+	amt, err := RandPositiveInt(r, ctx, max.Sub(min).AddRaw(1))
 	if err != nil {
 		return nil, err
 	}
+	amt = amt.Add(min).SubRaw(1)
 
 	// Create a random fee and verify the fees are within the account's spendable
 	// balance.
@@ -0,0 +1,43 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/multisig"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRandomMultisigAccount(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	accs := RandomAccounts(r, 10)
+
+	for i := 0; i < 20; i++ {
+		acc := RandomMultisigAccount(r, accs)
+
+		pubKey, ok := acc.PubKey.(multisig.PubKeyMultisigThreshold)
+		require.True(t, ok)
+		require.True(t, len(pubKey.PubKeys) <= MaxMultisigKeys)
+		require.True(t, pubKey.K >= 1 && int(pubKey.K) <= len(pubKey.PubKeys))
+		require.Equal(t, sdk.AccAddress(pubKey.Address()), acc.Address)
+		require.True(t, acc.PrivKey.PubKey().Equals(acc.PubKey))
+
+		// the multisignatures of threshold keys are valid
+		for j := 0; j < 5; j++ {
+			msg := []byte(fmt.Sprintf("msg-%d-%d", i, j))
+			sig, err := acc.PrivKey.Sign(msg)
+			require.NoError(t, err)
+			require.True(t, acc.PubKey.VerifyBytes(msg, sig))
+			require.False(t, acc.PubKey.VerifyBytes([]byte("other"), sig))
+		}
+	}
+
+	// larger multisig accounts sign with threshold of their keys too
+	acc := NewMultisigAccount(3, accs[:5])
+	sig, err := acc.PrivKey.Sign([]byte("msg"))
+	require.NoError(t, err)
+	require.True(t, acc.PubKey.VerifyBytes([]byte("msg"), sig))
+}
@@ -0,0 +1,118 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// Distributions of the number of operations per block
+const (
+	// BlockSizeMarkov moves between over stuffed, normal sized and empty blocks
+	// following the block size transition matrix of the simulation params
+	BlockSizeMarkov = "markov"
+
+	// BlockSizeFixed runs exactly BlockSize operations on every block
+	BlockSizeFixed = "fixed"
+
+	// BlockSizeUniform runs a uniformly distributed number of operations in
+	// [0, 2 * BlockSize) on every block
+	BlockSizeUniform = "uniform"
+)
+
+// validateBlockShape checks the parameters of the config that shape the
+// simulated blocks
+func validateBlockShape(config Config) error {
+	switch config.BlockSizeDistribution {
+	case "", BlockSizeMarkov, BlockSizeFixed, BlockSizeUniform:
+	default:
+		return fmt.Errorf("unknown block size distribution %q", config.BlockSizeDistribution)
+	}
+
+	if config.EmptyBlockRate < 0 || config.EmptyBlockRate > 1 {
+		return fmt.Errorf("empty block rate must be between 0 and 1, got %v", config.EmptyBlockRate)
+	}
+	if config.EmptyBlockStretch < 0 {
+		return fmt.Errorf("empty block stretch must not be negative, got %d", config.EmptyBlockStretch)
+	}
+
+	minTime, maxTime := blockTimeRange(config)
+	if minTime < 0 || maxTime < minTime {
+		return fmt.Errorf("invalid block time range [%s, %s]", minTime, maxTime)
+	}
+
+	return nil
+}
+
+// blockTimeRange returns the range of the time between two simulated blocks
+func blockTimeRange(config Config) (minTime, maxTime time.Duration) {
+	minTime, maxTime = config.MinBlockTime, config.MaxBlockTime
+	if minTime == 0 {
+		minTime = time.Duration(minTimePerBlock) * time.Second
+	}
+	if maxTime == 0 {
+		maxTime = time.Duration(maxTimePerBlock) * time.Second
+	}
+
+	return minTime, maxTime
+}
+
+// nextBlockTime returns the time of the block following the one at the given
+// time, in whole seconds within the block time range of the config
+func nextBlockTime(r *rand.Rand, config Config, blockTime time.Time) time.Time {
+	minTime, maxTime := blockTimeRange(config)
+	blockTime = blockTime.Add(minTime)
+
+	if diff := int64((maxTime - minTime) / time.Second); diff > 0 {
+		blockTime = blockTime.Add(time.Duration(r.Intn(int(diff))) * time.Second)
+	}
+
+	return blockTime
+}
+
+// blockSizer draws the number of operations of every simulated block
+type blockSizer struct {
+	config Config
+	params Params
+
+	lastState  int // state of the block size transition matrix
+	emptyCount int // remaining empty blocks of the current stretch
+}
+
+func newBlockSizer(config Config, params Params) *blockSizer {
+	return &blockSizer{config: config, params: params}
+}
+
+// next returns the number of operations of the next block
+func (bs *blockSizer) next(r *rand.Rand) int {
+	if bs.emptyCount > 0 {
+		bs.emptyCount--
+		return 0
+	}
+
+	if bs.config.EmptyBlockRate > 0 && r.Float64() < bs.config.EmptyBlockRate {
+		stretch := bs.config.EmptyBlockStretch
+		if stretch < 1 {
+			stretch = 1
+		}
+
+		bs.emptyCount = r.Intn(stretch)
+		return 0
+	}
+
+	switch bs.config.BlockSizeDistribution {
+	case BlockSizeFixed:
+		return bs.config.BlockSize
+
+	case BlockSizeUniform:
+		if bs.config.BlockSize <= 0 {
+			return 0
+		}
+		return r.Intn(2 * bs.config.BlockSize)
+
+	default:
+		var blockSize int
+		bs.lastState, blockSize = getBlockSize(r, bs.params, bs.lastState, bs.config.BlockSize)
+		return blockSize
+	}
+}
@@ -0,0 +1,109 @@
+package simulation
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBlockShape(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config Config
+		expErr bool
+	}{
+		{"defaults", Config{}, false},
+		{"fixed", Config{BlockSizeDistribution: BlockSizeFixed}, false},
+		{"unknown distribution", Config{BlockSizeDistribution: "poisson"}, true},
+		{"negative empty block rate", Config{EmptyBlockRate: -0.1}, true},
+		{"empty block rate over one", Config{EmptyBlockRate: 1.1}, true},
+		{"negative empty block stretch", Config{EmptyBlockStretch: -1}, true},
+		{"fixed block time", Config{MinBlockTime: time.Minute, MaxBlockTime: time.Minute}, false},
+		{"inverted block time range", Config{MinBlockTime: time.Hour, MaxBlockTime: time.Minute}, true},
+	}
+
+	for _, tc := range testCases {
+		err := validateBlockShape(tc.config)
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestNextBlockTime(t *testing.T) {
+	genesis := time.Unix(0, 0).UTC()
+
+	// the default range draws the same block times as before it was configurable
+	r1, r2 := rand.New(rand.NewSource(1)), rand.New(rand.NewSource(1))
+	blockTime := genesis
+	for i := 0; i < 100; i++ {
+		expected := blockTime.Add(time.Duration(minTimePerBlock) * time.Second).
+			Add(time.Duration(int64(r2.Intn(int(maxTimePerBlock-minTimePerBlock)))) * time.Second)
+
+		blockTime = nextBlockTime(r1, Config{}, blockTime)
+		require.Equal(t, expected, blockTime)
+		expected = blockTime
+	}
+
+	r := rand.New(rand.NewSource(1))
+	config := Config{MinBlockTime: 5 * time.Second, MaxBlockTime: 7 * time.Second}
+	for i := 0; i < 100; i++ {
+		next := nextBlockTime(r, config, genesis)
+		require.True(t, !next.Before(genesis.Add(5*time.Second)) && next.Before(genesis.Add(7*time.Second)), next)
+	}
+
+	config = Config{MinBlockTime: 6 * time.Second, MaxBlockTime: 6 * time.Second}
+	require.Equal(t, genesis.Add(6*time.Second), nextBlockTime(r, config, genesis))
+}
+
+func TestBlockSizer(t *testing.T) {
+	params := RandomParams(rand.New(rand.NewSource(1)))
+
+	// the default distribution follows the block size transition matrix
+	r1, r2 := rand.New(rand.NewSource(2)), rand.New(rand.NewSource(2))
+	sizer := newBlockSizer(Config{BlockSize: 10}, params)
+	state, expected := 0, 0
+	for i := 0; i < 100; i++ {
+		state, expected = getBlockSize(r2, params, state, 10)
+		require.Equal(t, expected, sizer.next(r1))
+	}
+
+	r := rand.New(rand.NewSource(3))
+	sizer = newBlockSizer(Config{BlockSize: 10, BlockSizeDistribution: BlockSizeFixed}, params)
+	for i := 0; i < 10; i++ {
+		require.Equal(t, 10, sizer.next(r))
+	}
+
+	sizer = newBlockSizer(Config{BlockSize: 10, BlockSizeDistribution: BlockSizeUniform}, params)
+	for i := 0; i < 100; i++ {
+		size := sizer.next(r)
+		require.True(t, size >= 0 && size < 20, size)
+	}
+
+	// every block is empty when a stretch starts on every block
+	sizer = newBlockSizer(Config{BlockSize: 10, BlockSizeDistribution: BlockSizeFixed, EmptyBlockRate: 1, EmptyBlockStretch: 5}, params)
+	for i := 0; i < 20; i++ {
+		require.Equal(t, 0, sizer.next(r))
+	}
+
+	// empty stretches are at most EmptyBlockStretch blocks long
+	sizer = newBlockSizer(Config{BlockSize: 10, BlockSizeDistribution: BlockSizeFixed, EmptyBlockRate: 0.1, EmptyBlockStretch: 5}, params)
+	empty, maxEmpty, numEmpty := 0, 0, 0
+	for i := 0; i < 1000; i++ {
+		if sizer.next(r) == 0 {
+			empty++
+			numEmpty++
+			continue
+		}
+		if empty > maxEmpty {
+			maxEmpty = empty
+		}
+		empty = 0
+	}
+	require.True(t, numEmpty > 0)
+	require.True(t, maxEmpty <= 10, maxEmpty) // two stretches may follow each other
+}
@@ -1,5 +1,7 @@
 package simulation
 
+import "time"
+
 // Config contains the necessary configuration flags for the simulator
 type Config struct {
 	GenesisFile string // custom simulation genesis file; cannot be used with params file
@@ -9,6 +11,16 @@ type Config struct {
 	ExportParamsHeight int    //height to which export the randomly generated params
 	ExportStatePath    string //custom file path to save the exported app state JSON
 	ExportStatsPath    string // custom file path to save the exported simulation statistics JSON
+	ExportReplayPath   string // custom file path to save the simulation replay JSON
+
+	ExportStateOnFailure bool // only export the app state to ExportStatePath if the simulation fails
+
+	MaxFailureRate float64 // fail the simulation if the operations of a msg type fail more often than this rate; zero disables the check
+	MaxNoOpRate    float64 // fail the simulation if the operations of a msg type end up as no-ops more often than this rate; zero disables the check
+
+	ReplayFile       string // simulation replay file to re-execute; overrides the seed and the block parameters
+	ReplayFromHeight int    // height of a block of the replay from which its blocks are checked; the previous ones are re-executed without checks
+	CorpusDir        string // directory to save the replay of the simulation to if it fails
 
 	Seed               int64  // simulation random seed
 	InitialBlockHeight int    // initial block to start the simulation
@@ -16,9 +28,33 @@ type Config struct {
 	BlockSize          int    // operations per block
 	ChainID            string // chain-id used on the simulation
 
+	BondDenom    string // bond denomination of the randomized genesis; defaults to sdk.DefaultBondDenom if empty
+	BondExponent uint   // number of decimal places of the bond denomination
+
 	Lean   bool // lean simulation log output
 	Commit bool // have the simulation commit
 
+	RestartPeriod int // restart the app from its committed state every period blocks; zero disables restarts
+
 	OnOperation   bool // run slow invariants every operation
 	AllInvariants bool // print all failed invariants if a broken invariant is found
+
+	InvariantCheckPeriod       int               // check the invariants every period blocks; zero disables the checks
+	InvariantsChecker          InvariantsChecker `json:"-"` // runs the invariants of the simulated app and dumps the stores they read
+	ExportInvariantsReportPath string            // custom file path to save the broken invariants report JSON
+
+	ImportExportCheckPeriod int                 // export and re-import the app state every period blocks and compare the stores; zero disables the checks
+	ImportExportChecker     ImportExportChecker `json:"-"` // exports the state of the simulated app, imports it in a fresh app and compares their stores
+
+	ExtremeValueRate float64 // probability of generating boundary values for random amounts
+
+	FeePressure          bool                 // vary the fees paid by the simulated txs across blocks following low, normal and high fee pressure regimes
+	FeeAccountingChecker FeeAccountingChecker `json:"-"` // checks the fees collected on every block are accounted for once burned and distributed; requires commit
+	MinGasPrices         bool                 // set a random minimum gas price on the app, and generate fees both below and above it
+
+	BlockSizeDistribution string        // distribution of the operations per block around BlockSize: "markov" (default), "fixed" or "uniform"
+	EmptyBlockRate        float64       // probability of starting a stretch of empty blocks on every block; zero disables it
+	EmptyBlockStretch     int           // maximum number of consecutive empty blocks of a stretch started by EmptyBlockRate; defaults to 1
+	MinBlockTime          time.Duration // minimum time between two blocks; defaults to 5000 seconds
+	MaxBlockTime          time.Duration // maximum time between two blocks; defaults to 10000 seconds
 }
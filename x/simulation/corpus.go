@@ -0,0 +1,108 @@
+package simulation
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// SaveToCorpus saves the replay of a failing simulation run as
+// seed-<seed>.json in the corpus directory, creating it if needed. It returns
+// the path of the saved replay.
+func SaveToCorpus(dir string, replay *Replay) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("seed-%d.json", replay.Config.Seed))
+	if err := replay.ExportJSON(path); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// ReadCorpus returns the paths of the replays saved in a corpus directory,
+// sorted by name.
+func ReadCorpus(dir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "seed-*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// MinimizeReplay bisects the steps of a failing replay, i.e. its blocks and
+// the operations run in each of them, to find its shortest prefix which still
+// fails according to fails. The original replay is assumed to fail, as is
+// every prefix longer than the shortest failing one.
+func MinimizeReplay(replay *Replay, fails func(*Replay) bool) *Replay {
+	lo, hi := 1, replay.numSteps()
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if fails(replay.prefix(mid)) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	return replay.prefix(hi)
+}
+
+// numSteps returns the number of steps of the replay: every block, along with
+// the queued operations it runs first, is a step and so is every non-queued
+// operation.
+func (rp *Replay) numSteps() (steps int) {
+	for _, checkpoint := range rp.Checkpoints {
+		steps += 1 + checkpoint.Operations
+	}
+
+	return steps
+}
+
+// prefix returns a copy of the replay truncated to its first steps. Queued
+// operations are always run with their block, as they don't depend on the
+// number of operations of the block. The app hash of a truncated block is
+// cleared, as it can no longer be reproduced.
+func (rp *Replay) prefix(steps int) *Replay {
+	prefix := &Replay{
+		Config:      rp.Config,
+		GenesisHash: rp.GenesisHash,
+		Failure:     rp.Failure,
+	}
+
+	for _, checkpoint := range rp.Checkpoints {
+		if steps == 0 {
+			break
+		}
+		steps--
+
+		truncated := *checkpoint
+		if steps < checkpoint.Operations {
+			truncated.Operations = steps
+			truncated.AppHash = nil
+		}
+		steps -= truncated.Operations
+
+		prefix.Checkpoints = append(prefix.Checkpoints, &truncated)
+	}
+
+	prefix.Config.NumBlocks = len(prefix.Checkpoints)
+	return prefix
+}
+
+// hasCheckpoint returns true if the replay recorded the block of the given
+// height
+func (rp *Replay) hasCheckpoint(height int64) bool {
+	for _, checkpoint := range rp.Checkpoints {
+		if checkpoint.Height == height {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,144 @@
+package simulation
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testReplay returns a replay of three blocks, running one, two and one
+// operations
+func testReplay() *Replay {
+	return &Replay{
+		Config:      Config{Seed: 7, NumBlocks: 3},
+		GenesisHash: []byte{0x01},
+		Checkpoints: []*ReplayCheckpoint{
+			{Height: 1, Operations: 1, AppHash: []byte{0x0a}},
+			{Height: 2, Operations: 2, AppHash: []byte{0x0b}},
+			{Height: 3, Operations: 1, AppHash: []byte{0x0c}},
+		},
+	}
+}
+
+func TestReplayPrefix(t *testing.T) {
+	replay := testReplay()
+	require.Equal(t, 7, replay.numSteps())
+
+	// the app hash of the truncated block is cleared
+	prefix := replay.prefix(3)
+	require.Equal(t, 2, prefix.Config.NumBlocks)
+	require.Len(t, prefix.Checkpoints, 2)
+	require.Equal(t, []byte{0x0a}, prefix.Checkpoints[0].AppHash)
+	require.Equal(t, &ReplayCheckpoint{Height: 2}, prefix.Checkpoints[1])
+
+	prefix = replay.prefix(5)
+	require.Len(t, prefix.Checkpoints, 2)
+	require.Equal(t, replay.Checkpoints[1], prefix.Checkpoints[1])
+
+	require.Equal(t, replay.Checkpoints, replay.prefix(7).Checkpoints)
+	require.Equal(t, 3, replay.Config.NumBlocks)
+	require.Equal(t, 2, replay.Checkpoints[1].Operations)
+}
+
+func TestMinimizeReplay(t *testing.T) {
+	replay := testReplay()
+
+	// fails once the second operation of the second block is run
+	var runs int
+	fails := func(rp *Replay) bool {
+		runs++
+		return len(rp.Checkpoints) >= 2 && rp.Checkpoints[1].Operations == 2
+	}
+
+	minimized := MinimizeReplay(replay, fails)
+	require.Equal(t, replay.prefix(5), minimized)
+	require.True(t, runs <= 3, runs)
+
+	// a replay failing on its first block
+	minimized = MinimizeReplay(replay, func(*Replay) bool { return true })
+	require.Len(t, minimized.Checkpoints, 1)
+	require.Zero(t, minimized.Checkpoints[0].Operations)
+}
+
+func TestReplayTracer(t *testing.T) {
+	recorded := &Replay{}
+	tracer := newReplayTracer(recorded, false, 0)
+	for height := int64(1); height <= 2; height++ {
+		ok, err := tracer.beginBlock(height)
+		require.True(t, ok)
+		require.NoError(t, err)
+		require.Equal(t, 3, tracer.operations(3))
+		require.NoError(t, tracer.commit([]byte{byte(height)}))
+	}
+	require.Equal(t, []*ReplayCheckpoint{
+		{Height: 1, Operations: 3, AppHash: []byte{0x01}},
+		{Height: 2, Operations: 3, AppHash: []byte{0x02}},
+	}, recorded.Checkpoints)
+
+	// the replay is checked from the second block and runs the operations of
+	// its truncated last block only
+	replay := recorded.prefix(6)
+	tracer = newReplayTracer(replay, true, 2)
+	ok, err := tracer.beginBlock(1)
+	require.True(t, ok)
+	require.NoError(t, err)
+	require.True(t, tracer.skipChecks(1))
+	require.Equal(t, 3, tracer.operations(3))
+	require.NoError(t, tracer.commit([]byte{0x01}))
+
+	ok, err = tracer.beginBlock(2)
+	require.True(t, ok)
+	require.NoError(t, err)
+	require.False(t, tracer.skipChecks(2))
+	require.Equal(t, 1, tracer.operations(3))
+	require.NoError(t, tracer.commit([]byte{0xff}))
+
+	ok, err = tracer.beginBlock(3)
+	require.False(t, ok)
+	require.NoError(t, err)
+
+	// diverging replays
+	tracer = newReplayTracer(recorded, true, 0)
+	_, err = tracer.beginBlock(2)
+	require.Error(t, err)
+
+	tracer = newReplayTracer(recorded, true, 0)
+	_, err = tracer.beginBlock(1)
+	require.NoError(t, err)
+	require.Panics(t, func() { tracer.operations(2) })
+	require.Error(t, tracer.commit([]byte{0xff}))
+}
+
+func TestCorpus(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sim-corpus")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	corpusDir := filepath.Join(dir, "corpus")
+	paths, err := ReadCorpus(corpusDir)
+	require.NoError(t, err)
+	require.Empty(t, paths)
+
+	replay := testReplay()
+	replay.Failure = "invariant broken"
+	path, err := SaveToCorpus(corpusDir, replay)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(corpusDir, "seed-7.json"), path)
+
+	replay.Config.Seed = 3
+	_, err = SaveToCorpus(corpusDir, replay)
+	require.NoError(t, err)
+
+	paths, err = ReadCorpus(corpusDir)
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(corpusDir, "seed-3.json"), path}, paths)
+
+	saved, err := ReadReplay(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), saved.Config.Seed)
+	require.Equal(t, "invariant broken", saved.Failure)
+	require.Len(t, saved.Checkpoints, 3)
+}
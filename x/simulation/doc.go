@@ -112,6 +112,9 @@ To export the simulation app state (i.e genesis) to a file:
 	-ExportStatePath=/path/to/genesis.json \
 	 v -timeout 24h
 
+Add -ExportStateOnFailure=true to only export the app state if the simulation
+fails.
+
 Params
 
 Params that are provided to simulation from a JSON file are used to used to set
@@ -2,11 +2,30 @@ package simulation
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"sort"
+	"strings"
 )
 
+// operation event results
+const (
+	EventResultOK      = "ok"
+	EventResultFailure = "failure"
+	EventResultNoOp    = "no-op"
+)
+
+// EventGasUsed is the event under which the gas consumed by the transactions
+// delivered by the operations of a msg type is tallied.
+const EventGasUsed = "gas_used"
+
+// ThresholdsMinSubmitted is the minimum number of times the operations of a msg
+// type must be submitted for their failure and no-op rates to be checked
+// against the simulation thresholds.
+const ThresholdsMinSubmitted = 10
+
 // EventStats defines an object that keeps a tally of each event that has occurred
 // during a simulation.
 type EventStats map[string]map[string]map[string]int
@@ -18,6 +37,17 @@ func NewEventStats() EventStats {
 
 // Tally increases the count of a simulation event.
 func (es EventStats) Tally(route, op, evResult string) {
+	es.results(route, op)[evResult]++
+}
+
+// TallyGas adds the gas consumed by the transactions delivered by an operation
+// to the gas used by the operations of its msg type.
+func (es EventStats) TallyGas(route, op string, gas uint64) {
+	es.results(route, op)[EventGasUsed] += int(gas)
+}
+
+// results returns the tallied events of a given route and operation
+func (es EventStats) results(route, op string) map[string]int {
 	_, ok := es[route]
 	if !ok {
 		es[route] = make(map[string]map[string]int)
@@ -28,7 +58,123 @@ func (es EventStats) Tally(route, op, evResult string) {
 		es[route][op] = make(map[string]int)
 	}
 
-	es[route][op][evResult]++
+	return es[route][op]
+}
+
+// OperationStats defines the aggregated results of the simulated operations of
+// a given msg type. The average gas is the gas used by the operations that
+// delivered a transaction, i.e. that didn't end up as a no-op.
+type OperationStats struct {
+	Submitted   int            `json:"submitted"`
+	OK          int            `json:"ok"`
+	Failed      int            `json:"failed"`
+	NoOp        int            `json:"no_op"`
+	NoOpReasons map[string]int `json:"no_op_reasons,omitempty"`
+	FailureRate float64        `json:"failure_rate"`
+	NoOpRate    float64        `json:"no_op_rate"`
+	GasUsed     int            `json:"gas_used"`
+	AvgGasUsed  int            `json:"avg_gas_used"`
+}
+
+// OperationsStats aggregates the results of the operations tallied by the
+// event stats per route and msg type. Events that aren't operation results,
+// e.g. the validators signing blocks, are ignored.
+func (es EventStats) OperationsStats() map[string]map[string]OperationStats {
+	stats := make(map[string]map[string]OperationStats)
+
+	for route, ops := range es {
+		for op, results := range ops {
+			var opStats OperationStats
+
+			for result, count := range results {
+				switch {
+				case result == EventResultOK:
+					opStats.OK += count
+
+				case result == EventResultFailure:
+					opStats.Failed += count
+
+				case result == EventResultNoOp:
+					opStats.NoOp += count
+
+				case strings.HasPrefix(result, EventResultNoOp+": "):
+					if opStats.NoOpReasons == nil {
+						opStats.NoOpReasons = make(map[string]int)
+					}
+
+					opStats.NoOp += count
+					opStats.NoOpReasons[strings.TrimPrefix(result, EventResultNoOp+": ")] += count
+
+				case result == EventGasUsed:
+					opStats.GasUsed += count
+					continue
+
+				default:
+					continue
+				}
+
+				opStats.Submitted += count
+			}
+
+			if opStats.Submitted == 0 {
+				continue
+			}
+
+			opStats.FailureRate = float64(opStats.Failed) / float64(opStats.Submitted)
+			opStats.NoOpRate = float64(opStats.NoOp) / float64(opStats.Submitted)
+			if delivered := opStats.OK + opStats.Failed; delivered > 0 {
+				opStats.AvgGasUsed = opStats.GasUsed / delivered
+			}
+
+			if _, ok := stats[route]; !ok {
+				stats[route] = make(map[string]OperationStats)
+			}
+			stats[route][op] = opStats
+		}
+	}
+
+	return stats
+}
+
+// CheckThresholds returns an error listing the msg types whose operations fail
+// or end up as no-ops more often than the given rates, or nil if none does. A
+// zero rate disables its check. Msg types submitted less than
+// ThresholdsMinSubmitted times aren't checked.
+func (es EventStats) CheckThresholds(maxFailureRate, maxNoOpRate float64) error {
+	var violations []string
+	for route, ops := range es.OperationsStats() {
+		for op, opStats := range ops {
+			if opStats.Submitted < ThresholdsMinSubmitted {
+				continue
+			}
+
+			if maxFailureRate > 0 && opStats.FailureRate > maxFailureRate {
+				violations = append(violations, fmt.Sprintf("%s/%s: %d of %d operations failed (%.2f > %.2f)",
+					route, op, opStats.Failed, opStats.Submitted, opStats.FailureRate, maxFailureRate))
+			}
+
+			if maxNoOpRate > 0 && opStats.NoOpRate > maxNoOpRate {
+				violations = append(violations, fmt.Sprintf("%s/%s: %d of %d operations were no-ops (%.2f > %.2f)",
+					route, op, opStats.NoOp, opStats.Submitted, opStats.NoOpRate, maxNoOpRate))
+			}
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	sort.Strings(violations)
+	return errors.New("operation stats thresholds exceeded:\n" + strings.Join(violations, "\n"))
+}
+
+// MarshalJSON returns the tallied events along with the aggregated operations
+// stats.
+func (es EventStats) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Events     map[string]map[string]map[string]int `json:"events"`
+		Operations map[string]map[string]OperationStats `json:"operations"`
+	}{es, es.OperationsStats()})
 }
 
 // Print the event stats in JSON format.
@@ -0,0 +1,82 @@
+package simulation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationsStats(t *testing.T) {
+	es := NewEventStats()
+
+	ops := []OperationMsg{
+		NewOperationMsgBasic("staking", "delegate", "", true, []byte("{}")),
+		NewOperationMsgBasic("staking", "delegate", "", true, []byte("{}")),
+		NewOperationMsgBasic("staking", "delegate", "", false, []byte("{}")),
+		NoOpMsg("staking", "delegate", "no validator"),
+		NoOpMsg("staking", "begin_redelegate", "no validator"),
+		NoOpMsg("staking", "begin_redelegate", "max redelegation entries reached"),
+		NoOpMsg("staking", "begin_redelegate", "no validator"),
+		NoOpMsg("staking", "begin_redelegate", ""),
+	}
+	for _, op := range ops {
+		op.LogEvent(es.Tally)
+	}
+	es.Tally("begin_block", "signing", "signed")
+	es.TallyGas("staking", "delegate", 30000)
+	es.TallyGas("staking", "delegate", 45000)
+
+	require.Equal(t, 1, es["staking"]["delegate"]["no-op: no validator"])
+	require.Equal(t, 1, es["staking"]["begin_redelegate"]["no-op"])
+
+	stats := es.OperationsStats()
+	require.Equal(t, map[string]map[string]OperationStats{
+		"staking": {
+			"delegate": {
+				Submitted: 4, OK: 2, Failed: 1, NoOp: 1,
+				NoOpReasons: map[string]int{"no validator": 1},
+				FailureRate: 0.25, NoOpRate: 0.25,
+				GasUsed: 75000, AvgGasUsed: 25000,
+			},
+			"begin_redelegate": {
+				Submitted: 4, NoOp: 4,
+				NoOpReasons: map[string]int{"no validator": 2, "max redelegation entries reached": 1},
+				NoOpRate:    1,
+			},
+		},
+	}, stats)
+
+	bz, err := json.Marshal(es)
+	require.NoError(t, err)
+
+	var exported struct {
+		Events     map[string]map[string]map[string]int `json:"events"`
+		Operations map[string]map[string]OperationStats `json:"operations"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &exported))
+	require.Equal(t, map[string]map[string]map[string]int(es), exported.Events)
+	require.Equal(t, stats, exported.Operations)
+}
+
+func TestCheckThresholds(t *testing.T) {
+	es := NewEventStats()
+	for i := 0; i < ThresholdsMinSubmitted; i++ {
+		es.Tally("bank", "send", EventResultOK)
+		es.Tally("staking", "delegate", EventResultNoOp)
+	}
+	es.Tally("bank", "send", EventResultFailure)
+	es.Tally("bank", "send", EventResultFailure)
+
+	// operations submitted less than the minimum times aren't checked
+	es.Tally("staking", "unbond", EventResultFailure)
+
+	require.NoError(t, es.CheckThresholds(0, 0))
+	require.NoError(t, es.CheckThresholds(0.2, 1))
+
+	err := es.CheckThresholds(0.1, 0.9)
+	require.Error(t, err)
+	require.Equal(t, `operation stats thresholds exceeded:
+bank/send: 2 of 12 operations failed (0.17 > 0.10)
+staking/delegate: 10 of 10 operations were no-ops (1.00 > 0.90)`, err.Error())
+}
@@ -0,0 +1,181 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Fee pressure regimes followed by the fees generated by RandomFees. The
+// simulator moves the regime across blocks when Config.FeePressure is set.
+const (
+	FeePressureLow    = iota // fees of at most a thousandth of the fee denom balance
+	FeePressureNormal        // fees uniformly distributed up to the fee denom balance
+	FeePressureHigh          // fees of at least half of the fee denom balance
+)
+
+// DefaultGenTxGas is the gas limit of the simulated txs, which RandomFees
+// prices the fees against when the context carries minimum gas prices.
+const DefaultGenTxGas = 1000000
+
+// feePressureKey is the context key of the fee pressure regime of a block
+type feePressureKey struct{}
+
+// WithFeePressure returns a copy of the context in which RandomFees generates
+// fees under the given fee pressure regime.
+func WithFeePressure(ctx sdk.Context, regime int) sdk.Context {
+	if regime < FeePressureLow || regime > FeePressureHigh {
+		panic(fmt.Sprintf("invalid fee pressure regime %d", regime))
+	}
+	return ctx.WithValue(feePressureKey{}, regime)
+}
+
+// GetFeePressure returns the fee pressure regime of the context, which is
+// FeePressureNormal unless it has been set by WithFeePressure.
+func GetFeePressure(ctx sdk.Context) int {
+	regime, ok := ctx.Value(feePressureKey{}).(int)
+	if !ok {
+		return FeePressureNormal
+	}
+	return regime
+}
+
+// feeAmountRange returns the bounds, both included, of the fee amounts
+// generated for a balance under a fee pressure regime.
+func feeAmountRange(regime int, balance sdk.Int) (min, max sdk.Int) {
+	switch regime {
+	case FeePressureLow:
+		max = balance.QuoRaw(1000)
+		if max.LT(sdk.OneInt()) {
+			max = sdk.OneInt()
+		}
+		return sdk.OneInt(), max
+
+	case FeePressureHigh:
+		min = balance.QuoRaw(2)
+		if min.LT(sdk.OneInt()) {
+			min = sdk.OneInt()
+		}
+		return min, balance
+
+	default:
+		return sdk.OneInt(), balance
+	}
+}
+
+// randomMinGasPrice returns a random minimum gas price, requiring fees of up to
+// 10000 units of the bond denomination for the simulated txs.
+func randomMinGasPrice(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(RandIntBetween(r, 1, 10001)), 6)
+}
+
+// requiredFees returns the fees a tx with the given gas limit must pay, in any
+// of their denominations, to meet the minimum gas prices.
+func requiredFees(minGasPrices sdk.DecCoins, gas uint64) sdk.Coins {
+	fees := make(sdk.Coins, len(minGasPrices))
+	gasDec := sdk.NewDec(int64(gas))
+	for i, gp := range minGasPrices {
+		fees[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(gasDec).Ceil().RoundInt())
+	}
+	return fees
+}
+
+// minGasPriceFeeRange narrows the bounds, both included, of the fee amounts
+// generated for a balance so that the fee is either below the amount required
+// by the minimum gas prices or at least at it, with even odds when the balance
+// allows both.
+func minGasPriceFeeRange(r *rand.Rand, min, max, required, balance sdk.Int) (sdk.Int, sdk.Int) {
+	underpriced := r.Intn(2) == 0
+	switch {
+	case required.LTE(sdk.OneInt()):
+		underpriced = false
+	case required.GT(balance):
+		underpriced = true
+	}
+
+	if underpriced {
+		max = sdk.MinInt(max, required.SubRaw(1))
+		return sdk.MinInt(min, max), max
+	}
+
+	min = sdk.MaxInt(min, required)
+	return min, sdk.MaxInt(max, min)
+}
+
+// feeTx is the interface of the txs whose fees CheckUnderpricedTx checks
+type feeTx interface {
+	sdk.Tx
+	GetGas() uint64
+	GetFee() sdk.Coins
+}
+
+// CheckUnderpricedTx checks that CheckTx rejects the tx with an insufficient
+// fee error if its fees are below the minimum gas prices of the context, which
+// the simulator sets to the ones of the app when Config.MinGasPrices is set. It
+// does nothing for txs paying enough fees, as CheckTx isn't run on the txs
+// delivered by the operations.
+func CheckUnderpricedTx(app *baseapp.BaseApp, ctx sdk.Context, tx feeTx) error {
+	minGasPrices := ctx.MinGasPrices()
+	if minGasPrices.IsZero() || tx.GetFee().IsAnyGTE(requiredFees(minGasPrices, tx.GetGas())) {
+		return nil
+	}
+
+	res := app.Check(tx)
+	if res.IsOK() {
+		return fmt.Errorf("CheckTx accepted a tx paying %s of fees below the minimum gas prices %s", tx.GetFee(), minGasPrices)
+	}
+	if res.Code != sdk.CodeInsufficientFee {
+		return fmt.Errorf("CheckTx rejected a tx paying %s of fees below the minimum gas prices %s with code %d instead of %d: %s",
+			tx.GetFee(), minGasPrices, res.Code, sdk.CodeInsufficientFee, res.Log)
+	}
+
+	return nil
+}
+
+// feePressureSimulator moves the fee pressure regime across blocks. It draws
+// its randomness from its own source so that enabling it leaves the random
+// streams of the blocks and operations untouched.
+type feePressureSimulator struct {
+	r      *rand.Rand
+	matrix TransitionMatrix
+	regime int
+	blocks []int // number of blocks simulated under each regime
+}
+
+func newFeePressureSimulator(seed int64, matrix TransitionMatrix) *feePressureSimulator {
+	return &feePressureSimulator{
+		r:      rand.New(rand.NewSource(seed)),
+		matrix: matrix,
+		regime: FeePressureNormal,
+		blocks: make([]int, FeePressureHigh+1),
+	}
+}
+
+// nextBlock moves the fee pressure regime to the one of the next block and
+// returns the block context carrying it.
+func (fp *feePressureSimulator) nextBlock(ctx sdk.Context) sdk.Context {
+	fp.regime = fp.matrix.NextState(fp.r, fp.regime)
+	fp.blocks[fp.regime]++
+	return WithFeePressure(ctx, fp.regime)
+}
+
+// String returns the number of blocks simulated under each regime.
+func (fp *feePressureSimulator) String() string {
+	return fmt.Sprintf(
+		"fee pressure regimes: %d low, %d normal and %d high blocks",
+		fp.blocks[FeePressureLow], fp.blocks[FeePressureNormal], fp.blocks[FeePressureHigh],
+	)
+}
+
+// FeeAccountingChecker checks that the fees collected from the transactions of
+// an application are accounted for once they are burned and distributed, so
+// that the simulator can catch fees that are lost or created along the way.
+type FeeAccountingChecker interface {
+	// CheckFeeAccounting compares the last committed state of the application
+	// (committedCtx), holding the fees collected on the previous block, with the
+	// state resulting from the BeginBlock of the current block (ctx). It returns
+	// an error describing the fees that aren't accounted for, if any.
+	CheckFeeAccounting(committedCtx, ctx sdk.Context) error
+}
@@ -0,0 +1,133 @@
+package simulation
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRandomFeesUnderFeePressure(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{}, false, nil)
+	require.Equal(t, FeePressureNormal, GetFeePressure(ctx))
+
+	balance := sdk.NewInt(1000000)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balance))
+
+	testCases := []struct {
+		regime   int
+		min, max sdk.Int
+	}{
+		{FeePressureLow, sdk.OneInt(), sdk.NewInt(1000)},
+		{FeePressureNormal, sdk.OneInt(), balance},
+		{FeePressureHigh, sdk.NewInt(500000), balance},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, tc := range testCases {
+		regimeCtx := WithFeePressure(ctx, tc.regime)
+		require.Equal(t, tc.regime, GetFeePressure(regimeCtx))
+
+		for i := 0; i < 100; i++ {
+			fees, err := RandomFees(r, regimeCtx, coins)
+			require.NoError(t, err)
+			amt := fees.AmountOf(sdk.DefaultBondDenom)
+			require.True(t, amt.GTE(tc.min) && amt.LTE(tc.max), "regime %d: %s", tc.regime, amt)
+		}
+	}
+
+	// tiny balances can still pay fees under every regime
+	for _, regime := range []int{FeePressureLow, FeePressureNormal, FeePressureHigh} {
+		fees, err := RandomFees(r, WithFeePressure(ctx, regime), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), fees)
+	}
+
+	require.Panics(t, func() { WithFeePressure(ctx, FeePressureHigh+1) })
+}
+
+func TestFeePressureSimulator(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{}, false, nil)
+	fpA := newFeePressureSimulator(7, defaultFeePressureTransitionMatrix)
+	fpB := newFeePressureSimulator(7, defaultFeePressureTransitionMatrix)
+
+	for i := 0; i < 500; i++ {
+		require.Equal(t, GetFeePressure(fpA.nextBlock(ctx)), GetFeePressure(fpB.nextBlock(ctx)))
+	}
+
+	// every regime is visited along a long enough run
+	for regime, blocks := range fpA.blocks {
+		require.True(t, blocks > 0, "no block simulated under regime %d", regime)
+	}
+	require.Equal(t, fpA.String(), fpB.String())
+}
+
+func TestRandomFeesWithMinGasPrices(t *testing.T) {
+	// a price of 0.001 requires fees of 1000 for DefaultGenTxGas
+	price := sdk.NewDecWithPrec(1, 3)
+	ctx := sdk.NewContext(nil, abci.Header{}, false, nil).
+		WithMinGasPrices(sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, price)})
+	required := sdk.NewInt(1000)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, required)), requiredFees(ctx.MinGasPrices(), DefaultGenTxGas))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+	r := rand.New(rand.NewSource(1))
+
+	var underpriced, priced int
+	for _, regime := range []int{FeePressureLow, FeePressureNormal, FeePressureHigh} {
+		for i := 0; i < 100; i++ {
+			fees, err := RandomFees(r, WithFeePressure(ctx, regime), coins)
+			require.NoError(t, err)
+
+			amt := fees.AmountOf(sdk.DefaultBondDenom)
+			require.True(t, amt.IsPositive())
+			if amt.LT(required) {
+				underpriced++
+			} else {
+				priced++
+			}
+		}
+	}
+	require.True(t, underpriced > 0 && priced > 0, "%d underpriced and %d priced fees", underpriced, priced)
+
+	// balances below the required fees can only pay underpriced fees
+	for i := 0; i < 100; i++ {
+		fees, err := RandomFees(r, ctx, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)))
+		require.NoError(t, err)
+		require.True(t, fees.AmountOf(sdk.DefaultBondDenom).LT(required))
+	}
+}
+
+func TestMinGasPriceFeeRange(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	balance := sdk.NewInt(1000000)
+
+	for i := 0; i < 100; i++ {
+		// under high fee pressure the underpriced fees are capped by the required amount
+		min, max := minGasPriceFeeRange(r, sdk.NewInt(500000), balance, sdk.NewInt(1000), balance)
+		require.True(t, min.LTE(max))
+		if max.LT(sdk.NewInt(1000)) {
+			require.Equal(t, sdk.NewInt(999), max)
+			require.Equal(t, sdk.NewInt(999), min)
+		} else {
+			require.Equal(t, sdk.NewInt(500000), min)
+			require.Equal(t, balance, max)
+		}
+
+		// under low fee pressure the priced fees are raised to the required amount
+		min, max = minGasPriceFeeRange(r, sdk.OneInt(), sdk.NewInt(1000), sdk.NewInt(5000), balance)
+		require.True(t, min.LTE(max))
+		if min.GTE(sdk.NewInt(5000)) {
+			require.Equal(t, sdk.NewInt(5000), max)
+		} else {
+			require.Equal(t, sdk.NewInt(1000), max)
+		}
+
+		// no positive fee is below a required amount of one
+		min, _ = minGasPriceFeeRange(r, sdk.OneInt(), balance, sdk.OneInt(), balance)
+		require.Equal(t, sdk.OneInt(), min)
+	}
+}
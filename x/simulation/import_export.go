@@ -0,0 +1,13 @@
+package simulation
+
+// ImportExportChecker exports the state of an application, imports it in a
+// brand-new application and compares the stores of both applications, so that
+// the simulator can catch genesis export bugs (e.g. missing fields or
+// non-deterministic iteration) before they surface on a chain upgrade.
+type ImportExportChecker interface {
+	// CheckImportExport exports the last committed state of the application
+	// and imports it in a fresh application. It returns an error describing
+	// the key-value pairs that differ between the stores of both applications,
+	// if any.
+	CheckImportExport() error
+}
@@ -0,0 +1,118 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InvariantsChecker runs the invariants registered by the modules of an
+// application, e.g. through its crisis keeper, and dumps the stores their
+// invariants read so that the simulator can report broken invariants along
+// with the state they were found broken in.
+type InvariantsChecker interface {
+	sdk.InvariantsChecker
+
+	// DumpInvariantStores returns the key-value pairs of each of the stores
+	// read by the invariants of a module, by store name.
+	DumpInvariantStores(ctx sdk.Context, moduleName string) map[string][]string
+
+	// DiffInvariantStores returns the key-value pairs that differ between the
+	// committed and the current state of each of the stores read by the
+	// invariants of a module, by store name, decoded through the store
+	// decoders of the application.
+	DiffInvariantStores(committedCtx, ctx sdk.Context, moduleName string) map[string][]string
+}
+
+// InvariantsReport is the report of the invariants found broken on a block
+type InvariantsReport struct {
+	Height     int64                   `json:"height"`
+	Invariants []BrokenInvariantReport `json:"invariants"`
+}
+
+// BrokenInvariantReport is the report of a single broken invariant, with the
+// dump of the stores read by the invariants of its module and their decoded
+// diff against the last committed state
+type BrokenInvariantReport struct {
+	sdk.BrokenInvariant
+
+	Stores map[string][]string `json:"stores,omitempty"`
+	Diffs  map[string][]string `json:"diffs,omitempty"`
+}
+
+// checkInvariants runs the invariants of the application on ctx and returns
+// the report of the broken ones, or nil if none is. The stores of the broken
+// invariants are diffed against committedCtx, i.e. the state of the last
+// committed block. Unless allInvariants is set, only the first broken
+// invariant is reported.
+func checkInvariants(committedCtx, ctx sdk.Context, checker InvariantsChecker, allInvariants bool) *InvariantsReport {
+	err := checker.CheckInvariants(ctx)
+	if err == nil {
+		return nil
+	}
+
+	broken, ok := err.(sdk.BrokenInvariantsError)
+	if !ok {
+		// the checker doesn't report its invariants one by one
+		broken = sdk.BrokenInvariantsError{{Message: err.Error()}}
+	}
+
+	if !allInvariants {
+		broken = broken[:1]
+	}
+
+	report := &InvariantsReport{Height: ctx.BlockHeight()}
+	for _, invar := range broken {
+		invarReport := BrokenInvariantReport{BrokenInvariant: invar}
+		if invar.ModuleName != "" {
+			invarReport.Stores = checker.DumpInvariantStores(ctx, invar.ModuleName)
+			invarReport.Diffs = checker.DiffInvariantStores(committedCtx, ctx, invar.ModuleName)
+		}
+
+		report.Invariants = append(report.Invariants, invarReport)
+	}
+
+	return report
+}
+
+// String implements the Stringer interface. Only the decoded store diffs are
+// printed, the full store dumps are solely included on the exported JSON.
+func (ir InvariantsReport) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d invariant(s) broken on block %d\n", len(ir.Invariants), ir.Height)
+
+	for _, invar := range ir.Invariants {
+		fmt.Fprintf(&sb, "\n%s/%s invariant broken:\n%s\n",
+			invar.ModuleName, invar.Route, strings.TrimSpace(invar.Message))
+
+		stores := make([]string, 0, len(invar.Diffs))
+		for store := range invar.Diffs {
+			stores = append(stores, store)
+		}
+		sort.Strings(stores)
+
+		for _, store := range stores {
+			diffs := invar.Diffs[store]
+			fmt.Fprintf(&sb, "\n%s store diff against the last committed state (%d entries):\n", store, len(diffs))
+			for _, diff := range diffs {
+				fmt.Fprintf(&sb, "  %s\n", strings.ReplaceAll(strings.TrimSpace(diff), "\n", "\n  "))
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// ExportJSON saves the report as a JSON file on a given path
+func (ir InvariantsReport) ExportJSON(path string) error {
+	bz, err := json.MarshalIndent(ir, "", " ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, bz, 0644)
+}
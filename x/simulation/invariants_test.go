@@ -0,0 +1,63 @@
+package simulation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockInvariantsChecker struct {
+	err error
+}
+
+func (ic mockInvariantsChecker) CheckInvariants(sdk.Context) error { return ic.err }
+
+func (ic mockInvariantsChecker) DumpInvariantStores(_ sdk.Context, moduleName string) map[string][]string {
+	return map[string][]string{moduleName + "_store": {"01 => 02"}}
+}
+
+func (ic mockInvariantsChecker) DiffInvariantStores(_, _ sdk.Context, moduleName string) map[string][]string {
+	return map[string][]string{moduleName + "_store": {"balance: 1\nbalance: 2"}}
+}
+
+func TestCheckInvariants(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{Height: 7}, false, nil)
+
+	require.Nil(t, checkInvariants(ctx, ctx, mockInvariantsChecker{}, true))
+
+	broken := sdk.BrokenInvariantsError{
+		{ModuleName: "bank", Route: "nonnegative-outstanding", Message: "negative balance"},
+		{ModuleName: "supply", Route: "total-supply", Message: "supply mismatch"},
+	}
+
+	// only the first broken invariant is reported by default
+	report := checkInvariants(ctx, ctx, mockInvariantsChecker{broken}, false)
+	require.NotNil(t, report)
+	require.Equal(t, int64(7), report.Height)
+	require.Equal(t, []BrokenInvariantReport{
+		{
+			BrokenInvariant: broken[0],
+			Stores:          map[string][]string{"bank_store": {"01 => 02"}},
+			Diffs:           map[string][]string{"bank_store": {"balance: 1\nbalance: 2"}},
+		},
+	}, report.Invariants)
+
+	report = checkInvariants(ctx, ctx, mockInvariantsChecker{broken}, true)
+	require.Len(t, report.Invariants, 2)
+	require.Equal(t, broken[1], report.Invariants[1].BrokenInvariant)
+	require.Contains(t, report.String(), "supply/total-supply invariant broken:\nsupply mismatch")
+	require.Contains(t, report.String(), "supply_store store diff against the last committed state (1 entries):\n  balance: 1\n  balance: 2")
+
+	// the full store dumps are only exported to JSON
+	require.NotContains(t, report.String(), "01 => 02")
+
+	// checkers not reporting their invariants one by one are reported as is
+	report = checkInvariants(ctx, ctx, mockInvariantsChecker{errors.New("broken")}, true)
+	require.Equal(t, []BrokenInvariantReport{
+		{BrokenInvariant: sdk.BrokenInvariant{Message: "broken"}},
+	}, report.Invariants)
+}
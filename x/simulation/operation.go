@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"encoding/json"
+	"fmt"
 	"math/rand"
 	"sort"
 	"time"
@@ -83,8 +84,8 @@ func (oe OperationEntry) MustMarshal() json.RawMessage {
 // OperationMsg - structure for operation output
 type OperationMsg struct {
 	Route   string          `json:"route" yaml:"route"`     // msg route (i.e module name)
-	Name    string          `json:"name" yaml:"name"`       // operation name (msg Type or "no-operation")
-	Comment string          `json:"comment" yaml:"comment"` // additional comment
+	Name    string          `json:"name" yaml:"name"`       // operation name (i.e msg Type)
+	Comment string          `json:"comment" yaml:"comment"` // additional comment (i.e the reason of a no-operation)
 	OK      bool            `json:"ok" yaml:"ok"`           // success
 	Msg     json.RawMessage `json:"msg" yaml:"msg"`         // JSON encoded msg
 }
@@ -105,9 +106,15 @@ func NewOperationMsg(msg sdk.Msg, ok bool, comment string) OperationMsg {
 	return NewOperationMsgBasic(msg.Route(), msg.Type(), comment, ok, msg.GetSignBytes())
 }
 
-// NoOpMsg - create a no-operation message
-func NoOpMsg(route string) OperationMsg {
-	return NewOperationMsgBasic(route, "no-operation", "", false, nil)
+// NoOpMsg - create a no-operation message for the given msg type, the comment
+// giving the reason why no msg was delivered
+func NoOpMsg(route, msgType, comment string) OperationMsg {
+	return NewOperationMsgBasic(route, msgType, comment, false, nil)
+}
+
+// IsNoOp returns true if the operation didn't deliver any msg
+func (om OperationMsg) IsNoOp() bool {
+	return !om.OK && om.Msg == nil
 }
 
 // log entry text for this operation msg
@@ -128,13 +135,25 @@ func (om OperationMsg) MustMarshal() json.RawMessage {
 	return out
 }
 
-// LogEvent adds an event for the events stats
+// LogEvent adds an event for the events stats. No-operations are tallied
+// along with their reason.
 func (om OperationMsg) LogEvent(eventLogger func(route, op, evResult string)) {
-	pass := "ok"
-	if !om.OK {
-		pass = "failure"
+	var result string
+	switch {
+	case om.OK:
+		result = EventResultOK
+
+	case om.IsNoOp() && om.Comment != "":
+		result = fmt.Sprintf("%s: %s", EventResultNoOp, om.Comment)
+
+	case om.IsNoOp():
+		result = EventResultNoOp
+
+	default:
+		result = EventResultFailure
 	}
-	eventLogger(om.Route, om.Name, pass)
+
+	eventLogger(om.Route, om.Name, result)
 }
 
 // OperationQueue defines an object for a queue of operations
@@ -147,7 +166,7 @@ func NewOperationQueue() OperationQueue {
 
 // queueOperations adds all future operations into the operation queue.
 func queueOperations(queuedOps OperationQueue,
-	queuedTimeOps []FutureOperation, futureOps []FutureOperation) {
+	queuedTimeOps *[]FutureOperation, futureOps []FutureOperation) {
 
 	if futureOps == nil {
 		return
@@ -166,24 +185,29 @@ func queueOperations(queuedOps OperationQueue,
 
 		// TODO: Replace with proper sorted data structure, so don't have the
 		// copy entire slice
+		timeOps := *queuedTimeOps
 		index := sort.Search(
-			len(queuedTimeOps),
+			len(timeOps),
 			func(i int) bool {
-				return queuedTimeOps[i].BlockTime.After(futureOp.BlockTime)
+				return timeOps[i].BlockTime.After(futureOp.BlockTime)
 			},
 		)
-		queuedTimeOps = append(queuedTimeOps, FutureOperation{})
-		copy(queuedTimeOps[index+1:], queuedTimeOps[index:])
-		queuedTimeOps[index] = futureOp
+		timeOps = append(timeOps, FutureOperation{})
+		copy(timeOps[index+1:], timeOps[index:])
+		timeOps[index] = futureOp
+		*queuedTimeOps = timeOps
 	}
 }
 
 //________________________________________________________________________
 
 // FutureOperation is an operation which will be ran at the beginning of the
-// provided BlockHeight. If both a BlockHeight and BlockTime are specified, it
+// provided BlockHeight, or of the first block whose time is equal to or after
+// the provided BlockTime (e.g. to model an operation that must happen before
+// the end of a period). If both a BlockHeight and BlockTime are specified, it
 // will use the BlockHeight. In the (likely) event that multiple operations
-// are queued at the same block height, they will execute in a FIFO pattern.
+// are queued at the same block height, they will execute in a FIFO pattern,
+// while operations queued by time execute in the order of their BlockTime.
 type FutureOperation struct {
 	BlockHeight int
 	BlockTime   time.Time
@@ -0,0 +1,82 @@
+package simulation
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestQueueOperations(t *testing.T) {
+	noOp := func(*rand.Rand, *baseapp.BaseApp, sdk.Context, []Account, string) (OperationMsg, []FutureOperation, error) {
+		return NoOpMsg("test", "test", ""), nil, nil
+	}
+
+	start := time.Unix(0, 0).UTC()
+	queuedOps := NewOperationQueue()
+	queuedTimeOps := []FutureOperation{}
+
+	queueOperations(queuedOps, &queuedTimeOps, []FutureOperation{
+		{BlockHeight: 2, Op: noOp},
+		{BlockTime: start.Add(2 * time.Second), Op: noOp},
+		{BlockHeight: 2, Op: noOp},
+		{BlockTime: start.Add(time.Second), Op: noOp},
+	})
+	queueOperations(queuedOps, &queuedTimeOps, []FutureOperation{
+		{BlockTime: start.Add(3 * time.Second), Op: noOp},
+	})
+
+	require.Len(t, queuedOps[2], 2)
+
+	// the time operations are kept sorted by time
+	require.Len(t, queuedTimeOps, 3)
+	for i, futureOp := range queuedTimeOps {
+		require.Equal(t, start.Add(time.Duration(i+1)*time.Second), futureOp.BlockTime)
+	}
+}
+
+func TestRunQueuedTimeOperations(t *testing.T) {
+	var ran []int
+	op := func(i int) Operation {
+		return func(*rand.Rand, *baseapp.BaseApp, sdk.Context, []Account, string) (OperationMsg, []FutureOperation, error) {
+			ran = append(ran, i)
+			return NewOperationMsgBasic("test", "test", "", true, nil), nil, nil
+		}
+	}
+
+	start := time.Unix(0, 0).UTC()
+	queuedTimeOps := []FutureOperation{}
+	queueOperations(NewOperationQueue(), &queuedTimeOps, []FutureOperation{
+		{BlockTime: start.Add(3 * time.Second), Op: op(3)},
+		{BlockTime: start.Add(time.Second), Op: op(1)},
+		{BlockTime: start.Add(2 * time.Second), Op: op(2)},
+	})
+
+	r := rand.New(rand.NewSource(1))
+	app := &baseapp.BaseApp{}
+	ctx := sdk.NewContext(nil, abci.Header{}, false, nil)
+	run := func(height int, blockTime time.Time) int {
+		return runQueuedTimeOperations(
+			&queuedTimeOps, height, blockTime, t, r, app, ctx, nil,
+			NewLogWriter(false), NewEventStats(), false, "",
+		)
+	}
+
+	// no operation is due before its scheduled time
+	require.Equal(t, 0, run(1, start))
+	require.Empty(t, ran)
+
+	// operations due at the block time are run in the order of their time
+	require.Equal(t, 2, run(2, start.Add(2*time.Second)))
+	require.Equal(t, []int{1, 2}, ran)
+	require.Len(t, queuedTimeOps, 1)
+
+	require.Equal(t, 1, run(3, start.Add(time.Hour)))
+	require.Equal(t, []int{1, 2, 3}, ran)
+	require.Empty(t, queuedTimeOps)
+}
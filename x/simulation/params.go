@@ -6,6 +6,8 @@ import (
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
@@ -33,6 +35,13 @@ var (
 		{15, 92, 1},
 		{0, 3, 99},
 	})
+
+	// 3 states: low, normal and high fee pressure
+	defaultFeePressureTransitionMatrix, _ = CreateTransitionMatrix([][]int{
+		{80, 10, 5},
+		{15, 80, 15},
+		{5, 10, 80},
+	})
 )
 
 // AppParams defines a flat JSON of key/values for all possible configurable
@@ -57,23 +66,27 @@ func (sp AppParams) GetOrGenerate(cdc *codec.Codec, key string, ptr interface{},
 
 // Params define the parameters necessary for running the simulations
 type Params struct {
-	PastEvidenceFraction      float64
-	NumKeys                   int
-	EvidenceFraction          float64
-	InitialLivenessWeightings []int
-	LivenessTransitionMatrix  TransitionMatrix
-	BlockSizeTransitionMatrix TransitionMatrix
+	PastEvidenceFraction        float64
+	NumKeys                     int
+	EvidenceFraction            float64
+	InitialLivenessWeightings   []int
+	LivenessTransitionMatrix    TransitionMatrix
+	BlockSizeTransitionMatrix   TransitionMatrix
+	FeePressureTransitionMatrix TransitionMatrix
+	MinGasPrice                 sdk.Dec // minimum gas price of the node in the bond denomination, zero unless Config.MinGasPrices is set
 }
 
 // RandomParams for simulation
 func RandomParams(r *rand.Rand) Params {
 	return Params{
-		PastEvidenceFraction:      r.Float64(),
-		NumKeys:                   RandIntBetween(r, 2, 2500), // number of accounts created for the simulation
-		EvidenceFraction:          r.Float64(),
-		InitialLivenessWeightings: []int{RandIntBetween(r, 1, 80), r.Intn(10), r.Intn(10)},
-		LivenessTransitionMatrix:  defaultLivenessTransitionMatrix,
-		BlockSizeTransitionMatrix: defaultBlockSizeTransitionMatrix,
+		PastEvidenceFraction:        r.Float64(),
+		NumKeys:                     RandIntBetween(r, 2, 2500), // number of accounts created for the simulation
+		EvidenceFraction:            r.Float64(),
+		InitialLivenessWeightings:   []int{RandIntBetween(r, 1, 80), r.Intn(10), r.Intn(10)},
+		LivenessTransitionMatrix:    defaultLivenessTransitionMatrix,
+		BlockSizeTransitionMatrix:   defaultBlockSizeTransitionMatrix,
+		FeePressureTransitionMatrix: defaultFeePressureTransitionMatrix,
+		MinGasPrice:                 sdk.ZeroDec(),
 	}
 }
 
@@ -105,3 +118,18 @@ func NewSimParamChange(subspace, key, subkey string, simVal SimValFn) ParamChang
 func (spc ParamChange) ComposedKey() string {
 	return fmt.Sprintf("%s/%s/%s", spc.Subspace, spc.Key, spc.Subkey)
 }
+
+//-----------------------------------------------------------------------------
+// Proposal Contents
+
+// ContentSimulatorFn defines a function type alias for generating random proposal
+// content.
+type ContentSimulatorFn func(r *rand.Rand, ctx sdk.Context, accs []Account) govtypes.Content
+
+// WeightedProposalContent defines a common struct for proposal contents defined by
+// the modules, which are submitted through the governance module
+type WeightedProposalContent struct {
+	AppParamsKey       string             // key used to retrieve the value of the weight from the simulation application params
+	DefaultWeight      int                // default weight
+	ContentSimulatorFn ContentSimulatorFn // content simulator function
+}
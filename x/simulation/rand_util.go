@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 	"math/rand"
 	"time"
@@ -16,6 +17,39 @@ const (
 	letterIdxMax  = 63 / letterIdxBits   // # of letter indices fitting in 63 bits
 )
 
+type extremeValueRateKey struct{}
+
+// WithExtremeValueRate returns a context holding the probability, in the range
+// [0, 1], with which the random amount generators are biased towards boundary
+// values (e.g. 1, max, max-1 or amounts that truncate to zero shares). The
+// simulation sets it from Config.ExtremeValueRate on the context of each block.
+func WithExtremeValueRate(ctx sdk.Context, rate float64) sdk.Context {
+	if rate < 0 || rate > 1 {
+		panic(fmt.Sprintf("extreme value rate must be between 0 and 1, got %v", rate))
+	}
+	return ctx.WithValue(extremeValueRateKey{}, rate)
+}
+
+// GetExtremeValueRate returns the extreme value rate of the context, which is
+// zero, i.e. no bias, unless it has been set by WithExtremeValueRate.
+func GetExtremeValueRate(ctx sdk.Context) float64 {
+	if ctx.Context() == nil {
+		return 0
+	}
+	rate, ok := ctx.Value(extremeValueRateKey{}).(float64)
+	if !ok {
+		return 0
+	}
+	return rate
+}
+
+// useExtremeValue returns true if a boundary value should be generated. No
+// randomness is consumed when the bias is disabled.
+func useExtremeValue(r *rand.Rand, ctx sdk.Context) bool {
+	rate := GetExtremeValueRate(ctx)
+	return rate > 0 && r.Float64() < rate
+}
+
 // shamelessly copied from
 // https://stackoverflow.com/questions/22892120/how-to-generate-a-random-string-of-a-fixed-length-in-golang#31832326
 
@@ -38,17 +72,24 @@ func RandStringOfLength(r *rand.Rand, n int) string {
 }
 
 // RandPositiveInt get a rand positive sdk.Int
-func RandPositiveInt(r *rand.Rand, max sdk.Int) (sdk.Int, error) {
+func RandPositiveInt(r *rand.Rand, ctx sdk.Context, max sdk.Int) (sdk.Int, error) {
 	if !max.GTE(sdk.OneInt()) {
 		return sdk.Int{}, errors.New("max too small")
 	}
+	if useExtremeValue(r, ctx) {
+		return extremeInt(r, sdk.OneInt(), max), nil
+	}
 	max = max.Sub(sdk.OneInt())
 	return sdk.NewIntFromBigInt(new(big.Int).Rand(r, max.BigInt())).Add(sdk.OneInt()), nil
 }
 
 // RandomAmount generates a random amount
 // Note: The range of RandomAmount includes max, and is, in fact, biased to return max as well as 0.
-func RandomAmount(r *rand.Rand, max sdk.Int) sdk.Int {
+func RandomAmount(r *rand.Rand, ctx sdk.Context, max sdk.Int) sdk.Int {
+	if useExtremeValue(r, ctx) && max.IsPositive() {
+		return extremeInt(r, sdk.ZeroInt(), max)
+	}
+
 	var randInt = big.NewInt(0)
 	switch r.Intn(10) {
 	case 0:
@@ -63,7 +104,11 @@ func RandomAmount(r *rand.Rand, max sdk.Int) sdk.Int {
 
 // RandomDecAmount generates a random decimal amount
 // Note: The range of RandomDecAmount includes max, and is, in fact, biased to return max as well as 0.
-func RandomDecAmount(r *rand.Rand, max sdk.Dec) sdk.Dec {
+func RandomDecAmount(r *rand.Rand, ctx sdk.Context, max sdk.Dec) sdk.Dec {
+	if useExtremeValue(r, ctx) && max.IsPositive() {
+		return extremeDec(r, max)
+	}
+
 	var randInt = big.NewInt(0)
 	switch r.Intn(10) {
 	case 0:
@@ -76,6 +121,53 @@ func RandomDecAmount(r *rand.Rand, max sdk.Dec) sdk.Dec {
 	return sdk.NewDecFromBigIntWithPrec(randInt, sdk.Precision)
 }
 
+// extremeInt returns one of the boundary values min, min+1, max-1 and max,
+// where max >= min.
+func extremeInt(r *rand.Rand, min, max sdk.Int) sdk.Int {
+	var v sdk.Int
+	switch r.Intn(4) {
+	case 0:
+		v = min
+	case 1:
+		v = min.Add(sdk.OneInt())
+	case 2:
+		v = max.Sub(sdk.OneInt())
+	default:
+		v = max
+	}
+
+	// clamp for ranges narrower than the boundary offsets
+	if v.LT(min) {
+		return min
+	}
+	if v.GT(max) {
+		return max
+	}
+	return v
+}
+
+// extremeDec returns one of the boundary values for a positive max: the
+// smallest representable decimal, a value just below one which truncates to a
+// zero integer amount, max minus the smallest decimal and max itself.
+func extremeDec(r *rand.Rand, max sdk.Dec) sdk.Dec {
+	var v sdk.Dec
+	switch r.Intn(4) {
+	case 0:
+		v = sdk.SmallestDec()
+	case 1:
+		v = sdk.OneDec().Sub(sdk.SmallestDec())
+	case 2:
+		v = max.Sub(sdk.SmallestDec())
+	default:
+		v = max
+	}
+
+	if v.GT(max) {
+		return max
+	}
+	return v
+}
+
 // RandTimestamp generates a random timestamp
 func RandTimestamp(r *rand.Rand) time.Time {
 	// json.Marshal breaks for timestamps greater with year greater than 9999
@@ -91,14 +183,14 @@ func RandIntBetween(r *rand.Rand, min, max int) int {
 // returns random subset of the provided coins
 // will return at least one coin unless coins argument is empty or malformed
 // i.e. 0 amt in coins
-func RandSubsetCoins(r *rand.Rand, coins sdk.Coins) sdk.Coins {
+func RandSubsetCoins(r *rand.Rand, ctx sdk.Context, coins sdk.Coins) sdk.Coins {
 	if len(coins) == 0 {
 		return sdk.Coins{}
 	}
 	// make sure at least one coin added
 	denomIdx := r.Intn(len(coins))
 	coin := coins[denomIdx]
-	amt, err := RandPositiveInt(r, coin.Amount)
+	amt, err := RandPositiveInt(r, ctx, coin.Amount)
 	// malformed coin. 0 amt in coins
 	if err != nil {
 		return sdk.Coins{}
@@ -115,7 +207,7 @@ func RandSubsetCoins(r *rand.Rand, coins sdk.Coins) sdk.Coins {
 			continue
 		}
 
-		amt, err := RandPositiveInt(r, c.Amount)
+		amt, err := RandPositiveInt(r, ctx, c.Amount)
 		// ignore errors and try another denom
 		if err != nil {
 			continue
@@ -0,0 +1,77 @@
+package simulation
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestExtremeValues(t *testing.T) {
+	max := sdk.NewInt(1000)
+	maxDec := sdk.NewDec(1000)
+	r := rand.New(rand.NewSource(1))
+	ctx := WithExtremeValueRate(sdk.NewContext(nil, abci.Header{}, false, nil), 1)
+
+	for i := 0; i < 100; i++ {
+		amt := RandomAmount(r, ctx, max)
+		require.True(t, amt.Equal(sdk.ZeroInt()) || amt.Equal(sdk.OneInt()) ||
+			amt.Equal(sdk.NewInt(999)) || amt.Equal(max), amt.String())
+
+		pos, err := RandPositiveInt(r, ctx, max)
+		require.NoError(t, err)
+		require.True(t, pos.GTE(sdk.OneInt()) && pos.LTE(max))
+		require.True(t, pos.Equal(sdk.OneInt()) || pos.Equal(sdk.NewInt(2)) ||
+			pos.Equal(sdk.NewInt(999)) || pos.Equal(max), pos.String())
+
+		dec := RandomDecAmount(r, ctx, maxDec)
+		require.True(t, dec.IsPositive() && dec.LTE(maxDec), dec.String())
+	}
+
+	// narrow ranges must stay within bounds
+	for i := 0; i < 20; i++ {
+		pos, err := RandPositiveInt(r, ctx, sdk.OneInt())
+		require.NoError(t, err)
+		require.True(t, pos.Equal(sdk.OneInt()))
+	}
+}
+
+func TestWithExtremeValueRate(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{}, false, nil)
+	require.Zero(t, GetExtremeValueRate(ctx))
+	require.Zero(t, GetExtremeValueRate(sdk.Context{}))
+
+	require.Equal(t, 0.5, GetExtremeValueRate(WithExtremeValueRate(ctx, 0.5)))
+	require.Panics(t, func() { WithExtremeValueRate(ctx, -0.1) })
+	require.Panics(t, func() { WithExtremeValueRate(ctx, 1.5) })
+}
+
+func TestExtremeValueRatePerContext(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{}, false, nil)
+	max := sdk.NewInt(1000000)
+
+	// the amounts drawn without bias don't depend on the rate of concurrent
+	// simulations, as the rate is held by the context of each of them
+	draw := func(rate float64) []sdk.Int {
+		r := rand.New(rand.NewSource(3))
+		rateCtx := WithExtremeValueRate(ctx, rate)
+		amounts := make([]sdk.Int, 100)
+		for i := range amounts {
+			amounts[i] = RandomAmount(r, rateCtx, max)
+		}
+		return amounts
+	}
+	expected := draw(0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() { defer wg.Done(); draw(1) }()
+		go func() { defer wg.Done(); require.Equal(t, expected, draw(0)) }()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,192 @@
+package simulation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Replay is the record of a simulation run. As the simulation is deterministic
+// for a given seed, the seed and the configuration of the run are enough to
+// re-execute it, while the checkpoints of its blocks tell where the
+// re-execution diverges from the recorded run, if it does.
+type Replay struct {
+	Config      Config              `json:"config"`
+	GenesisHash []byte              `json:"genesis_hash"` // SHA-256 of the sorted genesis app state
+	Checkpoints []*ReplayCheckpoint `json:"checkpoints"`
+	Failure     string              `json:"failure,omitempty"` // reason of the failure of the run, if it failed
+}
+
+// ReplayCheckpoint is the outcome of a single simulated block
+type ReplayCheckpoint struct {
+	Height     int64  `json:"height"`
+	Operations int    `json:"operations"`         // number of operations run by the block, besides the queued ones
+	AppHash    []byte `json:"app_hash,omitempty"` // set only if the simulation commits and the block is complete
+}
+
+// ReadReplay reads a simulation replay from a JSON file
+func ReadReplay(path string) (*Replay, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	replay := new(Replay)
+	if err := json.Unmarshal(bz, replay); err != nil {
+		return nil, fmt.Errorf("failed to decode simulation replay %s: %v", path, err)
+	}
+
+	return replay, nil
+}
+
+// ExportJSON saves the replay as a JSON file on a given path
+func (rp *Replay) ExportJSON(path string) error {
+	bz, err := json.Marshal(rp)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, bz, 0644)
+}
+
+// apply overrides the parameters of config which define the simulated blocks
+// with the recorded ones
+func (rp *Replay) apply(config Config) Config {
+	config.GenesisFile = rp.Config.GenesisFile
+	config.ParamsFile = rp.Config.ParamsFile
+	config.Seed = rp.Config.Seed
+	config.InitialBlockHeight = rp.Config.InitialBlockHeight
+	config.NumBlocks = rp.Config.NumBlocks
+	config.BlockSize = rp.Config.BlockSize
+	config.ChainID = rp.Config.ChainID
+	config.BondDenom = rp.Config.BondDenom
+	config.BondExponent = rp.Config.BondExponent
+	config.Commit = rp.Config.Commit
+	config.ExtremeValueRate = rp.Config.ExtremeValueRate
+	config.BlockSizeDistribution = rp.Config.BlockSizeDistribution
+	config.EmptyBlockRate = rp.Config.EmptyBlockRate
+	config.EmptyBlockStretch = rp.Config.EmptyBlockStretch
+	config.MinBlockTime = rp.Config.MinBlockTime
+	config.MaxBlockTime = rp.Config.MaxBlockTime
+	config.MinGasPrices = rp.Config.MinGasPrices
+	config.FeePressure = rp.Config.FeePressure
+	return config
+}
+
+// genesisHash returns the SHA-256 of the genesis app state with its JSON keys
+// sorted, as the app state JSON generated for a given seed isn't guaranteed to
+// order them deterministically
+func genesisHash(appState json.RawMessage) []byte {
+	hash := sha256.Sum256(sdk.MustSortJSON(appState))
+	return hash[:]
+}
+
+//______________________________________________________________________________
+
+// replayTracer records the checkpoints of a simulation run into a Replay, or
+// checks them when replaying one. A nil tracer leaves the simulation untouched.
+type replayTracer struct {
+	replay     *Replay
+	replaying  bool
+	fromHeight int64 // height from which the replayed blocks are checked
+
+	checkpoint *ReplayCheckpoint // checkpoint of the block being recorded or replayed
+	next       int               // next checkpoint to replay
+}
+
+func newReplayTracer(replay *Replay, replaying bool, fromHeight int64) *replayTracer {
+	return &replayTracer{
+		replay:     replay,
+		replaying:  replaying,
+		fromHeight: fromHeight,
+	}
+}
+
+// isReplaying returns true if the tracer checks a recorded replay
+func (rt *replayTracer) isReplaying() bool {
+	return rt != nil && rt.replaying
+}
+
+// skipChecks returns true if the block of the given height is replayed ahead
+// of the height the replay is checked from, so that the invariants and the
+// other checks of the simulation are skipped.
+func (rt *replayTracer) skipChecks(height int64) bool {
+	return rt.isReplaying() && height < rt.fromHeight
+}
+
+// beginBlock records the checkpoint of a new block, or moves to the recorded
+// one when replaying. It returns false if the replay has no blocks left.
+func (rt *replayTracer) beginBlock(height int64) (bool, error) {
+	if rt == nil {
+		return true, nil
+	}
+
+	if !rt.replaying {
+		rt.checkpoint = &ReplayCheckpoint{Height: height}
+		rt.replay.Checkpoints = append(rt.replay.Checkpoints, rt.checkpoint)
+		return true, nil
+	}
+
+	if rt.next >= len(rt.replay.Checkpoints) {
+		return false, nil
+	}
+
+	rt.checkpoint = rt.replay.Checkpoints[rt.next]
+	rt.next++
+
+	if rt.checkpoint.Height != height {
+		return false, fmt.Errorf("replay diverged on block %d: recorded block %d", height, rt.checkpoint.Height)
+	}
+
+	return true, nil
+}
+
+// operations records the number of operations of the current block, or
+// returns the recorded one when replaying. A block of a truncated replay runs
+// fewer operations than the simulation draws for it. It panics if the block
+// draws fewer operations than recorded.
+func (rt *replayTracer) operations(blocksize int) int {
+	if rt == nil {
+		return blocksize
+	}
+
+	if !rt.replaying {
+		rt.checkpoint.Operations = blocksize
+		return blocksize
+	}
+
+	if blocksize < rt.checkpoint.Operations {
+		panic(fmt.Sprintf(
+			"replay diverged on block %d: %d operations, recorded %d",
+			rt.checkpoint.Height, blocksize, rt.checkpoint.Operations,
+		))
+	}
+
+	return rt.checkpoint.Operations
+}
+
+// commit records the app hash of the current block, or checks it against the
+// recorded one when replaying, unless none was recorded.
+func (rt *replayTracer) commit(appHash []byte) error {
+	if rt == nil {
+		return nil
+	}
+
+	if !rt.replaying {
+		rt.checkpoint.AppHash = appHash
+		return nil
+	}
+
+	if rt.checkpoint.AppHash != nil && !bytes.Equal(appHash, rt.checkpoint.AppHash) {
+		return fmt.Errorf(
+			"replay diverged on block %d: app hash %X, recorded %X",
+			rt.checkpoint.Height, appHash, rt.checkpoint.AppHash,
+		)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package simulation
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -26,7 +27,7 @@ type AppStateFn func(r *rand.Rand, accs []Account, config Config) (
 func initChain(
 	r *rand.Rand, params Params, accounts []Account, app *baseapp.BaseApp,
 	appStateFn AppStateFn, config Config,
-) (mockValidators, time.Time, []Account, string) {
+) (mockValidators, time.Time, []Account, string, json.RawMessage) {
 
 	appState, accounts, chainID, genesisTimestamp := appStateFn(r, accounts, config)
 
@@ -37,39 +38,201 @@ func initChain(
 	res := app.InitChain(req)
 	validators := newMockValidators(r, res.Validators, params)
 
-	return validators, genesisTimestamp, accounts, chainID
+	return validators, genesisTimestamp, accounts, chainID, appState
+}
+
+// AppRestartFn builds a new application on top of the committed state of the
+// running one, the same way a node restarts from its database. It returns the
+// new application, loaded at the last committed height, along with the
+// operations bound to it. The operations queued by the previous application
+// keep running against the new one.
+type AppRestartFn func() (app *baseapp.BaseApp, ops WeightedOperations, err error)
+
+// restart the application from its committed state, checking that the new
+// application resumes the chain where the previous one stopped
+func restartApp(
+	app *baseapp.BaseApp, restartFn AppRestartFn,
+) (*baseapp.BaseApp, WeightedOperations, error) {
+
+	newApp, ops, err := restartFn()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if newApp.LastBlockHeight() != app.LastBlockHeight() {
+		return nil, nil, fmt.Errorf(
+			"restarted app is at height %d, expected %d", newApp.LastBlockHeight(), app.LastBlockHeight(),
+		)
+	}
+	if !bytes.Equal(newApp.LastCommitID().Hash, app.LastCommitID().Hash) {
+		return nil, nil, fmt.Errorf(
+			"restarted app hash %X differs from the app hash %X at height %d",
+			newApp.LastCommitID().Hash, app.LastCommitID().Hash, app.LastBlockHeight(),
+		)
+	}
+
+	return newApp, ops, nil
 }
 
 // SimulateFromSeed tests an application by running the provided
 // operations, testing the provided invariants, but using the provided config.Seed.
-// TODO: split this monster function up
 func SimulateFromSeed(
 	tb testing.TB, w io.Writer, app *baseapp.BaseApp,
 	appStateFn AppStateFn, ops WeightedOperations,
 	blackListedAccs map[string]bool, config Config,
 ) (stopEarly bool, exportedParams Params, err error) {
+	return SimulateFromSeedWithRestarts(tb, w, app, appStateFn, nil, ops, blackListedAccs, config)
+}
+
+// SimulateFromSeedWithRestarts behaves like SimulateFromSeed but, if both
+// restartFn and config.RestartPeriod are set, every RestartPeriod blocks it
+// replaces the application by a new one loaded from the committed state and
+// carries on with the same operation stream at the next height. The operations
+// queued by previous operations are kept across restarts.
+//
+// If config.ExportReplayPath is set, the seed, the configuration and the
+// checkpoints of the run are recorded into a Replay file, which re-executes it
+// once passed through config.ReplayFile. If config.ReplayFromHeight is set as
+// well, the blocks of the replay preceding it are re-executed without being
+// checked. If config.CorpusDir is set, the run is recorded as well and its
+// replay is saved to the corpus directory if the simulation fails.
+// TODO: split this monster function up
+func SimulateFromSeedWithRestarts(
+	tb testing.TB, w io.Writer, app *baseapp.BaseApp,
+	appStateFn AppStateFn, restartFn AppRestartFn, ops WeightedOperations,
+	blackListedAccs map[string]bool, config Config,
+) (stopEarly bool, exportedParams Params, err error) {
 
 	// in case we have to end early, don't os.Exit so that we can run cleanup code.
 	testingMode, t, b := getTestingMode(tb)
+
+	var replay *Replay
+	if config.ReplayFile != "" {
+		replay, err = ReadReplay(config.ReplayFile)
+		if err != nil {
+			return true, exportedParams, err
+		}
+
+		config = replay.apply(config)
+		fmt.Fprintf(w, "Replaying %d blocks from %s\n", len(replay.Checkpoints), config.ReplayFile)
+
+		if config.ReplayFromHeight > 0 && !replay.hasCheckpoint(int64(config.ReplayFromHeight)) {
+			return true, exportedParams, fmt.Errorf("replay %s has no block at height %d", config.ReplayFile, config.ReplayFromHeight)
+		}
+	}
+
 	fmt.Fprintf(w, "Starting SimulateFromSeed with randomness created with seed %d\n", int(config.Seed))
 
+	if err := validateBlockShape(config); err != nil {
+		return true, exportedParams, err
+	}
+	if config.ExtremeValueRate < 0 || config.ExtremeValueRate > 1 {
+		return true, exportedParams, fmt.Errorf("extreme value rate must be between 0 and 1, got %v", config.ExtremeValueRate)
+	}
+
+	restarts := restartFn != nil && config.RestartPeriod > 0
+	if restarts && !config.Commit {
+		return true, exportedParams, fmt.Errorf("restarting the app requires the simulation to commit")
+	}
+	if restarts && (config.ReplayFile != "" || config.ExportReplayPath != "" || config.CorpusDir != "") {
+		return true, exportedParams, fmt.Errorf("simulation replays do not support restarting the app")
+	}
+
+	checkInvars := config.InvariantsChecker != nil && config.InvariantCheckPeriod > 0
+	if restarts && checkInvars {
+		return true, exportedParams, fmt.Errorf("periodic invariant checks do not support restarting the app")
+	}
+
+	checkImportExport := config.ImportExportChecker != nil && config.ImportExportCheckPeriod > 0
+	if checkImportExport && !config.Commit {
+		return true, exportedParams, fmt.Errorf("import/export checks require the simulation to commit")
+	}
+	if restarts && checkImportExport {
+		return true, exportedParams, fmt.Errorf("import/export checks do not support restarting the app")
+	}
+
+	checkFees := config.FeePressure && config.FeeAccountingChecker != nil
+	if checkFees && !config.Commit {
+		return true, exportedParams, fmt.Errorf("fee accounting checks require the simulation to commit")
+	}
+	if restarts && checkFees {
+		return true, exportedParams, fmt.Errorf("fee accounting checks do not support restarting the app")
+	}
+
+	if restarts && config.MinGasPrices {
+		return true, exportedParams, fmt.Errorf("minimum gas prices do not support restarting the app")
+	}
+
 	r := rand.New(rand.NewSource(config.Seed))
 	params := RandomParams(r)
+
+	// The minimum gas price is drawn from its own source so that enabling it
+	// leaves the random streams of the simulation untouched. It is set before
+	// InitChain, which sets up the CheckTx state with it.
+	if config.MinGasPrices {
+		params.MinGasPrice = randomMinGasPrice(rand.New(rand.NewSource(config.Seed)))
+		baseapp.SetMinGasPrices(minGasPrices(config, params).String())(app)
+	}
 	fmt.Fprintf(w, "Randomized simulation params: \n%s\n", mustMarshalJSONIndent(params))
 
-	timeDiff := maxTimePerBlock - minTimePerBlock
 	accs := RandomAccounts(r, params.NumKeys)
 	eventStats := NewEventStats()
 
 	// Second variable to keep pending validator set (delayed one block since
 	// TM 0.24) Initially this is the same as the initial validator set
-	validators, genesisTimestamp, accs, chainID := initChain(r, params, accs, app, appStateFn, config)
+	validators, genesisTimestamp, accs, chainID, appState := initChain(r, params, accs, app, appStateFn, config)
 	if len(accs) == 0 {
 		return true, params, fmt.Errorf("must have greater than zero genesis accounts")
 	}
 
 	config.ChainID = chainID
 
+	var tracer *replayTracer
+	switch {
+	case replay != nil:
+		if !bytes.Equal(replay.GenesisHash, genesisHash(appState)) {
+			return true, params, fmt.Errorf("genesis state differs from the recorded one; replays must run with the same genesis")
+		}
+		tracer = newReplayTracer(replay, true, int64(config.ReplayFromHeight))
+
+	case config.ExportReplayPath != "" || config.CorpusDir != "":
+		tracer = newReplayTracer(&Replay{Config: config, GenesisHash: genesisHash(appState)}, false, 0)
+
+		// export the replay even if the simulation fails. Failed operations
+		// fail tb, while panics are re-raised once the replay is exported.
+		defer func() {
+			panicked := recover()
+			switch {
+			case panicked != nil:
+				tracer.replay.Failure = fmt.Sprintf("panic: %v", panicked)
+			case err != nil:
+				tracer.replay.Failure = err.Error()
+			case tb.Failed():
+				tracer.replay.Failure = "simulation failed; see the test output"
+			}
+
+			if config.ExportReplayPath != "" {
+				fmt.Fprintf(w, "\nExporting simulation replay to %s\n", config.ExportReplayPath)
+				if err := tracer.replay.ExportJSON(config.ExportReplayPath); err != nil {
+					fmt.Fprintf(w, "failed to export simulation replay: %v\n", err)
+				}
+			}
+
+			if config.CorpusDir != "" && tracer.replay.Failure != "" {
+				path, err := SaveToCorpus(config.CorpusDir, tracer.replay)
+				if err != nil {
+					fmt.Fprintf(w, "failed to save simulation replay to the corpus: %v\n", err)
+				} else {
+					fmt.Fprintf(w, "\nSaved the replay of the failed simulation to %s\n", path)
+				}
+			}
+
+			if panicked != nil {
+				panic(panicked)
+			}
+		}()
+	}
+
 	fmt.Printf(
 		"Starting the simulation from time %v (unixtime %v)\n",
 		genesisTimestamp.UTC().Format(time.UnixDate), genesisTimestamp.Unix(),
@@ -118,8 +281,8 @@ func SimulateFromSeed(
 	logWriter := NewLogWriter(testingMode)
 
 	blockSimulator := createBlockSimulator(
-		testingMode, tb, t, w, params, eventStats.Tally,
-		ops, operationQueue, timeOperationQueue, logWriter, config)
+		testingMode, tb, t, w, params, eventStats,
+		ops, operationQueue, &timeOperationQueue, logWriter, tracer, config)
 
 	if !testingMode {
 		b.ResetTimer()
@@ -134,6 +297,11 @@ func SimulateFromSeed(
 		}()
 	}
 
+	var feePressure *feePressureSimulator
+	if config.FeePressure {
+		feePressure = newFeePressureSimulator(config.Seed, params.FeePressureTransitionMatrix)
+	}
+
 	// set exported params to the initial state
 	if config.ExportParamsPath != "" && config.ExportParamsHeight == 0 {
 		exportedParams = params
@@ -142,6 +310,17 @@ func SimulateFromSeed(
 	// TODO: split up the contents of this for loop into new functions
 	for height := config.InitialBlockHeight; height < config.NumBlocks+config.InitialBlockHeight && !stopEarly; height++ {
 
+		// Stop after the last recorded block when replaying
+		ok, replayErr := tracer.beginBlock(header.Height)
+		if replayErr != nil {
+			return true, exportedParams, replayErr
+		}
+		if !ok {
+			fmt.Fprintf(w, "\nSimulation replay ended on block %d\n", header.Height)
+			break
+		}
+		checks := !tracer.skipChecks(header.Height)
+
 		// Log the header time for future lookup
 		pastTimes = append(pastTimes, header.Time)
 		pastVoteInfos = append(pastVoteInfos, request.LastCommitInfo.Votes)
@@ -150,17 +329,38 @@ func SimulateFromSeed(
 		logWriter.AddEntry(BeginBlockEntry(int64(height)))
 		app.BeginBlock(request)
 
-		ctx := app.NewContext(false, header)
+		ctx := WithExtremeValueRate(app.NewContext(false, header), config.ExtremeValueRate)
+		if feePressure != nil {
+			ctx = feePressure.nextBlock(ctx)
+		}
+		if config.MinGasPrices {
+			ctx = ctx.WithMinGasPrices(minGasPrices(config, params))
+		}
+
+		// Check the fees collected on the previous block have been burned and
+		// distributed by BeginBlock. The genesis state is never committed on
+		// its own, so there is nothing to compare on the first block.
+		if checkFees && checks && header.Height > 1 {
+			committedCtx := app.NewContext(true, header)
+			if checkErr := config.FeeAccountingChecker.CheckFeeAccounting(committedCtx, ctx); checkErr != nil {
+				fmt.Fprintf(w, "\nfee accounting check failed on block %d:\n%s\n", header.Height, checkErr)
+				logWriter.PrintLogs()
+
+				err = fmt.Errorf("fee accounting check failed on block %d", header.Height)
+				stopEarly = true
+				break
+			}
+		}
 
 		// Run queued operations. Ignores blocksize if blocksize is too small
 		numQueuedOpsRan := runQueuedOperations(
 			operationQueue, int(header.Height), tb, r, app, ctx, accs, logWriter,
-			eventStats.Tally, config.Lean, config.ChainID,
+			eventStats, config.Lean, config.ChainID,
 		)
 
 		numQueuedTimeOpsRan := runQueuedTimeOperations(
-			timeOperationQueue, int(header.Height), header.Time,
-			tb, r, app, ctx, accs, logWriter, eventStats.Tally,
+			&timeOperationQueue, int(header.Height), header.Time,
+			tb, r, app, ctx, accs, logWriter, eventStats,
 			config.Lean, config.ChainID,
 		)
 
@@ -169,16 +369,50 @@ func SimulateFromSeed(
 		opCount += operations + numQueuedOpsRan + numQueuedTimeOpsRan
 
 		res := app.EndBlock(abci.RequestEndBlock{})
+
+		// Check the invariants on the state resulting from the block
+		if checkInvars && checks && (height-config.InitialBlockHeight+1)%config.InvariantCheckPeriod == 0 {
+			committedCtx := app.NewContext(true, header)
+			if report := checkInvariants(committedCtx, ctx, config.InvariantsChecker, config.AllInvariants); report != nil {
+				fmt.Fprintf(w, "\n%s\n", report)
+				logWriter.PrintLogs()
+
+				if config.ExportInvariantsReportPath != "" {
+					fmt.Println("Exporting broken invariants report...")
+					if err := report.ExportJSON(config.ExportInvariantsReportPath); err != nil {
+						return true, exportedParams, err
+					}
+				}
+
+				err = fmt.Errorf("%d invariant(s) broken on block %d", len(report.Invariants), header.Height)
+				stopEarly = true
+				break
+			}
+		}
+
 		header.Height++
-		header.Time = header.Time.Add(
-			time.Duration(minTimePerBlock) * time.Second)
-		header.Time = header.Time.Add(
-			time.Duration(int64(r.Intn(int(timeDiff)))) * time.Second)
+		header.Time = nextBlockTime(r, config, header.Time)
 		header.ProposerAddress = validators.randomProposer(r)
 		logWriter.AddEntry(EndBlockEntry(int64(height)))
 
 		if config.Commit {
-			app.Commit()
+			commitRes := app.Commit()
+			if err := tracer.commit(commitRes.Data); err != nil {
+				return true, exportedParams, err
+			}
+		}
+
+		// Export the committed state, import it in a fresh app and compare
+		// the stores of both apps
+		if checkImportExport && checks && (height-config.InitialBlockHeight+1)%config.ImportExportCheckPeriod == 0 {
+			if checkErr := config.ImportExportChecker.CheckImportExport(); checkErr != nil {
+				fmt.Fprintf(w, "\nimport/export check failed on block %d:\n%s\n", app.LastBlockHeight(), checkErr)
+				logWriter.PrintLogs()
+
+				err = fmt.Errorf("import/export check failed on block %d", app.LastBlockHeight())
+				stopEarly = true
+				break
+			}
 		}
 
 		if header.ProposerAddress == nil {
@@ -202,6 +436,24 @@ func SimulateFromSeed(
 		if config.ExportParamsPath != "" && config.ExportParamsHeight == height {
 			exportedParams = params
 		}
+
+		lastHeight := height == config.NumBlocks+config.InitialBlockHeight-1
+		if restarts && !lastHeight && (height-config.InitialBlockHeight+1)%config.RestartPeriod == 0 {
+			fmt.Fprintf(w, "\nRestarting the app from its committed state at block %d\n", height)
+
+			app, ops, err = restartApp(app, restartFn)
+			if err != nil {
+				return true, exportedParams, err
+			}
+
+			blockSimulator = createBlockSimulator(
+				testingMode, tb, t, w, params, eventStats,
+				ops, operationQueue, &timeOperationQueue, logWriter, tracer, config)
+		}
+	}
+
+	if feePressure != nil {
+		fmt.Fprintf(w, "\nSimulated %s\n", feePressure)
 	}
 
 	if stopEarly {
@@ -228,9 +480,29 @@ func SimulateFromSeed(
 		eventStats.Print(w)
 	}
 
+	if err := eventStats.CheckThresholds(config.MaxFailureRate, config.MaxNoOpRate); err != nil {
+		return false, exportedParams, err
+	}
+
 	return false, exportedParams, nil
 }
 
+// runOperation runs a simulation operation, tallying its result along with the
+// gas consumed by the transactions it delivered.
+func runOperation(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accounts []Account,
+	chainID string, op Operation, eventStats EventStats) (OperationMsg, []FutureOperation, error) {
+
+	gasBefore := app.BlockGasConsumed()
+	opMsg, futureOps, err := op(r, app, ctx, accounts, chainID)
+	opMsg.LogEvent(eventStats.Tally)
+
+	if gasAfter := app.BlockGasConsumed(); gasAfter > gasBefore {
+		eventStats.TallyGas(opMsg.Route, opMsg.Name, gasAfter-gasBefore)
+	}
+
+	return opMsg, futureOps, err
+}
+
 //______________________________________________________________________________
 
 type blockSimFn func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
@@ -239,11 +511,11 @@ type blockSimFn func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 // Returns a function to simulate blocks. Written like this to avoid constant
 // parameters being passed everytime, to minimize memory overhead.
 func createBlockSimulator(testingMode bool, tb testing.TB, t *testing.T, w io.Writer, params Params,
-	event func(route, op, evResult string), ops WeightedOperations,
-	operationQueue OperationQueue, timeOperationQueue []FutureOperation,
-	logWriter LogWriter, config Config) blockSimFn {
+	eventStats EventStats, ops WeightedOperations,
+	operationQueue OperationQueue, timeOperationQueue *[]FutureOperation,
+	logWriter LogWriter, tracer *replayTracer, config Config) blockSimFn {
 
-	lastBlockSizeState := 0 // state for [4 * uniform distribution]
+	sizer := newBlockSizer(config, params)
 	blocksize := 0
 	selectOp := ops.getSelectOpFn()
 
@@ -255,13 +527,12 @@ func createBlockSimulator(testingMode bool, tb testing.TB, t *testing.T, w io.Wr
 			w, "\rSimulating... block %d/%d, operation %d/%d.",
 			header.Height, config.NumBlocks, opCount, blocksize,
 		)
-		lastBlockSizeState, blocksize = getBlockSize(r, params, lastBlockSizeState, config.BlockSize)
-
 		type opAndR struct {
 			op   Operation
 			rand *rand.Rand
 		}
 
+		blocksize = tracer.operations(sizer.next(r))
 		opAndRz := make([]opAndR, 0, blocksize)
 
 		// Predetermine the blocksize slice so that we can do things like block
@@ -277,8 +548,7 @@ func createBlockSimulator(testingMode bool, tb testing.TB, t *testing.T, w io.Wr
 			// NOTE: the Rand 'r' should not be used here.
 			opAndR := opAndRz[i]
 			op, r2 := opAndR.op, opAndR.rand
-			opMsg, futureOps, err := op(r2, app, ctx, accounts, config.ChainID)
-			opMsg.LogEvent(event)
+			opMsg, futureOps, err := runOperation(r2, app, ctx, accounts, config.ChainID, op, eventStats)
 
 			if !config.Lean || opMsg.OK {
 				logWriter.AddEntry(MsgEntry(header.Height, int64(i), opMsg))
@@ -309,7 +579,7 @@ Comment: %s`,
 func runQueuedOperations(queueOps map[int][]Operation,
 	height int, tb testing.TB, r *rand.Rand, app *baseapp.BaseApp,
 	ctx sdk.Context, accounts []Account, logWriter LogWriter,
-	event func(route, op, evResult string), lean bool, chainID string) (numOpsRan int) {
+	eventStats EventStats, lean bool, chainID string) (numOpsRan int) {
 
 	queuedOp, ok := queueOps[height]
 	if !ok {
@@ -322,44 +592,53 @@ func runQueuedOperations(queueOps map[int][]Operation,
 		// For now, queued operations cannot queue more operations.
 		// If a need arises for us to support queued messages to queue more messages, this can
 		// be changed.
-		opMsg, _, err := queuedOp[i](r, app, ctx, accounts, chainID)
-		opMsg.LogEvent(event)
+		opMsg, _, err := runOperation(r, app, ctx, accounts, chainID, queuedOp[i], eventStats)
 		if !lean || opMsg.OK {
 			logWriter.AddEntry((QueuedMsgEntry(int64(height), opMsg)))
 		}
 		if err != nil {
 			logWriter.PrintLogs()
-			tb.FailNow()
+			tb.Fatalf("error on block %d, queued operation from x/%s:\n%v", height, opMsg.Route, err)
 		}
 	}
 	delete(queueOps, height)
 	return numOpsRan
 }
 
-func runQueuedTimeOperations(queueOps []FutureOperation,
+func runQueuedTimeOperations(queueOps *[]FutureOperation,
 	height int, currentTime time.Time, tb testing.TB, r *rand.Rand,
 	app *baseapp.BaseApp, ctx sdk.Context, accounts []Account,
-	logWriter LogWriter, event func(route, op, evResult string),
+	logWriter LogWriter, eventStats EventStats,
 	lean bool, chainID string) (numOpsRan int) {
 
 	numOpsRan = 0
-	for len(queueOps) > 0 && currentTime.After(queueOps[0].BlockTime) {
+	// operations are due once the block time reaches their scheduled time
+	for len(*queueOps) > 0 && !currentTime.Before((*queueOps)[0].BlockTime) {
 
 		// For now, queued operations cannot queue more operations.
 		// If a need arises for us to support queued messages to queue more messages, this can
 		// be changed.
-		opMsg, _, err := queueOps[0].Op(r, app, ctx, accounts, chainID)
-		opMsg.LogEvent(event)
+		opMsg, _, err := runOperation(r, app, ctx, accounts, chainID, (*queueOps)[0].Op, eventStats)
 		if !lean || opMsg.OK {
 			logWriter.AddEntry(QueuedMsgEntry(int64(height), opMsg))
 		}
 		if err != nil {
 			logWriter.PrintLogs()
-			tb.FailNow()
+			tb.Fatalf("error on block %d, queued operation from x/%s:\n%v", height, opMsg.Route, err)
 		}
 
-		queueOps = queueOps[1:]
+		*queueOps = (*queueOps)[1:]
 		numOpsRan++
 	}
 	return numOpsRan
 }
+
+// minGasPrices returns the minimum gas prices of the simulated app, in the bond
+// denomination of the simulation
+func minGasPrices(config Config, params Params) sdk.DecCoins {
+	denom := config.BondDenom
+	if denom == "" {
+		denom = sdk.DefaultBondDenom
+	}
+	return sdk.DecCoins{sdk.NewDecCoinFromDec(denom, params.MinGasPrice)}
+}
@@ -11,33 +11,48 @@ import (
 )
 
 const (
-	DefaultCodespace            = types.DefaultCodespace
-	CodeInvalidValidator        = types.CodeInvalidValidator
-	CodeValidatorJailed         = types.CodeValidatorJailed
-	CodeValidatorNotJailed      = types.CodeValidatorNotJailed
-	CodeMissingSelfDelegation   = types.CodeMissingSelfDelegation
-	CodeSelfDelegationTooLow    = types.CodeSelfDelegationTooLow
-	CodeMissingSigningInfo      = types.CodeMissingSigningInfo
-	ModuleName                  = types.ModuleName
-	StoreKey                    = types.StoreKey
-	RouterKey                   = types.RouterKey
-	QuerierRoute                = types.QuerierRoute
-	DefaultParamspace           = types.DefaultParamspace
-	DefaultMaxEvidenceAge       = types.DefaultMaxEvidenceAge
-	DefaultSignedBlocksWindow   = types.DefaultSignedBlocksWindow
-	DefaultDowntimeJailDuration = types.DefaultDowntimeJailDuration
-	QueryParameters             = types.QueryParameters
-	QuerySigningInfo            = types.QuerySigningInfo
-	QuerySigningInfos           = types.QuerySigningInfos
+	DefaultCodespace                 = types.DefaultCodespace
+	CodeInvalidValidator             = types.CodeInvalidValidator
+	CodeValidatorJailed              = types.CodeValidatorJailed
+	CodeValidatorNotJailed           = types.CodeValidatorNotJailed
+	CodeMissingSelfDelegation        = types.CodeMissingSelfDelegation
+	CodeSelfDelegationTooLow         = types.CodeSelfDelegationTooLow
+	CodeMissingSigningInfo           = types.CodeMissingSigningInfo
+	CodeInvalidMaintenance           = types.CodeInvalidMaintenance
+	ModuleName                       = types.ModuleName
+	StoreKey                         = types.StoreKey
+	RouterKey                        = types.RouterKey
+	TypeMsgUnjail                    = types.TypeMsgUnjail
+	TypeMsgScheduleMaintenanceWindow = types.TypeMsgScheduleMaintenanceWindow
+	QuerierRoute                     = types.QuerierRoute
+	DefaultParamspace                = types.DefaultParamspace
+	DefaultMaxEvidenceAge            = types.DefaultMaxEvidenceAge
+	DefaultSignedBlocksWindow        = types.DefaultSignedBlocksWindow
+	DefaultDowntimeJailDuration      = types.DefaultDowntimeJailDuration
+	DefaultDowntimeGracePeriod       = types.DefaultDowntimeGracePeriod
+	DefaultMaxMaintenanceWindow      = types.DefaultMaxMaintenanceWindow
+	DefaultMaxMaintenanceWindowDelay = types.DefaultMaxMaintenanceWindowDelay
+	QueryParameters                  = types.QueryParameters
+	QuerySigningInfo                 = types.QuerySigningInfo
+	QuerySigningInfos                = types.QuerySigningInfos
+	QueryInfractionRecords           = types.QueryInfractionRecords
+	QueryValidatorUptime             = types.QueryValidatorUptime
+	QueryActiveMaintenanceWindows    = types.QueryActiveMaintenanceWindows
+	MetricsSubsystem                 = types.MetricsSubsystem
+	MetricsValidatorLabel            = types.MetricsValidatorLabel
 
 	EventTypeSlash                 = types.EventTypeSlash
 	EventTypeLiveness              = types.EventTypeLiveness
+	EventTypeMaintenanceWindow     = types.EventTypeMaintenanceWindow
 	AttributeKeyAddress            = types.AttributeKeyAddress
 	AttributeKeyHeight             = types.AttributeKeyHeight
 	AttributeKeyPower              = types.AttributeKeyPower
 	AttributeKeyReason             = types.AttributeKeyReason
 	AttributeKeyJailed             = types.AttributeKeyJailed
 	AttributeKeyMissedBlocks       = types.AttributeKeyMissedBlocks
+	AttributeKeyStartHeight        = types.AttributeKeyStartHeight
+	AttributeKeyEndHeight          = types.AttributeKeyEndHeight
+	AttributeKeyMaintenance        = types.AttributeKeyMaintenance
 	AttributeValueDoubleSign       = types.AttributeValueDoubleSign
 	AttributeValueMissingSignature = types.AttributeValueMissingSignature
 	AttributeValueCategory         = types.AttributeValueCategory
@@ -55,6 +70,7 @@ var (
 	ErrMissingSelfDelegation                 = types.ErrMissingSelfDelegation
 	ErrSelfDelegationTooLowToUnjail          = types.ErrSelfDelegationTooLowToUnjail
 	ErrNoSigningInfoFound                    = types.ErrNoSigningInfoFound
+	ErrInvalidMaintenanceWindow              = types.ErrInvalidMaintenanceWindow
 	NewGenesisState                          = types.NewGenesisState
 	NewMissedBlock                           = types.NewMissedBlock
 	DefaultGenesisState                      = types.DefaultGenesisState
@@ -63,20 +79,42 @@ var (
 	GetValidatorSigningInfoAddress           = types.GetValidatorSigningInfoAddress
 	GetValidatorMissedBlockBitArrayPrefixKey = types.GetValidatorMissedBlockBitArrayPrefixKey
 	GetValidatorMissedBlockBitArrayKey       = types.GetValidatorMissedBlockBitArrayKey
+	GetValidatorMissedBlockBitmapPrefixKey   = types.GetValidatorMissedBlockBitmapPrefixKey
+	GetValidatorMissedBlockBitmapKey         = types.GetValidatorMissedBlockBitmapKey
+	MissedBlockPosition                      = types.MissedBlockPosition
 	GetAddrPubkeyRelationKey                 = types.GetAddrPubkeyRelationKey
 	NewMsgUnjail                             = types.NewMsgUnjail
+	NewMsgScheduleMaintenanceWindow          = types.NewMsgScheduleMaintenanceWindow
 	ParamKeyTable                            = types.ParamKeyTable
 	NewParams                                = types.NewParams
 	DefaultParams                            = types.DefaultParams
 	NewQuerySigningInfoParams                = types.NewQuerySigningInfoParams
 	NewQuerySigningInfosParams               = types.NewQuerySigningInfosParams
 	NewValidatorSigningInfo                  = types.NewValidatorSigningInfo
+	NewInfractionRecord                      = types.NewInfractionRecord
+	NewQueryInfractionRecordsParams          = types.NewQueryInfractionRecordsParams
+	GetInfractionRecordsPrefixKey            = types.GetInfractionRecordsPrefixKey
+	GetInfractionRecordKey                   = types.GetInfractionRecordKey
+	NewValidatorUptime                       = types.NewValidatorUptime
+	NewMaintenanceWindow                     = types.NewMaintenanceWindow
+	UptimeSmoothingFactor                    = types.UptimeSmoothingFactor
+	NewQueryValidatorUptimeParams            = types.NewQueryValidatorUptimeParams
+	GetValidatorUptimeKey                    = types.GetValidatorUptimeKey
+	GetMaintenanceWindowKey                  = types.GetMaintenanceWindowKey
+	GetValidatorUptimeAddress                = types.GetValidatorUptimeAddress
+	PrometheusMetrics                        = types.PrometheusMetrics
+	NopMetrics                               = types.NopMetrics
 
 	// variable aliases
 	ModuleCdc                       = types.ModuleCdc
 	ValidatorSigningInfoKey         = types.ValidatorSigningInfoKey
 	ValidatorMissedBlockBitArrayKey = types.ValidatorMissedBlockBitArrayKey
 	AddrPubkeyRelationKey           = types.AddrPubkeyRelationKey
+	InfractionRecordKey             = types.InfractionRecordKey
+	ValidatorUptimeKey              = types.ValidatorUptimeKey
+	MaintenanceWindowKey            = types.MaintenanceWindowKey
+	ValidatorMissedBlockBitmapKey   = types.ValidatorMissedBlockBitmapKey
+	MissedBlocksWindowKey           = types.MissedBlocksWindowKey
 	DoubleSignJailEndTime           = types.DoubleSignJailEndTime
 	DefaultMinSignedPerWindow       = types.DefaultMinSignedPerWindow
 	DefaultSlashFractionDoubleSign  = types.DefaultSlashFractionDoubleSign
@@ -87,17 +125,28 @@ var (
 	KeyDowntimeJailDuration         = types.KeyDowntimeJailDuration
 	KeySlashFractionDoubleSign      = types.KeySlashFractionDoubleSign
 	KeySlashFractionDowntime        = types.KeySlashFractionDowntime
+	KeyDowntimeGracePeriod          = types.KeyDowntimeGracePeriod
+	KeyMaxMaintenanceWindow         = types.KeyMaxMaintenanceWindow
+	KeyMaxMaintenanceWindowDelay    = types.KeyMaxMaintenanceWindowDelay
 )
 
 type (
-	Hooks                   = keeper.Hooks
-	Keeper                  = keeper.Keeper
-	CodeType                = types.CodeType
-	GenesisState            = types.GenesisState
-	MissedBlock             = types.MissedBlock
-	MsgUnjail               = types.MsgUnjail
-	Params                  = types.Params
-	QuerySigningInfoParams  = types.QuerySigningInfoParams
-	QuerySigningInfosParams = types.QuerySigningInfosParams
-	ValidatorSigningInfo    = types.ValidatorSigningInfo
+	Hooks                        = keeper.Hooks
+	Keeper                       = keeper.Keeper
+	CodeType                     = types.CodeType
+	GenesisState                 = types.GenesisState
+	MissedBlock                  = types.MissedBlock
+	MsgUnjail                    = types.MsgUnjail
+	MsgScheduleMaintenanceWindow = types.MsgScheduleMaintenanceWindow
+	Params                       = types.Params
+	QuerySigningInfoParams       = types.QuerySigningInfoParams
+	QuerySigningInfosParams      = types.QuerySigningInfosParams
+	ValidatorSigningInfo         = types.ValidatorSigningInfo
+
+	InfractionRecord             = types.InfractionRecord
+	QueryInfractionRecordsParams = types.QueryInfractionRecordsParams
+	ValidatorUptime              = types.ValidatorUptime
+	MaintenanceWindow            = types.MaintenanceWindow
+	QueryValidatorUptimeParams   = types.QueryValidatorUptimeParams
+	Metrics                      = types.Metrics
 )
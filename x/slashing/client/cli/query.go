@@ -28,6 +28,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	slashingQueryCmd.AddCommand(
 		client.GetCommands(
 			GetCmdQuerySigningInfo(queryRoute, cdc),
+			GetCmdQueryInfractionRecords(cdc),
+			GetCmdQueryUptime(cdc),
+			GetCmdQueryMaintenanceWindows(cdc),
 			GetCmdQueryParams(cdc),
 		)...,
 	)
@@ -73,6 +76,114 @@ $ <appcli> query slashing signing-info cosmosvalconspub1zcjduepqfhvwcmt7p06fvdge
 	}
 }
 
+// GetCmdQueryInfractionRecords implements the command to query the double-sign
+// infraction records of a validator.
+func GetCmdQueryInfractionRecords(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "infraction-records [validator-conspub]",
+		Short: "Query a validator's double-sign infraction records",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the double-sign infractions
+committed by that validator, along with their height, time, power at infraction,
+amount slashed and tombstone flag:
+
+$ <appcli> query slashing infraction-records cosmosvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pk, err := sdk.GetConsPubKeyBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryInfractionRecordsParams(sdk.ConsAddress(pk.Address()))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInfractionRecords)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var records []types.InfractionRecord
+			cdc.MustUnmarshalJSON(res, &records)
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
+
+// GetCmdQueryUptime implements the command to query the uptime moving average
+// of a validator.
+func GetCmdQueryUptime(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "uptime [validator-conspub]",
+		Short: "Query a validator's uptime",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the exponential moving average
+of the blocks signed by that validator, from 0 (missed every block) to 1 (signed
+every block), spanning roughly the signed blocks window:
+
+$ <appcli> query slashing uptime cosmosvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pk, err := sdk.GetConsPubKeyBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryValidatorUptimeParams(sdk.ConsAddress(pk.Address()))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorUptime)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var uptime types.ValidatorUptime
+			cdc.MustUnmarshalJSON(res, &uptime)
+			return cliCtx.PrintOutput(uptime)
+		},
+	}
+}
+
+// GetCmdQueryMaintenanceWindows implements the command to query the active
+// maintenance windows.
+func GetCmdQueryMaintenanceWindows(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "maintenance-windows",
+		Short: "Query the active maintenance windows",
+		Long: strings.TrimSpace(`Query the maintenance windows covering the latest block, during which the
+blocks missed by their validator don't count towards the downtime threshold:
+
+$ <appcli> query slashing maintenance-windows
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryActiveMaintenanceWindows)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var windows []types.MaintenanceWindow
+			cdc.MustUnmarshalJSON(res, &windows)
+			return cliCtx.PrintOutput(windows)
+		},
+	}
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
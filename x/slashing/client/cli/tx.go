@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -24,6 +27,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	slashingTxCmd.AddCommand(client.PostCommands(
 		GetCmdUnjail(cdc),
+		GetCmdScheduleMaintenanceWindow(cdc),
 	)...)
 
 	return slashingTxCmd
@@ -50,3 +54,41 @@ $ <appcli> tx slashing unjail --from mykey
 		},
 	}
 }
+
+// GetCmdScheduleMaintenanceWindow implements the schedule maintenance window
+// command.
+func GetCmdScheduleMaintenanceWindow(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "schedule-maintenance [start-height] [end-height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "schedule a maintenance window during which missed blocks don't count towards downtime",
+		Long: `schedule a future range of blocks, bounds included, during which the blocks missed
+by the validator don't count towards the downtime threshold:
+
+$ <appcli> tx slashing schedule-maintenance 1200 1220 --from mykey
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr := cliCtx.GetFromAddress()
+
+			startHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("start-height %s not a valid int, please input a valid start-height", args[0])
+			}
+
+			endHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("end-height %s not a valid int, please input a valid end-height", args[1])
+			}
+
+			msg := types.NewMsgScheduleMaintenanceWindow(sdk.ValAddress(valAddr), startHeight, endHeight)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -18,6 +18,11 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/slashing/validators/{validatorAddr}/unjail",
 		unjailRequestHandlerFn(cliCtx),
 	).Methods("POST")
+
+	r.HandleFunc(
+		"/slashing/validators/{validatorAddr}/maintenance_window",
+		scheduleMaintenanceWindowHandlerFn(cliCtx),
+	).Methods("POST")
 }
 
 // Unjail TX body
@@ -25,6 +30,14 @@ type UnjailReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 }
 
+// ScheduleMaintenanceWindowReq defines the properties of a maintenance window
+// request's body
+type ScheduleMaintenanceWindowReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	StartHeight int64        `json:"start_height" yaml:"start_height"`
+	EndHeight   int64        `json:"end_height" yaml:"end_height"`
+}
+
 func unjailRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -68,3 +81,46 @@ func unjailRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func scheduleMaintenanceWindowHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		bech32validator := vars["validatorAddr"]
+
+		var req ScheduleMaintenanceWindowReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(bech32validator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, valAddr) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own validator address")
+			return
+		}
+
+		msg := types.NewMsgScheduleMaintenanceWindow(valAddr, req.StartHeight, req.EndHeight)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -24,15 +24,36 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, stakingKeeper types.StakingKeep
 		keeper.SetValidatorSigningInfo(ctx, address, info)
 	}
 
+	// the missed blocks are indexed by their position in the signing window,
+	// which maps to their position in the bitmap given the validator IndexOffset
+	window := data.Params.SignedBlocksWindow
 	for addr, array := range data.MissedBlocks {
 		address, err := sdk.ConsAddressFromBech32(addr)
 		if err != nil {
 			panic(err)
 		}
+		info := data.SigningInfos[addr]
 		for _, missed := range array {
-			keeper.SetValidatorMissedBlockBitArray(ctx, address, missed.Index, missed.Missed)
+			position := types.MissedBlockPosition(info.IndexOffset, window, missed.Index)
+			if !missed.Missed || position < 0 {
+				continue
+			}
+			keeper.SetValidatorMissedBlockBitArray(ctx, address, position, true)
 		}
 	}
+	keeper.SetMissedBlocksWindow(ctx, window)
+
+	for _, record := range data.InfractionRecords {
+		keeper.SetInfractionRecord(ctx, record)
+	}
+
+	for _, uptime := range data.Uptimes {
+		keeper.SetValidatorUptime(ctx, uptime.Address, uptime.Uptime)
+	}
+
+	for _, window := range data.MaintenanceWindows {
+		keeper.SetMaintenanceWindow(ctx, window)
+	}
 
 	keeper.SetParams(ctx, data.Params)
 }
@@ -50,7 +71,9 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data types.GenesisState) {
 		localMissedBlocks := []types.MissedBlock{}
 
 		keeper.IterateValidatorMissedBlockBitArray(ctx, address, func(index int64, missed bool) (stop bool) {
-			localMissedBlocks = append(localMissedBlocks, types.NewMissedBlock(index, missed))
+			if missed {
+				localMissedBlocks = append(localMissedBlocks, types.NewMissedBlock(index%params.SignedBlocksWindow, true))
+			}
 			return false
 		})
 		missedBlocks[bechAddr] = localMissedBlocks
@@ -58,5 +81,23 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	var infractionRecords []types.InfractionRecord
+	keeper.IterateInfractionRecords(ctx, func(record types.InfractionRecord) (stop bool) {
+		infractionRecords = append(infractionRecords, record)
+		return false
+	})
+
+	var uptimes []types.ValidatorUptime
+	keeper.IterateValidatorUptimes(ctx, func(address sdk.ConsAddress, uptime sdk.Dec) (stop bool) {
+		uptimes = append(uptimes, types.NewValidatorUptime(address, uptime))
+		return false
+	})
+
+	var maintenanceWindows []types.MaintenanceWindow
+	keeper.IterateMaintenanceWindows(ctx, func(window types.MaintenanceWindow) (stop bool) {
+		maintenanceWindows = append(maintenanceWindows, window)
+		return false
+	})
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, infractionRecords, uptimes, maintenanceWindows)
 }
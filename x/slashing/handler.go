@@ -16,6 +16,9 @@ func NewHandler(k Keeper) sdk.Handler {
 		case MsgUnjail:
 			return handleMsgUnjail(ctx, msg, k)
 
+		case MsgScheduleMaintenanceWindow:
+			return handleMsgScheduleMaintenanceWindow(ctx, msg, k)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized slashing message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -42,3 +45,22 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) sdk.Result {
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// Validators can schedule a maintenance window ahead of a planned downtime,
+// so that the blocks they miss during it don't get them jailed
+func handleMsgScheduleMaintenanceWindow(ctx sdk.Context, msg MsgScheduleMaintenanceWindow, k Keeper) sdk.Result {
+	err := k.ScheduleMaintenanceWindow(ctx, msg.ValidatorAddr, msg.StartHeight, msg.EndHeight)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddr.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
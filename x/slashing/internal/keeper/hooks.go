@@ -12,9 +12,20 @@ import (
 
 func (k Keeper) AfterValidatorBonded(ctx sdk.Context, address sdk.ConsAddress, _ sdk.ValAddress) {
 	// Update the signing info start height or create a new signing info
-	_, found := k.GetValidatorSigningInfo(ctx, address)
-	if !found {
-		signingInfo := types.NewValidatorSigningInfo(
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, address)
+	if found {
+		// restart the signing window of a validator re-entering the set when
+		// a downtime grace period is defined, so that it isn't jailed for the
+		// blocks it missed before, mid-window
+		if k.DowntimeGracePeriod(ctx) > 0 {
+			signingInfo.StartHeight = ctx.BlockHeight()
+			signingInfo.IndexOffset = 0
+			signingInfo.MissedBlocksCounter = 0
+			k.clearValidatorMissedBlockBitArray(ctx, address)
+			k.SetValidatorSigningInfo(ctx, address, signingInfo)
+		}
+	} else {
+		signingInfo = types.NewValidatorSigningInfo(
 			address,
 			ctx.BlockHeight(),
 			0,
@@ -32,9 +43,12 @@ func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	k.AddPubkey(ctx, validator.GetConsPubKey())
 }
 
-// When a validator is removed, delete the address-pubkey relation.
+// When a validator is removed, delete the address-pubkey relation, its
+// maintenance window and its uptime.
 func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress) {
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
+	k.DeleteMaintenanceWindow(ctx, address)
+	k.DeleteValidatorUptime(ctx, address)
 }
 
 //_________________________________________________________________________________________
@@ -74,3 +88,5 @@ func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec)                {}
+func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, _ sdk.Int, _ time.Time) {
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
+)
+
+// SetInfractionRecord stores a double-sign infraction record by consensus
+// address and infraction height
+func (k Keeper) SetInfractionRecord(ctx sdk.Context, record types.InfractionRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(types.GetInfractionRecordKey(record.Address, record.Height), bz)
+}
+
+// RecordInfraction stores the infraction record of a validator slashed for
+// double signing, for post-incident analysis
+func (k Keeper) RecordInfraction(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64,
+	timestamp time.Time, power int64, fraction sdk.Dec, slashedTokens sdk.Int) {
+
+	k.SetInfractionRecord(ctx, types.NewInfractionRecord(
+		consAddr, infractionHeight, timestamp, power, fraction, slashedTokens, k.IsTombstoned(ctx, consAddr),
+	))
+}
+
+// GetInfractionRecords returns the infraction records of a specific validator
+// ConsAddress, ordered by infraction height
+func (k Keeper) GetInfractionRecords(ctx sdk.Context, address sdk.ConsAddress) (records []types.InfractionRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetInfractionRecordsPrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.InfractionRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// IterateInfractionRecords iterates over the stored infraction records of all
+// validators
+func (k Keeper) IterateInfractionRecords(ctx sdk.Context,
+	handler func(record types.InfractionRecord) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.InfractionRecordKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.InfractionRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &record)
+		if handler(record) {
+			break
+		}
+	}
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -10,107 +9,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
 )
 
-// HandleDoubleSign handles a validator signing two blocks at the same height.
-// power: power of the double-signing validator at the height of infraction
-func (k Keeper) HandleDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, timestamp time.Time, power int64) {
-	logger := k.Logger(ctx)
-
-	// calculate the age of the evidence
-	time := ctx.BlockHeader().Time
-	age := time.Sub(timestamp)
-
-	// fetch the validator public key
-	consAddr := sdk.ConsAddress(addr)
-	if _, err := k.GetPubkey(ctx, addr); err != nil {
-		// Ignore evidence that cannot be handled.
-		// NOTE:
-		// We used to panic with:
-		// `panic(fmt.Sprintf("Validator consensus-address %v not found", consAddr))`,
-		// but this couples the expectations of the app to both Tendermint and
-		// the simulator.  Both are expected to provide the full range of
-		// allowable but none of the disallowed evidence types.  Instead of
-		// getting this coordination right, it is easier to relax the
-		// constraints and ignore evidence that cannot be handled.
-		return
-	}
-
-	// Reject evidence if the double-sign is too old
-	if age > k.MaxEvidenceAge(ctx) {
-		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, age of %d past max age of %d",
-			consAddr, infractionHeight, age, k.MaxEvidenceAge(ctx)))
-		return
-	}
-
-	// Get validator and signing info
-	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
-	if validator == nil || validator.IsUnbonded() {
-		// Defensive.
-		// Simulation doesn't take unbonding periods into account, and
-		// Tendermint might break this assumption at some point.
-		return
-	}
-
-	// fetch the validator signing info
-	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
-	if !found {
-		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
-	}
-
-	// validator is already tombstoned
-	if signInfo.Tombstoned {
-		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, validator already tombstoned", consAddr, infractionHeight))
-		return
-	}
-
-	// double sign confirmed
-	logger.Info(fmt.Sprintf("Confirmed double sign from %s at height %d, age of %d", consAddr, infractionHeight, age))
-
-	// We need to retrieve the stake distribution which signed the block, so we subtract ValidatorUpdateDelay from the evidence height.
-	// Note that this *can* result in a negative "distributionHeight", up to -ValidatorUpdateDelay,
-	// i.e. at the end of the pre-genesis block (none) = at the beginning of the genesis block.
-	// That's fine since this is just used to filter unbonding delegations & redelegations.
-	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
-
-	// get the percentage slash penalty fraction
-	fraction := k.SlashFractionDoubleSign(ctx)
-
-	// Slash validator
-	// `power` is the int64 power of the validator as provided to/by
-	// Tendermint. This value is validator.Tokens as sent to Tendermint via
-	// ABCI, and now received as evidence.
-	// The fraction is passed in to separately to slash unbonding and rebonding delegations.
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeSlash,
-			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
-			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
-			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
-		),
-	)
-	k.sk.Slash(ctx, consAddr, distributionHeight, power, fraction)
-
-	// Jail validator if not already jailed
-	// begin unbonding validator if not already unbonding (tombstone)
-	if !validator.IsJailed() {
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeSlash,
-				sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
-			),
-		)
-		k.sk.Jail(ctx, consAddr)
-	}
-
-	// Set tombstoned to be true
-	signInfo.Tombstoned = true
-
-	// Set jailed until to be forever (max time)
-	signInfo.JailedUntil = types.DoubleSignJailEndTime
-
-	// Set validator signing info
-	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
-}
-
 // HandleValidatorSignature handles a validator signature, must be called once per validator per block.
 func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, power int64, signed bool) {
 	logger := k.Logger(ctx)
@@ -128,45 +26,68 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
 	}
 
+	// validators are exempt from downtime tracking during the grace period
+	// following their bonding, their signing window starting once it elapses
+	gracePeriod := k.DowntimeGracePeriod(ctx)
+	if gracePeriod > 0 && height <= signInfo.StartHeight+gracePeriod {
+		return
+	}
+
+	k.updateValidatorUptime(ctx, consAddr, signed)
+
 	// this is a relative index, so it counts blocks the validator *should* have signed
 	// will use the 0-value default signing info if not present, except for start height
-	index := signInfo.IndexOffset % k.SignedBlocksWindow(ctx)
+	window := k.SignedBlocksWindow(ctx)
+	index := signInfo.IndexOffset
 	signInfo.IndexOffset++
 
-	// Update signed block bit array & counter
-	// This counter just tracks the sum of the bit array
-	// That way we avoid needing to read/write the whole array each time
-	previous := k.GetValidatorMissedBlockBitArray(ctx, consAddr, index)
+	// Update the missed block bitmap & counter
+	// This counter just tracks the number of blocks missed in the window, the last
+	// SignedBlocksWindow positions of the bitmap
+	// That way we avoid needing to read the whole window each time
+	// The blocks missed during a maintenance window scheduled by the validator
+	// are only counted by the window, and don't count towards the threshold
 	missed := !signed
+	maintenance, inMaintenance := k.getActiveMaintenanceWindow(ctx, consAddr)
 	switch {
-	case !previous && missed:
-		// Array value has changed from not missed to missed, increment counter
+	case missed && inMaintenance:
+		maintenance.MissedBlocks++
+		k.SetMaintenanceWindow(ctx, maintenance)
+
+	case missed && !k.GetValidatorMissedBlockBitArray(ctx, consAddr, index):
 		k.SetValidatorMissedBlockBitArray(ctx, consAddr, index, true)
 		signInfo.MissedBlocksCounter++
-	case previous && !missed:
-		// Array value has changed from missed to not missed, decrement counter
-		k.SetValidatorMissedBlockBitArray(ctx, consAddr, index, false)
-		signInfo.MissedBlocksCounter--
-	default:
-		// Array value at this index has not changed, no need to update counter
+	}
+
+	// the block which slid out of the window no longer counts, and the chunks
+	// of the bitmap are pruned once all their blocks are out of the window
+	if expired := index - window; expired >= 0 {
+		if k.GetValidatorMissedBlockBitArray(ctx, consAddr, expired) {
+			signInfo.MissedBlocksCounter--
+		}
+		if (expired+1)%types.MissedBlockBitmapChunkSize == 0 {
+			k.pruneValidatorMissedBlockBitmap(ctx, consAddr, expired+1)
+		}
 	}
 
 	if missed {
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeLiveness,
-				sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
-				sdk.NewAttribute(types.AttributeKeyMissedBlocks, fmt.Sprintf("%d", signInfo.MissedBlocksCounter)),
-				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", height)),
-			),
+		event := sdk.NewEvent(
+			types.EventTypeLiveness,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyMissedBlocks, fmt.Sprintf("%d", signInfo.MissedBlocksCounter)),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", height)),
 		)
+		if inMaintenance {
+			event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyMaintenance, fmt.Sprintf("%d", maintenance.MissedBlocks)))
+		}
+		ctx.EventManager().EmitEvent(event)
 
 		logger.Info(
 			fmt.Sprintf("Absent validator %s at height %d, %d missed, threshold %d", consAddr, height, signInfo.MissedBlocksCounter, k.MinSignedPerWindow(ctx)))
 	}
 
-	minHeight := signInfo.StartHeight + k.SignedBlocksWindow(ctx)
-	maxMissed := k.SignedBlocksWindow(ctx) - k.MinSignedPerWindow(ctx)
+	minHeight := signInfo.StartHeight + gracePeriod + window
+	maxMissed := window - k.MinSignedPerWindow(ctx)
 
 	// if we are past the minimum height and the validator has missed too many blocks, punish them
 	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
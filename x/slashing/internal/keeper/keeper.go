@@ -18,6 +18,8 @@ type Keeper struct {
 	sk         types.StakingKeeper
 	paramspace types.ParamSubspace
 	codespace  sdk.CodespaceType
+
+	metrics *types.Metrics
 }
 
 // NewKeeper creates a slashing keeper
@@ -28,10 +30,25 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk types.StakingKeeper, param
 		sk:         sk,
 		paramspace: paramspace.WithKeyTable(types.ParamKeyTable()),
 		codespace:  codespace,
+		metrics:    types.NopMetrics(),
 	}
 	return keeper
 }
 
+// SetMetrics sets the metrics the keeper reports the validator uptimes to.
+func (k *Keeper) SetMetrics(metrics *types.Metrics) {
+	k.metrics = metrics
+}
+
+// getMetrics returns the keeper metrics, falling back to no-op metrics for
+// keepers not built through NewKeeper.
+func (k Keeper) getMetrics() *types.Metrics {
+	if k.metrics == nil {
+		return types.NopMetrics()
+	}
+	return k.metrics
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -64,3 +81,36 @@ func (k Keeper) deleteAddrPubkeyRelation(ctx sdk.Context, addr crypto.Address) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetAddrPubkeyRelationKey(addr))
 }
+
+// Slash attempts to slash a validator for double signing. The slash is delegated
+// to the staking module to make the necessary validator changes. It returns the
+// amount of tokens slashed.
+func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64) sdk.Int {
+	// `power` is the int64 power of the validator as provided to/by
+	// Tendermint. This value is validator.Tokens as sent to Tendermint via
+	// ABCI, and now received as evidence.
+	// The fraction is passed in to separately to slash unbonding and rebonding delegations.
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
+		),
+	)
+
+	return k.sk.Slash(ctx, consAddr, distributionHeight, power, fraction)
+}
+
+// Jail attempts to jail a validator. The jailing is delegated to the staking
+// module to make the necessary validator changes.
+func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+		),
+	)
+
+	k.sk.Jail(ctx, consAddr)
+}
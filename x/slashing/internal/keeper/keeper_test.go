@@ -1,22 +1,26 @@
 package keeper
 
 import (
+	"bytes"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
-// ______________________________________________________________
-
-// Test that a validator is slashed correctly
-// when we discover evidence of infraction
-func TestHandleDoubleSign(t *testing.T) {
+// Test that the double sign primitives used by the evidence module slash, jail
+// and tombstone a validator
+func TestSlashJailTombstone(t *testing.T) {
 
 	// initial setup
 	ctx, ck, sk, _, keeper := CreateTestInput(t, TestParams())
@@ -25,6 +29,7 @@ func TestHandleDoubleSign(t *testing.T) {
 	power := int64(100)
 	amt := sdk.TokensFromConsensusPower(power)
 	operatorAddr, val := Addrs[0], Pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
 	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, val, amt))
 	require.True(t, got.IsOK())
 	staking.EndBlocker(ctx, sk)
@@ -36,24 +41,38 @@ func TestHandleDoubleSign(t *testing.T) {
 
 	// handle a signature to set signing info
 	keeper.HandleValidatorSignature(ctx, val.Address(), amt.Int64(), true)
+	require.True(t, keeper.HasValidatorSigningInfo(ctx, consAddr))
+	require.False(t, keeper.IsTombstoned(ctx, consAddr))
 
 	oldTokens := sk.Validator(ctx, operatorAddr).GetTokens()
 
-	// double sign less than max age
-	keeper.HandleDoubleSign(ctx, val.Address(), 0, time.Unix(0, 0), power)
-
-	// should be jailed
-	require.True(t, sk.Validator(ctx, operatorAddr).IsJailed())
+	// slash for double signing at the genesis height
+	fraction := keeper.SlashFractionDoubleSign(ctx)
+	slashed := keeper.Slash(ctx, consAddr, fraction, power, 0-sdk.ValidatorUpdateDelay)
 
 	// tokens should be decreased
 	newTokens := sk.Validator(ctx, operatorAddr).GetTokens()
 	require.True(t, newTokens.LT(oldTokens))
+	require.Equal(t, oldTokens.Sub(newTokens), slashed)
 
-	// New evidence
-	keeper.HandleDoubleSign(ctx, val.Address(), 0, time.Unix(0, 0), power)
+	keeper.Jail(ctx, consAddr)
+	require.True(t, sk.Validator(ctx, operatorAddr).IsJailed())
+
+	keeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
+	keeper.Tombstone(ctx, consAddr)
+	require.True(t, keeper.IsTombstoned(ctx, consAddr))
+	require.Panics(t, func() { keeper.Tombstone(ctx, consAddr) })
 
-	// tokens should be the same (capped slash)
-	require.True(t, sk.Validator(ctx, operatorAddr).GetTokens().Equal(newTokens))
+	info, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.True(t, types.DoubleSignJailEndTime.Equal(info.JailedUntil))
+
+	// infraction should be recorded
+	keeper.RecordInfraction(ctx, consAddr, 0, time.Unix(0, 0), power, fraction, slashed)
+	records := keeper.GetInfractionRecords(ctx, consAddr)
+	require.Equal(t, []types.InfractionRecord{types.NewInfractionRecord(
+		consAddr, 0, time.Unix(0, 0).UTC(), power, fraction, slashed, true,
+	)}, records)
 
 	// Jump to past the unbonding period
 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1, 0).Add(sk.GetParams(ctx).UnbondingTime)})
@@ -71,43 +90,15 @@ func TestHandleDoubleSign(t *testing.T) {
 	require.True(t, res.IsOK())
 }
 
-// ______________________________________________________________
-
-// Test that a validator is slashed correctly
-// when we discover evidence of infraction
-func TestPastMaxEvidenceAge(t *testing.T) {
+// Test that jailing and tombstoning requires signing info
+func TestTombstoneWithoutSigningInfo(t *testing.T) {
+	ctx, _, _, _, keeper := CreateTestInput(t, TestParams())
+	consAddr := sdk.ConsAddress(Pks[0].Address())
 
-	// initial setup
-	ctx, ck, sk, _, keeper := CreateTestInput(t, TestParams())
-	// validator added pre-genesis
-	ctx = ctx.WithBlockHeight(-1)
-	power := int64(100)
-	amt := sdk.TokensFromConsensusPower(power)
-	operatorAddr, val := Addrs[0], Pks[0]
-	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, val, amt))
-	require.True(t, got.IsOK())
-	staking.EndBlocker(ctx, sk)
-	require.Equal(
-		t, ck.GetCoins(ctx, sdk.AccAddress(operatorAddr)),
-		sdk.NewCoins(sdk.NewCoin(sk.GetParams(ctx).BondDenom, InitTokens.Sub(amt))),
-	)
-	require.Equal(t, amt, sk.Validator(ctx, operatorAddr).GetBondedTokens())
-
-	// handle a signature to set signing info
-	keeper.HandleValidatorSignature(ctx, val.Address(), power, true)
-
-	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1, 0).Add(keeper.MaxEvidenceAge(ctx))})
-
-	oldPower := sk.Validator(ctx, operatorAddr).GetConsensusPower()
-
-	// double sign past max age
-	keeper.HandleDoubleSign(ctx, val.Address(), 0, time.Unix(0, 0), power)
-
-	// should still be bonded
-	require.True(t, sk.Validator(ctx, operatorAddr).IsBonded())
-
-	// should still have same power
-	require.Equal(t, oldPower, sk.Validator(ctx, operatorAddr).GetConsensusPower())
+	require.False(t, keeper.HasValidatorSigningInfo(ctx, consAddr))
+	require.False(t, keeper.IsTombstoned(ctx, consAddr))
+	require.Panics(t, func() { keeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime) })
+	require.Panics(t, func() { keeper.Tombstone(ctx, consAddr) })
 }
 
 // Test a new validator entering the validator set
@@ -310,3 +301,272 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	require.Equal(t, sdk.Unbonding, validator.Status)
 
 }
+
+// Test a validator within the downtime grace period following its bonding
+// Ensure that missed blocks aren't tracked until it elapses and that the
+// signing window restarts when the validator re-enters the set
+func TestDowntimeGracePeriod(t *testing.T) {
+
+	// initial setup
+	params := TestParams()
+	params.DowntimeGracePeriod = 100
+	ctx, _, sk, _, keeper := CreateTestInput(t, params)
+	stakingParams := sk.GetParams(ctx)
+	stakingParams.MaxValidators = 1
+	sk.SetParams(ctx, stakingParams)
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	addr, val := Addrs[0], Pks[0]
+	consAddr := sdk.ConsAddress(addr)
+	sh := staking.NewHandler(sk)
+	got := sh(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// blocks missed during the grace period aren't tracked
+	height := int64(0)
+	for ; height <= keeper.DowntimeGracePeriod(ctx); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+
+	signInfo, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(0), signInfo.IndexOffset)
+	require.Equal(t, int64(0), signInfo.MissedBlocksCounter)
+
+	// missed blocks are tracked once it elapses, but the validator can't be
+	// jailed before a full window
+	for ; height <= keeper.DowntimeGracePeriod(ctx)+keeper.SignedBlocksWindow(ctx); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+
+	staking.EndBlocker(ctx, sk)
+	validator, _ := sk.GetValidator(ctx, addr)
+	require.Equal(t, sdk.Bonded, validator.Status)
+
+	signInfo, found = keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, keeper.SignedBlocksWindow(ctx), signInfo.MissedBlocksCounter)
+
+	// kick the validator out of the validator set before it gets jailed
+	newAmt := sdk.TokensFromConsensusPower(101)
+	got = sh(ctx, NewTestMsgCreateValidator(Addrs[1], Pks[1], newAmt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+	validator, _ = sk.GetValidator(ctx, addr)
+	require.Equal(t, sdk.Unbonding, validator.Status)
+
+	// validator added back in
+	height += 50
+	ctx = ctx.WithBlockHeight(height)
+	delTokens := sdk.TokensFromConsensusPower(50)
+	got = sh(ctx, NewTestMsgDelegate(sdk.AccAddress(Addrs[2]), Addrs[0], delTokens))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+	validator, _ = sk.GetValidator(ctx, addr)
+	require.Equal(t, sdk.Bonded, validator.Status)
+
+	// the signing window restarts
+	signInfo, found = keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, height, signInfo.StartHeight)
+	require.Equal(t, int64(0), signInfo.IndexOffset)
+	require.Equal(t, int64(0), signInfo.MissedBlocksCounter)
+	for offset := int64(0); offset < keeper.SignedBlocksWindow(ctx); offset++ {
+		require.False(t, keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, offset))
+	}
+
+	// validator misses a block right after re-entering and isn't jailed
+	keeper.HandleValidatorSignature(ctx, val.Address(), int64(150), false)
+	staking.EndBlocker(ctx, sk)
+	validator, _ = sk.GetValidator(ctx, addr)
+	require.Equal(t, sdk.Bonded, validator.Status)
+}
+
+// Test a change of the signed blocks window while a validator misses blocks
+// Ensure that its missed blocks counter follows the new window
+func TestSignedBlocksWindowChange(t *testing.T) {
+
+	// initial setup, the validator never gets jailed
+	params := TestParams()
+	params.MinSignedPerWindow = sdk.ZeroDec()
+	ctx, _, sk, _, keeper := CreateTestInput(t, params)
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	addr, val := Addrs[0], Pks[0]
+	consAddr := sdk.ConsAddress(addr)
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+	keeper.UpdateMissedBlocksWindow(ctx)
+
+	// the validator misses blocks over two windows, the chunks of the bitmap
+	// sliding out of the window being pruned
+	height := int64(0)
+	for ; height < 2100; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+
+	signInfo, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(2100), signInfo.IndexOffset)
+	require.Equal(t, int64(1000), signInfo.MissedBlocksCounter)
+	require.False(t, keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 0))
+	require.True(t, keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 1100))
+
+	// the window shrinks
+	params.SignedBlocksWindow = 500
+	keeper.SetParams(ctx, params)
+	keeper.UpdateMissedBlocksWindow(ctx)
+	signInfo, _ = keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, int64(500), signInfo.MissedBlocksCounter)
+
+	// the window grows back, the blocks of the pruned chunks counting as signed
+	params.SignedBlocksWindow = 2000
+	keeper.SetParams(ctx, params)
+	keeper.UpdateMissedBlocksWindow(ctx)
+	signInfo, _ = keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, int64(2100-types.MissedBlockBitmapChunkSize), signInfo.MissedBlocksCounter)
+
+	// the counter keeps following the window as the validator signs
+	for latest := height; height < latest+100; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+	signInfo, _ = keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, int64(2100-types.MissedBlockBitmapChunkSize), signInfo.MissedBlocksCounter)
+	require.Equal(t,
+		keeper.CountValidatorMissedBlocks(ctx, consAddr, signInfo.IndexOffset-2000, signInfo.IndexOffset),
+		signInfo.MissedBlocksCounter,
+	)
+}
+
+// Test a validator scheduling a maintenance window
+// Ensure that the blocks missed during the window are only counted by the
+// window, and that the blocks missed after it get the validator jailed
+func TestMaintenanceWindow(t *testing.T) {
+
+	// initial setup
+	params := TestParams()
+	params.SignedBlocksWindow = 10
+	params.MaxMaintenanceWindow = 20
+	params.MaxMaintenanceWindowDelay = 100
+	ctx, _, sk, _, keeper := CreateTestInput(t, params)
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	addr, val := Addrs[0], Pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	sh := staking.NewHandler(sk)
+	got := sh(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// the validator signs a first signing window
+	height := int64(0)
+	for ; height < keeper.SignedBlocksWindow(ctx); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+
+	// windows must start in the future, within the delay, and span at most
+	// MaxMaintenanceWindow blocks
+	ctx = ctx.WithBlockHeight(height)
+	require.Error(t, keeper.ScheduleMaintenanceWindow(ctx, addr, height, height+5))
+	require.Error(t, keeper.ScheduleMaintenanceWindow(ctx, addr, height+101, height+105))
+	require.Error(t, keeper.ScheduleMaintenanceWindow(ctx, addr, height+1, height+21))
+	require.Error(t, keeper.ScheduleMaintenanceWindow(ctx, Addrs[1], height+1, height+20))
+
+	start, end := height+1, height+20
+	require.NoError(t, keeper.ScheduleMaintenanceWindow(ctx, addr, start, end))
+	require.Empty(t, keeper.GetActiveMaintenanceWindows(ctx))
+
+	// the next window must start a signing window after the end of this one
+	require.Error(t, keeper.ScheduleMaintenanceWindow(ctx, addr, end+keeper.SignedBlocksWindow(ctx), end+keeper.SignedBlocksWindow(ctx)))
+
+	// the blocks missed during the window don't count towards the threshold
+	keeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	for height++; height <= end; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.Len(t, keeper.GetActiveMaintenanceWindows(ctx), 1)
+		keeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+
+	staking.EndBlocker(ctx, sk)
+	validator, _ := sk.GetValidator(ctx, addr)
+	require.Equal(t, sdk.Bonded, validator.Status)
+
+	signInfo, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(0), signInfo.MissedBlocksCounter)
+
+	window, found := keeper.GetMaintenanceWindow(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, end-start+1, window.MissedBlocks)
+
+	uptime, found := keeper.GetValidatorUptime(ctx, consAddr)
+	require.True(t, found)
+	require.True(t, uptime.LT(sdk.OneDec()))
+
+	// the blocks missed after the window do
+	maxMissed := keeper.SignedBlocksWindow(ctx) - keeper.MinSignedPerWindow(ctx)
+	for i := int64(0); i <= maxMissed; i++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.Empty(t, keeper.GetActiveMaintenanceWindows(ctx))
+		keeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+		height++
+	}
+
+	staking.EndBlocker(ctx, sk)
+	validator, _ = sk.GetValidator(ctx, addr)
+	require.Equal(t, sdk.Unbonding, validator.Status)
+
+	// a new window can be scheduled once a signing window went by
+	ctx = ctx.WithBlockHeight(end + keeper.SignedBlocksWindow(ctx))
+	require.NoError(t, keeper.ScheduleMaintenanceWindow(ctx, addr, ctx.BlockHeight()+1, ctx.BlockHeight()+1))
+
+	// and they are disabled by a zero MaxMaintenanceWindow
+	params.MaxMaintenanceWindow = 0
+	keeper.SetParams(ctx, params)
+	require.Error(t, keeper.ScheduleMaintenanceWindow(ctx, addr, ctx.BlockHeight()+20, ctx.BlockHeight()+20))
+}
+
+// Test that the maintenance window parameters default when they are missing
+// from the param store, as on chains predating them
+func TestMaintenanceWindowParamsUnset(t *testing.T) {
+	keySlashing := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	cdc := createTestCodec()
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	paramstore := paramsKeeper.Subspace(types.DefaultParamspace)
+	keeper := NewKeeper(cdc, keySlashing, nil, paramstore, types.DefaultCodespace)
+
+	// set all the parameters but the maintenance window ones
+	defaults := TestParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyMaxMaintenanceWindow) || bytes.Equal(pair.Key, types.KeyMaxMaintenanceWindowDelay) {
+			continue
+		}
+		paramstore.Set(ctx, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+	}
+
+	require.Equal(t, types.DefaultMaxMaintenanceWindow, keeper.MaxMaintenanceWindow(ctx))
+	require.Equal(t, types.DefaultMaxMaintenanceWindowDelay, keeper.MaxMaintenanceWindowDelay(ctx))
+
+	got := keeper.GetParams(ctx)
+	require.Equal(t, types.DefaultMaxMaintenanceWindow, got.MaxMaintenanceWindow)
+	require.Equal(t, types.DefaultMaxMaintenanceWindowDelay, got.MaxMaintenanceWindowDelay)
+	require.Equal(t, defaults.SignedBlocksWindow, got.SignedBlocksWindow)
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
+)
+
+// GetMaintenanceWindow returns the last maintenance window scheduled by a
+// validator, if any
+func (k Keeper) GetMaintenanceWindow(ctx sdk.Context, address sdk.ConsAddress) (window types.MaintenanceWindow, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMaintenanceWindowKey(address))
+	if bz == nil {
+		return window, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &window)
+	return window, true
+}
+
+// SetMaintenanceWindow sets the maintenance window of a validator
+func (k Keeper) SetMaintenanceWindow(ctx sdk.Context, window types.MaintenanceWindow) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(window)
+	store.Set(types.GetMaintenanceWindowKey(window.Address), bz)
+}
+
+// DeleteMaintenanceWindow deletes the maintenance window of a validator
+func (k Keeper) DeleteMaintenanceWindow(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMaintenanceWindowKey(address))
+}
+
+// IterateMaintenanceWindows iterates over the stored maintenance windows of
+// all validators
+func (k Keeper) IterateMaintenanceWindows(ctx sdk.Context,
+	handler func(window types.MaintenanceWindow) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.MaintenanceWindowKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var window types.MaintenanceWindow
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &window)
+		if handler(window) {
+			break
+		}
+	}
+}
+
+// GetActiveMaintenanceWindows returns the maintenance windows covering the
+// current block
+func (k Keeper) GetActiveMaintenanceWindows(ctx sdk.Context) (windows []types.MaintenanceWindow) {
+	k.IterateMaintenanceWindows(ctx, func(window types.MaintenanceWindow) (stop bool) {
+		if window.IsActive(ctx.BlockHeight()) {
+			windows = append(windows, window)
+		}
+		return false
+	})
+	return windows
+}
+
+// ScheduleMaintenanceWindow schedules a future range of blocks during which
+// the blocks missed by a validator don't count towards the downtime threshold.
+// The window is bounded by the MaxMaintenanceWindow and MaxMaintenanceWindowDelay
+// parameters, and must start at least a signing window after the end of the
+// previous window of the validator, so that a signing window never overlaps
+// more than one maintenance window.
+func (k Keeper) ScheduleMaintenanceWindow(ctx sdk.Context, validatorAddr sdk.ValAddress, startHeight, endHeight int64) sdk.Error {
+	maxWindow := k.MaxMaintenanceWindow(ctx)
+	if maxWindow == 0 {
+		return types.ErrInvalidMaintenanceWindow(k.codespace, "maintenance windows are disabled")
+	}
+
+	validator := k.sk.Validator(ctx, validatorAddr)
+	if validator == nil {
+		return types.ErrNoValidatorForAddress(k.codespace)
+	}
+
+	height := ctx.BlockHeight()
+	switch {
+	case startHeight <= height:
+		return types.ErrInvalidMaintenanceWindow(k.codespace,
+			fmt.Sprintf("start height %d isn't after the current height %d", startHeight, height))
+
+	case startHeight-height > k.MaxMaintenanceWindowDelay(ctx):
+		return types.ErrInvalidMaintenanceWindow(k.codespace,
+			fmt.Sprintf("start height %d is more than %d blocks ahead", startHeight, k.MaxMaintenanceWindowDelay(ctx)))
+
+	case endHeight < startHeight || endHeight-startHeight+1 > maxWindow:
+		return types.ErrInvalidMaintenanceWindow(k.codespace,
+			fmt.Sprintf("window [%d, %d] doesn't span between 1 and %d blocks", startHeight, endHeight, maxWindow))
+	}
+
+	consAddr := validator.GetConsAddr()
+	if previous, found := k.GetMaintenanceWindow(ctx, consAddr); found {
+		if earliest := previous.EndHeight + k.SignedBlocksWindow(ctx); startHeight <= earliest {
+			return types.ErrInvalidMaintenanceWindow(k.codespace,
+				fmt.Sprintf("start height %d isn't after height %d, a signing window after the previous window", startHeight, earliest))
+		}
+	}
+
+	k.SetMaintenanceWindow(ctx, types.NewMaintenanceWindow(consAddr, startHeight, endHeight))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMaintenanceWindow,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyStartHeight, fmt.Sprintf("%d", startHeight)),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, fmt.Sprintf("%d", endHeight)),
+		),
+	)
+
+	return nil
+}
+
+// getActiveMaintenanceWindow returns the maintenance window of a validator if
+// it covers the current block
+func (k Keeper) getActiveMaintenanceWindow(ctx sdk.Context, address sdk.ConsAddress) (types.MaintenanceWindow, bool) {
+	window, found := k.GetMaintenanceWindow(ctx, address)
+	if !found || !window.IsActive(ctx.BlockHeight()) {
+		return types.MaintenanceWindow{}, false
+	}
+	return window, true
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
+)
+
+// MigrateMissedBlockBitArrays moves the missed blocks of the validators from the
+// legacy bit array, indexed by the position of the blocks in the signing window,
+// to the missed block bitmap, and recounts them. It assumes the signed blocks
+// window hasn't changed since the bit arrays were written, and is meant to be
+// run once, by the upgrade handler of the chains upgrading their store in
+// place. It returns the number of legacy entries migrated.
+func (k Keeper) MigrateMissedBlockBitArrays(ctx sdk.Context) (migrated int) {
+	store := ctx.KVStore(k.storeKey)
+	window := k.SignedBlocksWindow(ctx)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayKey)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	touched := make(map[string]sdk.ConsAddress)
+	for _, key := range keys {
+		// 0x02 | consAddress | LittleEndianUint64(index)
+		address := sdk.ConsAddress(key[1 : len(key)-8])
+		index := int64(binary.LittleEndian.Uint64(key[len(key)-8:]))
+
+		var missed bool
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(store.Get(key), &missed)
+		store.Delete(key)
+		migrated++
+
+		info, found := k.GetValidatorSigningInfo(ctx, address)
+		if !found || !missed {
+			continue
+		}
+		position := types.MissedBlockPosition(info.IndexOffset, window, index)
+		if position < 0 {
+			continue
+		}
+		k.SetValidatorMissedBlockBitArray(ctx, address, position, true)
+		touched[address.String()] = address
+	}
+
+	// the counters are recounted from the bitmap so that they match it, even if
+	// the legacy bit arrays held stale entries
+	k.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
+		info.MissedBlocksCounter = k.CountValidatorMissedBlocks(ctx, address, info.IndexOffset-window, info.IndexOffset)
+		k.SetValidatorSigningInfo(ctx, address, info)
+		return false
+	})
+	k.SetMissedBlocksWindow(ctx, window)
+
+	k.Logger(ctx).Info(fmt.Sprintf("migrated %d missed block entries of %d validators", migrated, len(touched)))
+	return migrated
+}
@@ -48,10 +48,45 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// DowntimeGracePeriod - number of blocks after bonding during which a validator
+// isn't jailed for downtime
+func (k Keeper) DowntimeGracePeriod(ctx sdk.Context) (res int64) {
+	k.paramspace.Get(ctx, types.KeyDowntimeGracePeriod, &res)
+	return
+}
+
+// MaxMaintenanceWindow - maximum number of blocks spanned by a maintenance
+// window, zero disabling them. Defaults to DefaultMaxMaintenanceWindow if unset.
+func (k Keeper) MaxMaintenanceWindow(ctx sdk.Context) (res int64) {
+	res = types.DefaultMaxMaintenanceWindow
+	k.paramspace.GetIfExists(ctx, types.KeyMaxMaintenanceWindow, &res)
+	return
+}
+
+// MaxMaintenanceWindowDelay - maximum number of blocks before a maintenance
+// window starts. Defaults to DefaultMaxMaintenanceWindowDelay if unset.
+func (k Keeper) MaxMaintenanceWindowDelay(ctx sdk.Context) (res int64) {
+	res = types.DefaultMaxMaintenanceWindowDelay
+	k.paramspace.GetIfExists(ctx, types.KeyMaxMaintenanceWindowDelay, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
-func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramspace.GetParamSet(ctx, &params)
-	return params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var minSignedPerWindow sdk.Dec
+	k.paramspace.Get(ctx, types.KeyMinSignedPerWindow, &minSignedPerWindow)
+
+	return types.NewParams(
+		k.MaxEvidenceAge(ctx),
+		k.SignedBlocksWindow(ctx),
+		minSignedPerWindow,
+		k.DowntimeJailDuration(ctx),
+		k.SlashFractionDoubleSign(ctx),
+		k.SlashFractionDowntime(ctx),
+		k.DowntimeGracePeriod(ctx),
+		k.MaxMaintenanceWindow(ctx),
+		k.MaxMaintenanceWindowDelay(ctx),
+	)
 }
 
 // SetParams sets the slashing parameters to the param space.
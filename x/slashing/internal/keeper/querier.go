@@ -21,6 +21,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return querySigningInfo(ctx, req, k)
 		case types.QuerySigningInfos:
 			return querySigningInfos(ctx, req, k)
+		case types.QueryInfractionRecords:
+			return queryInfractionRecords(ctx, req, k)
+		case types.QueryValidatorUptime:
+			return queryValidatorUptime(ctx, req, k)
+		case types.QueryActiveMaintenanceWindows:
+			return queryActiveMaintenanceWindows(ctx, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -88,3 +94,64 @@ func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte
 
 	return res, nil
 }
+
+func queryInfractionRecords(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryInfractionRecordsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	records := k.GetInfractionRecords(ctx, params.ConsAddress)
+	if records == nil {
+		records = []types.InfractionRecord{}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryValidatorUptime(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorUptimeParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if _, found := k.GetValidatorSigningInfo(ctx, params.ConsAddress); !found {
+		return nil, types.ErrNoSigningInfoFound(types.DefaultCodespace, params.ConsAddress)
+	}
+
+	// validators whose signatures aren't tracked yet have a full uptime
+	uptime, found := k.GetValidatorUptime(ctx, params.ConsAddress)
+	if !found {
+		uptime = sdk.OneDec()
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewValidatorUptime(params.ConsAddress, uptime))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryActiveMaintenanceWindows(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	windows := k.GetActiveMaintenanceWindows(ctx)
+	if windows == nil {
+		windows = []types.MaintenanceWindow{}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, windows)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
 )
 
@@ -36,3 +38,80 @@ func TestQueryParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, keeper.GetParams(ctx), params)
 }
+
+func TestQueryInfractionRecords(t *testing.T) {
+	ctx, _, _, _, keeper := CreateTestInput(t, TestParams())
+	consAddr := sdk.ConsAddress(Pks[0].Address())
+
+	record := types.NewInfractionRecord(consAddr, 5, time.Unix(10, 0).UTC(), 100, sdk.NewDecWithPrec(5, 2), sdk.NewInt(5), true)
+	keeper.SetInfractionRecord(ctx, record)
+	keeper.SetInfractionRecord(ctx, types.NewInfractionRecord(
+		sdk.ConsAddress(Pks[1].Address()), 3, time.Unix(10, 0).UTC(), 10, sdk.NewDecWithPrec(5, 2), sdk.NewInt(1), true,
+	))
+
+	query := abci.RequestQuery{
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryInfractionRecordsParams(consAddr)),
+	}
+
+	res, err := queryInfractionRecords(ctx, query, keeper)
+	require.NoError(t, err)
+
+	var records []types.InfractionRecord
+	types.ModuleCdc.MustUnmarshalJSON(res, &records)
+	require.Equal(t, []types.InfractionRecord{record}, records)
+
+	query.Data = types.ModuleCdc.MustMarshalJSON(types.NewQueryInfractionRecordsParams(sdk.ConsAddress(Pks[2].Address())))
+	res, err = queryInfractionRecords(ctx, query, keeper)
+	require.NoError(t, err)
+	require.Equal(t, "[]", string(res))
+}
+
+func TestQueryValidatorUptime(t *testing.T) {
+	ctx, _, _, _, keeper := CreateTestInput(t, TestParams())
+	consAddr := sdk.ConsAddress(Pks[0].Address())
+
+	query := abci.RequestQuery{
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryValidatorUptimeParams(consAddr)),
+	}
+
+	// validators without signing info are unknown
+	_, err := queryValidatorUptime(ctx, query, keeper)
+	require.Error(t, err)
+
+	// validators whose signatures aren't tracked yet have a full uptime
+	keeper.SetValidatorSigningInfo(ctx, consAddr, types.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0))
+	res, err := queryValidatorUptime(ctx, query, keeper)
+	require.NoError(t, err)
+
+	var uptime types.ValidatorUptime
+	types.ModuleCdc.MustUnmarshalJSON(res, &uptime)
+	require.Equal(t, types.NewValidatorUptime(consAddr, sdk.OneDec()), uptime)
+
+	keeper.SetValidatorUptime(ctx, consAddr, sdk.NewDecWithPrec(9, 1))
+	res, err = queryValidatorUptime(ctx, query, keeper)
+	require.NoError(t, err)
+
+	types.ModuleCdc.MustUnmarshalJSON(res, &uptime)
+	require.Equal(t, types.NewValidatorUptime(consAddr, sdk.NewDecWithPrec(9, 1)), uptime)
+}
+
+func TestQueryActiveMaintenanceWindows(t *testing.T) {
+	ctx, _, _, _, keeper := CreateTestInput(t, TestParams())
+	ctx = ctx.WithBlockHeight(10)
+
+	active := types.NewMaintenanceWindow(sdk.ConsAddress(Pks[0].Address()), 5, 15)
+	keeper.SetMaintenanceWindow(ctx, active)
+	keeper.SetMaintenanceWindow(ctx, types.NewMaintenanceWindow(sdk.ConsAddress(Pks[1].Address()), 11, 15))
+	keeper.SetMaintenanceWindow(ctx, types.NewMaintenanceWindow(sdk.ConsAddress(Pks[2].Address()), 1, 9))
+
+	res, err := queryActiveMaintenanceWindows(ctx, keeper)
+	require.NoError(t, err)
+
+	var windows []types.MaintenanceWindow
+	types.ModuleCdc.MustUnmarshalJSON(res, &windows)
+	require.Equal(t, []types.MaintenanceWindow{active}, windows)
+
+	res, err = queryActiveMaintenanceWindows(ctx.WithBlockHeight(20), keeper)
+	require.NoError(t, err)
+	require.Equal(t, "[]", string(res))
+}
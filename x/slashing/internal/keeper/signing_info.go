@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
 )
@@ -26,6 +30,51 @@ func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress
 	store.Set(types.GetValidatorSigningInfoKey(address), bz)
 }
 
+// HasValidatorSigningInfo returns if a given validator has signing information
+// persisted.
+func (k Keeper) HasValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	_, ok := k.GetValidatorSigningInfo(ctx, consAddr)
+	return ok
+}
+
+// JailUntil attempts to set a validator's JailedUntil attribute in its signing
+// info. It will panic if the signing info does not exist for the validator.
+func (k Keeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time) {
+	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !ok {
+		panic("cannot jail validator that does not have any signing information")
+	}
+
+	signInfo.JailedUntil = jailTime
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+}
+
+// Tombstone attempts to tombstone a validator. It will panic if signing info for
+// the given validator does not exist.
+func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !ok {
+		panic("cannot tombstone validator that does not have any signing information")
+	}
+
+	if signInfo.Tombstoned {
+		panic("cannot tombstone validator that is already tombstoned")
+	}
+
+	signInfo.Tombstoned = true
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+}
+
+// IsTombstoned returns if a given validator by consensus address is tombstoned.
+func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !ok {
+		return false
+	}
+
+	return signInfo.Tombstoned
+}
+
 // IterateValidatorSigningInfos iterates over the stored ValidatorSigningInfo
 func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 	handler func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool)) {
@@ -43,54 +92,193 @@ func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 	}
 }
 
-// GetValidatorMissedBlockBitArray gets the bit for the missed blocks array
+// GetValidatorMissedBlockBitArray gets the bit of the missed block bitmap of a
+// validator at the given position, which is the IndexOffset of the block
 func (k Keeper) GetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64) (missed bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetValidatorMissedBlockBitArrayKey(address, index))
-	if bz == nil {
-		// lazy: treat empty key as not missed
-		missed = false
-		return
+	chunk := k.getValidatorMissedBlockChunk(ctx, address, index/types.MissedBlockBitmapChunkSize)
+	if chunk == nil {
+		// lazy: treat missing chunks as not missed
+		return false
 	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &missed)
-	return
+	bit := index % types.MissedBlockBitmapChunkSize
+	return chunk[bit/8]&(1<<uint(bit%8)) != 0
 }
 
-// IterateValidatorMissedBlockBitArray iterates over the signed blocks window
-// and performs a callback function
+// IterateValidatorMissedBlockBitArray iterates over the blocks of the signing
+// window of a validator and performs a callback function. The index passed to
+// the callback is the position of the block in the missed block bitmap.
 func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context,
 	address sdk.ConsAddress, handler func(index int64, missed bool) (stop bool)) {
 
-	store := ctx.KVStore(k.storeKey)
-	index := int64(0)
-	// Array may be sparse
-	for ; index < k.SignedBlocksWindow(ctx); index++ {
-		var missed bool
-		bz := store.Get(types.GetValidatorMissedBlockBitArrayKey(address, index))
-		if bz == nil {
-			continue
+	signInfo, found := k.GetValidatorSigningInfo(ctx, address)
+	if !found {
+		return
+	}
+
+	start := signInfo.IndexOffset - k.SignedBlocksWindow(ctx)
+	if start < 0 {
+		start = 0
+	}
+
+	var chunk []byte
+	for index := start; index < signInfo.IndexOffset; index++ {
+		bit := index % types.MissedBlockBitmapChunkSize
+		if chunk == nil || bit == 0 {
+			chunk = k.getValidatorMissedBlockChunk(ctx, address, index/types.MissedBlockBitmapChunkSize)
 		}
-		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &missed)
+		missed := chunk != nil && chunk[bit/8]&(1<<uint(bit%8)) != 0
 		if handler(index, missed) {
 			break
 		}
 	}
 }
 
-// SetValidatorMissedBlockBitArray sets the bit that checks if the validator has
-// missed a block in the current window
+// SetValidatorMissedBlockBitArray sets the bit of the missed block bitmap of a
+// validator at the given position, which is the IndexOffset of the block
 func (k Keeper) SetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64, missed bool) {
+	chunkIndex := index / types.MissedBlockBitmapChunkSize
+	chunk := k.getValidatorMissedBlockChunk(ctx, address, chunkIndex)
+	if chunk == nil {
+		if !missed {
+			return
+		}
+		chunk = make([]byte, types.MissedBlockBitmapChunkSize/8)
+	}
+
+	bit := index % types.MissedBlockBitmapChunkSize
+	if missed {
+		chunk[bit/8] |= 1 << uint(bit%8)
+	} else {
+		chunk[bit/8] &^= 1 << uint(bit%8)
+	}
+
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(missed)
-	store.Set(types.GetValidatorMissedBlockBitArrayKey(address, index), bz)
+	store.Set(types.GetValidatorMissedBlockBitmapKey(address, chunkIndex), chunk)
 }
 
-// clearValidatorMissedBlockBitArray deletes every instance of ValidatorMissedBlockBitArray in the store
+// CountValidatorMissedBlocks returns the number of blocks missed by a validator
+// among the positions [start, end) of its missed block bitmap
+func (k Keeper) CountValidatorMissedBlocks(ctx sdk.Context, address sdk.ConsAddress, start, end int64) (count int64) {
+	if start < 0 {
+		start = 0
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		types.GetValidatorMissedBlockBitmapKey(address, start/types.MissedBlockBitmapChunkSize),
+		types.GetValidatorMissedBlockBitmapKey(address, (end+types.MissedBlockBitmapChunkSize-1)/types.MissedBlockBitmapChunkSize),
+	)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		chunkStart := int64(binary.BigEndian.Uint64(key[len(key)-8:])) * types.MissedBlockBitmapChunkSize
+		chunk := iter.Value()
+		for i, b := range chunk {
+			if b == 0 {
+				continue
+			}
+			for j := 0; j < 8; j++ {
+				index := chunkStart + int64(i*8+j)
+				if b&(1<<uint(j)) != 0 && index >= start && index < end {
+					count++
+				}
+			}
+		}
+	}
+
+	return count
+}
+
+// getValidatorMissedBlockChunk returns a copy of a chunk of the missed block
+// bitmap of a validator, nil if none of its blocks were missed
+func (k Keeper) getValidatorMissedBlockChunk(ctx sdk.Context, address sdk.ConsAddress, chunkIndex int64) []byte {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorMissedBlockBitmapKey(address, chunkIndex))
+	if bz == nil {
+		return nil
+	}
+	chunk := make([]byte, len(bz))
+	copy(chunk, bz)
+	return chunk
+}
+
+// pruneValidatorMissedBlockBitmap deletes the chunks of the missed block bitmap
+// of a validator holding only positions before the given one
+func (k Keeper) pruneValidatorMissedBlockBitmap(ctx sdk.Context, address sdk.ConsAddress, before int64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		types.GetValidatorMissedBlockBitmapPrefixKey(address),
+		types.GetValidatorMissedBlockBitmapKey(address, before/types.MissedBlockBitmapChunkSize),
+	)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// clearValidatorMissedBlockBitArray deletes the missed block bitmap of a validator
 func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorMissedBlockBitArrayPrefixKey(address))
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorMissedBlockBitmapPrefixKey(address))
 	defer iter.Close()
+
+	var keys [][]byte
 	for ; iter.Valid(); iter.Next() {
-		store.Delete(iter.Key())
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetMissedBlocksWindow returns the signed blocks window the missed blocks
+// counters of the validators were last computed over
+func (k Keeper) GetMissedBlocksWindow(ctx sdk.Context) (window int64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MissedBlocksWindowKey)
+	if bz == nil {
+		return 0, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &window)
+	return window, true
+}
+
+// SetMissedBlocksWindow sets the signed blocks window the missed blocks
+// counters of the validators are computed over
+func (k Keeper) SetMissedBlocksWindow(ctx sdk.Context, window int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MissedBlocksWindowKey, k.cdc.MustMarshalBinaryLengthPrefixed(window))
+}
+
+// UpdateMissedBlocksWindow recounts the blocks missed by each validator over
+// the last SignedBlocksWindow blocks of its missed block bitmap when the window
+// has been changed since the counters were last computed. Blocks whose chunk of
+// the bitmap was pruned before the window grew are counted as signed.
+func (k Keeper) UpdateMissedBlocksWindow(ctx sdk.Context) {
+	window := k.SignedBlocksWindow(ctx)
+	previous, found := k.GetMissedBlocksWindow(ctx)
+	if found && previous == window {
+		return
 	}
+
+	if found {
+		k.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
+			start := info.IndexOffset - window
+			info.MissedBlocksCounter = k.CountValidatorMissedBlocks(ctx, address, start, info.IndexOffset)
+			k.SetValidatorSigningInfo(ctx, address, info)
+			if start > 0 {
+				k.pruneValidatorMissedBlockBitmap(ctx, address, start)
+			}
+			return false
+		})
+
+		k.Logger(ctx).Info(fmt.Sprintf("recounted missed blocks over a signed blocks window of %d, was %d", window, previous))
+	}
+
+	k.SetMissedBlocksWindow(ctx, window)
 }
@@ -39,3 +39,62 @@ func TestGetSetValidatorMissedBlockBitArray(t *testing.T) {
 	missed = keeper.GetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(Addrs[0]), 0)
 	require.True(t, missed) // now should be missed
 }
+
+func TestValidatorMissedBlockBitmapChunks(t *testing.T) {
+	ctx, _, _, _, keeper := CreateTestInput(t, types.DefaultParams())
+	consAddr := sdk.ConsAddress(Addrs[0])
+
+	// blocks of different chunks are tracked independently
+	keeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 1, true)
+	keeper.SetValidatorMissedBlockBitArray(ctx, consAddr, types.MissedBlockBitmapChunkSize+1, true)
+	require.True(t, keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 1))
+	require.False(t, keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 2))
+	require.True(t, keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, types.MissedBlockBitmapChunkSize+1))
+	require.Equal(t, int64(2), keeper.CountValidatorMissedBlocks(ctx, consAddr, 0, 2*types.MissedBlockBitmapChunkSize))
+	require.Equal(t, int64(1), keeper.CountValidatorMissedBlocks(ctx, consAddr, 2, 2*types.MissedBlockBitmapChunkSize))
+
+	keeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 1, false)
+	require.False(t, keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 1))
+	require.Equal(t, int64(1), keeper.CountValidatorMissedBlocks(ctx, consAddr, 0, 2*types.MissedBlockBitmapChunkSize))
+
+	// pruning only deletes the chunks holding positions before the given one
+	keeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 1, true)
+	keeper.pruneValidatorMissedBlockBitmap(ctx, consAddr, types.MissedBlockBitmapChunkSize+1)
+	require.False(t, keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 1))
+	require.True(t, keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, types.MissedBlockBitmapChunkSize+1))
+}
+
+func TestMigrateMissedBlockBitArrays(t *testing.T) {
+	ctx, _, _, _, keeper := CreateTestInput(t, types.DefaultParams())
+	consAddr := sdk.ConsAddress(Addrs[0])
+	window := keeper.SignedBlocksWindow(ctx)
+
+	// the validator went past its first window, the block at index 3 of the
+	// legacy bit array being its 103rd block
+	info := types.NewValidatorSigningInfo(consAddr, 0, window+5, time.Unix(0, 0), false, 7)
+	keeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	store := ctx.KVStore(keeper.storeKey)
+	for index, missed := range map[int64]bool{3: true, 10: true, 11: false} {
+		store.Set(types.GetValidatorMissedBlockBitArrayKey(consAddr, index), keeper.cdc.MustMarshalBinaryLengthPrefixed(missed))
+	}
+
+	require.Equal(t, 3, keeper.MigrateMissedBlockBitArrays(ctx))
+
+	require.True(t, keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, window+3))
+	require.True(t, keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 10))
+	require.False(t, keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 11))
+	require.False(t, keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 3))
+
+	info, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(2), info.MissedBlocksCounter)
+
+	migrated, found := keeper.GetMissedBlocksWindow(ctx)
+	require.True(t, found)
+	require.Equal(t, window, migrated)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayKey)
+	defer iter.Close()
+	require.False(t, iter.Valid())
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
+)
+
+// GetValidatorUptime returns the moving average of the signed blocks of a
+// validator, if it is tracked
+func (k Keeper) GetValidatorUptime(ctx sdk.Context, address sdk.ConsAddress) (uptime sdk.Dec, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorUptimeKey(address))
+	if bz == nil {
+		return sdk.Dec{}, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &uptime)
+	return uptime, true
+}
+
+// SetValidatorUptime sets the moving average of the signed blocks of a
+// validator
+func (k Keeper) SetValidatorUptime(ctx sdk.Context, address sdk.ConsAddress, uptime sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(uptime)
+	store.Set(types.GetValidatorUptimeKey(address), bz)
+}
+
+// DeleteValidatorUptime deletes the moving average of the signed blocks of a
+// validator
+func (k Keeper) DeleteValidatorUptime(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorUptimeKey(address))
+}
+
+// IterateValidatorUptimes iterates over the stored uptime moving averages of
+// all validators
+func (k Keeper) IterateValidatorUptimes(ctx sdk.Context,
+	handler func(address sdk.ConsAddress, uptime sdk.Dec) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorUptimeKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		address := types.GetValidatorUptimeAddress(iter.Key())
+		var uptime sdk.Dec
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &uptime)
+		if handler(address, uptime) {
+			break
+		}
+	}
+}
+
+// updateValidatorUptime folds whether or not a validator signed the current
+// block into its uptime moving average. Validators start with an uptime of
+// one, the first time their signature is tracked.
+func (k Keeper) updateValidatorUptime(ctx sdk.Context, address sdk.ConsAddress, signed bool) {
+	uptime, found := k.GetValidatorUptime(ctx, address)
+	if !found {
+		uptime = sdk.OneDec()
+	}
+
+	sample := sdk.ZeroDec()
+	if signed {
+		sample = sdk.OneDec()
+	}
+
+	alpha := types.UptimeSmoothingFactor(k.SignedBlocksWindow(ctx))
+	uptime = uptime.Add(alpha.Mul(sample.Sub(uptime)))
+	k.SetValidatorUptime(ctx, address, uptime)
+
+	if value, err := strconv.ParseFloat(uptime.String(), 64); err == nil {
+		k.getMetrics().ValidatorUptime.With(types.MetricsValidatorLabel, address.String()).Set(value)
+	}
+}
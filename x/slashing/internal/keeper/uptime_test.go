@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// uptimeGauge records the last value set for each validator
+type uptimeGauge struct {
+	validator string
+	values    map[string]float64
+}
+
+func (g uptimeGauge) With(labelValues ...string) metrics.Gauge {
+	return uptimeGauge{labelValues[1], g.values}
+}
+
+func (g uptimeGauge) Set(value float64) { g.values[g.validator] = value }
+
+func (g uptimeGauge) Add(delta float64) { g.values[g.validator] += delta }
+
+func TestGetSetValidatorUptime(t *testing.T) {
+	ctx, _, _, _, keeper := CreateTestInput(t, types.DefaultParams())
+	consAddr := sdk.ConsAddress(Addrs[0])
+
+	_, found := keeper.GetValidatorUptime(ctx, consAddr)
+	require.False(t, found)
+
+	keeper.SetValidatorUptime(ctx, consAddr, sdk.NewDecWithPrec(95, 2))
+	uptime, found := keeper.GetValidatorUptime(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(95, 2), uptime)
+
+	var uptimes []types.ValidatorUptime
+	keeper.IterateValidatorUptimes(ctx, func(address sdk.ConsAddress, uptime sdk.Dec) (stop bool) {
+		uptimes = append(uptimes, types.NewValidatorUptime(address, uptime))
+		return false
+	})
+	require.Equal(t, []types.ValidatorUptime{types.NewValidatorUptime(consAddr, sdk.NewDecWithPrec(95, 2))}, uptimes)
+
+	// the uptime is deleted along with the validator
+	keeper.AfterValidatorRemoved(ctx, consAddr)
+	_, found = keeper.GetValidatorUptime(ctx, consAddr)
+	require.False(t, found)
+}
+
+// Test the uptime moving average of a validator is updated on every tracked
+// block and reported to the keeper metrics
+func TestHandleValidatorSignatureUptime(t *testing.T) {
+	params := TestParams()
+	params.DowntimeGracePeriod = 10
+	ctx, _, sk, _, keeper := CreateTestInput(t, params)
+	gauge := uptimeGauge{values: make(map[string]float64)}
+	keeper.SetMetrics(&types.Metrics{ValidatorUptime: gauge})
+
+	power := int64(100)
+	addr, val := Addrs[0], Pks[0]
+	consAddr := sdk.ConsAddress(addr)
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(addr, val, sdk.TokensFromConsensusPower(power)))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// blocks missed during the grace period aren't tracked
+	height := int64(0)
+	for ; height <= keeper.DowntimeGracePeriod(ctx); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+
+	_, found := keeper.GetValidatorUptime(ctx, consAddr)
+	require.False(t, found)
+
+	// the uptime starts at one and moves towards the signing performance
+	alpha := types.UptimeSmoothingFactor(keeper.SignedBlocksWindow(ctx))
+	expected := sdk.OneDec()
+	for i, signed := range []bool{true, false, false, true} {
+		ctx = ctx.WithBlockHeight(height + int64(i))
+		keeper.HandleValidatorSignature(ctx, val.Address(), power, signed)
+
+		sample := sdk.ZeroDec()
+		if signed {
+			sample = sdk.OneDec()
+		}
+		expected = expected.Add(alpha.Mul(sample.Sub(expected)))
+
+		uptime, found := keeper.GetValidatorUptime(ctx, consAddr)
+		require.True(t, found)
+		require.Equal(t, expected, uptime)
+	}
+
+	require.True(t, expected.LT(sdk.OneDec()))
+	value, err := strconv.ParseFloat(expected.String(), 64)
+	require.NoError(t, err)
+	require.InDelta(t, value, gauge.values[consAddr.String()], 1e-9)
+}
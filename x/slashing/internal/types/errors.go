@@ -22,6 +22,7 @@ const (
 	CodeMissingSelfDelegation CodeType = 104
 	CodeSelfDelegationTooLow  CodeType = 105
 	CodeMissingSigningInfo    CodeType = 106
+	CodeInvalidMaintenance    CodeType = 107
 )
 
 func ErrNoValidatorForAddress(codespace sdk.CodespaceType) sdk.Error {
@@ -51,3 +52,7 @@ func ErrSelfDelegationTooLowToUnjail(codespace sdk.CodespaceType) sdk.Error {
 func ErrNoSigningInfoFound(codespace sdk.CodespaceType, consAddr sdk.ConsAddress) sdk.Error {
 	return sdk.NewError(codespace, CodeMissingSigningInfo, fmt.Sprintf("no signing info found for address: %s", consAddr))
 }
+
+func ErrInvalidMaintenanceWindow(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMaintenance, fmt.Sprintf("invalid maintenance window: %s", reason))
+}
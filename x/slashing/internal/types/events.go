@@ -3,8 +3,9 @@ package types
 
 // Slashing module event types
 const (
-	EventTypeSlash    = "slash"
-	EventTypeLiveness = "liveness"
+	EventTypeSlash             = "slash"
+	EventTypeLiveness          = "liveness"
+	EventTypeMaintenanceWindow = "maintenance_window"
 
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
@@ -12,6 +13,9 @@ const (
 	AttributeKeyReason       = "reason"
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
+	AttributeKeyStartHeight  = "start_height"
+	AttributeKeyEndHeight    = "end_height"
+	AttributeKeyMaintenance  = "maintenance"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
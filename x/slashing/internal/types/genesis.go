@@ -2,7 +2,6 @@ package types
 
 import (
 	"fmt"
-	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -12,21 +11,33 @@ type GenesisState struct {
 	Params       Params                          `json:"params" yaml:"params"`
 	SigningInfos map[string]ValidatorSigningInfo `json:"signing_infos" yaml:"signing_infos"`
 	MissedBlocks map[string][]MissedBlock        `json:"missed_blocks" yaml:"missed_blocks"`
+
+	InfractionRecords []InfractionRecord `json:"infraction_records,omitempty" yaml:"infraction_records,omitempty"`
+	Uptimes           []ValidatorUptime  `json:"uptimes,omitempty" yaml:"uptimes,omitempty"`
+
+	MaintenanceWindows []MaintenanceWindow `json:"maintenance_windows,omitempty" yaml:"maintenance_windows,omitempty"`
 }
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params, signingInfos map[string]ValidatorSigningInfo, missedBlocks map[string][]MissedBlock,
+	infractionRecords []InfractionRecord, uptimes []ValidatorUptime, maintenanceWindows []MaintenanceWindow,
 ) GenesisState {
 
 	return GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:            params,
+		SigningInfos:      signingInfos,
+		MissedBlocks:      missedBlocks,
+		InfractionRecords: infractionRecords,
+		Uptimes:           uptimes,
+
+		MaintenanceWindows: maintenanceWindows,
 	}
 }
 
-// MissedBlock
+// MissedBlock records whether a validator missed the block at an index of its
+// signing window, which is the position of the block in its missed block bitmap
+// modulo the SignedBlocksWindow.
 type MissedBlock struct {
 	Index  int64 `json:"index" yaml:"index"`
 	Missed bool  `json:"missed" yaml:"missed"`
@@ -40,6 +51,23 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 	}
 }
 
+// MissedBlockPosition returns the position in the missed block bitmap of a
+// validator of the block at the given index of its signing window, given its
+// IndexOffset. It returns -1 if no block of the window has that index yet.
+func MissedBlockPosition(indexOffset, window, index int64) int64 {
+	if window <= 0 || index < 0 || index >= window {
+		return -1
+	}
+
+	start := indexOffset - window
+	position := start + ((index-start)%window+window)%window
+	if position < 0 {
+		return -1
+	}
+
+	return position
+}
+
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
@@ -51,34 +79,47 @@ func DefaultGenesisState() GenesisState {
 
 // ValidateGenesis validates the slashing genesis parameters
 func ValidateGenesis(data GenesisState) error {
-	downtime := data.Params.SlashFractionDowntime
-	if downtime.IsNegative() || downtime.GT(sdk.OneDec()) {
-		return fmt.Errorf("slashing fraction downtime should be less than or equal to one and greater than zero, is %s", downtime.String())
+	if err := data.Params.Validate(); err != nil {
+		return err
 	}
 
-	dblSign := data.Params.SlashFractionDoubleSign
-	if dblSign.IsNegative() || dblSign.GT(sdk.OneDec()) {
-		return fmt.Errorf("slashing fraction double sign should be less than or equal to one and greater than zero, is %s", dblSign.String())
-	}
+	signedWindow := data.Params.SignedBlocksWindow
 
-	minSign := data.Params.MinSignedPerWindow
-	if minSign.IsNegative() || minSign.GT(sdk.OneDec()) {
-		return fmt.Errorf("min signed per window should be less than or equal to one and greater than zero, is %s", minSign.String())
+	for addr, array := range data.MissedBlocks {
+		for _, missed := range array {
+			if missed.Index < 0 || missed.Index >= signedWindow {
+				return fmt.Errorf("missed block of validator %s has index %d outside of the signed blocks window of %d", addr, missed.Index, signedWindow)
+			}
+		}
 	}
 
-	maxEvidence := data.Params.MaxEvidenceAge
-	if maxEvidence < 1*time.Minute {
-		return fmt.Errorf("max evidence age must be at least 1 minute, is %s", maxEvidence.String())
+	for _, record := range data.InfractionRecords {
+		if record.Address.Empty() {
+			return fmt.Errorf("infraction record at height %d has no validator address", record.Height)
+		}
 	}
 
-	downtimeJail := data.Params.DowntimeJailDuration
-	if downtimeJail < 1*time.Minute {
-		return fmt.Errorf("downtime unblond duration must be at least 1 minute, is %s", downtimeJail.String())
+	for _, uptime := range data.Uptimes {
+		if uptime.Address.Empty() {
+			return fmt.Errorf("validator uptime %s has no validator address", uptime.Uptime)
+		}
+		if uptime.Uptime.IsNil() || uptime.Uptime.IsNegative() || uptime.Uptime.GT(sdk.OneDec()) {
+			return fmt.Errorf("uptime of validator %s should be between zero and one, is %s", uptime.Address, uptime.Uptime)
+		}
 	}
 
-	signedWindow := data.Params.SignedBlocksWindow
-	if signedWindow < 10 {
-		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
+	for _, window := range data.MaintenanceWindows {
+		if window.Address.Empty() {
+			return fmt.Errorf("maintenance window starting at height %d has no validator address", window.StartHeight)
+		}
+		if window.StartHeight <= 0 || window.EndHeight < window.StartHeight {
+			return fmt.Errorf("maintenance window of validator %s spans an invalid range of blocks [%d, %d]",
+				window.Address, window.StartHeight, window.EndHeight)
+		}
+		if window.MissedBlocks < 0 || window.MissedBlocks > window.EndHeight-window.StartHeight+1 {
+			return fmt.Errorf("maintenance window of validator %s has %d missed blocks out of %d",
+				window.Address, window.MissedBlocks, window.EndHeight-window.StartHeight+1)
+		}
 	}
 
 	return nil
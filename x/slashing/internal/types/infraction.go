@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InfractionRecord defines the record of a double-sign infraction handled by
+// the slashing module, kept for post-incident analysis.
+type InfractionRecord struct {
+	Address       sdk.ConsAddress `json:"address" yaml:"address"`               // validator consensus address
+	Height        int64           `json:"height" yaml:"height"`                 // height at which the infraction was committed
+	Time          time.Time       `json:"time" yaml:"time"`                     // time at which the infraction was committed
+	Power         int64           `json:"power" yaml:"power"`                   // power of the validator at the infraction height
+	SlashFraction sdk.Dec         `json:"slash_fraction" yaml:"slash_fraction"` // fraction of the stake slashed
+	SlashedTokens sdk.Int         `json:"slashed_tokens" yaml:"slashed_tokens"` // amount of tokens slashed
+	Tombstoned    bool            `json:"tombstoned" yaml:"tombstoned"`         // whether or not the validator was tombstoned
+}
+
+// NewInfractionRecord creates a new InfractionRecord instance
+func NewInfractionRecord(
+	consAddr sdk.ConsAddress, height int64, time time.Time, power int64,
+	slashFraction sdk.Dec, slashedTokens sdk.Int, tombstoned bool,
+) InfractionRecord {
+
+	return InfractionRecord{
+		Address:       consAddr,
+		Height:        height,
+		Time:          time,
+		Power:         power,
+		SlashFraction: slashFraction,
+		SlashedTokens: slashedTokens,
+		Tombstoned:    tombstoned,
+	}
+}
+
+// String implements the stringer interface for InfractionRecord
+func (r InfractionRecord) String() string {
+	return fmt.Sprintf(`Infraction Record:
+  Address:        %s
+  Height:         %d
+  Time:           %v
+  Power:          %d
+  Slash Fraction: %s
+  Slashed Tokens: %s
+  Tombstoned:     %t`,
+		r.Address, r.Height, r.Time, r.Power,
+		r.SlashFraction, r.SlashedTokens, r.Tombstoned)
+}
@@ -18,6 +18,10 @@ const (
 
 	// QuerierRoute is the querier route for slashing
 	QuerierRoute = ModuleName
+
+	// MissedBlockBitmapChunkSize is the number of blocks tracked by each chunk
+	// of the missed block bitmap of a validator
+	MissedBlockBitmapChunkSize = 1024
 )
 
 // Keys for slashing store
@@ -25,13 +29,28 @@ const (
 //
 // - 0x01<consAddress_Bytes>: ValidatorSigningInfo
 //
-// - 0x02<consAddress_Bytes><period_Bytes>: bool
+// - 0x02<consAddress_Bytes><index_Bytes>: bool (legacy, read by the migration only)
 //
 // - 0x03<accAddr_Bytes>: crypto.PubKey
+//
+// - 0x04<consAddress_Bytes><height_Bytes>: InfractionRecord
+//
+// - 0x05<consAddress_Bytes>: sdk.Dec
+//
+// - 0x06<consAddress_Bytes><chunk_Bytes>: []byte
+//
+// - 0x07: int64
+//
+// - 0x08<consAddress_Bytes>: MaintenanceWindow
 var (
 	ValidatorSigningInfoKey         = []byte{0x01} // Prefix for signing info
-	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for missed block bit array
+	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for the legacy missed block bit array
 	AddrPubkeyRelationKey           = []byte{0x03} // Prefix for address-pubkey relation
+	InfractionRecordKey             = []byte{0x04} // Prefix for double-sign infraction records
+	ValidatorUptimeKey              = []byte{0x05} // Prefix for validator uptime moving averages
+	ValidatorMissedBlockBitmapKey   = []byte{0x06} // Prefix for missed block bitmap chunks
+	MissedBlocksWindowKey           = []byte{0x07} // Key for the window the missed blocks are counted over
+	MaintenanceWindowKey            = []byte{0x08} // Prefix for validator maintenance windows
 )
 
 // GetValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -48,7 +67,8 @@ func GetValidatorSigningInfoAddress(key []byte) (v sdk.ConsAddress) {
 	return sdk.ConsAddress(addr)
 }
 
-// GetValidatorMissedBlockBitArrayPrefixKey - stored by *Consensus* address (not operator address)
+// GetValidatorMissedBlockBitArrayPrefixKey - stored by *Consensus* address (not operator address).
+// The bit array is only kept by the stores predating the missed block bitmap.
 func GetValidatorMissedBlockBitArrayPrefixKey(v sdk.ConsAddress) []byte {
 	return append(ValidatorMissedBlockBitArrayKey, v.Bytes()...)
 }
@@ -60,7 +80,46 @@ func GetValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 	return append(GetValidatorMissedBlockBitArrayPrefixKey(v), b...)
 }
 
+// GetValidatorMissedBlockBitmapPrefixKey - stored by *Consensus* address (not operator address)
+func GetValidatorMissedBlockBitmapPrefixKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorMissedBlockBitmapKey, v.Bytes()...)
+}
+
+// GetValidatorMissedBlockBitmapKey - stored by *Consensus* address and chunk index
+func GetValidatorMissedBlockBitmapKey(v sdk.ConsAddress, chunk int64) []byte {
+	return append(GetValidatorMissedBlockBitmapPrefixKey(v), sdk.Uint64ToBigEndian(uint64(chunk))...)
+}
+
 // GetAddrPubkeyRelationKey gets pubkey relation key used to get the pubkey from the address
 func GetAddrPubkeyRelationKey(address []byte) []byte {
 	return append(AddrPubkeyRelationKey, address...)
 }
+
+// GetInfractionRecordsPrefixKey - stored by *Consensus* address (not operator address)
+func GetInfractionRecordsPrefixKey(v sdk.ConsAddress) []byte {
+	return append(InfractionRecordKey, v.Bytes()...)
+}
+
+// GetValidatorUptimeKey - stored by *Consensus* address (not operator address)
+func GetValidatorUptimeKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorUptimeKey, v.Bytes()...)
+}
+
+// GetValidatorUptimeAddress - extract the address from a validator uptime key
+func GetValidatorUptimeAddress(key []byte) (v sdk.ConsAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.ConsAddress(addr)
+}
+
+// GetInfractionRecordKey - stored by *Consensus* address and infraction height
+func GetInfractionRecordKey(v sdk.ConsAddress, height int64) []byte {
+	return append(GetInfractionRecordsPrefixKey(v), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetMaintenanceWindowKey - stored by *Consensus* address (not operator address)
+func GetMaintenanceWindowKey(v sdk.ConsAddress) []byte {
+	return append(MaintenanceWindowKey, v.Bytes()...)
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaintenanceWindow defines a range of blocks, scheduled in advance by a
+// validator, during which the blocks it misses don't count towards the
+// downtime threshold. They are still folded into its uptime and counted by
+// the window.
+type MaintenanceWindow struct {
+	Address      sdk.ConsAddress `json:"address" yaml:"address"`             // validator consensus address
+	StartHeight  int64           `json:"start_height" yaml:"start_height"`   // first block of the window
+	EndHeight    int64           `json:"end_height" yaml:"end_height"`       // last block of the window
+	MissedBlocks int64           `json:"missed_blocks" yaml:"missed_blocks"` // blocks missed during the window
+}
+
+// NewMaintenanceWindow creates a new MaintenanceWindow instance
+func NewMaintenanceWindow(consAddr sdk.ConsAddress, startHeight, endHeight int64) MaintenanceWindow {
+	return MaintenanceWindow{
+		Address:     consAddr,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
+
+// IsActive returns whether the window covers the given height
+func (w MaintenanceWindow) IsActive(height int64) bool {
+	return w.StartHeight <= height && height <= w.EndHeight
+}
+
+// String implements the stringer interface for MaintenanceWindow
+func (w MaintenanceWindow) String() string {
+	return fmt.Sprintf(`Maintenance Window:
+  Address:       %s
+  Start Height:  %d
+  End Height:    %d
+  Missed Blocks: %d`,
+		w.Address, w.StartHeight, w.EndHeight, w.MissedBlocks)
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by the
+	// slashing keeper.
+	MetricsSubsystem = ModuleName
+
+	// MetricsValidatorLabel is the label holding the validator consensus
+	// address.
+	MetricsValidatorLabel = "validator"
+)
+
+// Metrics contains metrics exposed by the slashing keeper.
+type Metrics struct {
+	// Exponential moving average of the signed blocks of each validator.
+	ValidatorUptime metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics built using the Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{MetricsValidatorLabel}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+
+	return &Metrics{
+		ValidatorUptime: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_uptime",
+			Help:      "Exponential moving average of the blocks signed by a validator, from 0 to 1.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		ValidatorUptime: discard.NewGauge(),
+	}
+}
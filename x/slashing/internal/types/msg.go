@@ -1,11 +1,19 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// slashing message types
+const (
+	TypeMsgUnjail                    = "unjail"
+	TypeMsgScheduleMaintenanceWindow = "schedule_maintenance_window"
+)
+
 // verify interface at compile time
-var _ sdk.Msg = &MsgUnjail{}
+var _, _ sdk.Msg = &MsgUnjail{}, &MsgScheduleMaintenanceWindow{}
 
 // MsgUnjail - struct for unjailing jailed validator
 type MsgUnjail struct {
@@ -21,7 +29,7 @@ func NewMsgUnjail(validatorAddr sdk.ValAddress) MsgUnjail {
 
 //nolint
 func (msg MsgUnjail) Route() string { return RouterKey }
-func (msg MsgUnjail) Type() string  { return "unjail" }
+func (msg MsgUnjail) Type() string  { return TypeMsgUnjail }
 func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddr)}
 }
@@ -39,3 +47,49 @@ func (msg MsgUnjail) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+// MsgScheduleMaintenanceWindow - struct for a validator declaring a future
+// range of blocks during which its missed blocks don't count towards the
+// downtime threshold
+type MsgScheduleMaintenanceWindow struct {
+	ValidatorAddr sdk.ValAddress `json:"address" yaml:"address"`           // address of the validator operator
+	StartHeight   int64          `json:"start_height" yaml:"start_height"` // first block of the window
+	EndHeight     int64          `json:"end_height" yaml:"end_height"`     // last block of the window
+}
+
+// NewMsgScheduleMaintenanceWindow creates a new MsgScheduleMaintenanceWindow instance
+func NewMsgScheduleMaintenanceWindow(validatorAddr sdk.ValAddress, startHeight, endHeight int64) MsgScheduleMaintenanceWindow {
+	return MsgScheduleMaintenanceWindow{
+		ValidatorAddr: validatorAddr,
+		StartHeight:   startHeight,
+		EndHeight:     endHeight,
+	}
+}
+
+//nolint
+func (msg MsgScheduleMaintenanceWindow) Route() string { return RouterKey }
+func (msg MsgScheduleMaintenanceWindow) Type() string  { return TypeMsgScheduleMaintenanceWindow }
+func (msg MsgScheduleMaintenanceWindow) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddr)}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgScheduleMaintenanceWindow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgScheduleMaintenanceWindow) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddr.Empty() {
+		return ErrBadValidatorAddr(DefaultCodespace)
+	}
+	if msg.StartHeight <= 0 {
+		return ErrInvalidMaintenanceWindow(DefaultCodespace, fmt.Sprintf("start height must be positive, is %d", msg.StartHeight))
+	}
+	if msg.EndHeight < msg.StartHeight {
+		return ErrInvalidMaintenanceWindow(DefaultCodespace,
+			fmt.Sprintf("end height %d is before start height %d", msg.EndHeight, msg.StartHeight))
+	}
+	return nil
+}
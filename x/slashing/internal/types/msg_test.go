@@ -18,3 +18,27 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgScheduleMaintenanceWindow(t *testing.T) {
+	addr := sdk.ValAddress("abcd")
+	tests := []struct {
+		validatorAddr sdk.ValAddress
+		start, end    int64
+		expectPass    bool
+	}{
+		{addr, 10, 20, true},
+		{addr, 10, 10, true},
+		{sdk.ValAddress{}, 10, 20, false},
+		{addr, 0, 20, false},
+		{addr, 10, 9, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgScheduleMaintenanceWindow(tc.validatorAddr, tc.start, tc.end)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -14,6 +14,10 @@ const (
 	DefaultMaxEvidenceAge       = 60 * 2 * time.Second
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultDowntimeGracePeriod  = int64(0)
+
+	DefaultMaxMaintenanceWindow      = int64(20)
+	DefaultMaxMaintenanceWindowDelay = int64(1000)
 )
 
 // The Double Sign Jail period ends at Max Time supported by Amino (Dec 31, 9999 - 23:59:59 GMT)
@@ -26,12 +30,15 @@ var (
 
 // Parameter store keys
 var (
-	KeyMaxEvidenceAge          = []byte("MaxEvidenceAge")
-	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
-	KeyMinSignedPerWindow      = []byte("MinSignedPerWindow")
-	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
-	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
-	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeyMaxEvidenceAge            = []byte("MaxEvidenceAge")
+	KeySignedBlocksWindow        = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow        = []byte("MinSignedPerWindow")
+	KeyDowntimeJailDuration      = []byte("DowntimeJailDuration")
+	KeySlashFractionDoubleSign   = []byte("SlashFractionDoubleSign")
+	KeySlashFractionDowntime     = []byte("SlashFractionDowntime")
+	KeyDowntimeGracePeriod       = []byte("DowntimeGracePeriod")
+	KeyMaxMaintenanceWindow      = []byte("MaxMaintenanceWindow")
+	KeyMaxMaintenanceWindowDelay = []byte("MaxMaintenanceWindowDelay")
 )
 
 // ParamKeyTable for slashing module
@@ -47,12 +54,17 @@ type Params struct {
 	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	DowntimeGracePeriod     int64         `json:"downtime_grace_period" yaml:"downtime_grace_period"` // blocks after bonding during which a validator isn't jailed for downtime
+
+	MaxMaintenanceWindow      int64 `json:"max_maintenance_window" yaml:"max_maintenance_window"`             // maximum blocks spanned by a maintenance window, zero disabling them
+	MaxMaintenanceWindowDelay int64 `json:"max_maintenance_window_delay" yaml:"max_maintenance_window_delay"` // maximum blocks before a maintenance window starts
 }
 
 // NewParams creates a new Params object
 func NewParams(maxEvidenceAge time.Duration, signedBlocksWindow int64,
 	minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec) Params {
+	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, downtimeGracePeriod int64,
+	maxMaintenanceWindow, maxMaintenanceWindowDelay int64) Params {
 
 	return Params{
 		MaxEvidenceAge:          maxEvidenceAge,
@@ -61,6 +73,10 @@ func NewParams(maxEvidenceAge time.Duration, signedBlocksWindow int64,
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		DowntimeGracePeriod:     downtimeGracePeriod,
+
+		MaxMaintenanceWindow:      maxMaintenanceWindow,
+		MaxMaintenanceWindowDelay: maxMaintenanceWindowDelay,
 	}
 }
 
@@ -72,22 +88,58 @@ func (p Params) String() string {
   MinSignedPerWindow:      %s
   DowntimeJailDuration:    %s
   SlashFractionDoubleSign: %s
-  SlashFractionDowntime:   %s`, p.MaxEvidenceAge,
+  SlashFractionDowntime:   %s
+  DowntimeGracePeriod:     %d
+  MaxMaintenanceWindow:      %d
+  MaxMaintenanceWindowDelay: %d`, p.MaxEvidenceAge,
 		p.SignedBlocksWindow, p.MinSignedPerWindow,
 		p.DowntimeJailDuration, p.SlashFractionDoubleSign,
-		p.SlashFractionDowntime)
+		p.SlashFractionDowntime, p.DowntimeGracePeriod,
+		p.MaxMaintenanceWindow, p.MaxMaintenanceWindowDelay)
 }
 
 // ParamSetPairs - Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		params.NewParamSetPair(KeyMaxEvidenceAge, &p.MaxEvidenceAge),
-		params.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow),
-		params.NewParamSetPair(KeyMinSignedPerWindow, &p.MinSignedPerWindow),
-		params.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration),
-		params.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign),
-		params.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime),
+		params.NewParamSetPair(KeyMaxEvidenceAge, &p.MaxEvidenceAge, validateMaxEvidenceAge),
+		params.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow, validateSignedBlocksWindow),
+		params.NewParamSetPair(KeyMinSignedPerWindow, &p.MinSignedPerWindow, validateMinSignedPerWindow),
+		params.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
+		params.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
+		params.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		params.NewParamSetPair(KeyDowntimeGracePeriod, &p.DowntimeGracePeriod, validateDowntimeGracePeriod),
+		params.NewParamSetPair(KeyMaxMaintenanceWindow, &p.MaxMaintenanceWindow, validateMaxMaintenanceWindow),
+		params.NewParamSetPair(KeyMaxMaintenanceWindowDelay, &p.MaxMaintenanceWindowDelay, validateMaxMaintenanceWindowDelay),
+	}
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateMaxEvidenceAge(p.MaxEvidenceAge); err != nil {
+		return err
+	}
+	if err := validateSignedBlocksWindow(p.SignedBlocksWindow); err != nil {
+		return err
+	}
+	if err := validateMinSignedPerWindow(p.MinSignedPerWindow); err != nil {
+		return err
+	}
+	if err := validateDowntimeJailDuration(p.DowntimeJailDuration); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDoubleSign(p.SlashFractionDoubleSign); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
+		return err
+	}
+	if err := validateDowntimeGracePeriod(p.DowntimeGracePeriod); err != nil {
+		return err
+	}
+	if err := validateMaxMaintenanceWindow(p.MaxMaintenanceWindow); err != nil {
+		return err
 	}
+	return validateMaxMaintenanceWindowDelay(p.MaxMaintenanceWindowDelay)
 }
 
 // DefaultParams defines the parameters for this module
@@ -95,5 +147,105 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultMaxEvidenceAge, DefaultSignedBlocksWindow, DefaultMinSignedPerWindow,
 		DefaultDowntimeJailDuration, DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultDowntimeGracePeriod, DefaultMaxMaintenanceWindow, DefaultMaxMaintenanceWindowDelay,
 	)
 }
+
+func validateMaxEvidenceAge(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 1*time.Minute {
+		return fmt.Errorf("max evidence age must be at least 1 minute, is %s", v.String())
+	}
+	return nil
+}
+
+func validateSignedBlocksWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 10 {
+		return fmt.Errorf("signed blocks window must be at least 10, is %d", v)
+	}
+	return nil
+}
+
+func validateMinSignedPerWindow(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min signed per window should be less than or equal to one and greater than zero, is %s", v)
+	}
+	return nil
+}
+
+func validateDowntimeJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 1*time.Minute {
+		return fmt.Errorf("downtime unblond duration must be at least 1 minute, is %s", v.String())
+	}
+	return nil
+}
+
+func validateSlashFractionDoubleSign(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slashing fraction double sign should be less than or equal to one and greater than zero, is %s", v)
+	}
+	return nil
+}
+
+func validateSlashFractionDowntime(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slashing fraction downtime should be less than or equal to one and greater than zero, is %s", v)
+	}
+	return nil
+}
+
+func validateDowntimeGracePeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("downtime grace period cannot be negative, is %d", v)
+	}
+	return nil
+}
+
+func validateMaxMaintenanceWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("max maintenance window cannot be negative, is %d", v)
+	}
+	return nil
+}
+
+func validateMaxMaintenanceWindowDelay(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("max maintenance window delay cannot be negative, is %d", v)
+	}
+	return nil
+}
@@ -11,6 +11,11 @@ const (
 	QueryParameters   = "parameters"
 	QuerySigningInfo  = "signingInfo"
 	QuerySigningInfos = "signingInfos"
+
+	QueryInfractionRecords = "infractionRecords"
+	QueryValidatorUptime   = "validatorUptime"
+
+	QueryActiveMaintenanceWindows = "activeMaintenanceWindows"
 )
 
 // QuerySigningInfoParams defines the params for the following queries:
@@ -34,3 +39,25 @@ type QuerySigningInfosParams struct {
 func NewQuerySigningInfosParams(page, limit int) QuerySigningInfosParams {
 	return QuerySigningInfosParams{page, limit}
 }
+
+// QueryInfractionRecordsParams defines the params for the following queries:
+// - 'custom/slashing/infractionRecords'
+type QueryInfractionRecordsParams struct {
+	ConsAddress sdk.ConsAddress
+}
+
+// NewQueryInfractionRecordsParams creates a new QueryInfractionRecordsParams instance
+func NewQueryInfractionRecordsParams(consAddr sdk.ConsAddress) QueryInfractionRecordsParams {
+	return QueryInfractionRecordsParams{consAddr}
+}
+
+// QueryValidatorUptimeParams defines the params for the following queries:
+// - 'custom/slashing/validatorUptime'
+type QueryValidatorUptimeParams struct {
+	ConsAddress sdk.ConsAddress
+}
+
+// NewQueryValidatorUptimeParams creates a new QueryValidatorUptimeParams instance
+func NewQueryValidatorUptimeParams(consAddr sdk.ConsAddress) QueryValidatorUptimeParams {
+	return QueryValidatorUptimeParams{consAddr}
+}
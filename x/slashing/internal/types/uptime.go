@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorUptime defines the exponential moving average of the signing
+// performance of a validator, from 0 (missed every block) to 1 (signed every
+// block).
+type ValidatorUptime struct {
+	Address sdk.ConsAddress `json:"address" yaml:"address"` // validator consensus address
+	Uptime  sdk.Dec         `json:"uptime" yaml:"uptime"`   // moving average of the signed blocks
+}
+
+// NewValidatorUptime creates a new ValidatorUptime instance
+func NewValidatorUptime(consAddr sdk.ConsAddress, uptime sdk.Dec) ValidatorUptime {
+	return ValidatorUptime{
+		Address: consAddr,
+		Uptime:  uptime,
+	}
+}
+
+// UptimeSmoothingFactor returns the smoothing factor of the uptime moving
+// average, 2 / (SignedBlocksWindow + 1), so that the average spans roughly the
+// signed blocks window.
+func UptimeSmoothingFactor(signedBlocksWindow int64) sdk.Dec {
+	return sdk.NewDec(2).QuoInt64(signedBlocksWindow + 1)
+}
+
+// String implements the stringer interface for ValidatorUptime
+func (u ValidatorUptime) String() string {
+	return fmt.Sprintf(`Validator Uptime:
+  Address: %s
+  Uptime:  %s`,
+		u.Address, u.Uptime)
+}
@@ -27,6 +27,15 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &missedB)
 		return fmt.Sprintf("missedA: %v\nmissedB: %v", missedA, missedB)
 
+	case bytes.Equal(kvA.Key[:1], types.ValidatorMissedBlockBitmapKey):
+		return fmt.Sprintf("chunkA: %X\nchunkB: %X", kvA.Value, kvB.Value)
+
+	case bytes.Equal(kvA.Key[:1], types.MissedBlocksWindowKey):
+		var windowA, windowB int64
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &windowA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &windowB)
+		return fmt.Sprintf("windowA: %d\nwindowB: %d", windowA, windowB)
+
 	case bytes.Equal(kvA.Key[:1], types.AddrPubkeyRelationKey):
 		var pubKeyA, pubKeyB crypto.PubKey
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &pubKeyA)
@@ -35,6 +44,24 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
 		bechPKB := sdk.MustBech32ifyAccPub(pubKeyB)
 		return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", bechPKA, bechPKB)
 
+	case bytes.Equal(kvA.Key[:1], types.InfractionRecordKey):
+		var recordA, recordB types.InfractionRecord
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &recordA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &recordB)
+		return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+	case bytes.Equal(kvA.Key[:1], types.ValidatorUptimeKey):
+		var uptimeA, uptimeB sdk.Dec
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &uptimeA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &uptimeB)
+		return fmt.Sprintf("%v\n%v", uptimeA, uptimeB)
+
+	case bytes.Equal(kvA.Key[:1], types.MaintenanceWindowKey):
+		var windowA, windowB types.MaintenanceWindow
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &windowA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &windowB)
+		return fmt.Sprintf("%v\n%v", windowA, windowB)
+
 	default:
 		panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 	}
@@ -37,11 +37,20 @@ func TestDecodeStore(t *testing.T) {
 	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
 	bechPK := sdk.MustBech32ifyAccPub(delPk1)
 	missed := true
+	uptime := sdk.NewDecWithPrec(95, 2)
+	chunk := make([]byte, types.MissedBlockBitmapChunkSize/8)
+	chunk[0] = 0x40
+	window := int64(100)
+	maintenance := types.NewMaintenanceWindow(consAddr1, 10, 20)
 
 	kvPairs := cmn.KVPairs{
 		cmn.KVPair{Key: types.GetValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(info)},
 		cmn.KVPair{Key: types.GetValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshalBinaryLengthPrefixed(missed)},
+		cmn.KVPair{Key: types.GetValidatorMissedBlockBitmapKey(consAddr1, 0), Value: chunk},
+		cmn.KVPair{Key: types.MissedBlocksWindowKey, Value: cdc.MustMarshalBinaryLengthPrefixed(window)},
 		cmn.KVPair{Key: types.GetAddrPubkeyRelationKey(delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(delPk1)},
+		cmn.KVPair{Key: types.GetValidatorUptimeKey(consAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(uptime)},
+		cmn.KVPair{Key: types.GetMaintenanceWindowKey(consAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(maintenance)},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -51,7 +60,11 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info)},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed, missed)},
+		{"ValidatorMissedBlockBitmap", fmt.Sprintf("chunkA: %X\nchunkB: %X", chunk, chunk)},
+		{"MissedBlocksWindow", fmt.Sprintf("windowA: %d\nwindowB: %d", window, window)},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", bechPK, bechPK)},
+		{"ValidatorUptime", fmt.Sprintf("%v\n%v", uptime, uptime)},
+		{"MaintenanceWindow", fmt.Sprintf("%v\n%v", maintenance, maintenance)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -22,6 +22,10 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+	DowntimeGracePeriod     = "downtime_grace_period"
+
+	MaxMaintenanceWindow      = "max_maintenance_window"
+	MaxMaintenanceWindowDelay = "max_maintenance_window_delay"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -49,6 +53,21 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeGracePeriod randomized DowntimeGracePeriod
+func GenDowntimeGracePeriod(r *rand.Rand) int64 {
+	return int64(r.Intn(100))
+}
+
+// GenMaxMaintenanceWindow randomized MaxMaintenanceWindow
+func GenMaxMaintenanceWindow(r *rand.Rand) int64 {
+	return int64(r.Intn(50))
+}
+
+// GenMaxMaintenanceWindowDelay randomized MaxMaintenanceWindowDelay
+func GenMaxMaintenanceWindowDelay(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 1, 1000))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -81,12 +100,31 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeGracePeriod int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeGracePeriod, &downtimeGracePeriod, simState.Rand,
+		func(r *rand.Rand) { downtimeGracePeriod = GenDowntimeGracePeriod(r) },
+	)
+
+	var maxMaintenanceWindow int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxMaintenanceWindow, &maxMaintenanceWindow, simState.Rand,
+		func(r *rand.Rand) { maxMaintenanceWindow = GenMaxMaintenanceWindow(r) },
+	)
+
+	var maxMaintenanceWindowDelay int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxMaintenanceWindowDelay, &maxMaintenanceWindowDelay, simState.Rand,
+		func(r *rand.Rand) { maxMaintenanceWindowDelay = GenMaxMaintenanceWindowDelay(r) },
+	)
+
 	params := types.NewParams(
 		simState.UnbondTime, signedBlocksWindow, minSignedPerWindow,
 		downtimeJailDuration, slashFractionDoubleSign, slashFractionDowntime,
+		downtimeGracePeriod, maxMaintenanceWindow, maxMaintenanceWindowDelay,
 	)
 
-	slashingGenesis := types.NewGenesisState(params, nil, nil)
+	slashingGenesis := types.NewGenesisState(params, nil, nil, nil, nil, nil)
 
 	fmt.Printf("Selected randomly generated slashing parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, slashingGenesis.Params))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(slashingGenesis)
@@ -5,14 +5,55 @@ import (
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/keeper"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// Simulation operation weights constants
+const (
+	OpWeightMsgUnjail                    = "op_weight_msg_unjail"
+	OpWeightMsgScheduleMaintenanceWindow = "op_weight_msg_schedule_maintenance_window"
+	OpWeightDowntime                     = "op_weight_downtime"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, ak types.AccountKeeper,
+	k keeper.Keeper, sk stakingkeeper.Keeper) simulation.WeightedOperations {
+
+	var weightMsgUnjail int
+	appParams.GetOrGenerate(cdc, OpWeightMsgUnjail, &weightMsgUnjail, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnjail = 100
+		},
+	)
+
+	var weightMsgScheduleMaintenanceWindow int
+	appParams.GetOrGenerate(cdc, OpWeightMsgScheduleMaintenanceWindow, &weightMsgScheduleMaintenanceWindow, nil,
+		func(_ *rand.Rand) {
+			weightMsgScheduleMaintenanceWindow = 20
+		},
+	)
+
+	var weightDowntime int
+	appParams.GetOrGenerate(cdc, OpWeightDowntime, &weightDowntime, nil,
+		func(_ *rand.Rand) {
+			weightDowntime = 20
+		},
+	)
+
+	return simulation.WeightedOperations{
+		{Weight: weightMsgUnjail, Op: SimulateMsgUnjail(ak, k, sk)},
+		{Weight: weightMsgScheduleMaintenanceWindow, Op: SimulateMsgScheduleMaintenanceWindow(ak, k, sk)},
+		{Weight: weightDowntime, Op: SimulateDowntime(k, sk)},
+	}
+}
+
 // SimulateMsgUnjail generates a MsgUnjail with random values
 // nolint: funlen
 func SimulateMsgUnjail(ak types.AccountKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simulation.Operation {
@@ -21,36 +62,33 @@ func SimulateMsgUnjail(ak types.AccountKeeper, k keeper.Keeper, sk stakingkeeper
 		accs []simulation.Account, chainID string,
 	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
 
-		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		// validators are jailed by the downtime evidence injected by the
+		// simulator and the double sign evidence handled by the evidence module
+		validator, ok := randomJailedValidator(r, k, sk, ctx)
 		if !ok {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil // skip
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUnjail, "no jailed validator"), nil, nil
 		}
 
 		simAccount, found := simulation.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
 		if !found {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil // skip
-		}
-
-		if !validator.IsJailed() {
-			// TODO: due to this condition this message is almost, if not always, skipped !
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUnjail, "validator account not found"), nil, nil
 		}
 
 		consAddr := sdk.ConsAddress(validator.GetConsPubKey().Address())
 		info, found := k.GetValidatorSigningInfo(ctx, consAddr)
 		if !found {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil // skip
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUnjail, "signing info not found"), nil, nil
 		}
 
 		selfDel := sk.Delegation(ctx, simAccount.Address, validator.GetOperator())
 		if selfDel == nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil // skip
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUnjail, "no self delegation"), nil, nil
 		}
 
 		account := ak.GetAccount(ctx, sdk.AccAddress(validator.GetOperator()))
 		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUnjail, ""), nil, err
 		}
 
 		msg := types.NewMsgUnjail(validator.GetOperator())
@@ -89,9 +127,153 @@ func SimulateMsgUnjail(ak types.AccountKeeper, k keeper.Keeper, sk stakingkeeper
 		}
 
 		if !res.IsOK() {
-			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUnjail, ""), nil, errors.New(res.Log)
 		}
 
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgScheduleMaintenanceWindow generates a MsgScheduleMaintenanceWindow
+// with random values
+// nolint: funlen
+func SimulateMsgScheduleMaintenanceWindow(ak types.AccountKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		maxWindow, maxDelay := k.MaxMaintenanceWindow(ctx), k.MaxMaintenanceWindowDelay(ctx)
+		if maxWindow == 0 || maxDelay == 0 {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgScheduleMaintenanceWindow, "maintenance windows are disabled"), nil, nil
+		}
+
+		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgScheduleMaintenanceWindow, "no validator"), nil, nil
+		}
+
+		simAccount, found := simulation.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgScheduleMaintenanceWindow, "validator account not found"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgScheduleMaintenanceWindow, ""), nil, err
+		}
+
+		startHeight := ctx.BlockHeight() + int64(simulation.RandIntBetween(r, 1, int(maxDelay)+1))
+		endHeight := startHeight + int64(r.Intn(int(maxWindow)))
+		msg := types.NewMsgScheduleMaintenanceWindow(validator.GetOperator(), startHeight, endHeight)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		// result should fail if the window starts within a signing window of
+		// the end of the previous window of the validator
+		previous, found := k.GetMaintenanceWindow(ctx, validator.GetConsAddr())
+		tooSoon := found && startHeight <= previous.EndHeight+k.SignedBlocksWindow(ctx)
+
+		res := app.Deliver(tx)
+
+		if tooSoon {
+			if res.IsOK() {
+				return simulation.NewOperationMsg(msg, true, ""), nil,
+					errors.New("maintenance window scheduled within a signing window of the previous one")
+			}
+			// msg failed as expected
+			return simulation.NewOperationMsg(msg, false, ""), nil, nil
+		}
+
+		if !res.IsOK() {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgScheduleMaintenanceWindow, ""), nil, errors.New(res.Log)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateDowntime injects downtime evidence for a random bonded validator by
+// recording it as absent for a whole signing window, which gets it slashed and
+// jailed for downtime
+func SimulateDowntime(k keeper.Keeper, sk stakingkeeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok || validator.IsJailed() || !validator.IsBonded() {
+			return simulation.NoOpMsg(types.ModuleName, types.AttributeValueMissingSignature, "no unjailed bonded validator"), nil, nil
+		}
+
+		consAddr := sdk.ConsAddress(validator.GetConsPubKey().Address())
+		info, found := k.GetValidatorSigningInfo(ctx, consAddr)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName, types.AttributeValueMissingSignature, "signing info not found"), nil, nil
+		}
+
+		// validators can't be punished for downtime before their first
+		// signing window elapses, nor if no signatures are required at all
+		window := k.SignedBlocksWindow(ctx)
+		if ctx.BlockHeight() <= info.StartHeight+k.DowntimeGracePeriod(ctx)+window ||
+			k.MinSignedPerWindow(ctx) == 0 {
+			return simulation.NoOpMsg(types.ModuleName, types.AttributeValueMissingSignature, "validator can't be jailed for downtime yet"), nil, nil
+		}
+
+		// nor while it's in a maintenance window
+		if maintenance, found := k.GetMaintenanceWindow(ctx, consAddr); found && maintenance.IsActive(ctx.BlockHeight()) {
+			return simulation.NoOpMsg(types.ModuleName, types.AttributeValueMissingSignature, "validator is in a maintenance window"), nil, nil
+		}
+
+		for i := int64(0); i < window; i++ {
+			k.HandleValidatorSignature(ctx, consAddr.Bytes(), validator.GetConsensusPower(), false)
+		}
+
+		validator, _ = sk.GetValidator(ctx, validator.GetOperator())
+		if !validator.IsJailed() {
+			return simulation.NoOpMsg(types.ModuleName, types.AttributeValueMissingSignature, ""), nil,
+				errors.New("validator should have been jailed after missing a whole signing window")
+		}
+
+		return simulation.NewOperationMsgBasic(types.ModuleName, types.AttributeValueMissingSignature, "", true, nil), nil, nil
+	}
+}
+
+// randomJailedValidator returns a random jailed validator, if any. Tombstoned
+// validators can never be unjailed, so they are only picked once in a while
+// in order not to crowd out the validators jailed for downtime.
+func randomJailedValidator(r *rand.Rand, k keeper.Keeper, sk stakingkeeper.Keeper,
+	ctx sdk.Context) (stakingtypes.Validator, bool) {
+
+	pickTombstoned := r.Intn(10) == 0
+
+	var jailed []stakingtypes.Validator
+	for _, validator := range sk.GetAllValidators(ctx) {
+		if !validator.IsJailed() {
+			continue
+		}
+
+		consAddr := sdk.ConsAddress(validator.GetConsPubKey().Address())
+		info, found := k.GetValidatorSigningInfo(ctx, consAddr)
+		if found && info.Tombstoned && !pickTombstoned {
+			continue
+		}
+
+		jailed = append(jailed, validator)
+	}
+
+	if len(jailed) == 0 {
+		return stakingtypes.Validator{}, false
+	}
+
+	return jailed[r.Intn(len(jailed))], true
+}
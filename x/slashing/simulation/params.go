@@ -11,9 +11,15 @@ import (
 )
 
 const (
-	keySignedBlocksWindow    = "SignedBlocksWindow"
-	keyMinSignedPerWindow    = "MinSignedPerWindow"
-	keySlashFractionDowntime = "SlashFractionDowntime"
+	keySignedBlocksWindow      = "SignedBlocksWindow"
+	keyMinSignedPerWindow      = "MinSignedPerWindow"
+	keyDowntimeJailDuration    = "DowntimeJailDuration"
+	keySlashFractionDoubleSign = "SlashFractionDoubleSign"
+	keySlashFractionDowntime   = "SlashFractionDowntime"
+	keyDowntimeGracePeriod     = "DowntimeGracePeriod"
+
+	keyMaxMaintenanceWindow      = "MaxMaintenanceWindow"
+	keyMaxMaintenanceWindowDelay = "MaxMaintenanceWindowDelay"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -30,10 +36,35 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenMinSignedPerWindow(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyDowntimeJailDuration, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenDowntimeJailDuration(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keySlashFractionDoubleSign, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenSlashFractionDoubleSign(r))
+			},
+		),
 		simulation.NewSimParamChange(types.ModuleName, keySlashFractionDowntime, "",
 			func(r *rand.Rand) string {
 				return fmt.Sprintf("\"%s\"", GenSlashFractionDowntime(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyDowntimeGracePeriod, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenDowntimeGracePeriod(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyMaxMaintenanceWindow, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxMaintenanceWindow(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyMaxMaintenanceWindowDelay, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxMaintenanceWindowDelay(r))
+			},
+		),
 	}
 }
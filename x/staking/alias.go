@@ -28,9 +28,15 @@ const (
 	TStoreKey                          = types.TStoreKey
 	QuerierRoute                       = types.QuerierRoute
 	RouterKey                          = types.RouterKey
+	TypeMsgCreateValidator             = types.TypeMsgCreateValidator
+	TypeMsgEditValidator               = types.TypeMsgEditValidator
+	TypeMsgDelegate                    = types.TypeMsgDelegate
+	TypeMsgUndelegate                  = types.TypeMsgUndelegate
+	TypeMsgBeginRedelegate             = types.TypeMsgBeginRedelegate
 	DefaultUnbondingTime               = types.DefaultUnbondingTime
 	DefaultMaxValidators               = types.DefaultMaxValidators
 	DefaultMaxEntries                  = types.DefaultMaxEntries
+	DefaultHistoricalEntries           = types.DefaultHistoricalEntries
 	NotBondedPoolName                  = types.NotBondedPoolName
 	BondedPoolName                     = types.BondedPoolName
 	QueryValidators                    = types.QueryValidators
@@ -47,6 +53,14 @@ const (
 	QueryDelegatorValidator            = types.QueryDelegatorValidator
 	QueryPool                          = types.QueryPool
 	QueryParameters                    = types.QueryParameters
+	QueryRecentUnbondings              = types.QueryRecentUnbondings
+	MaxRecentUnbondingsLimit           = types.MaxRecentUnbondingsLimit
+	QueryUnbondingQueue                = types.QueryUnbondingQueue
+	QueryRedelegationQueue             = types.QueryRedelegationQueue
+	QueryPoolsRepair                   = types.QueryPoolsRepair
+	QueryValidatorsByPower             = types.QueryValidatorsByPower
+	QueryValidatorsNearCutoff          = types.QueryValidatorsNearCutoff
+	QueryHistoricalInfo                = types.QueryHistoricalInfo
 	MaxMonikerLength                   = types.MaxMonikerLength
 	MaxIdentityLength                  = types.MaxIdentityLength
 	MaxWebsiteLength                   = types.MaxWebsiteLength
@@ -98,6 +112,7 @@ var (
 	ErrCommissionNegative              = types.ErrCommissionNegative
 	ErrCommissionHuge                  = types.ErrCommissionHuge
 	ErrCommissionGTMaxRate             = types.ErrCommissionGTMaxRate
+	ErrCommissionLTMinRate             = types.ErrCommissionLTMinRate
 	ErrCommissionUpdateTime            = types.ErrCommissionUpdateTime
 	ErrCommissionChangeRateNegative    = types.ErrCommissionChangeRateNegative
 	ErrCommissionChangeRateGTMaxRate   = types.ErrCommissionChangeRateGTMaxRate
@@ -131,6 +146,9 @@ var (
 	ErrBothShareMsgsGiven              = types.ErrBothShareMsgsGiven
 	ErrNeitherShareMsgsGiven           = types.ErrNeitherShareMsgsGiven
 	ErrMissingSignature                = types.ErrMissingSignature
+	ErrInvalidPoolsRepair              = types.ErrInvalidPoolsRepair
+	ErrInvalidHistoricalInfo           = types.ErrInvalidHistoricalInfo
+	ErrNoHistoricalInfo                = types.ErrNoHistoricalInfo
 	NewGenesisState                    = types.NewGenesisState
 	DefaultGenesisState                = types.DefaultGenesisState
 	NewMultiStakingHooks               = types.NewMultiStakingHooks
@@ -143,6 +161,9 @@ var (
 	GetValidatorQueueTimeKey           = types.GetValidatorQueueTimeKey
 	GetDelegationKey                   = types.GetDelegationKey
 	GetDelegationsKey                  = types.GetDelegationsKey
+	GetDelegationByValIndexKey         = types.GetDelegationByValIndexKey
+	GetDelegationsByValIndexKey        = types.GetDelegationsByValIndexKey
+	GetDelegationKeyFromValIndexKey    = types.GetDelegationKeyFromValIndexKey
 	GetUBDKey                          = types.GetUBDKey
 	GetUBDByValIndexKey                = types.GetUBDByValIndexKey
 	GetUBDKeyFromValIndexKey           = types.GetUBDKeyFromValIndexKey
@@ -159,6 +180,7 @@ var (
 	GetREDsFromValSrcIndexKey          = types.GetREDsFromValSrcIndexKey
 	GetREDsToValDstIndexKey            = types.GetREDsToValDstIndexKey
 	GetREDsByDelToValDstIndexKey       = types.GetREDsByDelToValDstIndexKey
+	GetHistoricalInfoKey               = types.GetHistoricalInfoKey
 	NewMsgCreateValidator              = types.NewMsgCreateValidator
 	NewMsgEditValidator                = types.NewMsgEditValidator
 	NewMsgDelegate                     = types.NewMsgDelegate
@@ -169,21 +191,42 @@ var (
 	MustUnmarshalParams                = types.MustUnmarshalParams
 	UnmarshalParams                    = types.UnmarshalParams
 	NewPool                            = types.NewPool
+	NewPoolsRepair                     = types.NewPoolsRepair
 	NewQueryDelegatorParams            = types.NewQueryDelegatorParams
 	NewQueryValidatorParams            = types.NewQueryValidatorParams
 	NewQueryBondsParams                = types.NewQueryBondsParams
 	NewQueryRedelegationParams         = types.NewQueryRedelegationParams
 	NewQueryValidatorsParams           = types.NewQueryValidatorsParams
+	NewQueryRecentUnbondingsParams     = types.NewQueryRecentUnbondingsParams
+	NewRecentUnbondings                = types.NewRecentUnbondings
+	NewQueryQueueParams                = types.NewQueryQueueParams
+	NewUnbondingQueueEntry             = types.NewUnbondingQueueEntry
+	NewRedelegationQueueEntry          = types.NewRedelegationQueueEntry
+	NewQueryValidatorsByPowerParams    = types.NewQueryValidatorsByPowerParams
+	NewValidatorsByPower               = types.NewValidatorsByPower
+	NewQueryValidatorsNearCutoffParams = types.NewQueryValidatorsNearCutoffParams
+	NewValidatorsNearCutoff            = types.NewValidatorsNearCutoff
+	NewQueryHistoricalInfoParams       = types.NewQueryHistoricalInfoParams
 	NewValidator                       = types.NewValidator
 	MustMarshalValidator               = types.MustMarshalValidator
 	MustUnmarshalValidator             = types.MustUnmarshalValidator
 	UnmarshalValidator                 = types.UnmarshalValidator
+	NewHistoricalInfo                  = types.NewHistoricalInfo
+	MustMarshalHistoricalInfo          = types.MustMarshalHistoricalInfo
+	MustUnmarshalHistoricalInfo        = types.MustUnmarshalHistoricalInfo
+	UnmarshalHistoricalInfo            = types.UnmarshalHistoricalInfo
 	NewDescription                     = types.NewDescription
 
+	NewPaginatedQueryDelegatorParams    = types.NewPaginatedQueryDelegatorParams
+	NewPaginatedQueryValidatorParams    = types.NewPaginatedQueryValidatorParams
+	NewPaginatedQueryRedelegationParams = types.NewPaginatedQueryRedelegationParams
+
 	// variable aliases
 	ModuleCdc                        = types.ModuleCdc
 	LastValidatorPowerKey            = types.LastValidatorPowerKey
 	LastTotalPowerKey                = types.LastTotalPowerKey
+	LastMinCommissionRateKey         = types.LastMinCommissionRateKey
+	LastMaxValidatorsKey             = types.LastMaxValidatorsKey
 	ValidatorsKey                    = types.ValidatorsKey
 	ValidatorsByConsAddrKey          = types.ValidatorsByConsAddrKey
 	ValidatorsByPowerIndexKey        = types.ValidatorsByPowerIndexKey
@@ -193,53 +236,73 @@ var (
 	RedelegationKey                  = types.RedelegationKey
 	RedelegationByValSrcIndexKey     = types.RedelegationByValSrcIndexKey
 	RedelegationByValDstIndexKey     = types.RedelegationByValDstIndexKey
+	DelegationByValIndexKey          = types.DelegationByValIndexKey
 	UnbondingQueueKey                = types.UnbondingQueueKey
 	RedelegationQueueKey             = types.RedelegationQueueKey
 	ValidatorQueueKey                = types.ValidatorQueueKey
+	HistoricalInfoKey                = types.HistoricalInfoKey
 	KeyUnbondingTime                 = types.KeyUnbondingTime
 	KeyMaxValidators                 = types.KeyMaxValidators
 	KeyMaxEntries                    = types.KeyMaxEntries
 	KeyBondDenom                     = types.KeyBondDenom
+	KeyMinCommissionRate             = types.KeyMinCommissionRate
+	KeyHistoricalEntries             = types.KeyHistoricalEntries
+	DefaultMinCommissionRate         = types.DefaultMinCommissionRate
 )
 
 type (
-	Keeper                    = keeper.Keeper
-	Commission                = types.Commission
-	CommissionRates           = types.CommissionRates
-	DVPair                    = types.DVPair
-	DVVTriplet                = types.DVVTriplet
-	Delegation                = types.Delegation
-	Delegations               = types.Delegations
-	UnbondingDelegation       = types.UnbondingDelegation
-	UnbondingDelegationEntry  = types.UnbondingDelegationEntry
-	UnbondingDelegations      = types.UnbondingDelegations
-	Redelegation              = types.Redelegation
-	RedelegationEntry         = types.RedelegationEntry
-	Redelegations             = types.Redelegations
-	DelegationResponse        = types.DelegationResponse
-	DelegationResponses       = types.DelegationResponses
-	RedelegationResponse      = types.RedelegationResponse
-	RedelegationEntryResponse = types.RedelegationEntryResponse
-	RedelegationResponses     = types.RedelegationResponses
-	CodeType                  = types.CodeType
-	GenesisState              = types.GenesisState
-	LastValidatorPower        = types.LastValidatorPower
-	MultiStakingHooks         = types.MultiStakingHooks
-	MsgCreateValidator        = types.MsgCreateValidator
-	MsgEditValidator          = types.MsgEditValidator
-	MsgDelegate               = types.MsgDelegate
-	MsgBeginRedelegate        = types.MsgBeginRedelegate
-	MsgUndelegate             = types.MsgUndelegate
-	Params                    = types.Params
-	Pool                      = types.Pool
-	QueryDelegatorParams      = types.QueryDelegatorParams
-	QueryValidatorParams      = types.QueryValidatorParams
-	QueryBondsParams          = types.QueryBondsParams
-	QueryRedelegationParams   = types.QueryRedelegationParams
-	QueryValidatorsParams     = types.QueryValidatorsParams
-	Validator                 = types.Validator
-	Validators                = types.Validators
-	Description               = types.Description
-	DelegationI               = exported.DelegationI
-	ValidatorI                = exported.ValidatorI
+	Keeper                          = keeper.Keeper
+	Commission                      = types.Commission
+	CommissionRates                 = types.CommissionRates
+	DVPair                          = types.DVPair
+	DVVTriplet                      = types.DVVTriplet
+	Delegation                      = types.Delegation
+	Delegations                     = types.Delegations
+	UnbondingDelegation             = types.UnbondingDelegation
+	UnbondingDelegationEntry        = types.UnbondingDelegationEntry
+	UnbondingDelegations            = types.UnbondingDelegations
+	Redelegation                    = types.Redelegation
+	RedelegationEntry               = types.RedelegationEntry
+	Redelegations                   = types.Redelegations
+	DelegationResponse              = types.DelegationResponse
+	DelegationResponses             = types.DelegationResponses
+	RedelegationResponse            = types.RedelegationResponse
+	RedelegationEntryResponse       = types.RedelegationEntryResponse
+	RedelegationResponses           = types.RedelegationResponses
+	CodeType                        = types.CodeType
+	GenesisState                    = types.GenesisState
+	LastValidatorPower              = types.LastValidatorPower
+	MultiStakingHooks               = types.MultiStakingHooks
+	StakingHooks                    = types.StakingHooks
+	MsgCreateValidator              = types.MsgCreateValidator
+	MsgEditValidator                = types.MsgEditValidator
+	MsgDelegate                     = types.MsgDelegate
+	MsgBeginRedelegate              = types.MsgBeginRedelegate
+	MsgUndelegate                   = types.MsgUndelegate
+	Params                          = types.Params
+	Pool                            = types.Pool
+	PoolsRepair                     = types.PoolsRepair
+	QueryDelegatorParams            = types.QueryDelegatorParams
+	QueryValidatorParams            = types.QueryValidatorParams
+	QueryBondsParams                = types.QueryBondsParams
+	QueryRedelegationParams         = types.QueryRedelegationParams
+	QueryValidatorsParams           = types.QueryValidatorsParams
+	QueryRecentUnbondingsParams     = types.QueryRecentUnbondingsParams
+	RecentUnbondings                = types.RecentUnbondings
+	QueryQueueParams                = types.QueryQueueParams
+	UnbondingQueueEntry             = types.UnbondingQueueEntry
+	RedelegationQueueEntry          = types.RedelegationQueueEntry
+	QueryValidatorsByPowerParams    = types.QueryValidatorsByPowerParams
+	RankedValidator                 = types.RankedValidator
+	ValidatorsByPower               = types.ValidatorsByPower
+	QueryValidatorsNearCutoffParams = types.QueryValidatorsNearCutoffParams
+	CutoffValidator                 = types.CutoffValidator
+	ValidatorsNearCutoff            = types.ValidatorsNearCutoff
+	QueryHistoricalInfoParams       = types.QueryHistoricalInfoParams
+	HistoricalInfo                  = types.HistoricalInfo
+	Validator                       = types.Validator
+	Validators                      = types.Validators
+	Description                     = types.Description
+	DelegationI                     = exported.DelegationI
+	ValidatorI                      = exported.ValidatorI
 )
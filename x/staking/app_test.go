@@ -35,7 +35,8 @@ func getMockApp(t *testing.T) (*mock.App, Keeper) {
 	blacklistedAddrs[notBondedPool.GetAddress().String()] = true
 	blacklistedAddrs[bondPool.GetAddress().String()] = true
 
-	bankKeeper := bank.NewBaseKeeper(mApp.AccountKeeper, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
+	keyBank := sdk.NewKVStoreKey(bank.StoreKey)
+	bankKeeper := bank.NewBaseKeeper(mApp.Cdc, keyBank, mApp.AccountKeeper, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
 	maccPerms := map[string][]string{
 		auth.FeeCollectorName:   nil,
 		types.NotBondedPoolName: {supply.Burner, supply.Staking},
@@ -49,7 +50,7 @@ func getMockApp(t *testing.T) (*mock.App, Keeper) {
 	mApp.SetInitChainer(getInitChainer(mApp, keeper, mApp.AccountKeeper, supplyKeeper,
 		[]supplyexported.ModuleAccountI{feeCollector, notBondedPool, bondPool}))
 
-	require.NoError(t, mApp.CompleteSetup(keyStaking, keySupply))
+	require.NoError(t, mApp.CompleteSetup(keyBank, keyStaking, keySupply))
 	return mApp, keeper
 }
 
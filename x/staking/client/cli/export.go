@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// delegations export formats
+const (
+	ExportFormatCSV   = "csv"
+	ExportFormatJSONL = "jsonl"
+)
+
+// delegationExportRow defines a single exported delegation. Tokens are the
+// tokens the delegation shares are worth at the export height.
+type delegationExportRow struct {
+	Height           int64  `json:"height"`
+	DelegatorAddress string `json:"delegator_address"`
+	ValidatorAddress string `json:"validator_address"`
+	Shares           string `json:"shares"`
+	Tokens           string `json:"tokens"`
+}
+
+var delegationExportHeader = []string{"height", "delegator_address", "validator_address", "shares", "tokens"}
+
+func (r delegationExportRow) record() []string {
+	return []string{
+		strconv.FormatInt(r.Height, 10), r.DelegatorAddress, r.ValidatorAddress, r.Shares, r.Tokens,
+	}
+}
+
+// GetCmdExportDelegations implements the command exporting all the
+// delegations of the chain at a given height.
+func GetCmdExportDelegations(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-delegations",
+		Short: "Export all the delegations at a given height in CSV or JSONL format",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export all the delegations (delegator, validator, shares and tokens) read
+from the staking store at the height given by the --height flag, or at the
+latest height if unset. The node must not have pruned the state at that height.
+
+Each delegation is written as a CSV record or as a JSON line, depending on the
+--format flag.
+
+Example:
+$ %s query staking export-delegations --height=100000 --format=csv > delegations.csv
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			format := viper.GetString(FlagExportFormat)
+			if format != ExportFormatCSV && format != ExportFormatJSONL {
+				return fmt.Errorf("invalid export format %q, expected %q or %q", format, ExportFormatCSV, ExportFormatJSONL)
+			}
+
+			delKVs, height, err := cliCtx.QuerySubspace(types.DelegationKey, storeName)
+			if err != nil {
+				return err
+			}
+
+			// read the validators at the same height as the delegations so
+			// that shares are converted with a consistent exchange rate
+			valKVs, _, err := cliCtx.WithHeight(height).QuerySubspace(types.ValidatorsKey, storeName)
+			if err != nil {
+				return err
+			}
+
+			validators := make(map[string]types.Validator, len(valKVs))
+			for _, kv := range valKVs {
+				validator := types.MustUnmarshalValidator(cdc, kv.Value)
+				validators[validator.OperatorAddress.String()] = validator
+			}
+
+			delegations := make([]types.Delegation, len(delKVs))
+			for i, kv := range delKVs {
+				delegations[i] = types.MustUnmarshalDelegation(cdc, kv.Value)
+			}
+
+			return exportDelegations(cmd.OutOrStdout(), format, height, delegations, validators)
+		},
+	}
+
+	cmd.Flags().String(FlagExportFormat, ExportFormatCSV, fmt.Sprintf("The export format, either %q or %q", ExportFormatCSV, ExportFormatJSONL))
+
+	return cmd
+}
+
+// exportDelegations writes the given delegations to w in the given format,
+// converting their shares to tokens with the given validators.
+func exportDelegations(
+	w io.Writer, format string, height int64, delegations []types.Delegation, validators map[string]types.Validator,
+) error {
+
+	var (
+		csvWriter   *csv.Writer
+		jsonEncoder *json.Encoder
+	)
+
+	switch format {
+	case ExportFormatCSV:
+		csvWriter = csv.NewWriter(w)
+		if err := csvWriter.Write(delegationExportHeader); err != nil {
+			return err
+		}
+
+	case ExportFormatJSONL:
+		jsonEncoder = json.NewEncoder(w)
+
+	default:
+		return fmt.Errorf("invalid export format %q", format)
+	}
+
+	for _, delegation := range delegations {
+		validator, ok := validators[delegation.ValidatorAddress.String()]
+		if !ok {
+			return fmt.Errorf("no validator found with address %s", delegation.ValidatorAddress)
+		}
+
+		row := delegationExportRow{
+			Height:           height,
+			DelegatorAddress: delegation.DelegatorAddress.String(),
+			ValidatorAddress: delegation.ValidatorAddress.String(),
+			Shares:           delegation.Shares.String(),
+			Tokens:           validator.TokensFromShares(delegation.Shares).String(),
+		}
+
+		var err error
+		if csvWriter != nil {
+			err = csvWriter.Write(row.record())
+		} else {
+			err = jsonEncoder.Encode(row)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if csvWriter != nil {
+		csvWriter.Flush()
+		return csvWriter.Error()
+	}
+
+	return nil
+}
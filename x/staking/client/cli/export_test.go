@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestExportDelegations(t *testing.T) {
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// a validator slashed by half: shares are worth half as many tokens
+	validator := types.NewValidator(valAddr, ed25519.GenPrivKey().PubKey(), types.Description{})
+	validator.Tokens = sdk.NewInt(50)
+	validator.DelegatorShares = sdk.NewDec(100)
+	validators := map[string]types.Validator{valAddr.String(): validator}
+
+	delegations := []types.Delegation{types.NewDelegation(delAddr, valAddr, sdk.NewDec(10))}
+
+	var buf bytes.Buffer
+	require.NoError(t, exportDelegations(&buf, ExportFormatCSV, 7, delegations, validators))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	require.Equal(t, "height,delegator_address,validator_address,shares,tokens", lines[0])
+	require.Equal(t, strings.Join([]string{
+		"7", delAddr.String(), valAddr.String(), sdk.NewDec(10).String(), sdk.NewDec(5).String(),
+	}, ","), lines[1])
+
+	buf.Reset()
+	require.NoError(t, exportDelegations(&buf, ExportFormatJSONL, 7, delegations, validators))
+
+	var row delegationExportRow
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &row))
+	require.Equal(t, delegationExportRow{
+		Height:           7,
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Shares:           sdk.NewDec(10).String(),
+		Tokens:           sdk.NewDec(5).String(),
+	}, row)
+
+	require.Error(t, exportDelegations(&buf, "xml", 7, delegations, validators))
+	require.Error(t, exportDelegations(&buf, ExportFormatCSV, 7, delegations, nil))
+}
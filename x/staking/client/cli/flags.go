@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"fmt"
+
 	flag "github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -31,6 +33,11 @@ const (
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
+
+	FlagExportFormat = "format"
+
+	FlagPage  = "page"
+	FlagLimit = "limit"
 )
 
 // common flagsets to add to various functions
@@ -45,6 +52,7 @@ var (
 	fsDescriptionEdit   = flag.NewFlagSet("", flag.ContinueOnError)
 	fsValidator         = flag.NewFlagSet("", flag.ContinueOnError)
 	fsRedelegation      = flag.NewFlagSet("", flag.ContinueOnError)
+	fsPagination        = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -70,4 +78,6 @@ func init() {
 	fsValidator.String(FlagAddressValidator, "", "The Bech32 address of the validator")
 	fsRedelegation.String(FlagAddressValidatorSrc, "", "The Bech32 address of the source validator")
 	fsRedelegation.String(FlagAddressValidatorDst, "", "The Bech32 address of the destination validator")
+	fsPagination.Int(FlagPage, 1, "Page of the results to query")
+	fsPagination.Int(FlagLimit, 0, fmt.Sprintf("Number of results per page, %d if unset, at most %d", types.DefaultQueryLimit, types.MaxQueryLimit))
 }
@@ -2,9 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -36,7 +39,14 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidatorUnbondingDelegations(queryRoute, cdc),
 		GetCmdQueryValidatorRedelegations(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
-		GetCmdQueryPool(queryRoute, cdc))...)
+		GetCmdQueryPool(queryRoute, cdc),
+		GetCmdQueryPoolsRepair(queryRoute, cdc),
+		GetCmdQueryValidatorsByPower(queryRoute, cdc),
+		GetCmdQueryValidatorsNearCutoff(queryRoute, cdc),
+		GetCmdQueryHistoricalInfo(queryRoute, cdc),
+		GetCmdQueryUnbondingQueue(queryRoute, cdc),
+		GetCmdQueryRedelegationQueue(queryRoute, cdc),
+		GetCmdExportDelegations(queryRoute, cdc))...)
 
 	return stakingQueryCmd
 
@@ -114,7 +124,7 @@ $ %s query staking validators
 
 // GetCmdQueryValidatorUnbondingDelegations implements the query all unbonding delegatations from a validator command.
 func GetCmdQueryValidatorUnbondingDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "unbonding-delegations-from [validator-addr]",
 		Short: "Query all unbonding delegatations from a validator",
 		Long: strings.TrimSpace(
@@ -135,7 +145,8 @@ $ %s query staking unbonding-delegations-from cosmosvaloper1gghjut3ccd8ay0zduzj6
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddr))
+			params := types.NewPaginatedQueryValidatorParams(valAddr, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
@@ -151,12 +162,15 @@ $ %s query staking unbonding-delegations-from cosmosvaloper1gghjut3ccd8ay0zduzj6
 			return cliCtx.PrintOutput(ubds)
 		},
 	}
+
+	cmd.Flags().AddFlagSet(fsPagination)
+	return cmd
 }
 
 // GetCmdQueryValidatorRedelegations implements the query all redelegatations
 // from a validator command.
 func GetCmdQueryValidatorRedelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "redelegations-from [validator-addr]",
 		Short: "Query all outgoing redelegatations from a validator",
 		Long: strings.TrimSpace(
@@ -177,7 +191,10 @@ $ %s query staking redelegations-from cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fx
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.QueryRedelegationParams{SrcValidatorAddr: valSrcAddr})
+			params := types.NewPaginatedQueryRedelegationParams(
+				nil, valSrcAddr, nil, viper.GetInt(FlagPage), viper.GetInt(FlagLimit),
+			)
+			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
@@ -196,6 +213,9 @@ $ %s query staking redelegations-from cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fx
 			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	cmd.Flags().AddFlagSet(fsPagination)
+	return cmd
 }
 
 // GetCmdQueryDelegation the query delegation command.
@@ -250,7 +270,7 @@ $ %s query staking delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosm
 // GetCmdQueryDelegations implements the command to query all the delegations
 // made from one delegator.
 func GetCmdQueryDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "delegations [delegator-addr]",
 		Short: "Query all delegations made by one delegator",
 		Long: strings.TrimSpace(
@@ -271,7 +291,8 @@ $ %s query staking delegations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delAddr))
+			params := types.NewPaginatedQueryDelegatorParams(delAddr, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
@@ -290,12 +311,15 @@ $ %s query staking delegations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	cmd.Flags().AddFlagSet(fsPagination)
+	return cmd
 }
 
 // GetCmdQueryValidatorDelegations implements the command to query all the
 // delegations to a specific validator.
 func GetCmdQueryValidatorDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "delegations-to [validator-addr]",
 		Short: "Query all delegations made to one validator",
 		Long: strings.TrimSpace(
@@ -316,7 +340,8 @@ $ %s query staking delegations-to cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ld
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddr))
+			params := types.NewPaginatedQueryValidatorParams(valAddr, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
@@ -335,6 +360,9 @@ $ %s query staking delegations-to cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ld
 			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	cmd.Flags().AddFlagSet(fsPagination)
+	return cmd
 }
 
 // GetCmdQueryUnbondingDelegation implements the command to query a single
@@ -385,7 +413,7 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 // GetCmdQueryUnbondingDelegations implements the command to query all the
 // unbonding-delegation records for a delegator.
 func GetCmdQueryUnbondingDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "unbonding-delegations [delegator-addr]",
 		Short: "Query all unbonding-delegations records for one delegator",
 		Long: strings.TrimSpace(
@@ -406,7 +434,8 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+			params := types.NewPaginatedQueryDelegatorParams(delegatorAddr, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
@@ -425,6 +454,9 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 			return cliCtx.PrintOutput(ubds)
 		},
 	}
+
+	cmd.Flags().AddFlagSet(fsPagination)
+	return cmd
 }
 
 // GetCmdQueryRedelegation implements the command to query a single
@@ -485,7 +517,7 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p co
 // GetCmdQueryRedelegations implements the command to query all the
 // redelegation records for a delegator.
 func GetCmdQueryRedelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "redelegations [delegator-addr]",
 		Args:  cobra.ExactArgs(1),
 		Short: "Query all redelegations records for one delegator",
@@ -506,7 +538,10 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.QueryRedelegationParams{DelegatorAddr: delAddr})
+			params := types.NewPaginatedQueryRedelegationParams(
+				delAddr, nil, nil, viper.GetInt(FlagPage), viper.GetInt(FlagLimit),
+			)
+			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
@@ -525,6 +560,9 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	cmd.Flags().AddFlagSet(fsPagination)
+	return cmd
 }
 
 // GetCmdQueryPool implements the pool query command.
@@ -560,6 +598,302 @@ $ %s query staking pool
 	}
 }
 
+// GetCmdQueryHistoricalInfo implements the historical info query command
+func GetCmdQueryHistoricalInfo(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "historical-info [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query historical info at given height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the header and validator set stored by the staking module at a
+given height. Only the last historical-entries heights are available.
+
+Example:
+$ %s query staking historical-info 5
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || height < 0 {
+				return fmt.Errorf("height argument provided must be a non-negative-integer: %v", err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryHistoricalInfoParams(height))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryHistoricalInfo)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var resp types.HistoricalInfo
+			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(resp)
+		},
+	}
+}
+
+// GetCmdQueryUnbondingQueue implements the unbonding queue query command.
+func GetCmdQueryUnbondingQueue(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unbonding-queue [start-time] [end-time]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the unbonding delegations maturing within a time range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the unbonding delegations whose entries mature between two RFC3339
+times, both included, along with the balance each of them releases.
+
+Example:
+$ %s query staking unbonding-queue 2020-01-01T00:00:00Z 2020-01-22T00:00:00Z
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params, err := parseQueryQueueParams(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryUnbondingQueue)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var entries []types.UnbondingQueueEntry
+			if err := cdc.UnmarshalJSON(res, &entries); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(entries)
+		},
+	}
+}
+
+// GetCmdQueryRedelegationQueue implements the redelegation queue query command.
+func GetCmdQueryRedelegationQueue(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redelegation-queue [start-time] [end-time]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the redelegations maturing within a time range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the redelegations whose entries mature between two RFC3339 times,
+both included, along with their initial balance.
+
+Example:
+$ %s query staking redelegation-queue 2020-01-01T00:00:00Z 2020-01-22T00:00:00Z
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params, err := parseQueryQueueParams(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRedelegationQueue)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var entries []types.RedelegationQueueEntry
+			if err := cdc.UnmarshalJSON(res, &entries); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(entries)
+		},
+	}
+}
+
+// parseQueryQueueParams parses the RFC3339 bounds of a queue query.
+func parseQueryQueueParams(startTimeStr, endTimeStr string) (types.QueryQueueParams, error) {
+	startTime, err := time.Parse(time.RFC3339, startTimeStr)
+	if err != nil {
+		return types.QueryQueueParams{}, fmt.Errorf("invalid start time %s: %v", startTimeStr, err)
+	}
+
+	endTime, err := time.Parse(time.RFC3339, endTimeStr)
+	if err != nil {
+		return types.QueryQueueParams{}, fmt.Errorf("invalid end time %s: %v", endTimeStr, err)
+	}
+
+	if endTime.Before(startTime) {
+		return types.QueryQueueParams{}, fmt.Errorf("end time %s is before start time %s", endTimeStr, startTimeStr)
+	}
+
+	return types.NewQueryQueueParams(startTime, endTime), nil
+}
+
+// GetCmdQueryPoolsRepair implements the pools repair query command.
+func GetCmdQueryPoolsRepair(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pools-repair",
+		Args:  cobra.NoArgs,
+		Short: "Compute the repair of the staking pools balances",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Compute the balances the bonded and not bonded pools should hold according
+to the validators and unbonding delegations, at the height given by the --height
+flag or at the latest height if unset, along with their actual balances.
+
+The resulting JSON patch can be applied at an upgrade height by an upgrade
+handler calling the staking keeper's ApplyPoolsRepair.
+
+Example:
+$ %s query staking pools-repair --height=100000 -o json > pools-repair.json
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryPoolsRepair)
+			bz, height, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var repair types.PoolsRepair
+			if err := cdc.UnmarshalJSON(bz, &repair); err != nil {
+				return err
+			}
+
+			// the querier context carries the latest block header
+			repair.Height = height
+			return cliCtx.PrintOutput(repair)
+		},
+	}
+}
+
+const flagTop = "top"
+
+// GetCmdQueryValidatorsByPower implements the validators by power query command.
+func GetCmdQueryValidatorsByPower(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators-by-power",
+		Args:  cobra.NoArgs,
+		Short: "Query the bonded validators ranked by voting power",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the bonded validators ranked by decreasing voting power, along with
+the share of the bonded tokens held by each validator, the cumulative share held
+by the validators up to each rank, and the share held by the --top first ones.
+
+Example:
+$ %s query staking validators-by-power --top=10
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryValidatorsByPowerParams(viper.GetInt(flagTop))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryValidatorsByPower)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var ranking types.ValidatorsByPower
+			if err := cdc.UnmarshalJSON(res, &ranking); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(ranking)
+		},
+	}
+
+	cmd.Flags().Int(flagTop, 0, "Number of top validators to summarize the share of, all of them if unset")
+	return cmd
+}
+
+const flagMargin = "margin"
+
+// GetCmdQueryValidatorsNearCutoff implements the validators near cutoff query command.
+func GetCmdQueryValidatorsNearCutoff(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators-near-cutoff",
+		Args:  cobra.NoArgs,
+		Short: "Query the validators whose voting power is close to the bonded set cut-off",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators whose voting power is within --margin of the cut-off
+power, which is the power of the last validator of the bonded validator set. The
+bonded validators listed are at risk of being demoted by a change of voting power
+or of the maximum number of validators, and the unbonded ones are close to
+entering the bonded validator set.
+
+Example:
+$ %s query staking validators-near-cutoff --margin=100
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			margin := viper.GetInt64(flagMargin)
+			if margin < 0 {
+				return fmt.Errorf("negative margin: %d", margin)
+			}
+
+			params := types.NewQueryValidatorsNearCutoffParams(margin)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryValidatorsNearCutoff)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var near types.ValidatorsNearCutoff
+			if err := cdc.UnmarshalJSON(res, &near); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(near)
+		},
+	}
+
+	cmd.Flags().Int64(flagMargin, 0, "Maximum distance in voting power to the cut-off power")
+	return cmd
+}
+
 // GetCmdQueryParams implements the params query command.
 func GetCmdQueryParams(storeName string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
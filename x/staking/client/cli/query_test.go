@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestParseQueryQueueParams(t *testing.T) {
+	params, err := parseQueryQueueParams("2020-01-01T00:00:00Z", "2020-01-22T12:00:00Z")
+	require.NoError(t, err)
+	require.Equal(t, types.NewQueryQueueParams(
+		time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 1, 22, 12, 0, 0, 0, time.UTC),
+	), params)
+
+	_, err = parseQueryQueueParams("2020-01-01", "2020-01-22T12:00:00Z")
+	require.Error(t, err)
+	_, err = parseQueryQueueParams("2020-01-01T00:00:00Z", "tomorrow")
+	require.Error(t, err)
+	_, err = parseQueryQueueParams("2020-01-22T12:00:00Z", "2020-01-01T00:00:00Z")
+	require.Error(t, err)
+}
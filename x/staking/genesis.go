@@ -30,6 +30,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 	ctx = ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay)
 
 	keeper.SetParams(ctx, data.Params)
+	keeper.SetLastMaxValidators(ctx, data.Params.MaxValidators)
 	keeper.SetLastTotalPower(ctx, data.LastTotalPower)
 
 	for _, validator := range data.Validators {
@@ -190,11 +191,11 @@ func WriteValidators(ctx sdk.Context, keeper Keeper) (vals []tmtypes.GenesisVali
 // ValidateGenesis validates the provided staking genesis state to ensure the
 // expected invariants holds. (i.e. params in correct bounds, no duplicate validators)
 func ValidateGenesis(data types.GenesisState) error {
-	err := validateGenesisStateValidators(data.Validators)
+	err := data.Params.Validate()
 	if err != nil {
 		return err
 	}
-	err = data.Params.Validate()
+	err = validateGenesisStateValidators(data.Validators, data.Params.MinCommissionRate)
 	if err != nil {
 		return err
 	}
@@ -202,7 +203,7 @@ func ValidateGenesis(data types.GenesisState) error {
 	return nil
 }
 
-func validateGenesisStateValidators(validators []types.Validator) (err error) {
+func validateGenesisStateValidators(validators []types.Validator, minCommissionRate sdk.Dec) (err error) {
 	addrMap := make(map[string]bool, len(validators))
 	for i := 0; i < len(validators); i++ {
 		val := validators[i]
@@ -216,6 +217,10 @@ func validateGenesisStateValidators(validators []types.Validator) (err error) {
 		if val.DelegatorShares.IsZero() && !val.IsUnbonding() {
 			return fmt.Errorf("bonded/unbonded genesis validator cannot have zero delegator shares, validator: %v", val)
 		}
+		if val.Commission.Rate.LT(minCommissionRate) {
+			return fmt.Errorf("genesis validator commission rate %s is below the minimum commission rate %s: moniker %v, address %v",
+				val.Commission.Rate, minCommissionRate, val.Description.Moniker, val.ConsAddress())
+		}
 		addrMap[strKey] = true
 	}
 	return
@@ -106,6 +106,12 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0].Tokens = sdk.OneInt()
 	genValidators1[0].DelegatorShares = sdk.OneDec()
 
+	pk2 := ed25519.GenPrivKey().PubKey()
+	genValidator2 := types.NewValidator(sdk.ValAddress(pk2.Address()), pk2, types.NewDescription("", "", "", "", ""))
+	genValidator2.Tokens = sdk.OneInt()
+	genValidator2.DelegatorShares = sdk.OneDec()
+	genValidator2.Commission = types.NewCommission(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(5, 1), sdk.ZeroDec())
+
 	tests := []struct {
 		name    string
 		mutate  func(*types.GenesisState)
@@ -126,6 +132,14 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = sdk.Bonded
 		}, true},
+		{"commission rate at the minimum commission rate", func(data *types.GenesisState) {
+			data.Validators = []types.Validator{genValidator2}
+			data.Params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+		}, false},
+		{"commission rate below the minimum commission rate", func(data *types.GenesisState) {
+			data.Validators = []types.Validator{genValidator2}
+			data.Params.MinCommissionRate = sdk.NewDecWithPrec(6, 2)
+		}, true},
 	}
 
 	for _, tt := range tests {
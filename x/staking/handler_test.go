@@ -376,6 +376,26 @@ func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 	require.False(t, got.IsOK(), "should not be able to decrease minSelfDelegation")
 }
 
+func TestCreateValidatorBelowMinCommissionRate(t *testing.T) {
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100)
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, initPower)
+
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	keeper.SetParams(ctx, params)
+
+	msgCreateValidator := NewTestMsgCreateValidatorWithCommission(validatorAddr, keep.PKs[0], initBond, sdk.NewDecWithPrec(1, 2))
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.False(t, got.IsOK(), "should not be able to create a validator below the min commission rate")
+
+	msgCreateValidator = NewTestMsgCreateValidatorWithCommission(validatorAddr, keep.PKs[0], initBond, sdk.NewDecWithPrec(5, 2))
+	got = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+}
+
 func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	validatorAddr := sdk.ValAddress(keep.Addrs[0])
 
@@ -761,10 +781,18 @@ func TestUnbondingPeriod(t *testing.T) {
 	require.True(t, found, "should not have unbonded")
 
 	// can complete unbonding at time 7 seconds later
-	ctx = ctx.WithBlockTime(origHeader.Time.Add(time.Second * 7))
+	ctx = ctx.WithBlockTime(origHeader.Time.Add(time.Second * 7)).WithEventManager(sdk.NewEventManager())
 	EndBlocker(ctx, keeper)
 	_, found = keeper.GetUnbondingDelegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr)
 	require.False(t, found, "should have unbonded")
+
+	// the completion event holds the released balance
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeCompleteUnbonding,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, unbondAmt.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, validatorAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDelegator, sdk.AccAddress(validatorAddr).String()),
+	))
 }
 
 func TestUnbondingFromUnbondingValidator(t *testing.T) {
@@ -955,11 +983,20 @@ func TestMultipleRedelegationAtSameTime(t *testing.T) {
 	require.Len(t, rd.Entries, 2)
 
 	// move forward in time, should complete both redelegations
-	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(1 * time.Second))
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(1 * time.Second)).WithEventManager(sdk.NewEventManager())
 	EndBlocker(ctx, keeper)
 
 	rd, found = keeper.GetRedelegation(ctx, selfDelAddr, valAddr, valAddr2)
 	require.False(t, found)
+
+	// a single completion event holds the balance of both redelegations
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeCompleteRedelegation,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(sdk.DefaultBondDenom, valTokens).String()),
+		sdk.NewAttribute(types.AttributeKeyDelegator, selfDelAddr.String()),
+		sdk.NewAttribute(types.AttributeKeySrcValidator, valAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDstValidator, valAddr2.String()),
+	))
 }
 
 func TestMultipleRedelegationAtUniqueTimes(t *testing.T) {
@@ -1173,6 +1210,46 @@ func TestUnbondingWhenExcessValidators(t *testing.T) {
 	require.Equal(t, sdk.Bonded, val1.Status, "%v", val1)
 }
 
+func TestEndBlockerDemotionEvents(t *testing.T) {
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+
+	// bond three validators with 30, 20 and 10 power
+	for i, power := range []int64{30, 20, 10} {
+		msgCreateValidator := NewTestMsgCreateValidator(sdk.ValAddress(keep.Addrs[i]), keep.PKs[i], sdk.TokensFromConsensusPower(power))
+		got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+		require.True(t, got.IsOK(), "%v", got)
+	}
+	EndBlocker(ctx, keeper)
+	require.Len(t, keeper.GetLastValidators(ctx), 3)
+
+	params := keeper.GetParams(ctx)
+	params.MaxValidators = 1
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	updates := EndBlocker(ctx, keeper)
+	require.Len(t, updates, 2)
+	require.Len(t, keeper.GetLastValidators(ctx), 1)
+
+	var demoted []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeDemoteValidator {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyValidator {
+				demoted = append(demoted, string(attr.Value))
+			}
+		}
+	}
+	require.Equal(t, []string{sdk.ValAddress(keep.Addrs[1]).String(), sdk.ValAddress(keep.Addrs[2]).String()}, demoted)
+
+	// the demotions are only reported once
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, keeper)
+	require.Empty(t, ctx.EventManager().Events())
+}
+
 func TestBondUnbondRedelegateSlashTwice(t *testing.T) {
 	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	valA, valB, del := sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1]), keep.Addrs[2]
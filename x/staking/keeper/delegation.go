@@ -83,19 +83,21 @@ func (k Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddres
 	return delegations[:i] // trim if the array length < maxRetrieve
 }
 
-// set a delegation
+// set a delegation and its index
 func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(types.GetDelegationKey(delegation.DelegatorAddress, delegation.ValidatorAddress), b)
+	store.Set(types.GetDelegationByValIndexKey(delegation.DelegatorAddress, delegation.ValidatorAddress), []byte{}) // index, store empty bytes
 }
 
-// remove a delegation
+// remove a delegation and its index
 func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) {
 	// TODO: Consider calling hooks outside of the store wrapper functions, it's unobvious.
 	k.BeforeDelegationRemoved(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetDelegationKey(delegation.DelegatorAddress, delegation.ValidatorAddress))
+	store.Delete(types.GetDelegationByValIndexKey(delegation.DelegatorAddress, delegation.ValidatorAddress))
 }
 
 // return a given amount of all the delegator unbonding-delegations
@@ -267,6 +269,109 @@ func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context,
 	return matureUnbonds
 }
 
+// GetRecentUnbondingDelegations returns the unbonding delegations with the
+// latest completion times, walking the unbonding queue from the most recent
+// timeslice to the oldest one and starting right before the startAfter time. A
+// zero startAfter starts from the most recent timeslice. Timeslices are never
+// split so that the returned cursor, the completion time of the last visited
+// timeslice, can be used to fetch the next page; hence more than limit
+// unbonding delegations might be returned.
+func (k Keeper) GetRecentUnbondingDelegations(ctx sdk.Context, startAfter time.Time,
+	limit int) (ubds []types.UnbondingDelegation, cursor time.Time) {
+
+	var startAfterKey []byte
+	if !startAfter.IsZero() {
+		startAfterKey = types.GetUnbondingDelegationTimeKey(startAfter)
+	}
+
+	ubds = []types.UnbondingDelegation{}
+	seen := make(map[string]bool)
+
+	store := ctx.KVStore(k.storeKey)
+	last := sdk.KVStoreIterateDescending(store, types.UnbondingQueueKey, startAfterKey, 0, func(_, value []byte) bool {
+		var timeslice []types.DVPair
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &timeslice)
+
+		for _, dvPair := range timeslice {
+			key := string(types.GetUBDKey(dvPair.DelegatorAddress, dvPair.ValidatorAddress))
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			ubd, found := k.GetUnbondingDelegation(ctx, dvPair.DelegatorAddress, dvPair.ValidatorAddress)
+			if found {
+				ubds = append(ubds, ubd)
+			}
+		}
+
+		return limit > 0 && len(ubds) >= limit
+	})
+
+	if last != nil {
+		var err error
+		cursor, err = sdk.ParseTimeBytes(last[len(types.UnbondingQueueKey):])
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return ubds, cursor
+}
+
+// GetUBDQueueEntries returns the unbonding delegations of the unbonding queue
+// timeslices between startTime and endTime inclusively, in completion time
+// order. Each entry sums the balances the unbonding delegation releases at the
+// completion time of its timeslice.
+func (k Keeper) GetUBDQueueEntries(ctx sdk.Context, startTime, endTime time.Time) []types.UnbondingQueueEntry {
+	entries := []types.UnbondingQueueEntry{}
+	if endTime.Before(startTime) {
+		return entries
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetUnbondingDelegationTimeKey(startTime),
+		sdk.InclusiveEndBytes(types.GetUnbondingDelegationTimeKey(endTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		completionTime, err := sdk.ParseTimeBytes(iterator.Key()[len(types.UnbondingQueueKey):])
+		if err != nil {
+			panic(err)
+		}
+
+		var timeslice []types.DVPair
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeslice)
+
+		seen := make(map[string]bool)
+		for _, dvPair := range timeslice {
+			key := string(types.GetUBDKey(dvPair.DelegatorAddress, dvPair.ValidatorAddress))
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			ubd, found := k.GetUnbondingDelegation(ctx, dvPair.DelegatorAddress, dvPair.ValidatorAddress)
+			if !found {
+				continue
+			}
+
+			balance := sdk.ZeroInt()
+			for _, entry := range ubd.Entries {
+				if entry.CompletionTime.Equal(completionTime) {
+					balance = balance.Add(entry.Balance)
+				}
+			}
+
+			entries = append(entries, types.NewUnbondingQueueEntry(
+				completionTime, ubd.DelegatorAddress, ubd.ValidatorAddress, balance,
+			))
+		}
+	}
+
+	return entries
+}
+
 // return a given amount of all the delegator redelegations
 func (k Keeper) GetRedelegations(ctx sdk.Context, delegator sdk.AccAddress,
 	maxRetrieve uint16) (redelegations []types.Redelegation) {
@@ -454,6 +559,59 @@ func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time
 	return matureRedelegations
 }
 
+// GetRedelegationQueueEntries returns the redelegations of the redelegation
+// queue timeslices between startTime and endTime inclusively, in completion
+// time order. Each entry sums the initial balances of the redelegation entries
+// maturing at the completion time of its timeslice.
+func (k Keeper) GetRedelegationQueueEntries(ctx sdk.Context, startTime, endTime time.Time) []types.RedelegationQueueEntry {
+	entries := []types.RedelegationQueueEntry{}
+	if endTime.Before(startTime) {
+		return entries
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetRedelegationTimeKey(startTime),
+		sdk.InclusiveEndBytes(types.GetRedelegationTimeKey(endTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		completionTime, err := sdk.ParseTimeBytes(iterator.Key()[len(types.RedelegationQueueKey):])
+		if err != nil {
+			panic(err)
+		}
+
+		var timeslice []types.DVVTriplet
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeslice)
+
+		seen := make(map[string]bool)
+		for _, dvvTriplet := range timeslice {
+			key := string(types.GetREDKey(dvvTriplet.DelegatorAddress, dvvTriplet.ValidatorSrcAddress, dvvTriplet.ValidatorDstAddress))
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			red, found := k.GetRedelegation(ctx, dvvTriplet.DelegatorAddress, dvvTriplet.ValidatorSrcAddress, dvvTriplet.ValidatorDstAddress)
+			if !found {
+				continue
+			}
+
+			balance := sdk.ZeroInt()
+			for _, entry := range red.Entries {
+				if entry.CompletionTime.Equal(completionTime) {
+					balance = balance.Add(entry.InitialBalance)
+				}
+			}
+
+			entries = append(entries, types.NewRedelegationQueueEntry(
+				completionTime, red.DelegatorAddress, red.ValidatorSrcAddress, red.ValidatorDstAddress, balance,
+			))
+		}
+	}
+
+	return entries
+}
+
 // Perform a delegation, set/update everything necessary within the store.
 // tokenSrc indicates the bond status of the incoming funds.
 func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc sdk.BondStatus,
@@ -652,20 +810,24 @@ func (k Keeper) Undelegate(
 	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
 	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
 	k.InsertUBDQueue(ctx, ubd, completionTime)
+	k.AfterUnbondingInitiated(ctx, delAddr, valAddr, returnAmount, completionTime)
 
 	return completionTime, nil
 }
 
 // CompleteUnbonding completes the unbonding of all mature entries in the
-// retrieved unbonding delegation object.
+// retrieved unbonding delegation object and returns the total unbonding balance
+// released to the delegator.
 func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress,
-	valAddr sdk.ValAddress) sdk.Error {
+	valAddr sdk.ValAddress) (sdk.Coins, sdk.Error) {
 
 	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
 	if !found {
-		return types.ErrNoUnbondingDelegation(k.Codespace())
+		return nil, types.ErrNoUnbondingDelegation(k.Codespace())
 	}
 
+	bondDenom := k.GetParams(ctx).BondDenom
+	balances := sdk.NewCoins()
 	ctxTime := ctx.BlockHeader().Time
 
 	// loop through all the entries and complete unbonding mature entries
@@ -677,11 +839,13 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress,
 
 			// track undelegation only when remaining or truncated shares are non-zero
 			if !entry.Balance.IsZero() {
-				amt := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).BondDenom, entry.Balance))
+				amt := sdk.NewCoins(sdk.NewCoin(bondDenom, entry.Balance))
 				err := k.supplyKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, ubd.DelegatorAddress, amt)
 				if err != nil {
-					return err
+					return nil, err
 				}
+
+				balances = balances.Add(amt)
 			}
 		}
 	}
@@ -693,7 +857,7 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress,
 		k.SetUnbondingDelegation(ctx, ubd)
 	}
 
-	return nil
+	return balances, nil
 }
 
 // begin unbonding / redelegation; create a redelegation record
@@ -751,16 +915,19 @@ func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 	return completionTime, nil
 }
 
-// CompleteRedelegation completes the unbonding of all mature entries in the
-// retrieved unbonding delegation object.
+// CompleteRedelegation completes the redelegation of all mature entries in the
+// retrieved redelegation object and returns the total initial balance of the
+// completed entries.
 func (k Keeper) CompleteRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
-	valSrcAddr, valDstAddr sdk.ValAddress) sdk.Error {
+	valSrcAddr, valDstAddr sdk.ValAddress) (sdk.Coins, sdk.Error) {
 
 	red, found := k.GetRedelegation(ctx, delAddr, valSrcAddr, valDstAddr)
 	if !found {
-		return types.ErrNoRedelegation(k.Codespace())
+		return nil, types.ErrNoRedelegation(k.Codespace())
 	}
 
+	bondDenom := k.GetParams(ctx).BondDenom
+	balances := sdk.NewCoins()
 	ctxTime := ctx.BlockHeader().Time
 
 	// loop through all the entries and complete mature redelegation entries
@@ -769,6 +936,10 @@ func (k Keeper) CompleteRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 		if entry.IsMature(ctxTime) {
 			red.RemoveEntry(int64(i))
 			i--
+
+			if !entry.InitialBalance.IsZero() {
+				balances = balances.Add(sdk.NewCoins(sdk.NewCoin(bondDenom, entry.InitialBalance)))
+			}
 		}
 	}
 
@@ -779,7 +950,7 @@ func (k Keeper) CompleteRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 		k.SetRedelegation(ctx, red)
 	}
 
-	return nil
+	return balances, nil
 }
 
 // ValidateUnbondAmount validates that a given unbond or redelegation amount is
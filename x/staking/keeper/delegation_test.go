@@ -258,8 +258,9 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 
 	// mature unbonding delegations
 	ctx = ctx.WithBlockTime(completionTime)
-	err = keeper.CompleteUnbonding(ctx, addrDels[0], addrVals[0])
+	balances, err := keeper.CompleteUnbonding(ctx, addrDels[0], addrVals[0])
 	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, int64(maxEntries))), balances)
 
 	bondedPool = keeper.GetBondedPool(ctx)
 	notBondedPool = keeper.GetNotBondedPool(ctx)
@@ -737,8 +738,9 @@ func TestRedelegationMaxEntries(t *testing.T) {
 
 	// mature redelegations
 	ctx = ctx.WithBlockTime(completionTime)
-	err = keeper.CompleteRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1])
+	balances, err := keeper.CompleteRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1])
 	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(keeper.BondDenom(ctx), int64(maxEntries))), balances)
 
 	// redelegation should work again
 	_, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], sdk.NewDec(1))
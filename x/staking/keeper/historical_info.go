@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetHistoricalInfo gets the historical info at a given height
+func (k Keeper) GetHistoricalInfo(ctx sdk.Context, height int64) (hi types.HistoricalInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetHistoricalInfoKey(height))
+	if value == nil {
+		return hi, false
+	}
+
+	hi = types.MustUnmarshalHistoricalInfo(k.cdc, value)
+	return hi, true
+}
+
+// SetHistoricalInfo sets the historical info at a given height
+func (k Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi types.HistoricalInfo) {
+	store := ctx.KVStore(k.storeKey)
+	value := types.MustMarshalHistoricalInfo(k.cdc, hi)
+	store.Set(types.GetHistoricalInfoKey(height), value)
+}
+
+// DeleteHistoricalInfo deletes the historical info at a given height
+func (k Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHistoricalInfoKey(height))
+}
+
+// TrackHistoricalInfo saves the latest historical info and deletes the
+// entries older than the HistoricalEntries param. All the entries below the
+// retained heights are deleted, so that lowering the param prunes the extra
+// entries at once.
+func (k Keeper) TrackHistoricalInfo(ctx sdk.Context) {
+	entryNum := k.HistoricalEntries(ctx)
+
+	// keys are ordered by height, the heights below pruneHeight are dropped
+	pruneHeight := ctx.BlockHeight() - int64(entryNum) + 1
+	if pruneHeight > 0 {
+		store := ctx.KVStore(k.storeKey)
+		iterator := store.Iterator(types.HistoricalInfoKey, types.GetHistoricalInfoKey(pruneHeight))
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			store.Delete(iterator.Key())
+		}
+	}
+
+	// no historical info is kept when the param is zero
+	if entryNum == 0 {
+		return
+	}
+
+	lastVals := k.GetLastValidators(ctx)
+	historicalEntry := types.NewHistoricalInfo(ctx.BlockHeader(), lastVals)
+	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), historicalEntry)
+}
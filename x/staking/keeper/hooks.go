@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -77,3 +79,10 @@ func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress,
 		k.hooks.BeforeValidatorSlashed(ctx, valAddr, fraction)
 	}
 }
+
+// AfterUnbondingInitiated - call hook if registered
+func (k Keeper) AfterUnbondingInitiated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, balance sdk.Int, completionTime time.Time) {
+	if k.hooks != nil {
+		k.hooks.AfterUnbondingInitiated(ctx, delAddr, valAddr, balance, completionTime)
+	}
+}
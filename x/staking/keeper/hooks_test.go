@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// unbondingHooks records the unbondings it is notified of, the empty
+// MultiStakingHooks implementing the other hooks as no-ops
+type unbondingHooks struct {
+	types.MultiStakingHooks
+
+	name  string
+	calls *[]string
+}
+
+func (h unbondingHooks) AfterUnbondingInitiated(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, balance sdk.Int, completionTime time.Time) {
+
+	*h.calls = append(*h.calls, fmt.Sprintf("%s %s %s %s %s", h.name, delAddr, valAddr, balance, completionTime))
+}
+
+func TestAfterUnbondingInitiatedHook(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 1)
+	startTokens := sdk.TokensFromConsensusPower(10)
+
+	notBondedPool := keeper.GetNotBondedPool(ctx)
+	err := notBondedPool.SetCoins(sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), startTokens)))
+	require.NoError(t, err)
+	keeper.supplyKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	keeper.SetDelegation(ctx, types.NewDelegation(addrDels[0], addrVals[0], issuedShares))
+
+	// the hooks are run in sequence
+	var calls []string
+	keeper.SetHooks(types.NewMultiStakingHooks(
+		unbondingHooks{name: "first", calls: &calls},
+		unbondingHooks{name: "second", calls: &calls},
+	))
+
+	completionTime, err := keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(7))
+	require.NoError(t, err)
+
+	call := fmt.Sprintf("%s %s 7 %s", addrDels[0], addrVals[0], completionTime)
+	require.Equal(t, []string{"first " + call, "second " + call}, calls)
+}
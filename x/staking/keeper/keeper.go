@@ -95,3 +95,43 @@ func (k Keeper) SetLastTotalPower(ctx sdk.Context, power sdk.Int) {
 	b := k.cdc.MustMarshalBinaryLengthPrefixed(power)
 	store.Set(types.LastTotalPowerKey, b)
 }
+
+// GetLastMinCommissionRate returns the minimum commission rate which was last
+// enforced on the existing validators.
+func (k Keeper) GetLastMinCommissionRate(ctx sdk.Context) (rate sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.LastMinCommissionRateKey)
+	if b == nil {
+		return sdk.ZeroDec()
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &rate)
+	return
+}
+
+// GetLastMaxValidators returns the maximum number of validators which was last
+// applied to the bonded validator set, zero if none was recorded yet.
+func (k Keeper) GetLastMaxValidators(ctx sdk.Context) (maxValidators uint16) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.LastMaxValidatorsKey)
+	if b == nil {
+		return 0
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &maxValidators)
+	return
+}
+
+// SetLastMaxValidators sets the maximum number of validators which was last
+// applied to the bonded validator set.
+func (k Keeper) SetLastMaxValidators(ctx sdk.Context, maxValidators uint16) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(maxValidators)
+	store.Set(types.LastMaxValidatorsKey, b)
+}
+
+// SetLastMinCommissionRate sets the minimum commission rate which was last
+// enforced on the existing validators.
+func (k Keeper) SetLastMinCommissionRate(ctx sdk.Context, rate sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(rate)
+	store.Set(types.LastMinCommissionRateKey, b)
+}
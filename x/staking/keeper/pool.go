@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/supply/exported"
 )
@@ -75,3 +78,101 @@ func (k Keeper) BondedRatio(ctx sdk.Context) sdk.Dec {
 	}
 	return sdk.ZeroDec()
 }
+
+// expectedPoolsTokens returns the tokens the bonded and not bonded pools should
+// hold according to the validators and unbonding delegations
+func (k Keeper) expectedPoolsTokens(ctx sdk.Context) (bonded, notBonded sdk.Int) {
+	bonded, notBonded = sdk.ZeroInt(), sdk.ZeroInt()
+
+	k.IterateValidators(ctx, func(_ int64, validator stakingexported.ValidatorI) bool {
+		switch validator.GetStatus() {
+		case sdk.Bonded:
+			bonded = bonded.Add(validator.GetTokens())
+		case sdk.Unbonding, sdk.Unbonded:
+			notBonded = notBonded.Add(validator.GetTokens())
+		default:
+			panic("invalid validator status")
+		}
+		return false
+	})
+
+	k.IterateUnbondingDelegations(ctx, func(_ int64, ubd types.UnbondingDelegation) bool {
+		for _, entry := range ubd.Entries {
+			notBonded = notBonded.Add(entry.Balance)
+		}
+		return false
+	})
+
+	return bonded, notBonded
+}
+
+// ComputePoolsRepair recomputes the balances of the bonded and not bonded pools
+// from the validators and unbonding delegations, and returns the repair
+// restoring them if they drifted.
+func (k Keeper) ComputePoolsRepair(ctx sdk.Context) types.PoolsRepair {
+	bondDenom := k.BondDenom(ctx)
+	bonded, notBonded := k.expectedPoolsTokens(ctx)
+
+	return types.NewPoolsRepair(
+		ctx.BlockHeight(), bondDenom,
+		k.GetBondedPool(ctx).GetCoins().AmountOf(bondDenom), bonded,
+		k.GetNotBondedPool(ctx).GetCoins().AmountOf(bondDenom), notBonded,
+	)
+}
+
+// ApplyPoolsRepair applies the drift of the pools recorded by a repair, e.g.
+// from an upgrade handler, provided they haven't drifted any further since, as
+// checked by recomputing the repair. Tokens missing from a pool are moved from
+// the surplus of the other one and the remaining surplus is burned, as no
+// tokens can be minted to the pools.
+func (k Keeper) ApplyPoolsRepair(ctx sdk.Context, repair types.PoolsRepair) sdk.Error {
+	if repair.BondDenom != k.BondDenom(ctx) {
+		return types.ErrInvalidPoolsRepair(k.codespace,
+			fmt.Sprintf("bond denom %s doesn't match %s", repair.BondDenom, k.BondDenom(ctx)))
+	}
+
+	current := k.ComputePoolsRepair(ctx)
+	if !repair.BondedTokens.Equal(current.BondedTokens) ||
+		!repair.ExpectedBondedTokens.Equal(current.ExpectedBondedTokens) ||
+		!repair.NotBondedTokens.Equal(current.NotBondedTokens) ||
+		!repair.ExpectedNotBondedTokens.Equal(current.ExpectedNotBondedTokens) {
+		return types.ErrInvalidPoolsRepair(k.codespace,
+			fmt.Sprintf("the pools drifted since the repair at height %d:\n%s", repair.Height, current))
+	}
+
+	bondedDiff, notBondedDiff := repair.BondedDiff(), repair.NotBondedDiff()
+	if missing := bondedDiff.Add(notBondedDiff); missing.IsPositive() {
+		return types.ErrInvalidPoolsRepair(k.codespace,
+			fmt.Sprintf("%s%s are missing from the pools and can't be minted", missing, repair.BondDenom))
+	}
+
+	var err sdk.Error
+	switch {
+	case bondedDiff.IsPositive():
+		coins := sdk.NewCoins(sdk.NewCoin(repair.BondDenom, bondedDiff))
+		if err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.NotBondedPoolName, types.BondedPoolName, coins); err == nil {
+			err = k.burnNotBondedTokens(ctx, notBondedDiff.Neg().Sub(bondedDiff))
+		}
+
+	case notBondedDiff.IsPositive():
+		coins := sdk.NewCoins(sdk.NewCoin(repair.BondDenom, notBondedDiff))
+		if err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.BondedPoolName, types.NotBondedPoolName, coins); err == nil {
+			err = k.burnBondedTokens(ctx, bondedDiff.Neg().Sub(notBondedDiff))
+		}
+
+	default:
+		if err = k.burnBondedTokens(ctx, bondedDiff.Neg()); err == nil {
+			err = k.burnNotBondedTokens(ctx, notBondedDiff.Neg())
+		}
+	}
+
+	if err != nil {
+		return types.ErrInvalidPoolsRepair(k.codespace, fmt.Sprintf("failed to move the pools tokens: %s", err))
+	}
+
+	if msg, broken := ModuleAccountInvariants(k)(ctx); broken {
+		return types.ErrInvalidPoolsRepair(k.codespace, fmt.Sprintf("the pools still drift once repaired:\n%s", msg))
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupPoolsRepair creates a bonded validator holding the given tokens and
+// burns the remaining tokens of the not bonded pool, so that the pools don't
+// drift.
+func setupPoolsRepair(t *testing.T, valTokens sdk.Int) (sdk.Context, Keeper, types.SupplyKeeper) {
+	ctx, _, keeper, supplyKeeper := CreateTestInput(t, false, 10)
+
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, _ = validator.AddTokensFromDel(valTokens)
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	require.Equal(t, sdk.Bonded, validator.Status)
+
+	require.Nil(t, keeper.ApplyPoolsRepair(ctx, keeper.ComputePoolsRepair(ctx)))
+	require.True(t, keeper.ComputePoolsRepair(ctx).IsEmpty())
+
+	return ctx, keeper, supplyKeeper
+}
+
+func TestComputePoolsRepair(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 10)
+	totalSupply := sdk.TokensFromConsensusPower(10).MulRaw(int64(len(Addrs)))
+
+	// the not bonded pool holds the whole supply without any validator
+	repair := keeper.ComputePoolsRepair(ctx)
+	require.False(t, repair.IsEmpty())
+	require.Equal(t, ctx.BlockHeight(), repair.Height)
+	require.Equal(t, sdk.DefaultBondDenom, repair.BondDenom)
+	require.True(t, repair.BondedDiff().IsZero())
+	require.Equal(t, totalSupply.Neg(), repair.NotBondedDiff())
+
+	require.Nil(t, keeper.ApplyPoolsRepair(ctx, repair))
+	require.True(t, keeper.ComputePoolsRepair(ctx).IsEmpty())
+	require.True(t, keeper.GetNotBondedPool(ctx).GetCoins().IsZero())
+
+	_, broken := ModuleAccountInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestApplyPoolsRepairMovesTokens(t *testing.T) {
+	valTokens := sdk.TokensFromConsensusPower(10)
+	ctx, keeper, supplyKeeper := setupPoolsRepair(t, valTokens)
+	supplyBefore := supplyKeeper.GetSupply(ctx).GetTotal()
+
+	drift := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	require.Nil(t, supplyKeeper.SendCoinsFromModuleToModule(ctx, types.BondedPoolName, types.NotBondedPoolName, drift))
+
+	repair := keeper.ComputePoolsRepair(ctx)
+	require.Equal(t, sdk.NewInt(100), repair.BondedDiff())
+	require.Equal(t, sdk.NewInt(-100), repair.NotBondedDiff())
+
+	require.Nil(t, keeper.ApplyPoolsRepair(ctx, repair))
+	require.True(t, keeper.ComputePoolsRepair(ctx).IsEmpty())
+	require.Equal(t, valTokens, keeper.GetBondedPool(ctx).GetCoins().AmountOf(sdk.DefaultBondDenom))
+
+	// no tokens are burned when they are only misplaced
+	require.Equal(t, supplyBefore, supplyKeeper.GetSupply(ctx).GetTotal())
+}
+
+func TestApplyPoolsRepairInvalid(t *testing.T) {
+	ctx, keeper, supplyKeeper := setupPoolsRepair(t, sdk.TokensFromConsensusPower(10))
+
+	drift := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	require.Nil(t, supplyKeeper.BurnCoins(ctx, types.BondedPoolName, drift))
+
+	// missing tokens can't be minted
+	repair := keeper.ComputePoolsRepair(ctx)
+	require.NotNil(t, keeper.ApplyPoolsRepair(ctx, repair))
+
+	// a repair for another denom is rejected
+	repair = types.NewPoolsRepair(ctx.BlockHeight(), "fake", sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())
+	require.NotNil(t, keeper.ApplyPoolsRepair(ctx, repair))
+
+	// a repair that doesn't match the current drift of the pools is rejected
+	bonded := keeper.GetBondedPool(ctx).GetCoins().AmountOf(sdk.DefaultBondDenom)
+	repair = types.NewPoolsRepair(ctx.BlockHeight(), sdk.DefaultBondDenom, bonded, bonded, sdk.ZeroInt(), sdk.ZeroInt())
+	require.NotNil(t, keeper.ApplyPoolsRepair(ctx, repair))
+}
+
+func TestApplyPoolsRepairDriftedFurther(t *testing.T) {
+	ctx, keeper, supplyKeeper := setupPoolsRepair(t, sdk.TokensFromConsensusPower(10))
+
+	drift := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	require.Nil(t, supplyKeeper.SendCoinsFromModuleToModule(ctx, types.BondedPoolName, types.NotBondedPoolName, drift))
+	repair := keeper.ComputePoolsRepair(ctx)
+
+	// the pools drift again once the repair is recorded
+	require.Nil(t, supplyKeeper.SendCoinsFromModuleToModule(ctx, types.BondedPoolName, types.NotBondedPoolName, drift))
+	require.NotNil(t, keeper.ApplyPoolsRepair(ctx, repair))
+	require.Equal(t, sdk.NewInt(200), keeper.ComputePoolsRepair(ctx).BondedDiff())
+
+	require.Nil(t, keeper.ApplyPoolsRepair(ctx, keeper.ComputePoolsRepair(ctx)))
+	require.True(t, keeper.ComputePoolsRepair(ctx).IsEmpty())
+}
@@ -32,6 +32,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryDelegatorDelegations(ctx, req, k)
 		case types.QueryDelegatorUnbondingDelegations:
 			return queryDelegatorUnbondingDelegations(ctx, req, k)
+		case types.QueryRecentUnbondings:
+			return queryRecentUnbondings(ctx, req, k)
+		case types.QueryUnbondingQueue:
+			return queryUnbondingQueue(ctx, req, k)
+		case types.QueryRedelegationQueue:
+			return queryRedelegationQueue(ctx, req, k)
 		case types.QueryRedelegations:
 			return queryRedelegations(ctx, req, k)
 		case types.QueryDelegatorValidators:
@@ -42,6 +48,14 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryPool(ctx, k)
 		case types.QueryParameters:
 			return queryParameters(ctx, k)
+		case types.QueryPoolsRepair:
+			return queryPoolsRepair(ctx, k)
+		case types.QueryValidatorsByPower:
+			return queryValidatorsByPower(ctx, req, k)
+		case types.QueryHistoricalInfo:
+			return queryHistoricalInfo(ctx, req, k)
+		case types.QueryValidatorsNearCutoff:
+			return queryValidatorsNearCutoff(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -80,6 +94,44 @@ func queryValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 	return res, nil
 }
 
+func queryValidatorsByPower(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorsByPowerParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	ranking := types.NewValidatorsByPower(k.GetBondedValidatorsByPower(ctx), params.TopN)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, ranking)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryValidatorsNearCutoff(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorsNearCutoffParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.Margin < 0 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("negative margin: %d", params.Margin))
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetValidatorsNearCutoff(ctx, params.Margin))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
 func queryValidator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	var params types.QueryValidatorParams
 
@@ -109,7 +161,7 @@ func queryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	delegations := k.GetValidatorDelegations(ctx, params.ValidatorAddr)
+	delegations := k.GetValidatorDelegationsPaginated(ctx, params.ValidatorAddr, params.Page, params.Limit)
 	delegationResps, err := delegationsToDelegationResponses(ctx, k, delegations)
 	if err != nil {
 		return nil, sdk.ErrInternal(err.Error())
@@ -135,10 +187,7 @@ func queryValidatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	unbonds := k.GetUnbondingDelegationsFromValidator(ctx, params.ValidatorAddr)
-	if unbonds == nil {
-		unbonds = types.UnbondingDelegations{}
-	}
+	unbonds := k.GetValidatorUnbondingDelegationsPaginated(ctx, params.ValidatorAddr, params.Page, params.Limit)
 
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, unbonds)
 	if err != nil {
@@ -156,7 +205,7 @@ func queryDelegatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	delegations := k.GetAllDelegatorDelegations(ctx, params.DelegatorAddr)
+	delegations := k.GetDelegatorDelegationsPaginated(ctx, params.DelegatorAddr, params.Page, params.Limit)
 	delegationResps, err := delegationsToDelegationResponses(ctx, k, delegations)
 	if err != nil {
 		return nil, sdk.ErrInternal(err.Error())
@@ -182,10 +231,7 @@ func queryDelegatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	unbondingDelegations := k.GetAllUnbondingDelegations(ctx, params.DelegatorAddr)
-	if unbondingDelegations == nil {
-		unbondingDelegations = types.UnbondingDelegations{}
-	}
+	unbondingDelegations := k.GetDelegatorUnbondingDelegationsPaginated(ctx, params.DelegatorAddr, params.Page, params.Limit)
 
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, unbondingDelegations)
 	if err != nil {
@@ -195,6 +241,65 @@ func queryDelegatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 	return res, nil
 }
 
+func queryRecentUnbondings(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryRecentUnbondingsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	limit := params.Limit
+	if limit <= 0 || limit > types.MaxRecentUnbondingsLimit {
+		limit = types.MaxRecentUnbondingsLimit
+	}
+
+	unbondingDelegations, cursor := k.GetRecentUnbondingDelegations(ctx, params.StartAfter, limit)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewRecentUnbondings(unbondingDelegations, cursor))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryUnbondingQueue(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryQueueParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	entries := k.GetUBDQueueEntries(ctx, params.StartTime, params.EndTime)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, entries)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryRedelegationQueue(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryQueueParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	entries := k.GetRedelegationQueueEntries(ctx, params.StartTime, params.EndTime)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, entries)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
 func queryDelegatorValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	var params types.QueryDelegatorParams
 
@@ -305,9 +410,11 @@ func queryRedelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byt
 
 		redels = []types.Redelegation{redel}
 	case params.DelegatorAddr.Empty() && !params.SrcValidatorAddr.Empty() && params.DstValidatorAddr.Empty():
-		redels = k.GetRedelegationsFromSrcValidator(ctx, params.SrcValidatorAddr)
+		redels = k.GetSrcValidatorRedelegationsPaginated(ctx, params.SrcValidatorAddr, params.Page, params.Limit)
 	default:
-		redels = k.GetAllRedelegations(ctx, params.DelegatorAddr, params.SrcValidatorAddr, params.DstValidatorAddr)
+		redels = k.GetRedelegationsPaginated(
+			ctx, params.DelegatorAddr, params.SrcValidatorAddr, params.DstValidatorAddr, params.Page, params.Limit,
+		)
 	}
 
 	redelResponses, err := redelegationsToRedelegationResponses(ctx, k, redels)
@@ -348,6 +455,19 @@ func queryPool(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	return res, nil
 }
 
+func queryPoolsRepair(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	if k.GetBondedPool(ctx) == nil || k.GetNotBondedPool(ctx) == nil {
+		return nil, sdk.ErrInternal("pool accounts haven't been set")
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.ComputePoolsRepair(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
 func queryParameters(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	params := k.GetParams(ctx)
 
@@ -359,6 +479,27 @@ func queryParameters(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	return res, nil
 }
 
+func queryHistoricalInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryHistoricalInfoParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(string(req.Data))
+	}
+
+	hi, found := k.GetHistoricalInfo(ctx, params.Height)
+	if !found {
+		return nil, types.ErrNoHistoricalInfo(types.DefaultCodespace)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, hi)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
 //______________________________________________________
 // util
 
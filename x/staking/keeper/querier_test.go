@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -551,3 +552,385 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 	require.NoError(t, cdc.UnmarshalJSON(res, &ubDels))
 	require.Equal(t, 0, len(ubDels))
 }
+
+func TestQueryRecentUnbondings(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper, _ := CreateTestInput(t, false, 10000)
+
+	val1 := types.NewValidator(addrVal1, pk1, types.Description{})
+	keeper.SetValidator(ctx, val1)
+
+	delAmount := sdk.TokensFromConsensusPower(100)
+	for _, addr := range []sdk.AccAddress{addrAcc1, addrAcc2} {
+		_, err := keeper.Delegate(ctx, addr, delAmount, sdk.Unbonded, val1, true)
+		require.NoError(t, err)
+	}
+	_ = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	queryRecentUnbondings := func(startAfter time.Time, limit int) types.RecentUnbondings {
+		bz, errRes := cdc.MarshalJSON(types.NewQueryRecentUnbondingsParams(startAfter, limit))
+		require.Nil(t, errRes)
+		query := abci.RequestQuery{
+			Path: "/custom/staking/recentUnbondings",
+			Data: bz,
+		}
+		res, err := queryRecentUnbondings(ctx, query, keeper)
+		require.Nil(t, err)
+
+		var recent types.RecentUnbondings
+		require.NoError(t, cdc.UnmarshalJSON(res, &recent))
+		return recent
+	}
+
+	require.Empty(t, queryRecentUnbondings(time.Time{}, 1).UnbondingDelegations)
+
+	// addrAcc1 unbonds twice, before and after addrAcc2
+	undelAmount := sdk.TokensFromConsensusPower(20).ToDec()
+	startTime := ctx.BlockHeader().Time
+	for i, addr := range []sdk.AccAddress{addrAcc1, addrAcc2, addrAcc1} {
+		ctx = ctx.WithBlockTime(startTime.Add(time.Duration(i) * time.Hour))
+		_, err := keeper.Undelegate(ctx, addr, val1.GetOperator(), undelAmount)
+		require.NoError(t, err)
+	}
+
+	unbondingTime := keeper.UnbondingTime(ctx)
+
+	// the latest unbondings come first, one timeslice at a time
+	var cursor time.Time
+	for i, expected := range []sdk.AccAddress{addrAcc1, addrAcc2, addrAcc1} {
+		recent := queryRecentUnbondings(cursor, 1)
+		require.Len(t, recent.UnbondingDelegations, 1)
+		require.Equal(t, expected, recent.UnbondingDelegations[0].DelegatorAddress)
+		require.True(t, startTime.Add(time.Duration(2-i)*time.Hour).Add(unbondingTime).Equal(recent.NextStartAfter))
+		cursor = recent.NextStartAfter
+	}
+
+	recent := queryRecentUnbondings(cursor, 1)
+	require.Empty(t, recent.UnbondingDelegations)
+	require.True(t, recent.NextStartAfter.IsZero())
+
+	// unbonding delegations are not duplicated within a page
+	recent = queryRecentUnbondings(time.Time{}, 0)
+	require.Len(t, recent.UnbondingDelegations, 2)
+	require.Equal(t, addrAcc1, recent.UnbondingDelegations[0].DelegatorAddress)
+	require.Len(t, recent.UnbondingDelegations[0].Entries, 2)
+	require.Equal(t, addrAcc2, recent.UnbondingDelegations[1].DelegatorAddress)
+}
+
+func TestQueryUnbondingAndRedelegationQueues(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper, _ := CreateTestInput(t, false, 10000)
+
+	val1 := types.NewValidator(addrVal1, pk1, types.Description{})
+	val2 := types.NewValidator(addrVal2, pk2, types.Description{})
+	keeper.SetValidator(ctx, val1)
+	keeper.SetValidator(ctx, val2)
+
+	delAmount := sdk.TokensFromConsensusPower(100)
+	for _, addr := range []sdk.AccAddress{addrAcc1, addrAcc2} {
+		_, err := keeper.Delegate(ctx, addr, delAmount, sdk.Unbonded, val1, true)
+		require.NoError(t, err)
+	}
+	_ = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	queryQueue := func(path string, querier func(sdk.Context, abci.RequestQuery, Keeper) ([]byte, sdk.Error),
+		start, end time.Time, dst interface{}) {
+		bz, errRes := cdc.MarshalJSON(types.NewQueryQueueParams(start, end))
+		require.Nil(t, errRes)
+		query := abci.RequestQuery{
+			Path: path,
+			Data: bz,
+		}
+		res, err := querier(ctx, query, keeper)
+		require.Nil(t, err)
+		require.NoError(t, cdc.UnmarshalJSON(res, dst))
+	}
+
+	// addrAcc1 unbonds twice within the same block, addrAcc2 an hour later
+	undelAmount := sdk.TokensFromConsensusPower(20)
+	startTime := ctx.BlockHeader().Time
+	for i, addr := range []sdk.AccAddress{addrAcc1, addrAcc1, addrAcc2} {
+		ctx = ctx.WithBlockTime(startTime.Add(time.Duration(i/2) * time.Hour))
+		_, err := keeper.Undelegate(ctx, addr, val1.GetOperator(), undelAmount.ToDec())
+		require.NoError(t, err)
+	}
+
+	unbondingTime := keeper.UnbondingTime(ctx)
+	first := startTime.Add(unbondingTime)
+	second := first.Add(time.Hour)
+
+	var ubdEntries []types.UnbondingQueueEntry
+	queryQueue("/custom/staking/unbondingQueue", queryUnbondingQueue, first, second, &ubdEntries)
+	require.Len(t, ubdEntries, 2)
+	require.Equal(t, addrAcc1, ubdEntries[0].DelegatorAddress)
+	require.True(t, first.Equal(ubdEntries[0].CompletionTime))
+	require.Equal(t, undelAmount.MulRaw(2), ubdEntries[0].Balance)
+	require.Equal(t, addrAcc2, ubdEntries[1].DelegatorAddress)
+	require.True(t, second.Equal(ubdEntries[1].CompletionTime))
+	require.Equal(t, undelAmount, ubdEntries[1].Balance)
+
+	// both bounds are inclusive
+	queryQueue("/custom/staking/unbondingQueue", queryUnbondingQueue, second, second, &ubdEntries)
+	require.Len(t, ubdEntries, 1)
+	require.Equal(t, addrAcc2, ubdEntries[0].DelegatorAddress)
+
+	queryQueue("/custom/staking/unbondingQueue", queryUnbondingQueue, second, first, &ubdEntries)
+	require.Empty(t, ubdEntries)
+
+	// redelegations
+	rdAmount := sdk.TokensFromConsensusPower(10)
+	_, err := keeper.BeginRedelegation(ctx, addrAcc2, val1.GetOperator(), val2.GetOperator(), rdAmount.ToDec())
+	require.NoError(t, err)
+
+	var redEntries []types.RedelegationQueueEntry
+	queryQueue("/custom/staking/redelegationQueue", queryRedelegationQueue, first, second, &redEntries)
+	require.Len(t, redEntries, 1)
+	require.Equal(t, addrAcc2, redEntries[0].DelegatorAddress)
+	require.Equal(t, val1.GetOperator(), redEntries[0].ValidatorSrcAddress)
+	require.Equal(t, val2.GetOperator(), redEntries[0].ValidatorDstAddress)
+	require.True(t, second.Equal(redEntries[0].CompletionTime))
+	require.Equal(t, rdAmount, redEntries[0].InitialBalance)
+
+	queryQueue("/custom/staking/redelegationQueue", queryRedelegationQueue, first, first, &redEntries)
+	require.Empty(t, redEntries)
+}
+
+func TestQueryValidatorsByPower(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper, _ := CreateTestInput(t, false, 0)
+
+	notBondedPool := keeper.GetNotBondedPool(ctx)
+	notBondedPool.SetCoins(sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), sdk.TokensFromConsensusPower(1000))))
+	keeper.supplyKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	// bond validators with 20, 40, 10 and 30 power, leave a fifth one unbonded
+	powers := []int64{20, 40, 10, 30}
+	for i, power := range powers {
+		val := types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
+		val, _ = val.AddTokensFromDel(sdk.TokensFromConsensusPower(power))
+		TestingUpdateValidator(keeper, ctx, val, true)
+	}
+	unbonded := types.NewValidator(sdk.ValAddress(Addrs[4]), PKs[4], types.Description{})
+	unbonded, _ = unbonded.AddTokensFromDel(sdk.TokensFromConsensusPower(50))
+	keeper.SetValidator(ctx, unbonded)
+
+	query := func(topN int) types.ValidatorsByPower {
+		bz, err := cdc.MarshalJSON(types.NewQueryValidatorsByPowerParams(topN))
+		require.NoError(t, err)
+
+		req := abci.RequestQuery{
+			Path: fmt.Sprintf("/custom/%s/%s", types.QuerierRoute, types.QueryValidatorsByPower),
+			Data: bz,
+		}
+		res, errRes := queryValidatorsByPower(ctx, req, keeper)
+		require.Nil(t, errRes)
+
+		var ranking types.ValidatorsByPower
+		require.NoError(t, cdc.UnmarshalJSON(res, &ranking))
+		return ranking
+	}
+
+	ranking := query(2)
+	require.Len(t, ranking.Validators, 4)
+	require.Equal(t, sdk.TokensFromConsensusPower(100), ranking.TotalTokens)
+	require.Equal(t, 2, ranking.TopN)
+	require.Equal(t, sdk.NewDecWithPrec(7, 1), ranking.TopNShare)
+
+	expected := []struct {
+		addr       sdk.ValAddress
+		share, cum sdk.Dec
+	}{
+		{sdk.ValAddress(Addrs[1]), sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(4, 1)},
+		{sdk.ValAddress(Addrs[3]), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(7, 1)},
+		{sdk.ValAddress(Addrs[0]), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(9, 1)},
+		{sdk.ValAddress(Addrs[2]), sdk.NewDecWithPrec(1, 1), sdk.OneDec()},
+	}
+	for i, exp := range expected {
+		require.Equal(t, i+1, ranking.Validators[i].Rank)
+		require.Equal(t, exp.addr, ranking.Validators[i].Validator.OperatorAddress)
+		require.Equal(t, exp.share, ranking.Validators[i].PowerShare)
+		require.Equal(t, exp.cum, ranking.Validators[i].CumulativeShare)
+	}
+
+	// a missing or too large top N covers all the validators
+	for _, topN := range []int{0, 10} {
+		ranking = query(topN)
+		require.Equal(t, 4, ranking.TopN)
+		require.Equal(t, sdk.OneDec(), ranking.TopNShare)
+	}
+}
+
+func TestQueryDelegationsPagination(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper, _ := CreateTestInput(t, false, 10000)
+
+	val1 := types.NewValidator(addrVal1, pk1, types.Description{})
+	keeper.SetValidator(ctx, val1)
+	val2 := types.NewValidator(addrVal2, pk2, types.Description{})
+	keeper.SetValidator(ctx, val2)
+
+	// five delegators delegate to, unbond and redelegate from the first validator
+	delegators := Addrs[10:15]
+	for _, delAddr := range delegators {
+		_, err := keeper.Delegate(ctx, delAddr, sdk.TokensFromConsensusPower(10), sdk.Unbonded, val1, true)
+		require.NoError(t, err)
+		val1, _ = keeper.GetValidator(ctx, addrVal1)
+	}
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	for _, delAddr := range delegators {
+		_, err := keeper.Undelegate(ctx, delAddr, addrVal1, sdk.TokensFromConsensusPower(1).ToDec())
+		require.NoError(t, err)
+
+		_, err = keeper.BeginRedelegation(ctx, delAddr, addrVal1, addrVal2, sdk.TokensFromConsensusPower(1).ToDec())
+		require.NoError(t, err)
+	}
+
+	queryValidatorDelegations := func(page, limit int) types.DelegationResponses {
+		bz, err := cdc.MarshalJSON(types.NewPaginatedQueryValidatorParams(addrVal1, page, limit))
+		require.NoError(t, err)
+
+		res, sdkErr := queryValidatorDelegations(ctx, abci.RequestQuery{Data: bz}, keeper)
+		require.Nil(t, sdkErr)
+
+		var delegations types.DelegationResponses
+		require.NoError(t, cdc.UnmarshalJSON(res, &delegations))
+		return delegations
+	}
+
+	all := queryValidatorDelegations(1, 0)
+	require.Len(t, all, len(delegators))
+	require.Equal(t, all[:2], queryValidatorDelegations(1, 2))
+	require.Equal(t, all[2:4], queryValidatorDelegations(2, 2))
+	require.Equal(t, all[4:], queryValidatorDelegations(3, 2))
+	require.Empty(t, queryValidatorDelegations(4, 2))
+
+	ubds := keeper.GetValidatorUnbondingDelegationsPaginated(ctx, addrVal1, 1, 0)
+	require.Len(t, ubds, len(delegators))
+	require.Equal(t, ubds[3:], keeper.GetValidatorUnbondingDelegationsPaginated(ctx, addrVal1, 2, 3))
+
+	reds := keeper.GetSrcValidatorRedelegationsPaginated(ctx, addrVal1, 1, 0)
+	require.Len(t, reds, len(delegators))
+	require.Equal(t, reds[1:2], keeper.GetSrcValidatorRedelegationsPaginated(ctx, addrVal1, 2, 1))
+
+	// the delegator side is paginated the same way
+	delegations := keeper.GetDelegatorDelegationsPaginated(ctx, delegators[0], 1, 0)
+	require.Len(t, delegations, 2)
+	require.Equal(t, delegations[1:], keeper.GetDelegatorDelegationsPaginated(ctx, delegators[0], 2, 1))
+	require.Empty(t, keeper.GetDelegatorDelegationsPaginated(ctx, delegators[0], 3, 1))
+	require.Len(t, keeper.GetDelegatorUnbondingDelegationsPaginated(ctx, delegators[0], 1, 0), 1)
+	require.Len(t, keeper.GetRedelegationsPaginated(ctx, delegators[0], addrVal1, nil, 1, 0), 1)
+	require.Empty(t, keeper.GetRedelegationsPaginated(ctx, delegators[0], addrVal2, nil, 1, 0))
+
+	// a zero limit returns a page of the default size, and the limit is capped
+	require.Equal(t, types.DefaultQueryLimit, newPagination(1, 0).limit)
+	require.Equal(t, types.MaxQueryLimit, newPagination(1, types.MaxQueryLimit+1).limit)
+	require.Equal(t, types.MaxQueryLimit, newPagination(2, types.MaxQueryLimit+1).skip)
+}
+
+func TestQueryHistoricalInfo(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper, _ := CreateTestInput(t, false, 10000)
+
+	// Create Validators
+	amts := []sdk.Int{sdk.NewInt(9), sdk.NewInt(8)}
+	var validators [2]types.Validator
+	for i, amt := range amts {
+		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
+		validators[i], _ = validators[i].AddTokensFromDel(amt)
+		keeper.SetValidator(ctx, validators[i])
+	}
+
+	header := abci.Header{
+		ChainID: "HelloChain",
+		Height:  5,
+	}
+	hi := types.NewHistoricalInfo(header, validators[:])
+	keeper.SetHistoricalInfo(ctx, 5, hi)
+
+	query := func(height int64) ([]byte, sdk.Error) {
+		bz, err := cdc.MarshalJSON(types.NewQueryHistoricalInfoParams(height))
+		require.NoError(t, err)
+
+		req := abci.RequestQuery{
+			Path: fmt.Sprintf("/custom/%s/%s", types.QuerierRoute, types.QueryHistoricalInfo),
+			Data: bz,
+		}
+		return queryHistoricalInfo(ctx, req, keeper)
+	}
+
+	_, errRes := query(4)
+	require.NotNil(t, errRes, "Invalid query passed")
+
+	res, errRes := query(5)
+	require.Nil(t, errRes, "Valid query passed")
+
+	var recv types.HistoricalInfo
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(res, &recv))
+	require.Equal(t, hi.Header.Height, recv.Header.Height)
+	require.Len(t, recv.ValSet, 2)
+	require.Equal(t, hi.ValSet[0].OperatorAddress, recv.ValSet[0].OperatorAddress)
+}
+
+func TestQueryValidatorsNearCutoff(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper, _ := CreateTestInput(t, false, 0)
+
+	notBondedPool := keeper.GetNotBondedPool(ctx)
+	notBondedPool.SetCoins(sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), sdk.TokensFromConsensusPower(1000))))
+	keeper.supplyKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	params := keeper.GetParams(ctx)
+	params.MaxValidators = 3
+	keeper.SetParams(ctx, params)
+
+	// validators with 50, 40, 32, 30 and 10 power, the one with 32 being the last bonded one
+	powers := []int64{50, 40, 32, 30, 10}
+	for i, power := range powers {
+		val := types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
+		val, _ = val.AddTokensFromDel(sdk.TokensFromConsensusPower(power))
+		TestingUpdateValidator(keeper, ctx, val, i < 3)
+	}
+
+	query := func(margin int64) (types.ValidatorsNearCutoff, sdk.Error) {
+		bz, err := cdc.MarshalJSON(types.NewQueryValidatorsNearCutoffParams(margin))
+		require.NoError(t, err)
+
+		req := abci.RequestQuery{
+			Path: fmt.Sprintf("/custom/%s/%s", types.QuerierRoute, types.QueryValidatorsNearCutoff),
+			Data: bz,
+		}
+		res, errRes := queryValidatorsNearCutoff(ctx, req, keeper)
+		if errRes != nil {
+			return types.ValidatorsNearCutoff{}, errRes
+		}
+
+		var near types.ValidatorsNearCutoff
+		require.NoError(t, cdc.UnmarshalJSON(res, &near))
+		return near, nil
+	}
+
+	near, err := query(5)
+	require.Nil(t, err)
+	require.Equal(t, int64(32), near.CutoffPower)
+	require.Equal(t, uint16(3), near.MaxValidators)
+	require.Len(t, near.Validators, 2)
+	require.Equal(t, 3, near.Validators[0].Rank)
+	require.Equal(t, sdk.ValAddress(Addrs[2]), near.Validators[0].Validator.OperatorAddress)
+	require.Equal(t, int64(0), near.Validators[0].PowerToCutoff)
+	require.Equal(t, 4, near.Validators[1].Rank)
+	require.Equal(t, sdk.ValAddress(Addrs[3]), near.Validators[1].Validator.OperatorAddress)
+	require.Equal(t, int64(-2), near.Validators[1].PowerToCutoff)
+
+	near, err = query(0)
+	require.Nil(t, err)
+	require.Len(t, near.Validators, 1)
+
+	near, err = query(100)
+	require.Nil(t, err)
+	require.Len(t, near.Validators, 5)
+
+	_, err = query(-1)
+	require.NotNil(t, err)
+}
+
@@ -109,3 +109,182 @@ func (k Keeper) GetAllRedelegations(ctx sdk.Context, delegator sdk.AccAddress,
 	}
 	return redelegations
 }
+
+//_____________________________________________________________________________________
+
+// pagination tracks the entries to skip and to collect when walking a store for
+// a 1-indexed page of limit entries. A zero limit collects DefaultQueryLimit
+// entries, and the limit is capped to MaxQueryLimit, so that a query never
+// walks an unbounded number of entries.
+type pagination struct {
+	skip, limit, count int
+}
+
+func newPagination(page, limit int) *pagination {
+	switch {
+	case limit <= 0:
+		limit = types.DefaultQueryLimit
+	case limit > types.MaxQueryLimit:
+		limit = types.MaxQueryLimit
+	}
+	if page < 1 {
+		page = 1
+	}
+	return &pagination{skip: (page - 1) * limit, limit: limit}
+}
+
+// collect reports whether the next matching entry is on the page.
+func (p *pagination) collect() bool {
+	if p.skip > 0 {
+		p.skip--
+		return false
+	}
+	p.count++
+	return true
+}
+
+// done reports whether the page is complete.
+func (p *pagination) done() bool {
+	return p.count >= p.limit
+}
+
+// GetValidatorDelegationsPaginated returns the delegations to a validator on a
+// 1-indexed page of limit delegations, walking the validator index of the
+// delegations.
+func (k Keeper) GetValidatorDelegationsPaginated(ctx sdk.Context, valAddr sdk.ValAddress,
+	page, limit int) []types.Delegation {
+
+	delegations := make([]types.Delegation, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDelegationsByValIndexKey(valAddr))
+	defer iterator.Close()
+
+	p := newPagination(page, limit)
+	for ; iterator.Valid() && !p.done(); iterator.Next() {
+		if p.collect() {
+			key := types.GetDelegationKeyFromValIndexKey(iterator.Key())
+			delegations = append(delegations, types.MustUnmarshalDelegation(k.cdc, store.Get(key)))
+		}
+	}
+
+	return delegations
+}
+
+// GetDelegatorDelegationsPaginated returns the delegations of a delegator on a
+// 1-indexed page of limit delegations.
+func (k Keeper) GetDelegatorDelegationsPaginated(ctx sdk.Context, delegator sdk.AccAddress,
+	page, limit int) []types.Delegation {
+
+	delegations := make([]types.Delegation, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDelegationsKey(delegator))
+	defer iterator.Close()
+
+	p := newPagination(page, limit)
+	for ; iterator.Valid() && !p.done(); iterator.Next() {
+		if p.collect() {
+			delegations = append(delegations, types.MustUnmarshalDelegation(k.cdc, iterator.Value()))
+		}
+	}
+
+	return delegations
+}
+
+// GetValidatorUnbondingDelegationsPaginated returns the unbonding delegations
+// from a validator on a 1-indexed page of limit unbonding delegations.
+func (k Keeper) GetValidatorUnbondingDelegationsPaginated(ctx sdk.Context, valAddr sdk.ValAddress,
+	page, limit int) []types.UnbondingDelegation {
+
+	ubds := make([]types.UnbondingDelegation, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetUBDsByValIndexKey(valAddr))
+	defer iterator.Close()
+
+	p := newPagination(page, limit)
+	for ; iterator.Valid() && !p.done(); iterator.Next() {
+		if p.collect() {
+			key := types.GetUBDKeyFromValIndexKey(iterator.Key())
+			ubds = append(ubds, types.MustUnmarshalUBD(k.cdc, store.Get(key)))
+		}
+	}
+
+	return ubds
+}
+
+// GetDelegatorUnbondingDelegationsPaginated returns the unbonding delegations
+// of a delegator on a 1-indexed page of limit unbonding delegations.
+func (k Keeper) GetDelegatorUnbondingDelegationsPaginated(ctx sdk.Context, delegator sdk.AccAddress,
+	page, limit int) []types.UnbondingDelegation {
+
+	ubds := make([]types.UnbondingDelegation, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetUBDsKey(delegator))
+	defer iterator.Close()
+
+	p := newPagination(page, limit)
+	for ; iterator.Valid() && !p.done(); iterator.Next() {
+		if p.collect() {
+			ubds = append(ubds, types.MustUnmarshalUBD(k.cdc, iterator.Value()))
+		}
+	}
+
+	return ubds
+}
+
+// GetSrcValidatorRedelegationsPaginated returns the redelegations from a
+// validator on a 1-indexed page of limit redelegations.
+func (k Keeper) GetSrcValidatorRedelegationsPaginated(ctx sdk.Context, valAddr sdk.ValAddress,
+	page, limit int) []types.Redelegation {
+
+	reds := make([]types.Redelegation, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetREDsFromValSrcIndexKey(valAddr))
+	defer iterator.Close()
+
+	p := newPagination(page, limit)
+	for ; iterator.Valid() && !p.done(); iterator.Next() {
+		if p.collect() {
+			key := types.GetREDKeyFromValSrcIndexKey(iterator.Key())
+			reds = append(reds, types.MustUnmarshalRED(k.cdc, store.Get(key)))
+		}
+	}
+
+	return reds
+}
+
+// GetRedelegationsPaginated returns the redelegations of a delegator, filtered
+// by source and destination validators when set, on a 1-indexed page of limit
+// redelegations.
+func (k Keeper) GetRedelegationsPaginated(ctx sdk.Context, delegator sdk.AccAddress,
+	srcValAddress, dstValAddress sdk.ValAddress, page, limit int) []types.Redelegation {
+
+	reds := make([]types.Redelegation, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetREDsKey(delegator))
+	defer iterator.Close()
+
+	srcValFilter := !(srcValAddress.Empty())
+	dstValFilter := !(dstValAddress.Empty())
+
+	p := newPagination(page, limit)
+	for ; iterator.Valid() && !p.done(); iterator.Next() {
+		red := types.MustUnmarshalRED(k.cdc, iterator.Value())
+		if srcValFilter && !(srcValAddress.Equals(red.ValidatorSrcAddress)) {
+			continue
+		}
+		if dstValFilter && !(dstValAddress.Equals(red.ValidatorDstAddress)) {
+			continue
+		}
+		if p.collect() {
+			reds = append(reds, red)
+		}
+	}
+
+	return reds
+}
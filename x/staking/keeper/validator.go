@@ -165,12 +165,109 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 		return commission, err
 	}
 
+	if minRate := k.MinCommissionRate(ctx); newRate.LT(minRate) {
+		return commission, types.ErrCommissionLTMinRate(k.Codespace(), minRate)
+	}
+
 	commission.Rate = newRate
 	commission.UpdateTime = blockTime
 
 	return commission, nil
 }
 
+// EnforceMinCommissionRate bumps the commission rate of every validator
+// charging less than the MinCommissionRate param up to it, raising the max rate
+// as well when needed. It only runs when the param has been increased since it
+// was last enforced, i.e. at the height the new minimum is activated, and
+// returns the addresses of the validators that were updated.
+func (k Keeper) EnforceMinCommissionRate(ctx sdk.Context) (updated []sdk.ValAddress) {
+	minRate := k.MinCommissionRate(ctx)
+	lastMinRate := k.GetLastMinCommissionRate(ctx)
+	if minRate.Equal(lastMinRate) {
+		return nil
+	}
+
+	k.SetLastMinCommissionRate(ctx, minRate)
+	if minRate.LT(lastMinRate) {
+		return nil
+	}
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validator.Commission.Rate.GTE(minRate) {
+			continue
+		}
+
+		// call the before-modification hook since we're about to update the commission
+		k.BeforeValidatorModified(ctx, validator.OperatorAddress)
+
+		validator.Commission.Rate = minRate
+		if validator.Commission.MaxRate.LT(minRate) {
+			validator.Commission.MaxRate = minRate
+		}
+		validator.Commission.UpdateTime = ctx.BlockHeader().Time
+
+		k.SetValidator(ctx, validator)
+		updated = append(updated, validator.OperatorAddress)
+	}
+
+	return updated
+}
+
+// ValidatorsDemotedByMaxValidators returns the bonded validators which leave
+// the bonded validator set because the MaxValidators param was lowered below
+// the value last applied, i.e. the ones which are still eligible for bonding but
+// are ranked beyond the new maximum. It must be called before the validator set
+// updates are applied, and only reports the demotions once per change.
+func (k Keeper) ValidatorsDemotedByMaxValidators(ctx sdk.Context) (demoted []types.Validator) {
+	maxValidators := k.MaxValidators(ctx)
+	lastMaxValidators := k.GetLastMaxValidators(ctx)
+	if maxValidators == lastMaxValidators {
+		return nil
+	}
+
+	k.SetLastMaxValidators(ctx, maxValidators)
+	if lastMaxValidators == 0 || maxValidators > lastMaxValidators {
+		return nil
+	}
+
+	iterator := k.ValidatorsPowerStoreIterator(ctx)
+	defer iterator.Close()
+	for rank := 1; iterator.Valid(); iterator.Next() {
+		validator := k.mustGetValidator(ctx, iterator.Value())
+
+		// zero-power validators are never bonded, so neither are the next ones
+		if validator.PotentialConsensusPower() == 0 {
+			break
+		}
+
+		if rank > int(maxValidators) && validator.IsBonded() {
+			demoted = append(demoted, validator)
+		}
+		rank++
+	}
+
+	return demoted
+}
+
+// GetValidatorsNearCutoff returns the validators eligible for bonding whose
+// voting power is within the given margin of the cut-off power of the bonded
+// validator set.
+func (k Keeper) GetValidatorsNearCutoff(ctx sdk.Context, margin int64) types.ValidatorsNearCutoff {
+	var validators []types.Validator
+
+	iterator := k.ValidatorsPowerStoreIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		validator := k.mustGetValidator(ctx, iterator.Value())
+		if validator.PotentialConsensusPower() == 0 {
+			break
+		}
+		validators = append(validators, validator)
+	}
+
+	return types.NewValidatorsNearCutoff(validators, k.MaxValidators(ctx), margin)
+}
+
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
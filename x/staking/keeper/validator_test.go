@@ -1082,3 +1082,87 @@ func TestUpdateValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+func TestEnforceMinCommissionRate(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Now().UTC()})
+
+	val1 := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	val2 := types.NewValidator(addrVals[1], PKs[1], types.Description{})
+
+	val1, _ = val1.SetInitialCommission(types.NewCommission(sdk.ZeroDec(), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2)))
+	val2, _ = val2.SetInitialCommission(types.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 1)))
+
+	keeper.SetValidator(ctx, val1)
+	keeper.SetValidator(ctx, val2)
+
+	// nothing to enforce while the param is unchanged
+	require.Empty(t, keeper.EnforceMinCommissionRate(ctx))
+
+	minRate := sdk.NewDecWithPrec(5, 2)
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = minRate
+	keeper.SetParams(ctx, params)
+
+	updated := keeper.EnforceMinCommissionRate(ctx)
+	require.Equal(t, []sdk.ValAddress{addrVals[0]}, updated)
+	require.Equal(t, minRate, keeper.GetLastMinCommissionRate(ctx))
+
+	val1, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, minRate, val1.Commission.Rate)
+	require.Equal(t, minRate, val1.Commission.MaxRate)
+	require.Equal(t, ctx.BlockHeader().Time, val1.Commission.UpdateTime)
+
+	val2, found = keeper.GetValidator(ctx, addrVals[1])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), val2.Commission.Rate)
+
+	// the migration only runs once per activation
+	require.Empty(t, keeper.EnforceMinCommissionRate(ctx))
+
+	// updates below the floor are rejected
+	ctx = ctx.WithBlockHeader(abci.Header{Time: ctx.BlockHeader().Time.Add(48 * time.Hour)})
+	_, err := keeper.UpdateValidatorCommission(ctx, val2, sdk.NewDecWithPrec(4, 2))
+	require.Error(t, err)
+	_, err = keeper.UpdateValidatorCommission(ctx, val2, minRate)
+	require.NoError(t, err)
+}
+
+func TestValidatorsDemotedByMaxValidators(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 1000)
+
+	// bond validators with 40, 30, 20 and 10 power
+	powers := []int64{40, 30, 20, 10}
+	for i, power := range powers {
+		val := types.NewValidator(addrVals[i], PKs[i], types.Description{})
+		tokens := sdk.TokensFromConsensusPower(power)
+		val, _ = val.AddTokensFromDel(tokens)
+		TestingUpdateValidator(keeper, ctx, val, true)
+	}
+	require.Len(t, keeper.GetLastValidators(ctx), 4)
+
+	// the first call records the current maximum
+	require.Empty(t, keeper.ValidatorsDemotedByMaxValidators(ctx))
+	require.Equal(t, keeper.MaxValidators(ctx), keeper.GetLastMaxValidators(ctx))
+
+	params := keeper.GetParams(ctx)
+	params.MaxValidators = 2
+	keeper.SetParams(ctx, params)
+
+	demoted := keeper.ValidatorsDemotedByMaxValidators(ctx)
+	require.Len(t, demoted, 2)
+	require.Equal(t, addrVals[2], demoted[0].OperatorAddress)
+	require.Equal(t, addrVals[3], demoted[1].OperatorAddress)
+	require.Equal(t, uint16(2), keeper.GetLastMaxValidators(ctx))
+
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Len(t, keeper.GetLastValidators(ctx), 2)
+
+	// the demotions are only reported once, and raising the maximum demotes no one
+	require.Empty(t, keeper.ValidatorsDemotedByMaxValidators(ctx))
+	params.MaxValidators = 3
+	keeper.SetParams(ctx, params)
+	require.Empty(t, keeper.ValidatorsDemotedByMaxValidators(ctx))
+}
+
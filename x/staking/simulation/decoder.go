@@ -37,6 +37,9 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &delegationB)
 		return fmt.Sprintf("%v\n%v", delegationA, delegationB)
 
+	case bytes.Equal(kvA.Key[:1], types.DelegationByValIndexKey):
+		return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
 	case bytes.Equal(kvA.Key[:1], types.UnbondingDelegationKey),
 		bytes.Equal(kvA.Key[:1], types.UnbondingDelegationByValIndexKey):
 		var ubdA, ubdB types.UnbondingDelegation
@@ -51,6 +54,12 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &redB)
 		return fmt.Sprintf("%v\n%v", redA, redB)
 
+	case bytes.Equal(kvA.Key[:1], types.HistoricalInfoKey):
+		var histInfoA, histInfoB types.HistoricalInfo
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &histInfoA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &histInfoB)
+		return fmt.Sprintf("%v\n%v", histInfoA, histInfoB)
+
 	default:
 		panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 	}
@@ -17,8 +17,9 @@ import (
 
 // Simulation parameter constants
 const (
-	UnbondingTime = "unbonding_time"
-	MaxValidators = "max_validators"
+	UnbondingTime     = "unbonding_time"
+	MaxValidators     = "max_validators"
+	HistoricalEntries = "historical_entries"
 )
 
 // GenUnbondingTime randomized UnbondingTime
@@ -31,6 +32,11 @@ func GenMaxValidators(r *rand.Rand) (maxValidators uint16) {
 	return uint16(r.Intn(250) + 1)
 }
 
+// GenHistoricalEntries randomized HistoricalEntries
+func GenHistoricalEntries(r *rand.Rand) uint16 {
+	return uint16(r.Intn(1000))
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
@@ -46,11 +52,17 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { maxValidators = GenMaxValidators(r) },
 	)
 
+	var historicalEntries uint16
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, HistoricalEntries, &historicalEntries, simState.Rand,
+		func(r *rand.Rand) { historicalEntries = GenHistoricalEntries(r) },
+	)
+
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
 
-	params := types.NewParams(simState.UnbondTime, maxValidators, 7, sdk.DefaultBondDenom)
+	params := types.NewParams(simState.UnbondTime, maxValidators, 7, simState.BondDenom, types.DefaultMinCommissionRate, historicalEntries)
 
 	// validators & delegations
 	var (
@@ -63,11 +75,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		valAddr := sdk.ValAddress(simState.Accounts[i].Address)
 		valAddrs[i] = valAddr
 
+		// the genesis has no block context, and so no extreme value bias
 		maxCommission := sdk.NewDecWithPrec(int64(simulation.RandIntBetween(simState.Rand, 1, 100)), 2)
 		commission := types.NewCommission(
-			simulation.RandomDecAmount(simState.Rand, maxCommission),
+			simulation.RandomDecAmount(simState.Rand, sdk.Context{}, maxCommission),
 			maxCommission,
-			simulation.RandomDecAmount(simState.Rand, maxCommission),
+			simulation.RandomDecAmount(simState.Rand, sdk.Context{}, maxCommission),
 		)
 
 		validator := types.NewValidator(valAddr, simState.Accounts[i].PubKey, types.Description{})
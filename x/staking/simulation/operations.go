@@ -6,6 +6,7 @@ import (
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -13,6 +14,63 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateValidator = "op_weight_msg_create_validator"
+	OpWeightMsgEditValidator   = "op_weight_msg_edit_validator"
+	OpWeightMsgDelegate        = "op_weight_msg_delegate"
+	OpWeightMsgUndelegate      = "op_weight_msg_undelegate"
+	OpWeightMsgBeginRedelegate = "op_weight_msg_begin_redelegate"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, ak types.AccountKeeper,
+	k keeper.Keeper) simulation.WeightedOperations {
+
+	var weightMsgCreateValidator int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateValidator = 100
+		},
+	)
+
+	var weightMsgEditValidator int
+	appParams.GetOrGenerate(cdc, OpWeightMsgEditValidator, &weightMsgEditValidator, nil,
+		func(_ *rand.Rand) {
+			weightMsgEditValidator = 20
+		},
+	)
+
+	var weightMsgDelegate int
+	appParams.GetOrGenerate(cdc, OpWeightMsgDelegate, &weightMsgDelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgDelegate = 100
+		},
+	)
+
+	var weightMsgUndelegate int
+	appParams.GetOrGenerate(cdc, OpWeightMsgUndelegate, &weightMsgUndelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgUndelegate = 100
+		},
+	)
+
+	var weightMsgBeginRedelegate int
+	appParams.GetOrGenerate(cdc, OpWeightMsgBeginRedelegate, &weightMsgBeginRedelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgBeginRedelegate = 100
+		},
+	)
+
+	return simulation.WeightedOperations{
+		{Weight: weightMsgCreateValidator, Op: SimulateMsgCreateValidator(ak, k)},
+		{Weight: weightMsgEditValidator, Op: SimulateMsgEditValidator(ak, k)},
+		{Weight: weightMsgDelegate, Op: SimulateMsgDelegate(ak, k)},
+		{Weight: weightMsgUndelegate, Op: SimulateMsgUndelegate(ak, k)},
+		{Weight: weightMsgBeginRedelegate, Op: SimulateMsgBeginRedelegate(ak, k)},
+	}
+}
+
 // SimulateMsgCreateValidator generates a MsgCreateValidator with random values
 // nolint: funlen
 func SimulateMsgCreateValidator(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
@@ -26,18 +84,18 @@ func SimulateMsgCreateValidator(ak types.AccountKeeper, k keeper.Keeper) simulat
 		// ensure the validator doesn't exist already
 		_, found := k.GetValidator(ctx, address)
 		if found {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgCreateValidator, "validator already exists"), nil, nil
 		}
 
 		denom := k.GetParams(ctx).BondDenom
 		amount := ak.GetAccount(ctx, simAccount.Address).GetCoins().AmountOf(denom)
 		if !amount.IsPositive() {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgCreateValidator, "no bond tokens to self delegate"), nil, nil
 		}
 
-		amount, err := simulation.RandPositiveInt(r, amount)
+		amount, err := simulation.RandPositiveInt(r, ctx, amount)
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgCreateValidator, ""), nil, err
 		}
 
 		selfDelegation := sdk.NewCoin(denom, amount)
@@ -50,7 +108,7 @@ func SimulateMsgCreateValidator(ak types.AccountKeeper, k keeper.Keeper) simulat
 		if !hasNeg {
 			fees, err = simulation.RandomFees(r, ctx, coins)
 			if err != nil {
-				return simulation.NoOpMsg(types.ModuleName), nil, err
+				return simulation.NoOpMsg(types.ModuleName, types.TypeMsgCreateValidator, ""), nil, err
 			}
 		}
 
@@ -64,9 +122,9 @@ func SimulateMsgCreateValidator(ak types.AccountKeeper, k keeper.Keeper) simulat
 
 		maxCommission := sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 100)), 2)
 		commission := types.NewCommissionRates(
-			simulation.RandomDecAmount(r, maxCommission),
+			simulation.RandomDecAmount(r, ctx, maxCommission),
 			maxCommission,
-			simulation.RandomDecAmount(r, maxCommission),
+			simulation.RandomDecAmount(r, ctx, maxCommission),
 		)
 
 		msg := types.NewMsgCreateValidator(address, simAccount.PubKey,
@@ -83,7 +141,7 @@ func SimulateMsgCreateValidator(ak types.AccountKeeper, k keeper.Keeper) simulat
 
 		res := app.Deliver(tx)
 		if !res.IsOK() {
-			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgCreateValidator, ""), nil, errors.New(res.Log)
 		}
 
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
@@ -98,32 +156,32 @@ func SimulateMsgEditValidator(ak types.AccountKeeper, k keeper.Keeper) simulatio
 	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
 
 		if len(k.GetAllValidators(ctx)) == 0 {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgEditValidator, "no validator"), nil, nil
 		}
 
 		val, ok := keeper.RandomValidator(r, k, ctx)
 		if !ok {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgEditValidator, "no validator"), nil, nil
 		}
 
 		address := val.GetOperator()
 
-		newCommissionRate := simulation.RandomDecAmount(r, val.Commission.MaxRate)
+		newCommissionRate := simulation.RandomDecAmount(r, ctx, val.Commission.MaxRate)
 
 		if err := val.Commission.ValidateNewRate(newCommissionRate, ctx.BlockHeader().Time); err != nil {
 			// skip as the commission is invalid
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgEditValidator, "invalid commission rate"), nil, nil
 		}
 
 		simAccount, found := simulation.FindAccount(accs, sdk.AccAddress(val.GetOperator()))
 		if !found {
-			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("validator %s not found", val.GetOperator())
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgEditValidator, ""), nil, fmt.Errorf("validator %s not found", val.GetOperator())
 		}
 
 		account := ak.GetAccount(ctx, simAccount.Address)
 		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgEditValidator, ""), nil, err
 		}
 
 		description := types.NewDescription(
@@ -147,7 +205,7 @@ func SimulateMsgEditValidator(ak types.AccountKeeper, k keeper.Keeper) simulatio
 
 		res := app.Deliver(tx)
 		if !res.IsOK() {
-			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgEditValidator, ""), nil, errors.New(res.Log)
 		}
 
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
@@ -163,27 +221,27 @@ func SimulateMsgDelegate(ak types.AccountKeeper, k keeper.Keeper) simulation.Ope
 
 		denom := k.GetParams(ctx).BondDenom
 		if len(k.GetAllValidators(ctx)) == 0 {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "no validator"), nil, nil
 		}
 
 		simAccount, _ := simulation.RandomAcc(r, accs)
 		val, ok := keeper.RandomValidator(r, k, ctx)
 		if !ok {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "no validator"), nil, nil
 		}
 
 		if val.InvalidExRate() {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "validator has an invalid exchange rate"), nil, nil
 		}
 
 		amount := ak.GetAccount(ctx, simAccount.Address).GetCoins().AmountOf(denom)
 		if !amount.IsPositive() {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "no bond tokens to delegate"), nil, nil
 		}
 
-		amount, err := simulation.RandPositiveInt(r, amount)
+		amount, err := simulation.RandPositiveInt(r, ctx, amount)
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, ""), nil, err
 		}
 
 		bondAmt := sdk.NewCoin(denom, amount)
@@ -196,7 +254,7 @@ func SimulateMsgDelegate(ak types.AccountKeeper, k keeper.Keeper) simulation.Ope
 		if !hasNeg {
 			fees, err = simulation.RandomFees(r, ctx, coins)
 			if err != nil {
-				return simulation.NoOpMsg(types.ModuleName), nil, err
+				return simulation.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, ""), nil, err
 			}
 		}
 
@@ -213,7 +271,7 @@ func SimulateMsgDelegate(ak types.AccountKeeper, k keeper.Keeper) simulation.Ope
 
 		res := app.Deliver(tx)
 		if !res.IsOK() {
-			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, ""), nil, errors.New(res.Log)
 		}
 
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
@@ -230,32 +288,35 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, k keeper.Keeper) simulation.O
 		// get random validator
 		validator, ok := keeper.RandomValidator(r, k, ctx)
 		if !ok {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "no validator"), nil, nil
 		}
 		valAddr := validator.GetOperator()
 
 		delegations := k.GetValidatorDelegations(ctx, validator.OperatorAddress)
+		if len(delegations) == 0 {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "validator has no delegations"), nil, nil
+		}
 
 		// get random delegator from validator
 		delegation := delegations[r.Intn(len(delegations))]
 		delAddr := delegation.GetDelegatorAddr()
 
 		if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "max unbonding delegation entries reached"), nil, nil
 		}
 
 		totalBond := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
 		if !totalBond.IsPositive() {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "delegation has no tokens"), nil, nil
 		}
 
-		unbondAmt, err := simulation.RandPositiveInt(r, totalBond)
+		unbondAmt, err := simulation.RandPositiveInt(r, ctx, totalBond)
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, ""), nil, err
 		}
 
 		if unbondAmt.IsZero() {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "unbonding amount is zero"), nil, nil
 		}
 
 		msg := types.NewMsgUndelegate(
@@ -272,13 +333,13 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, k keeper.Keeper) simulation.O
 		}
 		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
 		if simAccount.PrivKey == nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, ""), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
 		}
 
 		account := ak.GetAccount(ctx, delAddr)
 		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, ""), nil, err
 		}
 
 		tx := helpers.GenTx(
@@ -292,7 +353,7 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, k keeper.Keeper) simulation.O
 
 		res := app.Deliver(tx)
 		if !res.IsOK() {
-			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, ""), nil, errors.New(res.Log)
 		}
 
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
@@ -309,56 +370,64 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, k keeper.Keeper) simulat
 		// get random source validator
 		srcVal, ok := keeper.RandomValidator(r, k, ctx)
 		if !ok {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "no validator"), nil, nil
 		}
 		srcAddr := srcVal.GetOperator()
 
 		delegations := k.GetValidatorDelegations(ctx, srcAddr)
+		if len(delegations) == 0 {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "source validator has no delegations"), nil, nil
+		}
 
 		// get random delegator from src validator
 		delegation := delegations[r.Intn(len(delegations))]
 		delAddr := delegation.GetDelegatorAddr()
 
 		if k.HasReceivingRedelegation(ctx, delAddr, srcAddr) {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil // skip
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "delegator has a receiving redelegation from the source validator"), nil, nil
 		}
 
 		// get random destination validator
 		destVal, ok := keeper.RandomValidator(r, k, ctx)
 		if !ok {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "no destination validator"), nil, nil
 		}
 		destAddr := destVal.GetOperator()
 
-		if srcAddr.Equals(destAddr) ||
-			destVal.InvalidExRate() ||
-			k.HasMaxRedelegationEntries(ctx, delAddr, srcAddr, destAddr) {
+		if srcAddr.Equals(destAddr) {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "source and destination validators are the same"), nil, nil
+		}
+
+		if destVal.InvalidExRate() {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "destination validator has an invalid exchange rate"), nil, nil
+		}
 
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		if k.HasMaxRedelegationEntries(ctx, delAddr, srcAddr, destAddr) {
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "max redelegation entries reached"), nil, nil
 		}
 
 		totalBond := srcVal.TokensFromShares(delegation.GetShares()).TruncateInt()
 		if !totalBond.IsPositive() {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "delegation has no tokens"), nil, nil
 		}
 
-		redAmt, err := simulation.RandPositiveInt(r, totalBond)
+		redAmt, err := simulation.RandPositiveInt(r, ctx, totalBond)
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, ""), nil, err
 		}
 
 		if redAmt.IsZero() {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "redelegation amount is zero"), nil, nil
 		}
 
 		// check if the shares truncate to zero
 		shares, err := srcVal.SharesFromTokens(redAmt)
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, ""), nil, err
 		}
 
 		if srcVal.TokensFromShares(shares).TruncateInt().IsZero() {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil // skip
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "shares truncate to zero tokens"), nil, nil
 		}
 
 		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
@@ -371,14 +440,14 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, k keeper.Keeper) simulat
 		}
 		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
 		if simAccount.PrivKey == nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, ""), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
 		}
 
 		// get tx fees
 		account := ak.GetAccount(ctx, delAddr)
 		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
 		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, err
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, ""), nil, err
 		}
 
 		msg := types.NewMsgBeginRedelegate(
@@ -397,7 +466,7 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, k keeper.Keeper) simulat
 
 		res := app.Deliver(tx)
 		if !res.IsOK() {
-			return simulation.NoOpMsg(types.ModuleName), nil, errors.New(res.Log)
+			return simulation.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, ""), nil, errors.New(res.Log)
 		}
 
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
@@ -11,8 +11,9 @@ import (
 )
 
 const (
-	keyMaxValidators = "MaxValidators"
-	keyUnbondingTime = "UnbondingTime"
+	keyMaxValidators     = "MaxValidators"
+	keyUnbondingTime     = "UnbondingTime"
+	keyHistoricalEntries = "HistoricalEntries"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -29,5 +30,10 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("\"%d\"", GenUnbondingTime(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyHistoricalEntries, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenHistoricalEntries(r))
+			},
+		),
 	}
 }
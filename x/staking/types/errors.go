@@ -75,6 +75,10 @@ func ErrCommissionGTMaxRate(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "commission cannot be more than the max rate")
 }
 
+func ErrCommissionLTMinRate(codespace sdk.CodespaceType, minRate sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, fmt.Sprintf("commission cannot be less than the min rate %s", minRate))
+}
+
 func ErrCommissionUpdateTime(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "commission cannot be changed more than once in 24h")
 }
@@ -212,3 +216,15 @@ func ErrNeitherShareMsgsGiven(codespace sdk.CodespaceType) sdk.Error {
 func ErrMissingSignature(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "missing signature")
 }
+
+func ErrInvalidPoolsRepair(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("invalid pools repair: %s", msg))
+}
+
+func ErrInvalidHistoricalInfo(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("invalid historical info: %s", msg))
+}
+
+func ErrNoHistoricalInfo(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "no historical info found")
+}
@@ -9,6 +9,8 @@ const (
 	EventTypeDelegate             = "delegate"
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
+	EventTypeEnforceMinCommission = "enforce_min_commission"
+	EventTypeDemoteValidator      = "demote_validator"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -17,5 +19,7 @@ const (
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyPower             = "power"
+	AttributeKeyMaxValidators     = "max_validators"
 	AttributeValueCategory        = ModuleName
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
@@ -55,8 +57,9 @@ type ValidatorSet interface {
 	TotalBondedTokens(sdk.Context) sdk.Int                                       // total bonded tokens within the validator set
 	StakingTokenSupply(sdk.Context) sdk.Int                                      // total staking token supply
 
-	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
-	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec)
+	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction,
+	// and return the amount of tokens slashed
+	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec) sdk.Int
 	Jail(sdk.Context, sdk.ConsAddress)   // jail a validator
 	Unjail(sdk.Context, sdk.ConsAddress) // unjail a validator
 
@@ -85,7 +88,8 @@ type DelegationSet interface {
 // state. The second keeper must implement this interface, which then the
 // staking keeper can call.
 
-// StakingHooks event hooks for staking validator object (noalias)
+// StakingHooks event hooks for staking validator object. Several hooks can be
+// composed with MultiStakingHooks.
 type StakingHooks interface {
 	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)                           // Must be called when a validator is created
 	BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress)                         // Must be called when a validator's state changes
@@ -99,4 +103,6 @@ type StakingHooks interface {
 	BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)        // Must be called when a delegation is removed
 	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec)
+
+	AfterUnbondingInitiated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, balance sdk.Int, completionTime time.Time) // Must be called when an unbonding delegation entry is created
 }
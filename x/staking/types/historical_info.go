@@ -0,0 +1,61 @@
+package types
+
+import (
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HistoricalInfo contains the header and the validator set of a block. The
+// staking keeper stores one for each of the last HistoricalEntries blocks so
+// that light clients of this chain (e.g. IBC clients) can be verified against
+// recent validator sets from within the state machine.
+type HistoricalInfo struct {
+	Header abci.Header `json:"header" yaml:"header"`
+	ValSet Validators  `json:"valset" yaml:"valset"`
+}
+
+// NewHistoricalInfo creates a new HistoricalInfo from a header and a validator
+// set, which is sorted by operator address before its inclusion.
+func NewHistoricalInfo(header abci.Header, valSet Validators) HistoricalInfo {
+	sort.Sort(valSet)
+	return HistoricalInfo{
+		Header: header,
+		ValSet: valSet,
+	}
+}
+
+// MustMarshalHistoricalInfo marshals a historical info and panics on error
+func MustMarshalHistoricalInfo(cdc *codec.Codec, hi HistoricalInfo) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(hi)
+}
+
+// MustUnmarshalHistoricalInfo unmarshals a historical info and panics on error
+func MustUnmarshalHistoricalInfo(cdc *codec.Codec, value []byte) HistoricalInfo {
+	hi, err := UnmarshalHistoricalInfo(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return hi
+}
+
+// UnmarshalHistoricalInfo unmarshals a historical info
+func UnmarshalHistoricalInfo(cdc *codec.Codec, value []byte) (hi HistoricalInfo, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &hi)
+	return hi, err
+}
+
+// ValidateBasic checks that the validator set of a historical info is not
+// empty and sorted by operator address.
+func (hi HistoricalInfo) ValidateBasic() sdk.Error {
+	if len(hi.ValSet) == 0 {
+		return ErrInvalidHistoricalInfo(DefaultCodespace, "validator set is empty")
+	}
+	if !sort.IsSorted(hi.ValSet) {
+		return ErrInvalidHistoricalInfo(DefaultCodespace, "validator set is not sorted by operator address")
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var header = abci.Header{
+	ChainID: "hello",
+	Height:  5,
+}
+
+func createValidators() []Validator {
+	return []Validator{
+		NewValidator(sdk.ValAddress(pk1.Address()), pk1, Description{}),
+		NewValidator(sdk.ValAddress(pk2.Address()), pk2, Description{}),
+		NewValidator(sdk.ValAddress(pk3.Address()), pk3, Description{}),
+	}
+}
+
+func TestHistoricalInfo(t *testing.T) {
+	validators := createValidators()
+	hi := NewHistoricalInfo(header, validators)
+	require.True(t, sort.IsSorted(hi.ValSet), "Validators are not sorted")
+
+	var value []byte
+	require.NotPanics(t, func() {
+		value = MustMarshalHistoricalInfo(ModuleCdc, hi)
+	})
+	require.NotNil(t, value, "Marshalled HistoricalInfo is nil")
+
+	recv, err := UnmarshalHistoricalInfo(ModuleCdc, value)
+	require.Nil(t, err, "Unmarshalling HistoricalInfo failed")
+	require.Equal(t, hi, recv, "Unmarshalled HistoricalInfo is different from original")
+	require.True(t, sort.IsSorted(hi.ValSet), "Validators are not sorted")
+}
+
+func TestValidateBasic(t *testing.T) {
+	validators := createValidators()
+	hi := HistoricalInfo{
+		Header: header,
+	}
+	require.NotNil(t, hi.ValidateBasic(), "ValidateBasic passed on nil ValSet")
+
+	hi.ValSet = validators
+	// make sure the validators are not sorted
+	if sort.IsSorted(hi.ValSet) {
+		hi.ValSet[0], hi.ValSet[len(hi.ValSet)-1] = hi.ValSet[len(hi.ValSet)-1], hi.ValSet[0]
+	}
+	require.NotNil(t, hi.ValidateBasic(), "ValidateBasic passed on unsorted ValSet")
+
+	hi = NewHistoricalInfo(header, validators)
+	require.Nil(t, hi.ValidateBasic(), "ValidateBasic failed on valid HistoricalInfo")
+}
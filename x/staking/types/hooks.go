@@ -1,12 +1,16 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // combine multiple staking hooks, all hook functions are run in array sequence
 type MultiStakingHooks []StakingHooks
 
+var _ StakingHooks = MultiStakingHooks{}
+
 func NewMultiStakingHooks(hooks ...StakingHooks) MultiStakingHooks {
 	return hooks
 }
@@ -62,3 +66,8 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.V
 		h[i].BeforeValidatorSlashed(ctx, valAddr, fraction)
 	}
 }
+func (h MultiStakingHooks) AfterUnbondingInitiated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, balance sdk.Int, completionTime time.Time) {
+	for i := range h {
+		h[i].AfterUnbondingInitiated(ctx, delAddr, valAddr, balance, completionTime)
+	}
+}
@@ -10,6 +10,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// staking message types
+const (
+	TypeMsgCreateValidator = "create_validator"
+	TypeMsgEditValidator   = "edit_validator"
+	TypeMsgDelegate        = "delegate"
+	TypeMsgUndelegate      = "begin_unbonding"
+	TypeMsgBeginRedelegate = "begin_redelegate"
+)
+
 // ensure Msg interface compliance at compile time
 var (
 	_ sdk.Msg = &MsgCreateValidator{}
@@ -61,7 +70,7 @@ func NewMsgCreateValidator(
 
 //nolint
 func (msg MsgCreateValidator) Route() string { return RouterKey }
-func (msg MsgCreateValidator) Type() string  { return "create_validator" }
+func (msg MsgCreateValidator) Type() string  { return TypeMsgCreateValidator }
 
 // Return address(es) that must sign over msg.GetSignBytes()
 func (msg MsgCreateValidator) GetSigners() []sdk.AccAddress {
@@ -205,7 +214,7 @@ func NewMsgEditValidator(valAddr sdk.ValAddress, description Description, newRat
 
 //nolint
 func (msg MsgEditValidator) Route() string { return RouterKey }
-func (msg MsgEditValidator) Type() string  { return "edit_validator" }
+func (msg MsgEditValidator) Type() string  { return TypeMsgEditValidator }
 func (msg MsgEditValidator) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress)}
 }
@@ -256,7 +265,7 @@ func NewMsgDelegate(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.C
 
 //nolint
 func (msg MsgDelegate) Route() string { return RouterKey }
-func (msg MsgDelegate) Type() string  { return "delegate" }
+func (msg MsgDelegate) Type() string  { return TypeMsgDelegate }
 func (msg MsgDelegate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.DelegatorAddress}
 }
@@ -304,7 +313,7 @@ func NewMsgBeginRedelegate(delAddr sdk.AccAddress, valSrcAddr,
 
 //nolint
 func (msg MsgBeginRedelegate) Route() string { return RouterKey }
-func (msg MsgBeginRedelegate) Type() string  { return "begin_redelegate" }
+func (msg MsgBeginRedelegate) Type() string  { return TypeMsgBeginRedelegate }
 func (msg MsgBeginRedelegate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.DelegatorAddress}
 }
@@ -349,7 +358,7 @@ func NewMsgUndelegate(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk
 
 //nolint
 func (msg MsgUndelegate) Route() string                { return RouterKey }
-func (msg MsgUndelegate) Type() string                 { return "begin_unbonding" }
+func (msg MsgUndelegate) Type() string                 { return TypeMsgUndelegate }
 func (msg MsgUndelegate) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.DelegatorAddress} }
 
 // get the bytes for the message signer to sign on
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -22,14 +23,24 @@ const (
 
 	// Default maximum entries in a UBD/RED pair
 	DefaultMaxEntries uint16 = 7
+
+	// DefaultHistoricalEntries is 0 since it must only be non-zero for
+	// IBC connected chains
+	DefaultHistoricalEntries uint16 = 0
 )
 
+// DefaultMinCommissionRate is the default minimum commission rate validators
+// must charge, i.e. no minimum.
+var DefaultMinCommissionRate = sdk.ZeroDec()
+
 // nolint - Keys for parameter access
 var (
-	KeyUnbondingTime = []byte("UnbondingTime")
-	KeyMaxValidators = []byte("MaxValidators")
-	KeyMaxEntries    = []byte("KeyMaxEntries")
-	KeyBondDenom     = []byte("BondDenom")
+	KeyUnbondingTime     = []byte("UnbondingTime")
+	KeyMaxValidators     = []byte("MaxValidators")
+	KeyMaxEntries        = []byte("KeyMaxEntries")
+	KeyBondDenom         = []byte("BondDenom")
+	KeyMinCommissionRate = []byte("MinCommissionRate")
+	KeyHistoricalEntries = []byte("HistoricalEntries")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -40,28 +51,34 @@ type Params struct {
 	MaxValidators uint16        `json:"max_validators" yaml:"max_validators"` // maximum number of validators (max uint16 = 65535)
 	MaxEntries    uint16        `json:"max_entries" yaml:"max_entries"`       // max entries for either unbonding delegation or redelegation (per pair/trio)
 	// note: we need to be a bit careful about potential overflow here, since this is user-determined
-	BondDenom string `json:"bond_denom" yaml:"bond_denom"` // bondable coin denomination
+	BondDenom         string  `json:"bond_denom" yaml:"bond_denom"`                   // bondable coin denomination
+	MinCommissionRate sdk.Dec `json:"min_commission_rate" yaml:"min_commission_rate"` // minimum commission rate charged by any validator
+	HistoricalEntries uint16  `json:"historical_entries" yaml:"historical_entries"`   // number of historical info entries to persist
 }
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint16,
-	bondDenom string) Params {
+	bondDenom string, minCommissionRate sdk.Dec, historicalEntries uint16) Params {
 
 	return Params{
-		UnbondingTime: unbondingTime,
-		MaxValidators: maxValidators,
-		MaxEntries:    maxEntries,
-		BondDenom:     bondDenom,
+		UnbondingTime:     unbondingTime,
+		MaxValidators:     maxValidators,
+		MaxEntries:        maxEntries,
+		BondDenom:         bondDenom,
+		MinCommissionRate: minCommissionRate,
+		HistoricalEntries: historicalEntries,
 	}
 }
 
 // Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		{Key: KeyUnbondingTime, Value: &p.UnbondingTime},
-		{Key: KeyMaxValidators, Value: &p.MaxValidators},
-		{Key: KeyMaxEntries, Value: &p.MaxEntries},
-		{Key: KeyBondDenom, Value: &p.BondDenom},
+		params.NewParamSetPair(KeyUnbondingTime, &p.UnbondingTime, validateUnbondingTime),
+		params.NewParamSetPair(KeyMaxValidators, &p.MaxValidators, validateMaxValidators),
+		params.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
+		params.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		params.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
+		params.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 	}
 }
 
@@ -75,17 +92,20 @@ func (p Params) Equal(p2 Params) bool {
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom)
+	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom, DefaultMinCommissionRate,
+		DefaultHistoricalEntries)
 }
 
 // String returns a human readable string representation of the parameters.
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-  Unbonding Time:    %s
-  Max Validators:    %d
-  Max Entries:       %d
-  Bonded Coin Denom: %s`, p.UnbondingTime,
-		p.MaxValidators, p.MaxEntries, p.BondDenom)
+  Unbonding Time:      %s
+  Max Validators:      %d
+  Max Entries:         %d
+  Bonded Coin Denom:   %s
+  Min Commission Rate: %s
+  Historical Entries:  %d`, p.UnbondingTime,
+		p.MaxValidators, p.MaxEntries, p.BondDenom, p.MinCommissionRate, p.HistoricalEntries)
 }
 
 // unmarshal the current staking params value from store key or panic
@@ -108,11 +128,82 @@ func UnmarshalParams(cdc *codec.Codec, value []byte) (params Params, err error)
 
 // validate a set of params
 func (p Params) Validate() error {
-	if p.BondDenom == "" {
-		return fmt.Errorf("staking parameter BondDenom can't be an empty string")
+	if err := validateUnbondingTime(p.UnbondingTime); err != nil {
+		return err
+	}
+	if err := validateMaxValidators(p.MaxValidators); err != nil {
+		return err
+	}
+	if err := validateMaxEntries(p.MaxEntries); err != nil {
+		return err
+	}
+	if err := validateBondDenom(p.BondDenom); err != nil {
+		return err
+	}
+	if err := validateMinCommissionRate(p.MinCommissionRate); err != nil {
+		return err
+	}
+	return validateHistoricalEntries(p.HistoricalEntries)
+}
+
+func validateUnbondingTime(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("staking parameter UnbondingTime must be positive, is %s", v)
 	}
-	if p.MaxValidators == 0 {
+	return nil
+}
+
+func validateMaxValidators(i interface{}) error {
+	v, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
 		return fmt.Errorf("staking parameter MaxValidators must be a positive integer")
 	}
 	return nil
 }
+
+func validateMaxEntries(i interface{}) error {
+	v, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("staking parameter MaxEntries must be a positive integer")
+	}
+	return nil
+}
+
+func validateBondDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if strings.TrimSpace(v) == "" {
+		return fmt.Errorf("staking parameter BondDenom can't be an empty string")
+	}
+	return nil
+}
+
+func validateMinCommissionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("staking parameter MinCommissionRate must be between 0 and 1, is %s", v)
+	}
+	return nil
+}
+
+func validateHistoricalEntries(i interface{}) error {
+	if _, ok := i.(uint16); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
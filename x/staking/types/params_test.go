@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -19,3 +20,24 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestParamsValidation(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	params := DefaultParams()
+	params.UnbondingTime = -1
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MaxEntries = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if string(pair.Key) == string(KeyUnbondingTime) {
+			require.Error(t, pair.ValidatorFn(time.Duration(-1)))
+			require.Error(t, pair.ValidatorFn(int64(1)))
+			require.NoError(t, pair.ValidatorFn(time.Hour))
+		}
+	}
+}
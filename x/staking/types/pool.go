@@ -37,3 +37,59 @@ func (p Pool) String() string {
   Bonded Tokens:      %s`, p.NotBondedTokens,
 		p.BondedTokens)
 }
+
+// PoolsRepair defines the balances of the bonded and not bonded pools at a
+// given height along with the balances restoring the module accounts
+// invariant, i.e. the tokens of the bonded validators for the bonded pool, and
+// the tokens of the unbonding and unbonded validators plus the balance of the
+// unbonding delegations for the not bonded pool.
+type PoolsRepair struct {
+	Height                  int64   `json:"height" yaml:"height"`
+	BondDenom               string  `json:"bond_denom" yaml:"bond_denom"`
+	BondedTokens            sdk.Int `json:"bonded_tokens" yaml:"bonded_tokens"`
+	ExpectedBondedTokens    sdk.Int `json:"expected_bonded_tokens" yaml:"expected_bonded_tokens"`
+	NotBondedTokens         sdk.Int `json:"not_bonded_tokens" yaml:"not_bonded_tokens"`
+	ExpectedNotBondedTokens sdk.Int `json:"expected_not_bonded_tokens" yaml:"expected_not_bonded_tokens"`
+}
+
+// NewPoolsRepair creates a new PoolsRepair instance
+func NewPoolsRepair(height int64, bondDenom string, bonded, expBonded, notBonded, expNotBonded sdk.Int) PoolsRepair {
+	return PoolsRepair{
+		Height:                  height,
+		BondDenom:               bondDenom,
+		BondedTokens:            bonded,
+		ExpectedBondedTokens:    expBonded,
+		NotBondedTokens:         notBonded,
+		ExpectedNotBondedTokens: expNotBonded,
+	}
+}
+
+// BondedDiff returns the tokens missing from the bonded pool, or the opposite
+// of its surplus.
+func (pr PoolsRepair) BondedDiff() sdk.Int {
+	return pr.ExpectedBondedTokens.Sub(pr.BondedTokens)
+}
+
+// NotBondedDiff returns the tokens missing from the not bonded pool, or the
+// opposite of its surplus.
+func (pr PoolsRepair) NotBondedDiff() sdk.Int {
+	return pr.ExpectedNotBondedTokens.Sub(pr.NotBondedTokens)
+}
+
+// IsEmpty returns true if the pools don't need to be repaired.
+func (pr PoolsRepair) IsEmpty() bool {
+	return pr.BondedDiff().IsZero() && pr.NotBondedDiff().IsZero()
+}
+
+// String returns a human readable string representation of a pools repair.
+func (pr PoolsRepair) String() string {
+	return fmt.Sprintf(`Pools Repair:
+  Height:                      %d
+  Bond Denom:                  %s
+  Bonded Tokens:               %s
+  Expected Bonded Tokens:      %s
+  Not Bonded Tokens:           %s
+  Expected Not Bonded Tokens:  %s`,
+		pr.Height, pr.BondDenom, pr.BondedTokens, pr.ExpectedBondedTokens,
+		pr.NotBondedTokens, pr.ExpectedNotBondedTokens)
+}
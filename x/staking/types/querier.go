@@ -1,6 +1,9 @@
 package types
 
 import (
+	"sort"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -20,6 +23,26 @@ const (
 	QueryDelegatorValidator            = "delegatorValidator"
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
+	QueryRecentUnbondings              = "recentUnbondings"
+	QueryUnbondingQueue                = "unbondingQueue"
+	QueryRedelegationQueue             = "redelegationQueue"
+	QueryPoolsRepair                   = "poolsRepair"
+	QueryValidatorsByPower             = "validatorsByPower"
+	QueryHistoricalInfo                = "historicalInfo"
+	QueryValidatorsNearCutoff          = "validatorsNearCutoff"
+)
+
+// MaxRecentUnbondingsLimit is the maximum number of unbonding
+// delegations requested by a single 'custom/staking/recentUnbondings'
+// query.
+const MaxRecentUnbondingsLimit = 100
+
+// DefaultQueryLimit is the number of entries on a page of the paginated
+// queries which set no limit, and MaxQueryLimit the maximum number of entries
+// on a page.
+const (
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
 )
 
 // defines the params for the following queries:
@@ -27,8 +50,12 @@ const (
 // - 'custom/staking/delegatorUnbondingDelegations'
 // - 'custom/staking/delegatorRedelegations'
 // - 'custom/staking/delegatorValidators'
+//
+// Page and Limit paginate the delegations and unbonding delegations queries,
+// a zero limit returning DefaultQueryLimit entries per page.
 type QueryDelegatorParams struct {
 	DelegatorAddr sdk.AccAddress
+	Page, Limit   int
 }
 
 func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams {
@@ -37,13 +64,25 @@ func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams
 	}
 }
 
+func NewPaginatedQueryDelegatorParams(delegatorAddr sdk.AccAddress, page, limit int) QueryDelegatorParams {
+	return QueryDelegatorParams{
+		DelegatorAddr: delegatorAddr,
+		Page:          page,
+		Limit:         limit,
+	}
+}
+
 // defines the params for the following queries:
 // - 'custom/staking/validator'
 // - 'custom/staking/validatorDelegations'
 // - 'custom/staking/validatorUnbondingDelegations'
 // - 'custom/staking/validatorRedelegations'
+//
+// Page and Limit paginate the delegations and unbonding delegations queries,
+// a zero limit returning DefaultQueryLimit entries per page.
 type QueryValidatorParams struct {
 	ValidatorAddr sdk.ValAddress
+	Page, Limit   int
 }
 
 func NewQueryValidatorParams(validatorAddr sdk.ValAddress) QueryValidatorParams {
@@ -52,6 +91,14 @@ func NewQueryValidatorParams(validatorAddr sdk.ValAddress) QueryValidatorParams
 	}
 }
 
+func NewPaginatedQueryValidatorParams(validatorAddr sdk.ValAddress, page, limit int) QueryValidatorParams {
+	return QueryValidatorParams{
+		ValidatorAddr: validatorAddr,
+		Page:          page,
+		Limit:         limit,
+	}
+}
+
 // defines the params for the following queries:
 // - 'custom/staking/delegation'
 // - 'custom/staking/unbondingDelegation'
@@ -70,10 +117,14 @@ func NewQueryBondsParams(delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddr
 
 // defines the params for the following queries:
 // - 'custom/staking/redelegation'
+//
+// Page and Limit paginate the redelegations, a zero limit returning
+// DefaultQueryLimit entries per page.
 type QueryRedelegationParams struct {
 	DelegatorAddr    sdk.AccAddress
 	SrcValidatorAddr sdk.ValAddress
 	DstValidatorAddr sdk.ValAddress
+	Page, Limit      int
 }
 
 func NewQueryRedelegationParams(delegatorAddr sdk.AccAddress,
@@ -86,6 +137,18 @@ func NewQueryRedelegationParams(delegatorAddr sdk.AccAddress,
 	}
 }
 
+func NewPaginatedQueryRedelegationParams(delegatorAddr sdk.AccAddress,
+	srcValidatorAddr, dstValidatorAddr sdk.ValAddress, page, limit int) QueryRedelegationParams {
+
+	return QueryRedelegationParams{
+		DelegatorAddr:    delegatorAddr,
+		SrcValidatorAddr: srcValidatorAddr,
+		DstValidatorAddr: dstValidatorAddr,
+		Page:             page,
+		Limit:            limit,
+	}
+}
+
 // QueryValidatorsParams defines the params for the following queries:
 // - 'custom/staking/validators'
 type QueryValidatorsParams struct {
@@ -96,3 +159,258 @@ type QueryValidatorsParams struct {
 func NewQueryValidatorsParams(page, limit int, status string) QueryValidatorsParams {
 	return QueryValidatorsParams{page, limit, status}
 }
+
+// QueryRecentUnbondingsParams defines the params for the following queries:
+// - 'custom/staking/recentUnbondings'
+type QueryRecentUnbondingsParams struct {
+	StartAfter time.Time // cursor returned by the previous page, zero for the first page
+	Limit      int
+}
+
+func NewQueryRecentUnbondingsParams(startAfter time.Time, limit int) QueryRecentUnbondingsParams {
+	return QueryRecentUnbondingsParams{
+		StartAfter: startAfter,
+		Limit:      limit,
+	}
+}
+
+// RecentUnbondings is the result of the
+// 'custom/staking/recentUnbondings' query. NextStartAfter is the
+// cursor to fetch the next page with, and is zero once the unbonding queue has
+// been walked entirely.
+type RecentUnbondings struct {
+	UnbondingDelegations UnbondingDelegations `json:"unbonding_delegations" yaml:"unbonding_delegations"`
+	NextStartAfter       time.Time            `json:"next_start_after" yaml:"next_start_after"`
+}
+
+func NewRecentUnbondings(ubds UnbondingDelegations, nextStartAfter time.Time) RecentUnbondings {
+	return RecentUnbondings{
+		UnbondingDelegations: ubds,
+		NextStartAfter:       nextStartAfter,
+	}
+}
+
+// QueryQueueParams defines the params for the following queries:
+// - 'custom/staking/unbondingQueue'
+// - 'custom/staking/redelegationQueue'
+type QueryQueueParams struct {
+	StartTime time.Time // inclusive lower bound of the completion times
+	EndTime   time.Time // inclusive upper bound of the completion times
+}
+
+func NewQueryQueueParams(startTime, endTime time.Time) QueryQueueParams {
+	return QueryQueueParams{
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+}
+
+// UnbondingQueueEntry is an element of the result of the
+// 'custom/staking/unbondingQueue' query. Balance is the total amount of
+// tokens the unbonding delegation releases at CompletionTime.
+type UnbondingQueueEntry struct {
+	CompletionTime   time.Time      `json:"completion_time" yaml:"completion_time"`
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Balance          sdk.Int        `json:"balance" yaml:"balance"`
+}
+
+func NewUnbondingQueueEntry(completionTime time.Time, delegatorAddr sdk.AccAddress,
+	validatorAddr sdk.ValAddress, balance sdk.Int) UnbondingQueueEntry {
+
+	return UnbondingQueueEntry{
+		CompletionTime:   completionTime,
+		DelegatorAddress: delegatorAddr,
+		ValidatorAddress: validatorAddr,
+		Balance:          balance,
+	}
+}
+
+// RedelegationQueueEntry is an element of the result of the
+// 'custom/staking/redelegationQueue' query. InitialBalance is the total amount
+// of tokens of the redelegation entries maturing at CompletionTime.
+type RedelegationQueueEntry struct {
+	CompletionTime      time.Time      `json:"completion_time" yaml:"completion_time"`
+	DelegatorAddress    sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorSrcAddress sdk.ValAddress `json:"validator_src_address" yaml:"validator_src_address"`
+	ValidatorDstAddress sdk.ValAddress `json:"validator_dst_address" yaml:"validator_dst_address"`
+	InitialBalance      sdk.Int        `json:"initial_balance" yaml:"initial_balance"`
+}
+
+func NewRedelegationQueueEntry(completionTime time.Time, delegatorAddr sdk.AccAddress,
+	validatorSrcAddr, validatorDstAddr sdk.ValAddress, initialBalance sdk.Int) RedelegationQueueEntry {
+
+	return RedelegationQueueEntry{
+		CompletionTime:      completionTime,
+		DelegatorAddress:    delegatorAddr,
+		ValidatorSrcAddress: validatorSrcAddr,
+		ValidatorDstAddress: validatorDstAddr,
+		InitialBalance:      initialBalance,
+	}
+}
+
+// QueryValidatorsByPowerParams defines the params for the following queries:
+// - 'custom/staking/validatorsByPower'
+type QueryValidatorsByPowerParams struct {
+	TopN int // number of top validators to summarize the share of, all of them if zero
+}
+
+func NewQueryValidatorsByPowerParams(topN int) QueryValidatorsByPowerParams {
+	return QueryValidatorsByPowerParams{
+		TopN: topN,
+	}
+}
+
+// RankedValidator is an element of the result of the
+// 'custom/staking/validatorsByPower' query. PowerShare is the share of the
+// bonded tokens held by the validator, and CumulativeShare the share held by
+// the validators ranked up to and including it.
+type RankedValidator struct {
+	Rank            int       `json:"rank" yaml:"rank"`
+	Validator       Validator `json:"validator" yaml:"validator"`
+	PowerShare      sdk.Dec   `json:"power_share" yaml:"power_share"`
+	CumulativeShare sdk.Dec   `json:"cumulative_share" yaml:"cumulative_share"`
+}
+
+// ValidatorsByPower is the result of the 'custom/staking/validatorsByPower'
+// query. TopNShare is the share of the bonded tokens held by the TopN
+// validators ranked first.
+type ValidatorsByPower struct {
+	Validators  []RankedValidator `json:"validators" yaml:"validators"`
+	TotalTokens sdk.Int           `json:"total_tokens" yaml:"total_tokens"`
+	TopN        int               `json:"top_n" yaml:"top_n"`
+	TopNShare   sdk.Dec           `json:"top_n_share" yaml:"top_n_share"`
+}
+
+// NewValidatorsByPower ranks the validators by decreasing tokens, keeping the
+// given order for validators with equal tokens, and summarizes the share of the
+// tokens held by the topN first ones. A topN that is not positive or exceeds
+// the number of validators covers all of them.
+func NewValidatorsByPower(validators []Validator, topN int) ValidatorsByPower {
+	sorted := make([]Validator, len(validators))
+	copy(sorted, validators)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Tokens.GT(sorted[j].Tokens)
+	})
+
+	total := sdk.ZeroInt()
+	for _, val := range sorted {
+		total = total.Add(val.Tokens)
+	}
+
+	if topN <= 0 || topN > len(sorted) {
+		topN = len(sorted)
+	}
+
+	share := func(tokens sdk.Int) sdk.Dec {
+		if total.IsZero() {
+			return sdk.ZeroDec()
+		}
+		return tokens.ToDec().Quo(total.ToDec())
+	}
+
+	ranked := make([]RankedValidator, len(sorted))
+	cumulative := sdk.ZeroInt()
+	topNShare := sdk.ZeroDec()
+	for i, val := range sorted {
+		cumulative = cumulative.Add(val.Tokens)
+		ranked[i] = RankedValidator{
+			Rank:            i + 1,
+			Validator:       val,
+			PowerShare:      share(val.Tokens),
+			CumulativeShare: share(cumulative),
+		}
+
+		if i+1 == topN {
+			topNShare = ranked[i].CumulativeShare
+		}
+	}
+
+	return ValidatorsByPower{
+		Validators:  ranked,
+		TotalTokens: total,
+		TopN:        topN,
+		TopNShare:   topNShare,
+	}
+}
+
+// QueryHistoricalInfoParams defines the params for the following queries:
+// - 'custom/staking/historicalInfo'
+type QueryHistoricalInfoParams struct {
+	Height int64
+}
+
+// NewQueryHistoricalInfoParams creates a new QueryHistoricalInfoParams instance
+func NewQueryHistoricalInfoParams(height int64) QueryHistoricalInfoParams {
+	return QueryHistoricalInfoParams{
+		Height: height,
+	}
+}
+
+// QueryValidatorsNearCutoffParams defines the params for the following queries:
+// - 'custom/staking/validatorsNearCutoff'
+type QueryValidatorsNearCutoffParams struct {
+	Margin int64 // maximum distance in voting power to the cut-off power
+}
+
+func NewQueryValidatorsNearCutoffParams(margin int64) QueryValidatorsNearCutoffParams {
+	return QueryValidatorsNearCutoffParams{
+		Margin: margin,
+	}
+}
+
+// CutoffValidator is an element of the result of the
+// 'custom/staking/validatorsNearCutoff' query. PowerToCutoff is the voting power
+// of the validator minus the cut-off power, so that validators at risk of
+// being demoted have a small non-negative one, and validators close to
+// entering the bonded validator set a negative one.
+type CutoffValidator struct {
+	Rank          int       `json:"rank" yaml:"rank"`
+	Validator     Validator `json:"validator" yaml:"validator"`
+	Power         int64     `json:"power" yaml:"power"`
+	PowerToCutoff int64     `json:"power_to_cutoff" yaml:"power_to_cutoff"`
+}
+
+// ValidatorsNearCutoff is the result of the
+// 'custom/staking/validatorsNearCutoff' query. CutoffPower is the voting power
+// of the last validator of the bonded validator set, or zero if the set has
+// less than MaxValidators validators.
+type ValidatorsNearCutoff struct {
+	MaxValidators uint16            `json:"max_validators" yaml:"max_validators"`
+	CutoffPower   int64             `json:"cutoff_power" yaml:"cutoff_power"`
+	Margin        int64             `json:"margin" yaml:"margin"`
+	Validators    []CutoffValidator `json:"validators" yaml:"validators"`
+}
+
+// NewValidatorsNearCutoff returns the validators, sorted by decreasing voting
+// power, whose power is within margin of the cut-off power of a bonded
+// validator set of at most maxValidators validators.
+func NewValidatorsNearCutoff(validators []Validator, maxValidators uint16, margin int64) ValidatorsNearCutoff {
+	var cutoffPower int64
+	if maxValidators > 0 && len(validators) >= int(maxValidators) {
+		cutoffPower = validators[maxValidators-1].PotentialConsensusPower()
+	}
+
+	near := []CutoffValidator{}
+	for i, val := range validators {
+		power := val.PotentialConsensusPower()
+		distance := power - cutoffPower
+		if distance > margin || distance < -margin {
+			continue
+		}
+
+		near = append(near, CutoffValidator{
+			Rank:          i + 1,
+			Validator:     val,
+			Power:         power,
+			PowerToCutoff: distance,
+		})
+	}
+
+	return ValidatorsNearCutoff{
+		MaxValidators: maxValidators,
+		CutoffPower:   cutoffPower,
+		Margin:        margin,
+		Validators:    near,
+	}
+}
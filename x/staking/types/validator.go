@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -102,6 +103,26 @@ func (v Validators) ToSDKValidators() (validators []exported.ValidatorI) {
 	return validators
 }
 
+// Sort sorts the validators in ascending operator address order
+func (v Validators) Sort() {
+	sort.Sort(v)
+}
+
+// Implements sort.Interface for Validators
+func (v Validators) Len() int {
+	return len(v)
+}
+
+// Implements sort.Interface for Validators
+func (v Validators) Less(i, j int) bool {
+	return bytes.Compare(v[i].OperatorAddress, v[j].OperatorAddress) == -1
+}
+
+// Implements sort.Interface for Validators
+func (v Validators) Swap(i, j int) {
+	v[i], v[j] = v[j], v[i]
+}
+
 // NewValidator - initialize a new validator
 func NewValidator(operator sdk.ValAddress, pubKey crypto.PubKey, description Description) Validator {
 	return Validator{
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -14,6 +15,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
 )
 
+const (
+	flagPage  = "page"
+	flagLimit = "limit"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	// Group supply queries under a subcommand
@@ -34,7 +40,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 // GetCmdQueryTotalSupply implements the query total supply command.
 func GetCmdQueryTotalSupply(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "total [denom]",
 		Args:  cobra.MaximumNArgs(1),
 		Short: "Query the total supply of coins of the chain",
@@ -45,25 +51,35 @@ func GetCmdQueryTotalSupply(cdc *codec.Codec) *cobra.Command {
 Example:
 $ %s query %s total
 
+The denominations are paginated, use the --page and --limit flags to query the
+next ones:
+$ %s query %s total --page=2 --limit=50
+
 To query for the total supply of a specific coin denomination use:
 $ %s query %s total stake
 `,
 				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+				version.ClientName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
 			if len(args) == 0 {
-				return queryTotalSupply(cliCtx, cdc)
+				return queryTotalSupply(cliCtx, cdc, viper.GetInt(flagPage), viper.GetInt(flagLimit))
 			}
 			return querySupplyOf(cliCtx, cdc, args[0])
 		},
 	}
+
+	cmd.Flags().Int(flagPage, 1, "Query a specific page of the denominations")
+	cmd.Flags().Int(flagLimit, 0, "Query a number of denominations per page, 100 if zero")
+
+	return cmd
 }
 
-func queryTotalSupply(cliCtx context.CLIContext, cdc *codec.Codec) error {
-	params := types.NewQueryTotalSupplyParams(1, 0) // no pagination
+func queryTotalSupply(cliCtx context.CLIContext, cdc *codec.Codec, page, limit int) error {
+	params := types.NewQueryTotalSupplyParams(page, limit)
 	bz, err := cdc.MarshalJSON(params)
 	if err != nil {
 		return err
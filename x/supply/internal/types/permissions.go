@@ -20,8 +20,14 @@ type PermissionsForAddress struct {
 	address     sdk.AccAddress
 }
 
-// NewPermissionsForAddress creates a new PermissionsForAddress object
+// NewPermissionsForAddress creates a new PermissionsForAddress object. It
+// panics if a permission is empty, so that misconfigured module accounts are
+// detected when the supply keeper is created rather than when the account is.
 func NewPermissionsForAddress(name string, permissions []string) PermissionsForAddress {
+	if err := validatePermissions(permissions...); err != nil {
+		panic(fmt.Sprintf("invalid permissions for module account %s: %s", name, err))
+	}
+
 	return PermissionsForAddress{
 		permissions: permissions,
 		address:     NewModuleAddress(name),
@@ -27,6 +27,7 @@ func TestHasPermission(t *testing.T) {
 		require.Equal(t, tc.expectHas, has, "test case #%d", i)
 	}
 
+	require.Panics(t, func() { NewPermissionsForAddress("invalid", []string{Minter, " "}) })
 }
 
 func TestValidatePermissions(t *testing.T) {
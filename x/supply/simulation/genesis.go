@@ -15,8 +15,8 @@ import (
 // RandomizedGenState generates a random GenesisState for supply
 func RandomizedGenState(simState *module.SimulationState) {
 	numAccs := int64(len(simState.Accounts))
-	totalSupply := sdk.NewInt(simState.InitialStake * (numAccs + simState.NumBonded))
-	supplyGenesis := types.NewGenesisState(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, totalSupply)))
+	totalSupply := sdk.NewInt(simState.InitialStake).MulRaw(numAccs + simState.NumBonded)
+	supplyGenesis := types.NewGenesisState(sdk.NewCoins(sdk.NewCoin(simState.BondDenom, totalSupply)))
 
 	fmt.Printf("Generated supply parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, supplyGenesis))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(supplyGenesis)
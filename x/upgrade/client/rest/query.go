@@ -21,8 +21,12 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 
 func getCurrentPlanHandler(cliCtx context.CLIContext) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, request *http.Request) {
-		// ignore height for now
-		res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", upgrade.QuerierKey, upgrade.QueryCurrent))
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, request)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", upgrade.QuerierKey, upgrade.QueryCurrent))
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -33,12 +37,13 @@ func getCurrentPlanHandler(cliCtx context.CLIContext) func(http.ResponseWriter,
 		}
 
 		var plan upgrade.Plan
-		err = cliCtx.Codec.UnmarshalBinaryBare(res, &plan)
+		err = cliCtx.Codec.UnmarshalJSON(res, &plan)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
+		cliCtx = cliCtx.WithHeight(height)
 		rest.PostProcessResponse(w, cliCtx, plan)
 	}
 }
@@ -47,6 +52,11 @@ func getDonePlanHandler(cliCtx context.CLIContext) func(http.ResponseWriter, *ht
 	return func(w http.ResponseWriter, r *http.Request) {
 		name := mux.Vars(r)["name"]
 
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		params := upgrade.NewQueryAppliedParams(name)
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if err != nil {
@@ -54,7 +64,7 @@ func getDonePlanHandler(cliCtx context.CLIContext) func(http.ResponseWriter, *ht
 			return
 		}
 
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", upgrade.QuerierKey, upgrade.QueryApplied), bz)
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", upgrade.QuerierKey, upgrade.QueryApplied), bz)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -66,10 +76,11 @@ func getDonePlanHandler(cliCtx context.CLIContext) func(http.ResponseWriter, *ht
 		}
 		if len(res) != 8 {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, "unknown format for applied-upgrade")
+			return
 		}
 
 		applied := int64(binary.BigEndian.Uint64(res))
-		fmt.Println(applied)
+		cliCtx = cliCtx.WithHeight(height)
 		rest.PostProcessResponse(w, cliCtx, applied)
 	}
 }
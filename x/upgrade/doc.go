@@ -68,6 +68,20 @@ as well as providing the opportunity for the upgraded software to perform any ne
 (with the old binary) and applying the migration (with the new binary) are enforced in the state machine. Actually
 switching the binaries is an ops task and not handled inside the sdk / abci app.
 
+Store Migrations
+
+Upgrades that add, rename or delete stores cannot perform these migrations in the upgrade handler, as the
+stores are mounted when the app is loaded. Instead, the store migrations of an upgrade can be set by name and the
+app must load its stores with the upgrade keeper's StoreLoader:
+	app.upgradeKeeper.SetStoreUpgrades("my-fancy-upgrade", storetypes.StoreUpgrades{
+		Renamed: []storetypes.StoreRename{{OldKey: "foo", NewKey: "bar"}},
+		Deleted: []string{"baz"},
+	})
+	app.SetStoreLoader(app.upgradeKeeper.StoreLoader())
+
+When the stores are loaded with a pending plan named "my-fancy-upgrade", the migrations are applied. They are
+only persisted once the upgrade block is committed.
+
 Halt Behavior
 
 Before halting the ABCI state machine in the BeginBlocker method, the upgrade module will log an error
@@ -1,6 +1,7 @@
 package exported
 
 import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/internal/types"
 )
@@ -15,6 +16,10 @@ type Keeper interface {
 	// must be set even if it is a no-op function.
 	SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandler)
 
+	// SetStoreUpgrades sets the store migrations to apply when loading the stores with a pending upgrade plan
+	// specified by name
+	SetStoreUpgrades(name string, upgrades storetypes.StoreUpgrades)
+
 	// ClearUpgradePlan clears any schedule upgrade
 	ClearUpgradePlan(ctx sdk.Context)
 
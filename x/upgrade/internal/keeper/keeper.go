@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/internal/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -15,6 +16,7 @@ type Keeper struct {
 	storeKey        sdk.StoreKey
 	cdc             *codec.Codec
 	upgradeHandlers map[string]types.UpgradeHandler
+	storeUpgrades   map[string]storetypes.StoreUpgrades
 }
 
 // NewKeeper constructs an upgrade Keeper
@@ -23,6 +25,7 @@ func NewKeeper(storeKey sdk.StoreKey, cdc *codec.Codec) Keeper {
 		storeKey:        storeKey,
 		cdc:             cdc,
 		upgradeHandlers: map[string]types.UpgradeHandler{},
+		storeUpgrades:   map[string]storetypes.StoreUpgrades{},
 	}
 }
 
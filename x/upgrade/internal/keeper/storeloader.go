@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/internal/types"
+)
+
+// SetStoreUpgrades sets the store migrations (renamed or deleted stores) to apply when loading the stores with a
+// pending upgrade plan specified by name. They are only applied if the app uses the StoreLoader of this keeper.
+func (k Keeper) SetStoreUpgrades(name string, upgrades storetypes.StoreUpgrades) {
+	k.storeUpgrades[name] = upgrades
+}
+
+// HasStoreUpgrades returns true iff there are store upgrades set for this name
+func (k Keeper) HasStoreUpgrades(name string) bool {
+	_, ok := k.storeUpgrades[name]
+	return ok
+}
+
+// StoreLoader returns a StoreLoader that loads the latest version of the stores. If an upgrade plan is pending and
+// store upgrades were set for its name, the stores are then reloaded applying them.
+//
+// The migrations are not written to disk until the upgrade block is committed, so they are discarded if the
+// BeginBlocker halts the chain because the plan is not due yet.
+func (k Keeper) StoreLoader() baseapp.StoreLoader {
+	return func(ms sdk.CommitMultiStore) error {
+		err := baseapp.DefaultStoreLoader(ms)
+		if err != nil {
+			return err
+		}
+
+		bz := ms.GetKVStore(k.storeKey).Get(types.PlanKey())
+		if bz == nil {
+			return nil
+		}
+
+		var plan types.Plan
+		k.cdc.MustUnmarshalBinaryBare(bz, &plan)
+
+		upgrades, ok := k.storeUpgrades[plan.Name]
+		if !ok {
+			return nil
+		}
+
+		err = ms.LoadLatestVersionAndUpgrade(&upgrades)
+		if err != nil {
+			return fmt.Errorf("load and upgrade stores for upgrade \"%s\": %v", plan.Name, err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,76 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// initStores commits a first version with a value in the "foo" store and the
+// given plan, if any, in the upgrade store
+func initStores(t *testing.T, db dbm.DB, keeper Keeper, upgradeKey sdk.StoreKey, plan *Plan) {
+	cms := store.NewCommitMultiStore(db)
+	fooKey := sdk.NewKVStoreKey("foo")
+	cms.MountStoreWithDB(upgradeKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(fooKey, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms, abci.Header{Height: 1}, false, log.NewNopLogger())
+	ctx.KVStore(fooKey).Set([]byte("key"), []byte("value"))
+	if plan != nil {
+		require.NoError(t, keeper.ScheduleUpgrade(ctx, *plan))
+	}
+	cms.Commit()
+}
+
+// loadStores loads the stores with the keeper's StoreLoader, renaming "foo"
+// to "bar" in the "test" upgrade, and returns the "bar" store
+func loadStores(t *testing.T, db dbm.DB, keeper Keeper, upgradeKey sdk.StoreKey) sdk.KVStore {
+	cms := store.NewCommitMultiStore(db)
+	barKey := sdk.NewKVStoreKey("bar")
+	cms.MountStoreWithDB(upgradeKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(barKey, sdk.StoreTypeIAVL, nil)
+
+	keeper.SetStoreUpgrades("test", storetypes.StoreUpgrades{
+		Renamed: []storetypes.StoreRename{{OldKey: "foo", NewKey: "bar"}},
+	})
+	require.NoError(t, keeper.StoreLoader()(cms))
+	require.Equal(t, int64(1), cms.LastCommitID().Version)
+
+	return cms.GetKVStore(barKey)
+}
+
+func TestStoreLoader(t *testing.T) {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+
+	testCases := []struct {
+		name     string
+		plan     *Plan
+		expValue []byte
+	}{
+		{"no plan", nil, nil},
+		{"plan without store upgrades", &Plan{Name: "other", Height: 2}, nil},
+		{"plan with store upgrades", &Plan{Name: "test", Height: 2}, []byte("value")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			upgradeKey := sdk.NewKVStoreKey(StoreKey)
+			keeper := NewKeeper(upgradeKey, cdc)
+
+			initStores(t, db, keeper, upgradeKey, tc.plan)
+			bar := loadStores(t, db, keeper, upgradeKey)
+			require.Equal(t, tc.expValue, bar.Get([]byte("key")))
+		})
+	}
+}